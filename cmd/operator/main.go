@@ -53,10 +53,14 @@ import (
 	"github.com/prometheus-operator/prometheus-operator/pkg/k8sutil"
 	"github.com/prometheus-operator/prometheus-operator/pkg/kubelet"
 	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+	prompkg "github.com/prometheus-operator/prometheus-operator/pkg/prometheus"
 	prometheusagentcontroller "github.com/prometheus-operator/prometheus-operator/pkg/prometheus/agent"
 	prometheuscontroller "github.com/prometheus-operator/prometheus-operator/pkg/prometheus/server"
 	"github.com/prometheus-operator/prometheus-operator/pkg/server"
 	thanoscontroller "github.com/prometheus-operator/prometheus-operator/pkg/thanos"
+	thanoscompactorcontroller "github.com/prometheus-operator/prometheus-operator/pkg/thanos/compactor"
+	thanosqueriercontroller "github.com/prometheus-operator/prometheus-operator/pkg/thanos/querier"
+	thanosstorecontroller "github.com/prometheus-operator/prometheus-operator/pkg/thanos/store"
 	"github.com/prometheus-operator/prometheus-operator/pkg/versionutil"
 )
 
@@ -268,10 +272,13 @@ func run(fs *flag.FlagSet) int {
 	logger.Info("connection established", "kubernetes_version", cfg.KubernetesVersion.String())
 
 	var (
-		alertmanagerControllerOptions = []alertmanagercontroller.ControllerOption{}
-		promAgentControllerOptions    = []prometheusagentcontroller.ControllerOption{}
-		promControllerOptions         = []prometheuscontroller.ControllerOption{}
-		thanosControllerOptions       = []thanoscontroller.ControllerOption{}
+		alertmanagerControllerOptions    = []alertmanagercontroller.ControllerOption{}
+		promAgentControllerOptions       = []prometheusagentcontroller.ControllerOption{}
+		promControllerOptions            = []prometheuscontroller.ControllerOption{}
+		thanosControllerOptions          = []thanoscontroller.ControllerOption{}
+		thanosCompactorControllerOptions = []thanoscompactorcontroller.ControllerOption{}
+		thanosQuerierControllerOptions   = []thanosqueriercontroller.ControllerOption{}
+		thanosStoreControllerOptions     = []thanosstorecontroller.ControllerOption{}
 	)
 	if disableUnmanagedPrometheusConfiguration {
 		logger.Info("Disabling support for unmanaged Prometheus configurations")
@@ -302,6 +309,8 @@ func run(fs *flag.FlagSet) int {
 		promAgentControllerOptions = append(promAgentControllerOptions, prometheusagentcontroller.WithStorageClassValidation())
 		promControllerOptions = append(promControllerOptions, prometheuscontroller.WithStorageClassValidation())
 		thanosControllerOptions = append(thanosControllerOptions, thanoscontroller.WithStorageClassValidation())
+		thanosCompactorControllerOptions = append(thanosCompactorControllerOptions, thanoscompactorcontroller.WithStorageClassValidation())
+		thanosStoreControllerOptions = append(thanosStoreControllerOptions, thanosstorecontroller.WithStorageClassValidation())
 	}
 
 	canEmitEvents, reasons, err := k8sutil.IsAllowed(ctx, kclient.AuthorizationV1().SelfSubjectAccessReviews(), nil,
@@ -348,6 +357,77 @@ func run(fs *flag.FlagSet) int {
 		promAgentControllerOptions = append(promAgentControllerOptions, prometheusagentcontroller.WithScrapeConfig())
 	}
 
+	otlpTenantSupported, err := checkPrerequisites(
+		ctx,
+		logger,
+		kclient,
+		cfg.Namespaces.AllowList.Slice(),
+		monitoringv1alpha1.SchemeGroupVersion,
+		monitoringv1alpha1.OTLPTenantName,
+		k8sutil.ResourceAttribute{
+			Group:    monitoring.GroupName,
+			Version:  monitoringv1alpha1.Version,
+			Resource: monitoringv1alpha1.OTLPTenantName,
+			Verbs:    []string{"get", "list", "watch"},
+		},
+	)
+	if err != nil {
+		logger.Error("failed to check OTLPTenant support", "err", err)
+		cancel()
+		return 1
+	}
+	if otlpTenantSupported {
+		promControllerOptions = append(promControllerOptions, prometheuscontroller.WithOTLPTenant())
+	}
+
+	remoteWriteSupported, err := checkPrerequisites(
+		ctx,
+		logger,
+		kclient,
+		cfg.Namespaces.AllowList.Slice(),
+		monitoringv1alpha1.SchemeGroupVersion,
+		monitoringv1alpha1.RemoteWriteName,
+		k8sutil.ResourceAttribute{
+			Group:    monitoring.GroupName,
+			Version:  monitoringv1alpha1.Version,
+			Resource: monitoringv1alpha1.RemoteWriteName,
+			Verbs:    []string{"get", "list", "watch"},
+		},
+	)
+	if err != nil {
+		logger.Error("failed to check RemoteWrite support", "err", err)
+		cancel()
+		return 1
+	}
+	if remoteWriteSupported {
+		promControllerOptions = append(promControllerOptions, prometheuscontroller.WithRemoteWrite())
+		promAgentControllerOptions = append(promAgentControllerOptions, prometheusagentcontroller.WithRemoteWrite())
+	}
+
+	httpRouteSupported, err := checkPrerequisites(
+		ctx,
+		logger,
+		kclient,
+		cfg.Namespaces.AllowList.Slice(),
+		prompkg.HTTPRouteGroupVersionResource.GroupVersion(),
+		prompkg.HTTPRouteGroupVersionResource.Resource,
+		k8sutil.ResourceAttribute{
+			Group:    prompkg.HTTPRouteGroupVersionResource.Group,
+			Version:  prompkg.HTTPRouteGroupVersionResource.Version,
+			Resource: prompkg.HTTPRouteGroupVersionResource.Resource,
+			Verbs:    []string{"get", "list", "watch"},
+		},
+	)
+	if err != nil {
+		logger.Error("failed to check HTTPRoute support", "err", err)
+		cancel()
+		return 1
+	}
+	if httpRouteSupported {
+		promControllerOptions = append(promControllerOptions, prometheuscontroller.WithHTTPRoute())
+		promAgentControllerOptions = append(promAgentControllerOptions, prometheusagentcontroller.WithHTTPRoute())
+	}
+
 	// EndpointSlice v1 became available with Kubernetes v1.21.0.
 	endpointSliceSupported := cfg.KubernetesVersion.GTE(semver.MustParse("1.21.0"))
 	logger.Info("Kubernetes API capabilities", "endpointslices", endpointSliceSupported)
@@ -480,6 +560,81 @@ func run(fs *flag.FlagSet) int {
 		return 1
 	}
 
+	clusterAlertmanagerConfigSupported, err := checkPrerequisites(
+		ctx,
+		logger,
+		kclient,
+		nil,
+		monitoringv1alpha1.SchemeGroupVersion,
+		monitoringv1alpha1.ClusterAlertmanagerConfigName,
+		k8sutil.ResourceAttribute{
+			Group:    monitoring.GroupName,
+			Version:  monitoringv1alpha1.Version,
+			Resource: monitoringv1alpha1.ClusterAlertmanagerConfigName,
+			Verbs:    []string{"get", "list", "watch"},
+		},
+	)
+	if err != nil {
+		logger.Error("failed to check ClusterAlertmanagerConfig support", "err", err)
+		cancel()
+		return 1
+	}
+	if clusterAlertmanagerConfigSupported {
+		alertmanagerControllerOptions = append(alertmanagerControllerOptions, alertmanagercontroller.WithClusterAlertmanagerConfig())
+	}
+
+	alertmanagerSilenceSupported, err := checkPrerequisites(
+		ctx,
+		logger,
+		kclient,
+		cfg.Namespaces.AlertmanagerConfigAllowList.Slice(),
+		monitoringv1alpha1.SchemeGroupVersion,
+		monitoringv1alpha1.AlertmanagerSilenceName,
+		k8sutil.ResourceAttribute{
+			Group:    monitoring.GroupName,
+			Version:  monitoringv1alpha1.Version,
+			Resource: monitoringv1alpha1.AlertmanagerSilenceName,
+			Verbs:    []string{"get", "list", "watch"},
+		},
+		k8sutil.ResourceAttribute{
+			Group:    monitoring.GroupName,
+			Version:  monitoringv1alpha1.Version,
+			Resource: fmt.Sprintf("%s/status", monitoringv1alpha1.AlertmanagerSilenceName),
+			Verbs:    []string{"update"},
+		},
+	)
+	if err != nil {
+		logger.Error("failed to check AlertmanagerSilence support", "err", err)
+		cancel()
+		return 1
+	}
+	if alertmanagerSilenceSupported {
+		alertmanagerControllerOptions = append(alertmanagerControllerOptions, alertmanagercontroller.WithAlertmanagerSilence())
+	}
+
+	canListServices, err := checkPrerequisites(
+		ctx,
+		logger,
+		kclient,
+		cfg.Namespaces.AlertmanagerConfigAllowList.Slice(),
+		corev1.SchemeGroupVersion,
+		corev1.SchemeGroupVersion.WithResource("services").Resource,
+		k8sutil.ResourceAttribute{
+			Group:    corev1.GroupName,
+			Version:  corev1.SchemeGroupVersion.Version,
+			Resource: corev1.SchemeGroupVersion.WithResource("services").Resource,
+			Verbs:    []string{"list", "watch"},
+		},
+	)
+	if err != nil {
+		logger.Error("failed to check Service permissions", "err", err)
+		cancel()
+		return 1
+	}
+	if canListServices {
+		alertmanagerControllerOptions = append(alertmanagerControllerOptions, alertmanagercontroller.WithPeerServiceDiscovery())
+	}
+
 	var ao *alertmanagercontroller.Operator
 	if alertmanagerSupported {
 		ao, err = alertmanagercontroller.New(ctx, restConfig, cfg, logger, r, alertmanagerControllerOptions...)
@@ -526,6 +681,116 @@ func run(fs *flag.FlagSet) int {
 		}
 	}
 
+	thanosStoreSupported, err := checkPrerequisites(
+		ctx,
+		logger,
+		kclient,
+		cfg.Namespaces.ThanosRulerAllowList.Slice(),
+		monitoringv1alpha1.SchemeGroupVersion,
+		monitoringv1alpha1.ThanosStoreName,
+		k8sutil.ResourceAttribute{
+			Group:    monitoring.GroupName,
+			Version:  monitoringv1alpha1.Version,
+			Resource: monitoringv1alpha1.ThanosStoreName,
+			Verbs:    []string{"get", "list", "watch"},
+		},
+		k8sutil.ResourceAttribute{
+			Group:    monitoring.GroupName,
+			Version:  monitoringv1alpha1.Version,
+			Resource: fmt.Sprintf("%s/status", monitoringv1alpha1.ThanosStoreName),
+			Verbs:    []string{"update"},
+		},
+	)
+	if err != nil {
+		logger.Error("failed to check ThanosStore support", "err", err)
+		cancel()
+		return 1
+	}
+
+	var tso *thanosstorecontroller.Operator
+	if thanosStoreSupported {
+		thanosQuerierControllerOptions = append(thanosQuerierControllerOptions, thanosqueriercontroller.WithThanosStore())
+
+		tso, err = thanosstorecontroller.New(ctx, restConfig, cfg, logger, r, thanosStoreControllerOptions...)
+		if err != nil {
+			logger.Error("instantiating thanos store controller failed", "err", err)
+			cancel()
+			return 1
+		}
+	}
+
+	thanosCompactorSupported, err := checkPrerequisites(
+		ctx,
+		logger,
+		kclient,
+		cfg.Namespaces.ThanosRulerAllowList.Slice(),
+		monitoringv1alpha1.SchemeGroupVersion,
+		monitoringv1alpha1.ThanosCompactorName,
+		k8sutil.ResourceAttribute{
+			Group:    monitoring.GroupName,
+			Version:  monitoringv1alpha1.Version,
+			Resource: monitoringv1alpha1.ThanosCompactorName,
+			Verbs:    []string{"get", "list", "watch"},
+		},
+		k8sutil.ResourceAttribute{
+			Group:    monitoring.GroupName,
+			Version:  monitoringv1alpha1.Version,
+			Resource: fmt.Sprintf("%s/status", monitoringv1alpha1.ThanosCompactorName),
+			Verbs:    []string{"update"},
+		},
+	)
+	if err != nil {
+		logger.Error("failed to check ThanosCompactor support", "err", err)
+		cancel()
+		return 1
+	}
+
+	var tco *thanoscompactorcontroller.Operator
+	if thanosCompactorSupported {
+		tco, err = thanoscompactorcontroller.New(ctx, restConfig, cfg, logger, r, thanosCompactorControllerOptions...)
+		if err != nil {
+			logger.Error("instantiating thanos compactor controller failed", "err", err)
+			cancel()
+			return 1
+		}
+	}
+
+	thanosQuerierSupported, err := checkPrerequisites(
+		ctx,
+		logger,
+		kclient,
+		cfg.Namespaces.ThanosRulerAllowList.Slice(),
+		monitoringv1alpha1.SchemeGroupVersion,
+		monitoringv1alpha1.ThanosQuerierName,
+		k8sutil.ResourceAttribute{
+			Group:    monitoring.GroupName,
+			Version:  monitoringv1alpha1.Version,
+			Resource: monitoringv1alpha1.ThanosQuerierName,
+			Verbs:    []string{"get", "list", "watch"},
+		},
+		k8sutil.ResourceAttribute{
+			Group:    monitoring.GroupName,
+			Version:  monitoringv1alpha1.Version,
+			Resource: fmt.Sprintf("%s/status", monitoringv1alpha1.ThanosQuerierName),
+			Verbs:    []string{"update"},
+		},
+	)
+	if err != nil {
+		logger.Error("failed to check ThanosQuerier support", "err", err)
+		cancel()
+		return 1
+	}
+
+	var tqo *thanosqueriercontroller.Operator
+	if thanosQuerierSupported {
+		tqo, err = thanosqueriercontroller.New(ctx, restConfig, cfg, logger, r, thanosQuerierControllerOptions...)
+		if err != nil {
+			logger.Error("instantiating thanos querier controller failed", "err", err)
+			cancel()
+			return 1
+		}
+	}
+
 	var kec *kubelet.Controller
 	if kubeletObject != "" {
 		opts := []kubelet.ControllerOption{kubelet.WithNodeAddressPriority(nodeAddressPriority.String())}
@@ -584,7 +849,7 @@ func run(fs *flag.FlagSet) int {
 		}
 	}
 
-	if po == nil && pao == nil && ao == nil && to == nil && kec == nil {
+	if po == nil && pao == nil && ao == nil && to == nil && tqo == nil && tso == nil && tco == nil && kec == nil {
 		logger.Error("no controller can be started, check the RBAC permissions of the service account")
 		cancel()
 		return 1
@@ -630,6 +895,15 @@ func run(fs *flag.FlagSet) int {
 	if to != nil {
 		wg.Go(func() error { return to.Run(ctx) })
 	}
+	if tqo != nil {
+		wg.Go(func() error { return tqo.Run(ctx) })
+	}
+	if tso != nil {
+		wg.Go(func() error { return tso.Run(ctx) })
+	}
+	if tco != nil {
+		wg.Go(func() error { return tco.Run(ctx) })
+	}
 	if kec != nil {
 		wg.Go(func() error { return kec.Run(ctx) })
 	}
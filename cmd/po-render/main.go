@@ -0,0 +1,227 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// po-render prints the configuration files that the operator would generate
+// for the Prometheus, PrometheusAgent and Alertmanager resources read from
+// disk, without connecting to a Kubernetes cluster.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/prometheus-operator/prometheus-operator/pkg/alertmanager"
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	monitoringfake "github.com/prometheus-operator/prometheus-operator/pkg/client/versioned/fake"
+	prometheusagent "github.com/prometheus-operator/prometheus-operator/pkg/prometheus/agent"
+	prometheus "github.com/prometheus-operator/prometheus-operator/pkg/prometheus/server"
+	"github.com/prometheus-operator/prometheus-operator/pkg/versionutil"
+)
+
+func main() {
+	os.Exit(run())
+}
+
+func run() int {
+	fs := flag.NewFlagSet(os.Args[0], flag.ExitOnError)
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "Usage: %s [flags] <file or directory>...\n\n", filepath.Base(os.Args[0]))
+		fmt.Fprintln(fs.Output(), "Print the configuration files generated by the operator for the Prometheus, PrometheusAgent and Alertmanager resources.")
+		fmt.Fprintln(fs.Output())
+		fs.PrintDefaults()
+	}
+
+	var (
+		outputDir string
+		namespace string
+		logLevel  string
+	)
+	fs.StringVar(&outputDir, "output-dir", "", "Directory where the configuration files are written. If empty, the files are printed to the standard output.")
+	fs.StringVar(&namespace, "namespace", "default", "Namespace of the objects which don't define one.")
+	fs.StringVar(&logLevel, "log-level", "warn", "Log level to use. Possible values: debug, info, warn, error. Logs are written to the standard error.")
+	versionutil.RegisterFlags(fs)
+
+	// No need to check for errors because Parse would exit on error.
+	_ = fs.Parse(os.Args[1:])
+
+	if versionutil.ShouldPrintVersion() {
+		versionutil.Print(os.Stdout, "po-render")
+		return 0
+	}
+
+	if fs.NArg() == 0 {
+		fs.Usage()
+		return 1
+	}
+
+	var level slog.Level
+	if err := level.UnmarshalText([]byte(logLevel)); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	// The standard output is reserved for the configuration files.
+	logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: level}))
+
+	m := newManifests()
+	if err := m.loadFiles(logger, fs.Args(), namespace); err != nil {
+		logger.Error("failed to load manifests", "err", err)
+		return 1
+	}
+
+	if err := m.decode(logger); err != nil {
+		logger.Error("failed to decode manifests", "err", err)
+		return 1
+	}
+
+	files, err := render(context.Background(), logger, m)
+	if err != nil {
+		logger.Error("failed to render configuration", "err", err)
+		return 1
+	}
+
+	if outputDir == "" {
+		if err := printFiles(os.Stdout, files); err != nil {
+			logger.Error("failed to print configuration", "err", err)
+			return 1
+		}
+		return 0
+	}
+
+	if err := writeFiles(outputDir, files); err != nil {
+		logger.Error("failed to write configuration", "err", err)
+		return 1
+	}
+
+	return 0
+}
+
+// render returns the configuration files of all the Prometheus,
+// PrometheusAgent and Alertmanager objects. The files are prefixed by
+// "<kind>/<namespace>/<name>/".
+func render(ctx context.Context, logger *slog.Logger, m *manifests) (map[string][]byte, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	res := map[string][]byte{}
+	add := func(kind, namespace, name string, files map[string][]byte) {
+		for f, b := range files {
+			res[path.Join(kind, namespace, name, f)] = b
+		}
+	}
+
+	// Each object gets its own set of clients so that the objects generated
+	// for one don't leak to the others.
+	newClients := func() (*fake.Clientset, *monitoringfake.Clientset) {
+		return fake.NewSimpleClientset(m.objects()...), monitoringfake.NewSimpleClientset(deepCopyObjects(m.monitoringObjects)...)
+	}
+
+	for _, p := range m.prometheuses {
+		kclient, mclient := newClients()
+		files, err := prometheus.RenderConfiguration(ctx, logger, kclient, mclient, p)
+		if err != nil {
+			return nil, fmt.Errorf("%s %s/%s: %w", monitoringv1.PrometheusesKind, p.Namespace, p.Name, err)
+		}
+		add(monitoringv1.PrometheusName, p.Namespace, p.Name, files)
+	}
+
+	for _, p := range m.agents {
+		kclient, mclient := newClients()
+		files, err := prometheusagent.RenderConfiguration(ctx, logger, kclient, mclient, p)
+		if err != nil {
+			return nil, fmt.Errorf("%s %s/%s: %w", monitoringv1alpha1.PrometheusAgentsKind, p.Namespace, p.Name, err)
+		}
+		add(monitoringv1alpha1.PrometheusAgentName, p.Namespace, p.Name, files)
+	}
+
+	for _, am := range m.alertmanagers {
+		kclient, mclient := newClients()
+		files, err := alertmanager.RenderConfiguration(ctx, logger, kclient, mclient, am)
+		if err != nil {
+			return nil, fmt.Errorf("%s %s/%s: %w", monitoringv1.AlertmanagersKind, am.Namespace, am.Name, err)
+		}
+		add(monitoringv1.AlertmanagerName, am.Namespace, am.Name, files)
+	}
+
+	return res, nil
+}
+
+func deepCopyObjects(objs []runtime.Object) []runtime.Object {
+	res := make([]runtime.Object, 0, len(objs))
+	for _, o := range objs {
+		res = append(res, o.DeepCopyObject())
+	}
+
+	return res
+}
+
+// printFiles writes the files sorted by name, each one preceded by a comment
+// line with its name.
+func printFiles(w io.Writer, files map[string][]byte) error {
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	for i, name := range names {
+		if i > 0 {
+			if _, err := fmt.Fprintln(w); err != nil {
+				return err
+			}
+		}
+
+		if _, err := fmt.Fprintf(w, "# Source: %s\n", name); err != nil {
+			return err
+		}
+
+		b := files[name]
+		if _, err := w.Write(b); err != nil {
+			return err
+		}
+
+		if len(b) > 0 && b[len(b)-1] != '\n' {
+			if _, err := fmt.Fprintln(w); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func writeFiles(dir string, files map[string][]byte) error {
+	for name, b := range files {
+		p := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(p), 0o755); err != nil {
+			return err
+		}
+
+		if err := os.WriteFile(p, b, 0o644); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,239 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testManifests = `
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: monitoring
+  labels:
+    team: sre
+---
+apiVersion: monitoring.coreos.com/v1
+kind: Prometheus
+metadata:
+  name: main
+  namespace: monitoring
+spec:
+  serviceMonitorSelector: {}
+  serviceMonitorNamespaceSelector:
+    matchLabels:
+      team: sre
+  ruleSelector: {}
+---
+apiVersion: monitoring.coreos.com/v1alpha1
+kind: PrometheusAgent
+metadata:
+  name: agent
+spec:
+  podMonitorSelector: {}
+---
+apiVersion: monitoring.coreos.com/v1
+kind: ServiceMonitor
+metadata:
+  name: app
+  namespace: monitoring
+spec:
+  selector:
+    matchLabels:
+      app: app
+  endpoints:
+  - port: web
+    bearerTokenSecret:
+      name: token
+      key: token
+---
+apiVersion: monitoring.coreos.com/v1
+kind: ServiceMonitor
+metadata:
+  name: ignored
+  namespace: default
+spec:
+  selector: {}
+  endpoints:
+  - port: web
+---
+apiVersion: v1
+kind: Secret
+metadata:
+  name: token
+  namespace: monitoring
+stringData:
+  token: secret
+---
+apiVersion: monitoring.coreos.com/v1
+kind: PodMonitor
+metadata:
+  name: pod
+spec:
+  selector: {}
+  podMetricsEndpoints:
+  - port: metrics
+---
+apiVersion: monitoring.coreos.com/v1
+kind: PrometheusRule
+metadata:
+  name: rules
+  namespace: monitoring
+spec:
+  groups:
+  - name: test
+    rules:
+    - alert: Test
+      expr: vector(1)
+---
+apiVersion: monitoring.coreos.com/v1
+kind: Alertmanager
+metadata:
+  name: main
+  namespace: monitoring
+spec:
+  alertmanagerConfigSelector: {}
+  clusterAlertmanagerConfigSelector:
+    matchLabels:
+      scope: platform
+---
+apiVersion: monitoring.coreos.com/v1alpha1
+kind: ClusterAlertmanagerConfig
+metadata:
+  name: platform
+  labels:
+    scope: platform
+spec:
+  route:
+    receiver: pager
+    matchers:
+    - name: severity
+      value: critical
+      matchType: "="
+  receivers:
+  - name: pager
+    webhookConfigs:
+    - url: http://pager.example.com/
+---
+apiVersion: monitoring.coreos.com/v1beta1
+kind: AlertmanagerConfig
+metadata:
+  name: team
+  namespace: monitoring
+spec:
+  route:
+    receiver: webhook
+  receivers:
+  - name: webhook
+    webhookConfigs:
+    - url: http://example.com/
+---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: ignored
+---
+apiVersion: example.com/v1
+kind: Unknown
+metadata:
+  name: ignored
+`
+
+func TestRender(t *testing.T) {
+	logger := slog.New(slog.DiscardHandler)
+
+	m := newManifests()
+	require.NoError(t, m.loadFiles(logger, []string{"../../example/prometheus-operator-crd"}, "default"))
+	require.NoError(t, m.load(logger, strings.NewReader(testManifests), "default"))
+	require.NoError(t, m.decode(logger))
+
+	files, err := render(context.Background(), logger, m)
+	require.NoError(t, err)
+
+	require.ElementsMatch(t,
+		[]string{
+			"prometheuses/monitoring/main/prometheus.yaml",
+			"prometheuses/monitoring/main/rules/monitoring-rules-.yaml",
+			"prometheusagents/default/agent/prometheus.yaml",
+			"alertmanagers/monitoring/main/alertmanager.yaml",
+		},
+		keys(files),
+	)
+
+	prom := string(files["prometheuses/monitoring/main/prometheus.yaml"])
+	require.Contains(t, prom, "job_name: serviceMonitor/monitoring/app/0")
+	// Default value from the CRD.
+	require.Contains(t, prom, "scrape_interval: 30s")
+	require.Contains(t, prom, "bearer_token: secret")
+	require.NotContains(t, prom, "serviceMonitor/default/ignored/0")
+	require.Contains(t, prom, "/etc/prometheus/rules/prometheus-main-rulefiles-0/*.yaml")
+
+	require.Contains(t, string(files["prometheuses/monitoring/main/rules/monitoring-rules-.yaml"]), "alert: Test")
+
+	require.Contains(t, string(files["prometheusagents/default/agent/prometheus.yaml"]), "job_name: podMonitor/default/pod/0")
+
+	am := string(files["alertmanagers/monitoring/main/alertmanager.yaml"])
+	require.Contains(t, am, "receiver: monitoring/team/webhook")
+	require.Contains(t, am, "url: http://example.com/")
+	require.Contains(t, am, "receiver: platform/pager")
+	require.Less(t, strings.Index(am, "receiver: platform/pager"), strings.Index(am, "receiver: monitoring/team/webhook"))
+}
+
+func TestRenderMissingSecret(t *testing.T) {
+	logger := slog.New(slog.DiscardHandler)
+
+	m := newManifests()
+	require.NoError(t, m.load(logger, strings.NewReader(`
+apiVersion: monitoring.coreos.com/v1
+kind: Prometheus
+metadata:
+  name: main
+spec:
+  serviceMonitorSelector: {}
+  additionalScrapeConfigs:
+    name: missing
+    key: config.yaml
+`), "default"))
+	require.NoError(t, m.decode(logger))
+
+	_, err := render(context.Background(), logger, m)
+	require.Error(t, err)
+}
+
+func TestPrintFiles(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, printFiles(&buf, map[string][]byte{
+		"b/file.yaml": []byte("b: 1"),
+		"a/file.yaml": []byte("a: 1\n"),
+	}))
+
+	require.Equal(t, "# Source: a/file.yaml\na: 1\n\n# Source: b/file.yaml\nb: 1\n", buf.String())
+}
+
+func keys(m map[string][]byte) []string {
+	res := make([]string, 0, len(m))
+	for k := range m {
+		res = append(res, k)
+	}
+
+	return res
+}
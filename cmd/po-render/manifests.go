@@ -0,0 +1,353 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"log/slog"
+	"os"
+	"path/filepath"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	structuralschema "k8s.io/apiextensions-apiserver/pkg/apiserver/schema"
+	"k8s.io/apiextensions-apiserver/pkg/apiserver/schema/defaulting"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	k8syaml "k8s.io/apimachinery/pkg/util/yaml"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	monitoringv1beta1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1beta1"
+	monitoringscheme "github.com/prometheus-operator/prometheus-operator/pkg/client/versioned/scheme"
+)
+
+var (
+	scheme  = runtime.NewScheme()
+	decoder runtime.Decoder
+)
+
+func init() {
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(monitoringscheme.AddToScheme(scheme))
+	utilruntime.Must(apiextensionsv1.AddToScheme(scheme))
+
+	decoder = serializer.NewCodecFactory(scheme).UniversalDeserializer()
+}
+
+// document is a decoded object which hasn't been converted to its typed
+// representation yet.
+type document struct {
+	obj              *unstructured.Unstructured
+	defaultNamespace string
+}
+
+// manifests holds the objects loaded from disk.
+type manifests struct {
+	// Documents waiting to be decoded.
+	documents []document
+	// Structural schemas from the CustomResourceDefinitions, used to apply
+	// the default values.
+	schemas map[schema.GroupVersionKind]*structuralschema.Structural
+
+	// Namespaces, Secrets and ConfigMaps.
+	kubeObjects []runtime.Object
+	// All the objects of the monitoring.coreos.com group.
+	monitoringObjects []runtime.Object
+
+	prometheuses  []*monitoringv1.Prometheus
+	agents        []*monitoringv1alpha1.PrometheusAgent
+	alertmanagers []*monitoringv1.Alertmanager
+
+	namespaces map[string]*v1.Namespace
+}
+
+func newManifests() *manifests {
+	return &manifests{
+		namespaces: map[string]*v1.Namespace{},
+		schemas:    map[schema.GroupVersionKind]*structuralschema.Structural{},
+	}
+}
+
+// loadFiles reads the manifests from the given files and directories.
+// Directories are walked recursively and only the files with the ".yaml",
+// ".yml" and ".json" extensions are read.
+//
+// The CustomResourceDefinitions found in the manifests are used to apply the
+// default values of the custom resources.
+func (m *manifests) loadFiles(logger *slog.Logger, paths []string, defaultNamespace string) error {
+	for _, p := range paths {
+		err := filepath.WalkDir(p, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+
+			if d.IsDir() {
+				return nil
+			}
+
+			// Explicit file arguments are always read.
+			if path != p {
+				switch filepath.Ext(path) {
+				case ".yaml", ".yml", ".json":
+				default:
+					return nil
+				}
+			}
+
+			f, err := os.Open(path)
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+
+			if err := m.load(logger.With("file", path), f, defaultNamespace); err != nil {
+				return fmt.Errorf("%s: %w", path, err)
+			}
+
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// load reads all the YAML or JSON documents from r. The objects are
+// converted to their typed representation by decode, once all the
+// CustomResourceDefinitions are known.
+func (m *manifests) load(logger *slog.Logger, r io.Reader, defaultNamespace string) error {
+	reader := k8syaml.NewYAMLReader(bufio.NewReader(r))
+	for {
+		doc, err := reader.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+
+		if len(bytes.TrimSpace(doc)) == 0 {
+			continue
+		}
+
+		b, err := k8syaml.ToJSON(doc)
+		if err != nil {
+			return err
+		}
+
+		u := &unstructured.Unstructured{}
+		if err := u.UnmarshalJSON(b); err != nil {
+			if runtime.IsMissingKind(err) {
+				logger.Debug("skipping object without kind", "err", err)
+				continue
+			}
+			return err
+		}
+
+		if u.GroupVersionKind().GroupKind() == apiextensionsv1.Kind("CustomResourceDefinition") {
+			if err := m.addCRD(b); err != nil {
+				return fmt.Errorf("CustomResourceDefinition %s: %w", u.GetName(), err)
+			}
+			continue
+		}
+
+		m.documents = append(m.documents, document{obj: u, defaultNamespace: defaultNamespace})
+	}
+}
+
+// addCRD records the structural schemas of the served versions.
+func (m *manifests) addCRD(b []byte) error {
+	crd := &apiextensionsv1.CustomResourceDefinition{}
+	if _, _, err := decoder.Decode(b, nil, crd); err != nil {
+		return err
+	}
+
+	for _, version := range crd.Spec.Versions {
+		if !version.Served || version.Schema == nil || version.Schema.OpenAPIV3Schema == nil {
+			continue
+		}
+
+		props := &apiextensions.JSONSchemaProps{}
+		if err := apiextensionsv1.Convert_v1_JSONSchemaProps_To_apiextensions_JSONSchemaProps(version.Schema.OpenAPIV3Schema, props, nil); err != nil {
+			return fmt.Errorf("version %s: %w", version.Name, err)
+		}
+
+		s, err := structuralschema.NewStructural(props)
+		if err != nil {
+			return fmt.Errorf("version %s: %w", version.Name, err)
+		}
+
+		m.schemas[schema.GroupVersionKind{
+			Group:   crd.Spec.Group,
+			Version: version.Name,
+			Kind:    crd.Spec.Names.Kind,
+		}] = s
+	}
+
+	return nil
+}
+
+// decode applies the default values from the CustomResourceDefinitions to
+// the loaded documents and converts them to typed objects. The objects of
+// unknown kinds are skipped.
+func (m *manifests) decode(logger *slog.Logger) error {
+	missingSchemas := map[schema.GroupVersionKind]struct{}{}
+
+	for _, doc := range m.documents {
+		gvk := doc.obj.GroupVersionKind()
+
+		if s, found := m.schemas[gvk]; found {
+			defaulting.Default(doc.obj.Object, s)
+		} else if gvk.Group == monitoringv1.SchemeGroupVersion.Group {
+			missingSchemas[gvk] = struct{}{}
+		}
+
+		obj, err := scheme.New(gvk)
+		if err != nil {
+			if runtime.IsNotRegisteredError(err) {
+				logger.Debug("skipping unsupported object", "kind", gvk.String(), "name", doc.obj.GetName())
+				continue
+			}
+			return err
+		}
+
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(doc.obj.Object, obj); err != nil {
+			return fmt.Errorf("%s %s: %w", gvk.Kind, doc.obj.GetName(), err)
+		}
+
+		if err := m.add(obj, doc.defaultNamespace); err != nil {
+			return fmt.Errorf("%s %s: %w", gvk.Kind, doc.obj.GetName(), err)
+		}
+	}
+	m.documents = nil
+
+	for gvk := range missingSchemas {
+		logger.Warn("no CustomResourceDefinition found, the default values won't be applied", "kind", gvk.String())
+	}
+
+	return nil
+}
+
+func (m *manifests) add(obj runtime.Object, defaultNamespace string) error {
+	// Cluster-scoped objects.
+	switch o := obj.(type) {
+	case *v1.Namespace:
+		m.namespaces[o.Name] = o
+		return nil
+
+	case *monitoringv1alpha1.ClusterAlertmanagerConfig:
+		o.Namespace = ""
+		m.monitoringObjects = append(m.monitoringObjects, o)
+		return nil
+	}
+
+	if o, ok := obj.(interface {
+		GetNamespace() string
+		SetNamespace(string)
+	}); ok && o.GetNamespace() == "" {
+		o.SetNamespace(defaultNamespace)
+	}
+
+	switch o := obj.(type) {
+
+	case *v1.Secret:
+		// Merge the string data like the Kubernetes API server does.
+		for k, v := range o.StringData {
+			if o.Data == nil {
+				o.Data = map[string][]byte{}
+			}
+			o.Data[k] = []byte(v)
+		}
+		o.StringData = nil
+		m.kubeObjects = append(m.kubeObjects, o)
+
+	case *v1.ConfigMap:
+		m.kubeObjects = append(m.kubeObjects, o)
+
+	case *monitoringv1.Prometheus:
+		m.prometheuses = append(m.prometheuses, o)
+		m.monitoringObjects = append(m.monitoringObjects, o)
+
+	case *monitoringv1alpha1.PrometheusAgent:
+		m.agents = append(m.agents, o)
+		m.monitoringObjects = append(m.monitoringObjects, o)
+
+	case *monitoringv1.Alertmanager:
+		m.alertmanagers = append(m.alertmanagers, o)
+		m.monitoringObjects = append(m.monitoringObjects, o)
+
+	case *monitoringv1beta1.AlertmanagerConfig:
+		// The operator works with the v1alpha1 version (storage version).
+		amc := &monitoringv1alpha1.AlertmanagerConfig{}
+		if err := o.ConvertTo(amc); err != nil {
+			return fmt.Errorf("failed to convert to %s: %w", monitoringv1alpha1.SchemeGroupVersion, err)
+		}
+		m.monitoringObjects = append(m.monitoringObjects, amc)
+
+	case *monitoringv1.ServiceMonitor,
+		*monitoringv1.PodMonitor,
+		*monitoringv1.Probe,
+		*monitoringv1.PrometheusRule,
+		*monitoringv1alpha1.ScrapeConfig,
+		*monitoringv1alpha1.OTLPTenant,
+		*monitoringv1alpha1.AlertmanagerConfig:
+		m.monitoringObjects = append(m.monitoringObjects, o)
+
+	default:
+		return nil
+	}
+
+	ns := obj.(interface{ GetNamespace() string }).GetNamespace()
+	if _, found := m.namespaces[ns]; !found {
+		m.namespaces[ns] = &v1.Namespace{}
+		m.namespaces[ns].Name = ns
+	}
+
+	return nil
+}
+
+// objects returns the Kubernetes objects, including the namespaces.
+func (m *manifests) objects() []runtime.Object {
+	objs := make([]runtime.Object, 0, len(m.kubeObjects)+len(m.namespaces))
+	for name, ns := range m.namespaces {
+		ns = ns.DeepCopy()
+		// The label is set by the Kubernetes API server.
+		if ns.Labels == nil {
+			ns.Labels = map[string]string{}
+		}
+		ns.Labels["kubernetes.io/metadata.name"] = name
+
+		objs = append(objs, ns)
+	}
+
+	for _, o := range m.kubeObjects {
+		objs = append(objs, o.DeepCopyObject())
+	}
+
+	return objs
+}
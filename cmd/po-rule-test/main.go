@@ -0,0 +1,126 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// po-rule-test runs unit tests against the rule groups of PrometheusRule
+// objects. The test files use the same format as `promtool test rules`
+// except that the `rule_files` field references manifests containing
+// PrometheusRule objects instead of Prometheus rule files.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/prometheus-operator/prometheus-operator/pkg/namespacelabeler"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+	"github.com/prometheus-operator/prometheus-operator/pkg/versionutil"
+)
+
+const (
+	successExitCode = 0
+	failureExitCode = 1
+	usageExitCode   = 2
+)
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+func run(args []string, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("po-rule-test", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "Usage: %s [flags] <test file>...\n\n", filepath.Base(os.Args[0]))
+		fmt.Fprintln(fs.Output(), "Run unit tests against the rule groups of PrometheusRule objects.")
+		fmt.Fprintln(fs.Output())
+		fs.PrintDefaults()
+	}
+
+	var (
+		runPattern             string
+		promVersion            string
+		enforcedNamespaceLabel string
+	)
+	fs.StringVar(&runPattern, "run", "", "If set, only the test groups whose name matches the regular expression are run.")
+	fs.StringVar(&promVersion, "prometheus-version", operator.DefaultPrometheusVersion, "Version of Prometheus loading the rules. The rule fields unsupported by the version are removed like the operator does.")
+	fs.StringVar(&enforcedNamespaceLabel, "enforced-namespace-label", "", "If set, the label is enforced on the rules with the namespace of the PrometheusRule object like the operator does when the Prometheus resource defines `enforcedNamespaceLabel`.")
+	versionutil.RegisterFlags(fs)
+
+	if err := fs.Parse(args); err != nil {
+		return usageExitCode
+	}
+
+	if versionutil.ShouldPrintVersion() {
+		versionutil.Print(stdout, "po-rule-test")
+		return successExitCode
+	}
+
+	if fs.NArg() == 0 {
+		fs.Usage()
+		return usageExitCode
+	}
+
+	var run *regexp.Regexp
+	if runPattern != "" {
+		var err error
+		run, err = regexp.Compile(runPattern)
+		if err != nil {
+			fmt.Fprintf(stderr, "invalid -run value: %v\n", err)
+			return usageExitCode
+		}
+	}
+
+	failed := false
+	for _, f := range fs.Args() {
+		fmt.Fprintln(stdout, "Unit Testing:", f)
+
+		errs := ruleUnitTest(f, run, ruleFileOptions{
+			version:   promVersion,
+			nsLabeler: namespacelabeler.New(enforcedNamespaceLabel, nil, true),
+		})
+		if len(errs) == 0 {
+			fmt.Fprintln(stdout, "  SUCCESS")
+			fmt.Fprintln(stdout)
+			continue
+		}
+
+		failed = true
+		fmt.Fprintln(stdout, "  FAILED:")
+		for _, err := range errs {
+			fmt.Fprintln(stdout, indentLines(err.Error(), "    "))
+		}
+		fmt.Fprintln(stdout)
+	}
+
+	if failed {
+		return failureExitCode
+	}
+
+	return successExitCode
+}
+
+// indentLines prefixes each line with the given indentation.
+func indentLines(s, indent string) string {
+	lines := strings.Split(s, "\n")
+	for i := range lines {
+		lines[i] = indent + lines[i]
+	}
+
+	return strings.Join(lines, "\n")
+}
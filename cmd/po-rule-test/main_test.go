@@ -0,0 +1,86 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRun(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		args     []string
+		exitCode int
+		contains []string
+	}{
+		{
+			name:     "success",
+			args:     []string{"testdata/success.yaml"},
+			exitCode: successExitCode,
+			contains: []string{"SUCCESS"},
+		},
+		{
+			name:     "failure",
+			args:     []string{"testdata/failure.yaml"},
+			exitCode: failureExitCode,
+			contains: []string{
+				"FAILED",
+				"name: instance up",
+				"alertname: InstanceDown, time: 10m",
+				`instance="localhost:9090"`,
+			},
+		},
+		{
+			name:     "run filter",
+			args:     []string{"-run", "^other$", "testdata/failure.yaml"},
+			exitCode: successExitCode,
+			contains: []string{"SUCCESS"},
+		},
+		{
+			name:     "enforced namespace label",
+			args:     []string{"-enforced-namespace-label", "namespace", "testdata/enforced-namespace-label.yaml"},
+			exitCode: successExitCode,
+			contains: []string{"SUCCESS"},
+		},
+		{
+			name:     "enforced namespace label with series from other namespaces",
+			args:     []string{"-enforced-namespace-label", "namespace", "testdata/success.yaml"},
+			exitCode: failureExitCode,
+			contains: []string{"FAILED", "name: instance down"},
+		},
+		{
+			name:     "missing file",
+			args:     []string{"testdata/missing.yaml"},
+			exitCode: failureExitCode,
+			contains: []string{"FAILED"},
+		},
+		{
+			name:     "no argument",
+			exitCode: usageExitCode,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var stdout, stderr bytes.Buffer
+			require.Equal(t, tc.exitCode, run(tc.args, &stdout, &stderr), "stdout: %s\nstderr: %s", stdout.String(), stderr.String())
+
+			for _, s := range tc.contains {
+				require.Contains(t, stdout.String(), s)
+			}
+		})
+	}
+}
@@ -0,0 +1,621 @@
+// Copyright 2018 The Prometheus Authors
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// This file is derived from prometheus/cmd/promtool/unittest.go @ v0.304.1.
+// It has been modified to load the rule groups from PrometheusRule objects
+// instead of Prometheus rule files.
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/prometheus/common/model"
+	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/model/rulefmt"
+	"github.com/prometheus/prometheus/promql"
+	"github.com/prometheus/prometheus/promql/parser"
+	"github.com/prometheus/prometheus/promql/promqltest"
+	"github.com/prometheus/prometheus/rules"
+	yamlv2 "gopkg.in/yaml.v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8syaml "k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/yaml"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/namespacelabeler"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+)
+
+// unitTestFile holds the contents of a single unit test file.
+type unitTestFile struct {
+	// Files containing PrometheusRule objects. Relative paths are resolved
+	// from the directory of the test file and globs are supported.
+	RuleFiles          []string       `yaml:"rule_files"`
+	EvaluationInterval model.Duration `yaml:"evaluation_interval,omitempty"`
+	GroupEvalOrder     []string       `yaml:"group_eval_order"`
+	Tests              []testGroup    `yaml:"tests"`
+}
+
+// testGroup is a group of input series and tests associated with it.
+type testGroup struct {
+	Interval        model.Duration    `yaml:"interval"`
+	InputSeries     []series          `yaml:"input_series"`
+	AlertRuleTests  []alertTestCase   `yaml:"alert_rule_test,omitempty"`
+	PromqlExprTests []promqlTestCase  `yaml:"promql_expr_test,omitempty"`
+	ExternalLabels  map[string]string `yaml:"external_labels,omitempty"`
+	ExternalURL     string            `yaml:"external_url,omitempty"`
+	TestGroupName   string            `yaml:"name,omitempty"`
+}
+
+type series struct {
+	Series string `yaml:"series"`
+	Values string `yaml:"values"`
+}
+
+type alertTestCase struct {
+	EvalTime  model.Duration `yaml:"eval_time"`
+	Alertname string         `yaml:"alertname"`
+	ExpAlerts []alert        `yaml:"exp_alerts"`
+}
+
+type alert struct {
+	ExpLabels      map[string]string `yaml:"exp_labels"`
+	ExpAnnotations map[string]string `yaml:"exp_annotations"`
+}
+
+type promqlTestCase struct {
+	Expr       string         `yaml:"expr"`
+	EvalTime   model.Duration `yaml:"eval_time"`
+	ExpSamples []sample       `yaml:"exp_samples"`
+}
+
+type sample struct {
+	Labels    string  `yaml:"labels"`
+	Value     float64 `yaml:"value"`
+	Histogram string  `yaml:"histogram"` // A non-empty string means Value is ignored.
+}
+
+// ruleFileOptions defines how the PrometheusRule objects are converted to rule
+// files.
+type ruleFileOptions struct {
+	// Prometheus version used to sanitize the rules.
+	version string
+	// Labeler enforcing the namespace label.
+	nsLabeler *namespacelabeler.Labeler
+}
+
+// ruleUnitTest runs the tests of the given file. Only the test groups whose
+// name matches run are evaluated (all if nil).
+func ruleUnitTest(filename string, run *regexp.Regexp, opts ruleFileOptions) []error {
+	b, err := os.ReadFile(filename)
+	if err != nil {
+		return []error{err}
+	}
+
+	var utf unitTestFile
+	if err := yamlv2.UnmarshalStrict(b, &utf); err != nil {
+		return []error{err}
+	}
+
+	if utf.EvaluationInterval == 0 {
+		utf.EvaluationInterval = model.Duration(time.Minute)
+	}
+
+	loader, err := loadPrometheusRules(filepath.Dir(filename), utf.RuleFiles, opts)
+	if err != nil {
+		return []error{err}
+	}
+
+	groupOrder := make(map[string]int, len(utf.GroupEvalOrder))
+	for i, gn := range utf.GroupEvalOrder {
+		if _, found := groupOrder[gn]; found {
+			return []error{fmt.Errorf("group name repeated in evaluation order: %s", gn)}
+		}
+		groupOrder[gn] = i
+	}
+
+	var errs []error
+	for i, tg := range utf.Tests {
+		if run != nil && !run.MatchString(tg.TestGroupName) {
+			continue
+		}
+
+		if tg.Interval == 0 {
+			tg.Interval = utf.EvaluationInterval
+		}
+
+		name := tg.TestGroupName
+		if name == "" {
+			name = fmt.Sprintf("unnamed#%d", i)
+		}
+
+		for _, err := range tg.test(time.Duration(utf.EvaluationInterval), groupOrder, loader) {
+			errs = append(errs, fmt.Errorf("name: %s\n%w", name, err))
+		}
+	}
+
+	return errs
+}
+
+// prometheusRuleLoader implements rules.GroupLoader for the rule groups of
+// PrometheusRule objects. The identifiers are "<file>:<namespace>/<name>".
+type prometheusRuleLoader struct {
+	opts        ruleFileOptions
+	identifiers []string
+	contents    map[string][]byte
+}
+
+var _ rules.GroupLoader = &prometheusRuleLoader{}
+
+func (l *prometheusRuleLoader) Load(identifier string, ignoreUnknownFields bool) (*rulefmt.RuleGroups, []error) {
+	content, found := l.contents[identifier]
+	if !found {
+		return nil, []error{fmt.Errorf("%s: unknown PrometheusRule", identifier)}
+	}
+
+	rgs, errs := rulefmt.Parse(content, ignoreUnknownFields)
+	for i := range errs {
+		errs[i] = fmt.Errorf("%s: %w", identifier, errs[i])
+	}
+
+	return rgs, errs
+}
+
+func (l *prometheusRuleLoader) Parse(query string) (parser.Expr, error) {
+	return parser.ParseExpr(query)
+}
+
+// loadPrometheusRules reads the PrometheusRule objects from the given files.
+// Other objects are ignored.
+func loadPrometheusRules(baseDir string, patterns []string, opts ruleFileOptions) (*prometheusRuleLoader, error) {
+	l := &prometheusRuleLoader{
+		opts:     opts,
+		contents: map[string][]byte{},
+	}
+
+	for _, pattern := range patterns {
+		if !filepath.IsAbs(pattern) {
+			pattern = filepath.Join(baseDir, pattern)
+		}
+
+		files, err := filepath.Glob(pattern)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(files) == 0 {
+			return nil, fmt.Errorf("no file matches pattern %q", pattern)
+		}
+
+		for _, f := range files {
+			if err := l.loadFile(f); err != nil {
+				return nil, fmt.Errorf("%s: %w", f, err)
+			}
+		}
+	}
+
+	if len(l.identifiers) == 0 {
+		return nil, errors.New("no PrometheusRule object found")
+	}
+
+	return l, nil
+}
+
+func (l *prometheusRuleLoader) loadFile(filename string) error {
+	f, err := os.Open(filename)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	reader := k8syaml.NewYAMLReader(bufio.NewReader(f))
+	for {
+		doc, err := reader.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+
+		if len(bytes.TrimSpace(doc)) == 0 {
+			continue
+		}
+
+		var tm metav1.TypeMeta
+		if err := yaml.Unmarshal(doc, &tm); err != nil {
+			return err
+		}
+
+		if tm.GroupVersionKind() != monitoringv1.SchemeGroupVersion.WithKind(monitoringv1.PrometheusRuleKind) {
+			continue
+		}
+
+		var pr monitoringv1.PrometheusRule
+		if err := yaml.UnmarshalStrict(doc, &pr); err != nil {
+			return err
+		}
+
+		if pr.Namespace == "" && l.opts.nsLabeler.GetEnforcedNamespaceLabel() != "" {
+			return fmt.Errorf("PrometheusRule %s: the namespace is required to enforce the namespace label", pr.Name)
+		}
+
+		content, err := operator.GenerateRulesConfiguration(&pr, operator.PrometheusFormat, l.opts.version, l.opts.nsLabeler, slog.New(slog.DiscardHandler))
+		if err != nil {
+			return fmt.Errorf("PrometheusRule %s/%s: %w", pr.Namespace, pr.Name, err)
+		}
+
+		id := fmt.Sprintf("%s:%s/%s", filename, pr.Namespace, pr.Name)
+		if _, found := l.contents[id]; found {
+			return fmt.Errorf("duplicate PrometheusRule %s/%s", pr.Namespace, pr.Name)
+		}
+
+		l.identifiers = append(l.identifiers, id)
+		l.contents[id] = []byte(content)
+	}
+}
+
+// test performs the unit tests of the test group.
+func (tg *testGroup) test(evalInterval time.Duration, groupOrder map[string]int, loader *prometheusRuleLoader) (outErr []error) {
+	suite, err := promqltest.NewLazyLoader(tg.seriesLoadingString(), promqltest.LazyLoaderOpts{
+		EnableAtModifier:     true,
+		EnableNegativeOffset: true,
+	})
+	if err != nil {
+		return []error{err}
+	}
+	defer func() {
+		if err := suite.Close(); err != nil {
+			outErr = append(outErr, err)
+		}
+	}()
+	suite.SubqueryInterval = evalInterval
+
+	m := rules.NewManager(&rules.ManagerOptions{
+		QueryFunc:   rules.EngineQueryFunc(suite.QueryEngine(), suite.Storage()),
+		Appendable:  suite.Storage(),
+		Context:     context.Background(),
+		NotifyFunc:  func(context.Context, string, ...*rules.Alert) {},
+		Logger:      slog.New(slog.DiscardHandler),
+		GroupLoader: loader,
+	})
+
+	groupsMap, errs := m.LoadGroups(time.Duration(tg.Interval), labels.FromMap(tg.ExternalLabels), tg.ExternalURL, nil, false, loader.identifiers...)
+	if errs != nil {
+		return errs
+	}
+
+	groups := make([]*rules.Group, 0, len(groupsMap))
+	for _, g := range groupsMap {
+		groups = append(groups, g)
+	}
+	// Like promtool, the order is partial.
+	sort.SliceStable(groups, func(i, j int) bool {
+		return groupOrder[groups[i].Name()] < groupOrder[groups[j].Name()]
+	})
+
+	for _, g := range groups {
+		for _, r := range g.Rules() {
+			if ar, ok := r.(*rules.AlertingRule); ok {
+				// Mark alerting rules as restored, to ensure the ALERTS
+				// time series are created when they run.
+				ar.SetRestored(true)
+			}
+		}
+	}
+
+	// The alert tests keyed by evaluation time.
+	alertTests := map[model.Duration][]alertTestCase{}
+	for _, at := range tg.AlertRuleTests {
+		if at.Alertname == "" {
+			return []error{fmt.Errorf("an item under alert_rule_test misses required attribute alertname at eval_time %v", at.EvalTime)}
+		}
+		alertTests[at.EvalTime] = append(alertTests[at.EvalTime], at)
+	}
+
+	alertEvalTimes := make([]model.Duration, 0, len(alertTests))
+	for t := range alertTests {
+		alertEvalTimes = append(alertEvalTimes, t)
+	}
+	slices.Sort(alertEvalTimes)
+
+	var (
+		mint = time.Unix(0, 0).UTC()
+		maxt = mint.Add(tg.maxEvalTime())
+		curr = 0
+	)
+
+	for ts := mint; !ts.After(maxt); ts = ts.Add(evalInterval) {
+		var evalErrs []error
+		suite.WithSamplesTill(ts, func(err error) {
+			if err != nil {
+				evalErrs = append(evalErrs, err)
+				return
+			}
+
+			for _, g := range groups {
+				g.Eval(suite.Context(), ts)
+				for _, r := range g.Rules() {
+					if r.LastError() != nil {
+						evalErrs = append(evalErrs, fmt.Errorf("rule: %s, time: %s, err: %w", r.Name(), ts.Sub(mint), r.LastError()))
+					}
+				}
+			}
+		})
+		if len(evalErrs) > 0 {
+			return append(errs, evalErrs...)
+		}
+
+		// The alerts expected at eval_time are compared with the state
+		// after the evaluation at ts if ts <= eval_time < ts+evalInterval.
+		for curr < len(alertEvalTimes) &&
+			ts.Sub(mint) <= time.Duration(alertEvalTimes[curr]) &&
+			time.Duration(alertEvalTimes[curr]) < ts.Add(evalInterval).Sub(mint) {
+			errs = append(errs, checkAlerts(groups, alertTests[alertEvalTimes[curr]])...)
+			curr++
+		}
+	}
+
+	for _, tc := range tg.PromqlExprTests {
+		if err := checkExpression(suite, mint, tc); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errs
+}
+
+// seriesLoadingString returns the input series in PromQL test notation.
+func (tg *testGroup) seriesLoadingString() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "load %v\n", tg.Interval)
+	for _, is := range tg.InputSeries {
+		fmt.Fprintf(&sb, "  %v %v\n", is.Series, is.Values)
+	}
+
+	return sb.String()
+}
+
+// maxEvalTime returns the maximum evaluation time of the test group.
+func (tg *testGroup) maxEvalTime() time.Duration {
+	var maxd model.Duration
+	for _, at := range tg.AlertRuleTests {
+		maxd = max(maxd, at.EvalTime)
+	}
+	for _, pt := range tg.PromqlExprTests {
+		maxd = max(maxd, pt.EvalTime)
+	}
+
+	return time.Duration(maxd)
+}
+
+// checkAlerts compares the firing alerts with the expected ones.
+func checkAlerts(groups []*rules.Group, tests []alertTestCase) []error {
+	// The same alert name may be present in several groups.
+	got := map[string]labelsAndAnnotations{}
+	for _, g := range groups {
+		for _, r := range g.Rules() {
+			ar, ok := r.(*rules.AlertingRule)
+			if !ok {
+				continue
+			}
+
+			for _, a := range ar.ActiveAlerts() {
+				if a.State != rules.StateFiring {
+					continue
+				}
+
+				got[ar.Name()] = append(got[ar.Name()], labelAndAnnotation{
+					Labels:      a.Labels.Copy(),
+					Annotations: a.Annotations.Copy(),
+				})
+			}
+		}
+	}
+
+	var errs []error
+	for _, tc := range tests {
+		gotAlerts := got[tc.Alertname]
+
+		var expAlerts labelsAndAnnotations
+		for _, a := range tc.ExpAlerts {
+			// The alertname label is added by Prometheus.
+			lbls := make(map[string]string, len(a.ExpLabels)+1)
+			for k, v := range a.ExpLabels {
+				lbls[k] = v
+			}
+			lbls[labels.AlertName] = tc.Alertname
+
+			expAlerts = append(expAlerts, labelAndAnnotation{
+				Labels:      labels.FromMap(lbls),
+				Annotations: labels.FromMap(a.ExpAnnotations),
+			})
+		}
+
+		sort.Sort(gotAlerts)
+		sort.Sort(expAlerts)
+
+		if !cmp.Equal(expAlerts, gotAlerts, cmp.Comparer(labels.Equal)) {
+			errs = append(errs, fmt.Errorf("alertname: %s, time: %s,\n    exp: %v,\n    got: %v",
+				tc.Alertname, tc.EvalTime, expAlerts, gotAlerts))
+		}
+	}
+
+	return errs
+}
+
+// checkExpression compares the result of the PromQL expression with the
+// expected samples.
+func checkExpression(suite *promqltest.LazyLoader, mint time.Time, tc promqlTestCase) error {
+	got, err := query(suite, tc.Expr, mint.Add(time.Duration(tc.EvalTime)))
+	if err != nil {
+		return fmt.Errorf("expr: %q, time: %s, err: %w", tc.Expr, tc.EvalTime, err)
+	}
+
+	gotSamples := make([]parsedSample, 0, len(got))
+	for _, s := range got {
+		gotSamples = append(gotSamples, parsedSample{
+			Labels:    s.Metric.Copy(),
+			Value:     s.F,
+			Histogram: promqltest.HistogramTestExpression(s.H),
+		})
+	}
+
+	expSamples := make([]parsedSample, 0, len(tc.ExpSamples))
+	for _, s := range tc.ExpSamples {
+		ps, err := s.parse()
+		if err != nil {
+			return fmt.Errorf("expr: %q, time: %s, err: labels %q: %w", tc.Expr, tc.EvalTime, s.Labels, err)
+		}
+		expSamples = append(expSamples, ps)
+	}
+
+	sortSamples := func(s []parsedSample) {
+		sort.Slice(s, func(i, j int) bool {
+			return labels.Compare(s[i].Labels, s[j].Labels) <= 0
+		})
+	}
+	sortSamples(expSamples)
+	sortSamples(gotSamples)
+
+	if !cmp.Equal(expSamples, gotSamples, cmp.Comparer(labels.Equal)) {
+		return fmt.Errorf("expr: %q, time: %s,\n    exp: %v\n    got: %v",
+			tc.Expr, tc.EvalTime, parsedSamplesString(expSamples), parsedSamplesString(gotSamples))
+	}
+
+	return nil
+}
+
+func query(suite *promqltest.LazyLoader, qs string, t time.Time) (promql.Vector, error) {
+	q, err := suite.QueryEngine().NewInstantQuery(suite.Context(), suite.Queryable(), nil, qs, t)
+	if err != nil {
+		return nil, err
+	}
+
+	res := q.Exec(suite.Context())
+	if res.Err != nil {
+		return nil, res.Err
+	}
+
+	switch v := res.Value.(type) {
+	case promql.Vector:
+		return v, nil
+	case promql.Scalar:
+		return promql.Vector{promql.Sample{T: v.T, F: v.V, Metric: labels.EmptyLabels()}}, nil
+	default:
+		return nil, errors.New("rule result is not a vector or scalar")
+	}
+}
+
+type labelAndAnnotation struct {
+	Labels      labels.Labels
+	Annotations labels.Labels
+}
+
+func (la labelAndAnnotation) String() string {
+	return "Labels:" + la.Labels.String() + " Annotations:" + la.Annotations.String()
+}
+
+type labelsAndAnnotations []labelAndAnnotation
+
+func (la labelsAndAnnotations) Len() int      { return len(la) }
+func (la labelsAndAnnotations) Swap(i, j int) { la[i], la[j] = la[j], la[i] }
+func (la labelsAndAnnotations) Less(i, j int) bool {
+	if diff := labels.Compare(la[i].Labels, la[j].Labels); diff != 0 {
+		return diff < 0
+	}
+
+	return labels.Compare(la[i].Annotations, la[j].Annotations) < 0
+}
+
+func (la labelsAndAnnotations) String() string {
+	s := make([]string, 0, len(la))
+	for _, l := range la {
+		s = append(s, l.String())
+	}
+
+	return "[" + strings.Join(s, ", ") + "]"
+}
+
+// parsedSample is a sample with parsed labels.
+type parsedSample struct {
+	Labels    labels.Labels
+	Value     float64
+	Histogram string // Test expression of the float histogram.
+}
+
+func (s sample) parse() (parsedSample, error) {
+	lbls, err := parser.ParseMetric(s.Labels)
+	if err != nil {
+		return parsedSample{}, err
+	}
+
+	ps := parsedSample{Labels: lbls, Value: s.Value}
+	if s.Histogram == "" {
+		return ps, nil
+	}
+
+	_, values, err := parser.ParseSeriesDesc("{} " + s.Histogram)
+	switch {
+	case err != nil:
+		return parsedSample{}, err
+	case len(values) != 1:
+		return parsedSample{}, fmt.Errorf("expected 1 value, got %d", len(values))
+	case values[0].Histogram == nil:
+		return parsedSample{}, fmt.Errorf("expected histogram, got %v", values[0])
+	}
+
+	ps.Value = 0
+	ps.Histogram = promqltest.HistogramTestExpression(values[0].Histogram)
+
+	return ps, nil
+}
+
+func (s parsedSample) String() string {
+	if s.Histogram != "" {
+		return s.Labels.String() + " " + s.Histogram
+	}
+
+	return s.Labels.String() + " " + strconv.FormatFloat(s.Value, 'E', -1, 64)
+}
+
+func parsedSamplesString(samples []parsedSample) string {
+	if len(samples) == 0 {
+		return "nil"
+	}
+
+	s := make([]string, 0, len(samples))
+	for _, ps := range samples {
+		s = append(s, ps.String())
+	}
+
+	return strings.Join(s, ", ")
+}
@@ -1077,13 +1077,49 @@
                       },
                       type: 'array',
                     },
-                    msteamsConfigs: {
-                      description: 'List of MSTeams configurations.\nIt requires Alertmanager >= 0.26.0.',
+                    jiraConfigs: {
+                      description: 'List of Jira configurations.\nIt requires Alertmanager >= 0.28.0.',
                       items: {
-                        description: 'MSTeamsConfig configures notifications via Microsoft Teams.\nIt requires Alertmanager >= 0.26.0.',
+                        description: 'JiraConfig configures notifications via Jira.\nSee https://prometheus.io/docs/alerting/latest/configuration/#jira_config\nIt requires Alertmanager >= 0.28.0.',
                         properties: {
+                          apiURL: {
+                            description: 'The URL of the Jira API.\nIf not specified, the value of the `jira_api_url` global setting is used.',
+                            pattern: '^https?://.+$',
+                            type: 'string',
+                          },
+                          description: {
+                            description: 'Issue description template.',
+                            type: 'string',
+                          },
+                          fields: {
+                            description: 'Other issue and custom fields.',
+                            items: {
+                              description: 'JiraField defines an issue field for Jira.',
+                              properties: {
+                                key: {
+                                  description: 'The name of the field (e.g. "customfield_10000").',
+                                  minLength: 1,
+                                  type: 'string',
+                                },
+                                value: {
+                                  description: 'The value of the field.',
+                                  'x-kubernetes-preserve-unknown-fields': true,
+                                },
+                              },
+                              required: [
+                                'key',
+                                'value',
+                              ],
+                              type: 'object',
+                            },
+                            type: 'array',
+                            'x-kubernetes-list-map-keys': [
+                              'key',
+                            ],
+                            'x-kubernetes-list-type': 'map',
+                          },
                           httpConfig: {
-                            description: 'HTTP client configuration.',
+                            description: "The HTTP client's configuration.\nYou must supply the Jira credentials via the `httpConfig.basicAuth` or\n`httpConfig.authorization` fields.",
                             properties: {
                               authorization: {
                                 description: 'Authorization header configuration for the client.\nThis is mutually exclusive with BasicAuth and is only available starting from Alertmanager v0.22+.',
@@ -1716,57 +1752,66 @@
                             },
                             type: 'object',
                           },
+                          issueType: {
+                            description: 'The type of the issue (e.g. Bug).',
+                            minLength: 1,
+                            type: 'string',
+                          },
+                          labels: {
+                            description: 'Labels to be added to the issue.',
+                            items: {
+                              type: 'string',
+                            },
+                            type: 'array',
+                            'x-kubernetes-list-type': 'set',
+                          },
+                          priority: {
+                            description: 'Priority of the issue.',
+                            type: 'string',
+                          },
+                          project: {
+                            description: 'The project key where issues are created.',
+                            minLength: 1,
+                            type: 'string',
+                          },
+                          reopenDuration: {
+                            description: 'If `reopenTransition` is defined, reopen the issue when it is not older\nthan this value (rounded down to the nearest minute).\nOtherwise, a new issue is created.',
+                            pattern: '^(0|(([0-9]+)y)?(([0-9]+)w)?(([0-9]+)d)?(([0-9]+)h)?(([0-9]+)m)?(([0-9]+)s)?(([0-9]+)ms)?)$',
+                            type: 'string',
+                          },
+                          reopenTransition: {
+                            description: 'Name of the workflow transition to reopen an issue.\nThe target status should not have the category "done".',
+                            type: 'string',
+                          },
+                          resolveTransition: {
+                            description: 'Name of the workflow transition to resolve an issue.\nThe target status must have the category "done".',
+                            type: 'string',
+                          },
                           sendResolved: {
                             description: 'Whether to notify about resolved alerts.',
                             type: 'boolean',
                           },
                           summary: {
-                            description: 'Message summary template.\nIt requires Alertmanager >= 0.27.0.',
-                            type: 'string',
-                          },
-                          text: {
-                            description: 'Message body template.',
+                            description: 'Issue summary template.',
                             type: 'string',
                           },
-                          title: {
-                            description: 'Message title template.',
+                          wontFixResolution: {
+                            description: 'If `reopenTransition` is defined, issues with this resolution are\nignored and a new issue is created instead.',
                             type: 'string',
                           },
-                          webhookUrl: {
-                            description: 'MSTeams webhook URL.',
-                            properties: {
-                              key: {
-                                description: 'The key of the secret to select from.  Must be a valid secret key.',
-                                type: 'string',
-                              },
-                              name: {
-                                default: '',
-                                description: 'Name of the referent.\nThis field is effectively required, but due to backwards compatibility is\nallowed to be empty. Instances of this type with an empty value here are\nalmost certainly wrong.\nMore info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names',
-                                type: 'string',
-                              },
-                              optional: {
-                                description: 'Specify whether the Secret or its key must be defined',
-                                type: 'boolean',
-                              },
-                            },
-                            required: [
-                              'key',
-                            ],
-                            type: 'object',
-                            'x-kubernetes-map-type': 'atomic',
-                          },
                         },
                         required: [
-                          'webhookUrl',
+                          'issueType',
+                          'project',
                         ],
                         type: 'object',
                       },
                       type: 'array',
                     },
-                    msteamsv2Configs: {
-                      description: 'List of MSTeamsV2 configurations.\nIt requires Alertmanager >= 0.28.0.',
+                    msteamsConfigs: {
+                      description: 'List of MSTeams configurations.\nIt requires Alertmanager >= 0.26.0.',
                       items: {
-                        description: 'MSTeamsV2Config configures notifications via Microsoft Teams using the new message format with adaptive cards as required by flows\nSee https://prometheus.io/docs/alerting/latest/configuration/#msteamsv2_config\nIt requires Alertmanager >= 0.28.0.',
+                        description: 'MSTeamsConfig configures notifications via Microsoft Teams.\nIt requires Alertmanager >= 0.26.0.',
                         properties: {
                           httpConfig: {
                             description: 'HTTP client configuration.',
@@ -2406,18 +2451,20 @@
                             description: 'Whether to notify about resolved alerts.',
                             type: 'boolean',
                           },
+                          summary: {
+                            description: 'Message summary template.\nIt requires Alertmanager >= 0.27.0.',
+                            type: 'string',
+                          },
                           text: {
                             description: 'Message body template.',
-                            minLength: 1,
                             type: 'string',
                           },
                           title: {
                             description: 'Message title template.',
-                            minLength: 1,
                             type: 'string',
                           },
-                          webhookURL: {
-                            description: 'MSTeams incoming webhook URL.',
+                          webhookUrl: {
+                            description: 'MSTeams webhook URL.',
                             properties: {
                               key: {
                                 description: 'The key of the secret to select from.  Must be a valid secret key.',
@@ -2440,79 +2487,18 @@
                             'x-kubernetes-map-type': 'atomic',
                           },
                         },
+                        required: [
+                          'webhookUrl',
+                        ],
                         type: 'object',
                       },
                       type: 'array',
                     },
-                    name: {
-                      description: 'Name of the receiver. Must be unique across all items from the list.',
-                      minLength: 1,
-                      type: 'string',
-                    },
-                    opsgenieConfigs: {
-                      description: 'List of OpsGenie configurations.',
+                    msteamsv2Configs: {
+                      description: 'List of MSTeamsV2 configurations.\nIt requires Alertmanager >= 0.28.0.',
                       items: {
-                        description: 'OpsGenieConfig configures notifications via OpsGenie.\nSee https://prometheus.io/docs/alerting/latest/configuration/#opsgenie_config',
+                        description: 'MSTeamsV2Config configures notifications via Microsoft Teams using the new message format with adaptive cards as required by flows\nSee https://prometheus.io/docs/alerting/latest/configuration/#msteamsv2_config\nIt requires Alertmanager >= 0.28.0.',
                         properties: {
-                          actions: {
-                            description: 'Comma separated list of actions that will be available for the alert.',
-                            type: 'string',
-                          },
-                          apiKey: {
-                            description: "The secret's key that contains the OpsGenie API key.\nThe secret needs to be in the same namespace as the AlertmanagerConfig\nobject and accessible by the Prometheus Operator.",
-                            properties: {
-                              key: {
-                                description: 'The key of the secret to select from.  Must be a valid secret key.',
-                                minLength: 1,
-                                type: 'string',
-                              },
-                              name: {
-                                description: "The name of the secret in the object's namespace to select from.",
-                                minLength: 1,
-                                type: 'string',
-                              },
-                            },
-                            required: [
-                              'key',
-                              'name',
-                            ],
-                            type: 'object',
-                          },
-                          apiURL: {
-                            description: 'The URL to send OpsGenie API requests to.',
-                            type: 'string',
-                          },
-                          description: {
-                            description: 'Description of the incident.',
-                            type: 'string',
-                          },
-                          details: {
-                            description: 'A set of arbitrary key/value pairs that provide further detail about the incident.',
-                            items: {
-                              description: 'KeyValue defines a (key, value) tuple.',
-                              properties: {
-                                key: {
-                                  description: 'Key of the tuple.',
-                                  minLength: 1,
-                                  type: 'string',
-                                },
-                                value: {
-                                  description: 'Value of the tuple.',
-                                  type: 'string',
-                                },
-                              },
-                              required: [
-                                'key',
-                                'value',
-                              ],
-                              type: 'object',
-                            },
-                            type: 'array',
-                          },
-                          entity: {
-                            description: 'Optional field that can be used to specify which domain alert is related to.',
-                            type: 'string',
-                          },
                           httpConfig: {
                             description: 'HTTP client configuration.',
                             properties: {
@@ -3147,91 +3133,84 @@
                             },
                             type: 'object',
                           },
-                          message: {
-                            description: 'Alert text limited to 130 characters.',
-                            type: 'string',
+                          sendResolved: {
+                            description: 'Whether to notify about resolved alerts.',
+                            type: 'boolean',
                           },
-                          note: {
-                            description: 'Additional alert note.',
+                          text: {
+                            description: 'Message body template.',
+                            minLength: 1,
                             type: 'string',
                           },
-                          priority: {
-                            description: 'Priority level of alert. Possible values are P1, P2, P3, P4, and P5.',
+                          title: {
+                            description: 'Message title template.',
+                            minLength: 1,
                             type: 'string',
                           },
-                          responders: {
-                            description: 'List of responders responsible for notifications.',
-                            items: {
-                              description: 'OpsGenieConfigResponder defines a responder to an incident.\nOne of `id`, `name` or `username` has to be defined.',
-                              properties: {
-                                id: {
-                                  description: 'ID of the responder.',
-                                  type: 'string',
-                                },
-                                name: {
-                                  description: 'Name of the responder.',
-                                  type: 'string',
-                                },
-                                type: {
-                                  description: 'Type of responder.',
-                                  enum: [
-                                    'team',
-                                    'teams',
-                                    'user',
-                                    'escalation',
-                                    'schedule',
-                                  ],
-                                  minLength: 1,
-                                  type: 'string',
-                                },
-                                username: {
-                                  description: 'Username of the responder.',
-                                  type: 'string',
-                                },
+                          webhookURL: {
+                            description: 'MSTeams incoming webhook URL.',
+                            properties: {
+                              key: {
+                                description: 'The key of the secret to select from.  Must be a valid secret key.',
+                                type: 'string',
+                              },
+                              name: {
+                                default: '',
+                                description: 'Name of the referent.\nThis field is effectively required, but due to backwards compatibility is\nallowed to be empty. Instances of this type with an empty value here are\nalmost certainly wrong.\nMore info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names',
+                                type: 'string',
+                              },
+                              optional: {
+                                description: 'Specify whether the Secret or its key must be defined',
+                                type: 'boolean',
                               },
-                              required: [
-                                'type',
-                              ],
-                              type: 'object',
                             },
-                            type: 'array',
-                          },
-                          sendResolved: {
-                            description: 'Whether or not to notify about resolved alerts.',
-                            type: 'boolean',
-                          },
-                          source: {
-                            description: 'Backlink to the sender of the notification.',
-                            type: 'string',
-                          },
-                          tags: {
-                            description: 'Comma separated list of tags attached to the notifications.',
-                            type: 'string',
+                            required: [
+                              'key',
+                            ],
+                            type: 'object',
+                            'x-kubernetes-map-type': 'atomic',
                           },
                         },
                         type: 'object',
                       },
                       type: 'array',
                     },
-                    pagerdutyConfigs: {
-                      description: 'List of PagerDuty configurations.',
+                    name: {
+                      description: 'Name of the receiver. Must be unique across all items from the list.',
+                      minLength: 1,
+                      type: 'string',
+                    },
+                    opsgenieConfigs: {
+                      description: 'List of OpsGenie configurations.',
                       items: {
-                        description: 'PagerDutyConfig configures notifications via PagerDuty.\nSee https://prometheus.io/docs/alerting/latest/configuration/#pagerduty_config',
+                        description: 'OpsGenieConfig configures notifications via OpsGenie.\nSee https://prometheus.io/docs/alerting/latest/configuration/#opsgenie_config',
                         properties: {
-                          class: {
-                            description: 'The class/type of the event.',
-                            type: 'string',
-                          },
-                          client: {
-                            description: 'Client identification.',
+                          actions: {
+                            description: 'Comma separated list of actions that will be available for the alert.',
                             type: 'string',
                           },
-                          clientURL: {
-                            description: 'Backlink to the sender of notification.',
-                            type: 'string',
+                          apiKey: {
+                            description: "The secret's key that contains the OpsGenie API key.\nThe secret needs to be in the same namespace as the AlertmanagerConfig\nobject and accessible by the Prometheus Operator.",
+                            properties: {
+                              key: {
+                                description: 'The key of the secret to select from.  Must be a valid secret key.',
+                                minLength: 1,
+                                type: 'string',
+                              },
+                              name: {
+                                description: "The name of the secret in the object's namespace to select from.",
+                                minLength: 1,
+                                type: 'string',
+                              },
+                            },
+                            required: [
+                              'key',
+                              'name',
+                            ],
+                            type: 'object',
                           },
-                          component: {
-                            description: 'The part or component of the affected system that is broken.',
+                          apiURL: {
+                            description: 'The URL to send OpsGenie API requests to.',
                             type: 'string',
                           },
                           description: {
@@ -3239,7 +3218,7 @@
                             type: 'string',
                           },
                           details: {
-                            description: 'Arbitrary key/value pairs that provide further detail about the incident.',
+                            description: 'A set of arbitrary key/value pairs that provide further detail about the incident.',
                             items: {
                               description: 'KeyValue defines a (key, value) tuple.',
                               properties: {
@@ -3261,8 +3240,8 @@
                             },
                             type: 'array',
                           },
-                          group: {
-                            description: 'A cluster or grouping of sources.',
+                          entity: {
+                            description: 'Optional field that can be used to specify which domain alert is related to.',
                             type: 'string',
                           },
                           httpConfig: {
@@ -3899,48 +3878,1542 @@
                             },
                             type: 'object',
                           },
-                          pagerDutyImageConfigs: {
-                            description: 'A list of image details to attach that provide further detail about an incident.',
+                          message: {
+                            description: 'Alert text limited to 130 characters.',
+                            type: 'string',
+                          },
+                          note: {
+                            description: 'Additional alert note.',
+                            type: 'string',
+                          },
+                          priority: {
+                            description: 'Priority level of alert. Possible values are P1, P2, P3, P4, and P5.',
+                            type: 'string',
+                          },
+                          responders: {
+                            description: 'List of responders responsible for notifications.',
                             items: {
-                              description: 'PagerDutyImageConfig attaches images to an incident',
+                              description: 'OpsGenieConfigResponder defines a responder to an incident.\nOne of `id`, `name` or `username` has to be defined.',
                               properties: {
-                                alt: {
-                                  description: 'Alt is the optional alternative text for the image.',
+                                id: {
+                                  description: 'ID of the responder.',
                                   type: 'string',
                                 },
-                                href: {
-                                  description: 'Optional URL; makes the image a clickable link.',
+                                name: {
+                                  description: 'Name of the responder.',
                                   type: 'string',
                                 },
-                                src: {
-                                  description: 'Src of the image being attached to the incident',
+                                type: {
+                                  description: 'Type of responder.',
+                                  enum: [
+                                    'team',
+                                    'teams',
+                                    'user',
+                                    'escalation',
+                                    'schedule',
+                                  ],
+                                  minLength: 1,
+                                  type: 'string',
+                                },
+                                username: {
+                                  description: 'Username of the responder.',
                                   type: 'string',
                                 },
                               },
+                              required: [
+                                'type',
+                              ],
                               type: 'object',
                             },
                             type: 'array',
                           },
-                          pagerDutyLinkConfigs: {
-                            description: 'A list of link details to attach that provide further detail about an incident.',
+                          sendResolved: {
+                            description: 'Whether or not to notify about resolved alerts.',
+                            type: 'boolean',
+                          },
+                          source: {
+                            description: 'Backlink to the sender of the notification.',
+                            type: 'string',
+                          },
+                          tags: {
+                            description: 'Comma separated list of tags attached to the notifications.',
+                            type: 'string',
+                          },
+                          updateAlerts: {
+                            description: 'Whether to update message and description of the alert in OpsGenie if it already exists\nBy default, the alert is never updated in OpsGenie, the new message only appears in activity log.',
+                            type: 'boolean',
+                          },
+                        },
+                        type: 'object',
+                      },
+                      type: 'array',
+                    },
+                    pagerdutyConfigs: {
+                      description: 'List of PagerDuty configurations.',
+                      items: {
+                        description: 'PagerDutyConfig configures notifications via PagerDuty.\nSee https://prometheus.io/docs/alerting/latest/configuration/#pagerduty_config',
+                        properties: {
+                          class: {
+                            description: 'The class/type of the event.',
+                            type: 'string',
+                          },
+                          client: {
+                            description: 'Client identification.',
+                            type: 'string',
+                          },
+                          clientURL: {
+                            description: 'Backlink to the sender of notification.',
+                            type: 'string',
+                          },
+                          component: {
+                            description: 'The part or component of the affected system that is broken.',
+                            type: 'string',
+                          },
+                          description: {
+                            description: 'Description of the incident.',
+                            type: 'string',
+                          },
+                          details: {
+                            description: 'Arbitrary key/value pairs that provide further detail about the incident.',
                             items: {
-                              description: 'PagerDutyLinkConfig attaches text links to an incident',
+                              description: 'KeyValue defines a (key, value) tuple.',
                               properties: {
-                                alt: {
-                                  description: "Text that describes the purpose of the link, and can be used as the link's text.",
+                                key: {
+                                  description: 'Key of the tuple.',
+                                  minLength: 1,
                                   type: 'string',
                                 },
-                                href: {
-                                  description: 'Href is the URL of the link to be attached',
+                                value: {
+                                  description: 'Value of the tuple.',
                                   type: 'string',
                                 },
                               },
+                              required: [
+                                'key',
+                                'value',
+                              ],
                               type: 'object',
                             },
                             type: 'array',
                           },
-                          routingKey: {
-                            description: "The secret's key that contains the PagerDuty integration key (when using\nEvents API v2). Either this field or `serviceKey` needs to be defined.\nThe secret needs to be in the same namespace as the AlertmanagerConfig\nobject and accessible by the Prometheus Operator.",
+                          group: {
+                            description: 'A cluster or grouping of sources.',
+                            type: 'string',
+                          },
+                          httpConfig: {
+                            description: 'HTTP client configuration.',
+                            properties: {
+                              authorization: {
+                                description: 'Authorization header configuration for the client.\nThis is mutually exclusive with BasicAuth and is only available starting from Alertmanager v0.22+.',
+                                properties: {
+                                  credentials: {
+                                    description: 'Selects a key of a Secret in the namespace that contains the credentials for authentication.',
+                                    properties: {
+                                      key: {
+                                        description: 'The key of the secret to select from.  Must be a valid secret key.',
+                                        type: 'string',
+                                      },
+                                      name: {
+                                        default: '',
+                                        description: 'Name of the referent.\nThis field is effectively required, but due to backwards compatibility is\nallowed to be empty. Instances of this type with an empty value here are\nalmost certainly wrong.\nMore info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names',
+                                        type: 'string',
+                                      },
+                                      optional: {
+                                        description: 'Specify whether the Secret or its key must be defined',
+                                        type: 'boolean',
+                                      },
+                                    },
+                                    required: [
+                                      'key',
+                                    ],
+                                    type: 'object',
+                                    'x-kubernetes-map-type': 'atomic',
+                                  },
+                                  type: {
+                                    description: 'Defines the authentication type. The value is case-insensitive.\n\n"Basic" is not a supported value.\n\nDefault: "Bearer"',
+                                    type: 'string',
+                                  },
+                                },
+                                type: 'object',
+                              },
+                              basicAuth: {
+                                description: 'BasicAuth for the client.\nThis is mutually exclusive with Authorization. If both are defined, BasicAuth takes precedence.',
+                                properties: {
+                                  password: {
+                                    description: '`password` specifies a key of a Secret containing the password for\nauthentication.',
+                                    properties: {
+                                      key: {
+                                        description: 'The key of the secret to select from.  Must be a valid secret key.',
+                                        type: 'string',
+                                      },
+                                      name: {
+                                        default: '',
+                                        description: 'Name of the referent.\nThis field is effectively required, but due to backwards compatibility is\nallowed to be empty. Instances of this type with an empty value here are\nalmost certainly wrong.\nMore info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names',
+                                        type: 'string',
+                                      },
+                                      optional: {
+                                        description: 'Specify whether the Secret or its key must be defined',
+                                        type: 'boolean',
+                                      },
+                                    },
+                                    required: [
+                                      'key',
+                                    ],
+                                    type: 'object',
+                                    'x-kubernetes-map-type': 'atomic',
+                                  },
+                                  username: {
+                                    description: '`username` specifies a key of a Secret containing the username for\nauthentication.',
+                                    properties: {
+                                      key: {
+                                        description: 'The key of the secret to select from.  Must be a valid secret key.',
+                                        type: 'string',
+                                      },
+                                      name: {
+                                        default: '',
+                                        description: 'Name of the referent.\nThis field is effectively required, but due to backwards compatibility is\nallowed to be empty. Instances of this type with an empty value here are\nalmost certainly wrong.\nMore info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names',
+                                        type: 'string',
+                                      },
+                                      optional: {
+                                        description: 'Specify whether the Secret or its key must be defined',
+                                        type: 'boolean',
+                                      },
+                                    },
+                                    required: [
+                                      'key',
+                                    ],
+                                    type: 'object',
+                                    'x-kubernetes-map-type': 'atomic',
+                                  },
+                                },
+                                type: 'object',
+                              },
+                              bearerTokenSecret: {
+                                description: "The secret's key that contains the bearer token to be used by the client\nfor authentication.\nThe secret needs to be in the same namespace as the AlertmanagerConfig\nobject and accessible by the Prometheus Operator.",
+                                properties: {
+                                  key: {
+                                    description: 'The key of the secret to select from.  Must be a valid secret key.',
+                                    minLength: 1,
+                                    type: 'string',
+                                  },
+                                  name: {
+                                    description: "The name of the secret in the object's namespace to select from.",
+                                    minLength: 1,
+                                    type: 'string',
+                                  },
+                                },
+                                required: [
+                                  'key',
+                                  'name',
+                                ],
+                                type: 'object',
+                              },
+                              followRedirects: {
+                                description: 'FollowRedirects specifies whether the client should follow HTTP 3xx redirects.',
+                                type: 'boolean',
+                              },
+                              noProxy: {
+                                description: '`noProxy` is a comma-separated string that can contain IPs, CIDR notation, domain names\nthat should be excluded from proxying. IP and domain names can\ncontain port numbers.\n\nIt requires Prometheus >= v2.43.0, Alertmanager >= v0.25.0 or Thanos >= v0.32.0.',
+                                type: 'string',
+                              },
+                              oauth2: {
+                                description: 'OAuth2 client credentials used to fetch a token for the targets.',
+                                properties: {
+                                  clientId: {
+                                    description: "`clientId` specifies a key of a Secret or ConfigMap containing the\nOAuth2 client's ID.",
+                                    properties: {
+                                      configMap: {
+                                        description: 'ConfigMap containing data to use for the targets.',
+                                        properties: {
+                                          key: {
+                                            description: 'The key to select.',
+                                            type: 'string',
+                                          },
+                                          name: {
+                                            default: '',
+                                            description: 'Name of the referent.\nThis field is effectively required, but due to backwards compatibility is\nallowed to be empty. Instances of this type with an empty value here are\nalmost certainly wrong.\nMore info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names',
+                                            type: 'string',
+                                          },
+                                          optional: {
+                                            description: 'Specify whether the ConfigMap or its key must be defined',
+                                            type: 'boolean',
+                                          },
+                                        },
+                                        required: [
+                                          'key',
+                                        ],
+                                        type: 'object',
+                                        'x-kubernetes-map-type': 'atomic',
+                                      },
+                                      secret: {
+                                        description: 'Secret containing data to use for the targets.',
+                                        properties: {
+                                          key: {
+                                            description: 'The key of the secret to select from.  Must be a valid secret key.',
+                                            type: 'string',
+                                          },
+                                          name: {
+                                            default: '',
+                                            description: 'Name of the referent.\nThis field is effectively required, but due to backwards compatibility is\nallowed to be empty. Instances of this type with an empty value here are\nalmost certainly wrong.\nMore info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names',
+                                            type: 'string',
+                                          },
+                                          optional: {
+                                            description: 'Specify whether the Secret or its key must be defined',
+                                            type: 'boolean',
+                                          },
+                                        },
+                                        required: [
+                                          'key',
+                                        ],
+                                        type: 'object',
+                                        'x-kubernetes-map-type': 'atomic',
+                                      },
+                                    },
+                                    type: 'object',
+                                  },
+                                  clientSecret: {
+                                    description: "`clientSecret` specifies a key of a Secret containing the OAuth2\nclient's secret.",
+                                    properties: {
+                                      key: {
+                                        description: 'The key of the secret to select from.  Must be a valid secret key.',
+                                        type: 'string',
+                                      },
+                                      name: {
+                                        default: '',
+                                        description: 'Name of the referent.\nThis field is effectively required, but due to backwards compatibility is\nallowed to be empty. Instances of this type with an empty value here are\nalmost certainly wrong.\nMore info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names',
+                                        type: 'string',
+                                      },
+                                      optional: {
+                                        description: 'Specify whether the Secret or its key must be defined',
+                                        type: 'boolean',
+                                      },
+                                    },
+                                    required: [
+                                      'key',
+                                    ],
+                                    type: 'object',
+                                    'x-kubernetes-map-type': 'atomic',
+                                  },
+                                  endpointParams: {
+                                    additionalProperties: {
+                                      type: 'string',
+                                    },
+                                    description: '`endpointParams` configures the HTTP parameters to append to the token\nURL.',
+                                    type: 'object',
+                                  },
+                                  noProxy: {
+                                    description: '`noProxy` is a comma-separated string that can contain IPs, CIDR notation, domain names\nthat should be excluded from proxying. IP and domain names can\ncontain port numbers.\n\nIt requires Prometheus >= v2.43.0, Alertmanager >= v0.25.0 or Thanos >= v0.32.0.',
+                                    type: 'string',
+                                  },
+                                  proxyConnectHeader: {
+                                    additionalProperties: {
+                                      items: {
+                                        description: 'SecretKeySelector selects a key of a Secret.',
+                                        properties: {
+                                          key: {
+                                            description: 'The key of the secret to select from.  Must be a valid secret key.',
+                                            type: 'string',
+                                          },
+                                          name: {
+                                            default: '',
+                                            description: 'Name of the referent.\nThis field is effectively required, but due to backwards compatibility is\nallowed to be empty. Instances of this type with an empty value here are\nalmost certainly wrong.\nMore info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names',
+                                            type: 'string',
+                                          },
+                                          optional: {
+                                            description: 'Specify whether the Secret or its key must be defined',
+                                            type: 'boolean',
+                                          },
+                                        },
+                                        required: [
+                                          'key',
+                                        ],
+                                        type: 'object',
+                                        'x-kubernetes-map-type': 'atomic',
+                                      },
+                                      type: 'array',
+                                    },
+                                    description: 'ProxyConnectHeader optionally specifies headers to send to\nproxies during CONNECT requests.\n\nIt requires Prometheus >= v2.43.0, Alertmanager >= v0.25.0 or Thanos >= v0.32.0.',
+                                    type: 'object',
+                                    'x-kubernetes-map-type': 'atomic',
+                                  },
+                                  proxyFromEnvironment: {
+                                    description: 'Whether to use the proxy configuration defined by environment variables (HTTP_PROXY, HTTPS_PROXY, and NO_PROXY).\n\nIt requires Prometheus >= v2.43.0, Alertmanager >= v0.25.0 or Thanos >= v0.32.0.',
+                                    type: 'boolean',
+                                  },
+                                  proxyUrl: {
+                                    description: '`proxyURL` defines the HTTP proxy server to use.',
+                                    pattern: '^(http|https|socks5)://.+$',
+                                    type: 'string',
+                                  },
+                                  scopes: {
+                                    description: '`scopes` defines the OAuth2 scopes used for the token request.',
+                                    items: {
+                                      type: 'string',
+                                    },
+                                    type: 'array',
+                                  },
+                                  tlsConfig: {
+                                    description: 'TLS configuration to use when connecting to the OAuth2 server.\nIt requires Prometheus >= v2.43.0.',
+                                    properties: {
+                                      ca: {
+                                        description: 'Certificate authority used when verifying server certificates.',
+                                        properties: {
+                                          configMap: {
+                                            description: 'ConfigMap containing data to use for the targets.',
+                                            properties: {
+                                              key: {
+                                                description: 'The key to select.',
+                                                type: 'string',
+                                              },
+                                              name: {
+                                                default: '',
+                                                description: 'Name of the referent.\nThis field is effectively required, but due to backwards compatibility is\nallowed to be empty. Instances of this type with an empty value here are\nalmost certainly wrong.\nMore info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names',
+                                                type: 'string',
+                                              },
+                                              optional: {
+                                                description: 'Specify whether the ConfigMap or its key must be defined',
+                                                type: 'boolean',
+                                              },
+                                            },
+                                            required: [
+                                              'key',
+                                            ],
+                                            type: 'object',
+                                            'x-kubernetes-map-type': 'atomic',
+                                          },
+                                          secret: {
+                                            description: 'Secret containing data to use for the targets.',
+                                            properties: {
+                                              key: {
+                                                description: 'The key of the secret to select from.  Must be a valid secret key.',
+                                                type: 'string',
+                                              },
+                                              name: {
+                                                default: '',
+                                                description: 'Name of the referent.\nThis field is effectively required, but due to backwards compatibility is\nallowed to be empty. Instances of this type with an empty value here are\nalmost certainly wrong.\nMore info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names',
+                                                type: 'string',
+                                              },
+                                              optional: {
+                                                description: 'Specify whether the Secret or its key must be defined',
+                                                type: 'boolean',
+                                              },
+                                            },
+                                            required: [
+                                              'key',
+                                            ],
+                                            type: 'object',
+                                            'x-kubernetes-map-type': 'atomic',
+                                          },
+                                        },
+                                        type: 'object',
+                                      },
+                                      cert: {
+                                        description: 'Client certificate to present when doing client-authentication.',
+                                        properties: {
+                                          configMap: {
+                                            description: 'ConfigMap containing data to use for the targets.',
+                                            properties: {
+                                              key: {
+                                                description: 'The key to select.',
+                                                type: 'string',
+                                              },
+                                              name: {
+                                                default: '',
+                                                description: 'Name of the referent.\nThis field is effectively required, but due to backwards compatibility is\nallowed to be empty. Instances of this type with an empty value here are\nalmost certainly wrong.\nMore info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names',
+                                                type: 'string',
+                                              },
+                                              optional: {
+                                                description: 'Specify whether the ConfigMap or its key must be defined',
+                                                type: 'boolean',
+                                              },
+                                            },
+                                            required: [
+                                              'key',
+                                            ],
+                                            type: 'object',
+                                            'x-kubernetes-map-type': 'atomic',
+                                          },
+                                          secret: {
+                                            description: 'Secret containing data to use for the targets.',
+                                            properties: {
+                                              key: {
+                                                description: 'The key of the secret to select from.  Must be a valid secret key.',
+                                                type: 'string',
+                                              },
+                                              name: {
+                                                default: '',
+                                                description: 'Name of the referent.\nThis field is effectively required, but due to backwards compatibility is\nallowed to be empty. Instances of this type with an empty value here are\nalmost certainly wrong.\nMore info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names',
+                                                type: 'string',
+                                              },
+                                              optional: {
+                                                description: 'Specify whether the Secret or its key must be defined',
+                                                type: 'boolean',
+                                              },
+                                            },
+                                            required: [
+                                              'key',
+                                            ],
+                                            type: 'object',
+                                            'x-kubernetes-map-type': 'atomic',
+                                          },
+                                        },
+                                        type: 'object',
+                                      },
+                                      insecureSkipVerify: {
+                                        description: 'Disable target certificate validation.',
+                                        type: 'boolean',
+                                      },
+                                      keySecret: {
+                                        description: 'Secret containing the client key file for the targets.',
+                                        properties: {
+                                          key: {
+                                            description: 'The key of the secret to select from.  Must be a valid secret key.',
+                                            type: 'string',
+                                          },
+                                          name: {
+                                            default: '',
+                                            description: 'Name of the referent.\nThis field is effectively required, but due to backwards compatibility is\nallowed to be empty. Instances of this type with an empty value here are\nalmost certainly wrong.\nMore info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names',
+                                            type: 'string',
+                                          },
+                                          optional: {
+                                            description: 'Specify whether the Secret or its key must be defined',
+                                            type: 'boolean',
+                                          },
+                                        },
+                                        required: [
+                                          'key',
+                                        ],
+                                        type: 'object',
+                                        'x-kubernetes-map-type': 'atomic',
+                                      },
+                                      maxVersion: {
+                                        description: 'Maximum acceptable TLS version.\n\nIt requires Prometheus >= v2.41.0 or Thanos >= v0.31.0.',
+                                        enum: [
+                                          'TLS10',
+                                          'TLS11',
+                                          'TLS12',
+                                          'TLS13',
+                                        ],
+                                        type: 'string',
+                                      },
+                                      minVersion: {
+                                        description: 'Minimum acceptable TLS version.\n\nIt requires Prometheus >= v2.35.0 or Thanos >= v0.28.0.',
+                                        enum: [
+                                          'TLS10',
+                                          'TLS11',
+                                          'TLS12',
+                                          'TLS13',
+                                        ],
+                                        type: 'string',
+                                      },
+                                      serverName: {
+                                        description: 'Used to verify the hostname for the targets.',
+                                        type: 'string',
+                                      },
+                                    },
+                                    type: 'object',
+                                  },
+                                  tokenUrl: {
+                                    description: '`tokenURL` configures the URL to fetch the token from.',
+                                    minLength: 1,
+                                    type: 'string',
+                                  },
+                                },
+                                required: [
+                                  'clientId',
+                                  'clientSecret',
+                                  'tokenUrl',
+                                ],
+                                type: 'object',
+                              },
+                              proxyConnectHeader: {
+                                additionalProperties: {
+                                  items: {
+                                    description: 'SecretKeySelector selects a key of a Secret.',
+                                    properties: {
+                                      key: {
+                                        description: 'The key of the secret to select from.  Must be a valid secret key.',
+                                        type: 'string',
+                                      },
+                                      name: {
+                                        default: '',
+                                        description: 'Name of the referent.\nThis field is effectively required, but due to backwards compatibility is\nallowed to be empty. Instances of this type with an empty value here are\nalmost certainly wrong.\nMore info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names',
+                                        type: 'string',
+                                      },
+                                      optional: {
+                                        description: 'Specify whether the Secret or its key must be defined',
+                                        type: 'boolean',
+                                      },
+                                    },
+                                    required: [
+                                      'key',
+                                    ],
+                                    type: 'object',
+                                    'x-kubernetes-map-type': 'atomic',
+                                  },
+                                  type: 'array',
+                                },
+                                description: 'ProxyConnectHeader optionally specifies headers to send to\nproxies during CONNECT requests.\n\nIt requires Prometheus >= v2.43.0, Alertmanager >= v0.25.0 or Thanos >= v0.32.0.',
+                                type: 'object',
+                                'x-kubernetes-map-type': 'atomic',
+                              },
+                              proxyFromEnvironment: {
+                                description: 'Whether to use the proxy configuration defined by environment variables (HTTP_PROXY, HTTPS_PROXY, and NO_PROXY).\n\nIt requires Prometheus >= v2.43.0, Alertmanager >= v0.25.0 or Thanos >= v0.32.0.',
+                                type: 'boolean',
+                              },
+                              proxyURL: {
+                                description: 'Optional proxy URL.\n\nIf defined, this field takes precedence over `proxyUrl`.',
+                                type: 'string',
+                              },
+                              proxyUrl: {
+                                description: '`proxyURL` defines the HTTP proxy server to use.',
+                                pattern: '^(http|https|socks5)://.+$',
+                                type: 'string',
+                              },
+                              tlsConfig: {
+                                description: 'TLS configuration for the client.',
+                                properties: {
+                                  ca: {
+                                    description: 'Certificate authority used when verifying server certificates.',
+                                    properties: {
+                                      configMap: {
+                                        description: 'ConfigMap containing data to use for the targets.',
+                                        properties: {
+                                          key: {
+                                            description: 'The key to select.',
+                                            type: 'string',
+                                          },
+                                          name: {
+                                            default: '',
+                                            description: 'Name of the referent.\nThis field is effectively required, but due to backwards compatibility is\nallowed to be empty. Instances of this type with an empty value here are\nalmost certainly wrong.\nMore info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names',
+                                            type: 'string',
+                                          },
+                                          optional: {
+                                            description: 'Specify whether the ConfigMap or its key must be defined',
+                                            type: 'boolean',
+                                          },
+                                        },
+                                        required: [
+                                          'key',
+                                        ],
+                                        type: 'object',
+                                        'x-kubernetes-map-type': 'atomic',
+                                      },
+                                      secret: {
+                                        description: 'Secret containing data to use for the targets.',
+                                        properties: {
+                                          key: {
+                                            description: 'The key of the secret to select from.  Must be a valid secret key.',
+                                            type: 'string',
+                                          },
+                                          name: {
+                                            default: '',
+                                            description: 'Name of the referent.\nThis field is effectively required, but due to backwards compatibility is\nallowed to be empty. Instances of this type with an empty value here are\nalmost certainly wrong.\nMore info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names',
+                                            type: 'string',
+                                          },
+                                          optional: {
+                                            description: 'Specify whether the Secret or its key must be defined',
+                                            type: 'boolean',
+                                          },
+                                        },
+                                        required: [
+                                          'key',
+                                        ],
+                                        type: 'object',
+                                        'x-kubernetes-map-type': 'atomic',
+                                      },
+                                    },
+                                    type: 'object',
+                                  },
+                                  cert: {
+                                    description: 'Client certificate to present when doing client-authentication.',
+                                    properties: {
+                                      configMap: {
+                                        description: 'ConfigMap containing data to use for the targets.',
+                                        properties: {
+                                          key: {
+                                            description: 'The key to select.',
+                                            type: 'string',
+                                          },
+                                          name: {
+                                            default: '',
+                                            description: 'Name of the referent.\nThis field is effectively required, but due to backwards compatibility is\nallowed to be empty. Instances of this type with an empty value here are\nalmost certainly wrong.\nMore info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names',
+                                            type: 'string',
+                                          },
+                                          optional: {
+                                            description: 'Specify whether the ConfigMap or its key must be defined',
+                                            type: 'boolean',
+                                          },
+                                        },
+                                        required: [
+                                          'key',
+                                        ],
+                                        type: 'object',
+                                        'x-kubernetes-map-type': 'atomic',
+                                      },
+                                      secret: {
+                                        description: 'Secret containing data to use for the targets.',
+                                        properties: {
+                                          key: {
+                                            description: 'The key of the secret to select from.  Must be a valid secret key.',
+                                            type: 'string',
+                                          },
+                                          name: {
+                                            default: '',
+                                            description: 'Name of the referent.\nThis field is effectively required, but due to backwards compatibility is\nallowed to be empty. Instances of this type with an empty value here are\nalmost certainly wrong.\nMore info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names',
+                                            type: 'string',
+                                          },
+                                          optional: {
+                                            description: 'Specify whether the Secret or its key must be defined',
+                                            type: 'boolean',
+                                          },
+                                        },
+                                        required: [
+                                          'key',
+                                        ],
+                                        type: 'object',
+                                        'x-kubernetes-map-type': 'atomic',
+                                      },
+                                    },
+                                    type: 'object',
+                                  },
+                                  insecureSkipVerify: {
+                                    description: 'Disable target certificate validation.',
+                                    type: 'boolean',
+                                  },
+                                  keySecret: {
+                                    description: 'Secret containing the client key file for the targets.',
+                                    properties: {
+                                      key: {
+                                        description: 'The key of the secret to select from.  Must be a valid secret key.',
+                                        type: 'string',
+                                      },
+                                      name: {
+                                        default: '',
+                                        description: 'Name of the referent.\nThis field is effectively required, but due to backwards compatibility is\nallowed to be empty. Instances of this type with an empty value here are\nalmost certainly wrong.\nMore info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names',
+                                        type: 'string',
+                                      },
+                                      optional: {
+                                        description: 'Specify whether the Secret or its key must be defined',
+                                        type: 'boolean',
+                                      },
+                                    },
+                                    required: [
+                                      'key',
+                                    ],
+                                    type: 'object',
+                                    'x-kubernetes-map-type': 'atomic',
+                                  },
+                                  maxVersion: {
+                                    description: 'Maximum acceptable TLS version.\n\nIt requires Prometheus >= v2.41.0 or Thanos >= v0.31.0.',
+                                    enum: [
+                                      'TLS10',
+                                      'TLS11',
+                                      'TLS12',
+                                      'TLS13',
+                                    ],
+                                    type: 'string',
+                                  },
+                                  minVersion: {
+                                    description: 'Minimum acceptable TLS version.\n\nIt requires Prometheus >= v2.35.0 or Thanos >= v0.28.0.',
+                                    enum: [
+                                      'TLS10',
+                                      'TLS11',
+                                      'TLS12',
+                                      'TLS13',
+                                    ],
+                                    type: 'string',
+                                  },
+                                  serverName: {
+                                    description: 'Used to verify the hostname for the targets.',
+                                    type: 'string',
+                                  },
+                                },
+                                type: 'object',
+                              },
+                            },
+                            type: 'object',
+                          },
+                          pagerDutyImageConfigs: {
+                            description: 'A list of image details to attach that provide further detail about an incident.',
+                            items: {
+                              description: 'PagerDutyImageConfig attaches images to an incident',
+                              properties: {
+                                alt: {
+                                  description: 'Alt is the optional alternative text for the image.',
+                                  type: 'string',
+                                },
+                                href: {
+                                  description: 'Optional URL; makes the image a clickable link.',
+                                  type: 'string',
+                                },
+                                src: {
+                                  description: 'Src of the image being attached to the incident',
+                                  type: 'string',
+                                },
+                              },
+                              type: 'object',
+                            },
+                            type: 'array',
+                          },
+                          pagerDutyLinkConfigs: {
+                            description: 'A list of link details to attach that provide further detail about an incident.',
+                            items: {
+                              description: 'PagerDutyLinkConfig attaches text links to an incident',
+                              properties: {
+                                alt: {
+                                  description: "Text that describes the purpose of the link, and can be used as the link's text.",
+                                  type: 'string',
+                                },
+                                href: {
+                                  description: 'Href is the URL of the link to be attached',
+                                  type: 'string',
+                                },
+                              },
+                              type: 'object',
+                            },
+                            type: 'array',
+                          },
+                          routingKey: {
+                            description: "The secret's key that contains the PagerDuty integration key (when using\nEvents API v2). Either this field or `serviceKey` needs to be defined.\nThe secret needs to be in the same namespace as the AlertmanagerConfig\nobject and accessible by the Prometheus Operator.",
+                            properties: {
+                              key: {
+                                description: 'The key of the secret to select from.  Must be a valid secret key.',
+                                minLength: 1,
+                                type: 'string',
+                              },
+                              name: {
+                                description: "The name of the secret in the object's namespace to select from.",
+                                minLength: 1,
+                                type: 'string',
+                              },
+                            },
+                            required: [
+                              'key',
+                              'name',
+                            ],
+                            type: 'object',
+                          },
+                          sendResolved: {
+                            description: 'Whether or not to notify about resolved alerts.',
+                            type: 'boolean',
+                          },
+                          serviceKey: {
+                            description: "The secret's key that contains the PagerDuty service key (when using\nintegration type \"Prometheus\"). Either this field or `routingKey` needs to\nbe defined.\nThe secret needs to be in the same namespace as the AlertmanagerConfig\nobject and accessible by the Prometheus Operator.",
+                            properties: {
+                              key: {
+                                description: 'The key of the secret to select from.  Must be a valid secret key.',
+                                minLength: 1,
+                                type: 'string',
+                              },
+                              name: {
+                                description: "The name of the secret in the object's namespace to select from.",
+                                minLength: 1,
+                                type: 'string',
+                              },
+                            },
+                            required: [
+                              'key',
+                              'name',
+                            ],
+                            type: 'object',
+                          },
+                          severity: {
+                            description: 'Severity of the incident.',
+                            type: 'string',
+                          },
+                          source: {
+                            description: 'Unique location of the affected system.',
+                            type: 'string',
+                          },
+                          url: {
+                            description: 'The URL to send requests to.',
+                            type: 'string',
+                          },
+                        },
+                        type: 'object',
+                      },
+                      type: 'array',
+                    },
+                    pushoverConfigs: {
+                      description: 'List of Pushover configurations.',
+                      items: {
+                        description: 'PushoverConfig configures notifications via Pushover.\nSee https://prometheus.io/docs/alerting/latest/configuration/#pushover_config',
+                        properties: {
+                          device: {
+                            description: 'The name of a device to send the notification to',
+                            type: 'string',
+                          },
+                          expire: {
+                            description: 'How long your notification will continue to be retried for, unless the user\nacknowledges the notification.',
+                            pattern: '^(([0-9]+)y)?(([0-9]+)w)?(([0-9]+)d)?(([0-9]+)h)?(([0-9]+)m)?(([0-9]+)s)?(([0-9]+)ms)?$',
+                            type: 'string',
+                          },
+                          html: {
+                            description: 'Whether notification message is HTML or plain text.',
+                            type: 'boolean',
+                          },
+                          httpConfig: {
+                            description: 'HTTP client configuration.',
+                            properties: {
+                              authorization: {
+                                description: 'Authorization header configuration for the client.\nThis is mutually exclusive with BasicAuth and is only available starting from Alertmanager v0.22+.',
+                                properties: {
+                                  credentials: {
+                                    description: 'Selects a key of a Secret in the namespace that contains the credentials for authentication.',
+                                    properties: {
+                                      key: {
+                                        description: 'The key of the secret to select from.  Must be a valid secret key.',
+                                        type: 'string',
+                                      },
+                                      name: {
+                                        default: '',
+                                        description: 'Name of the referent.\nThis field is effectively required, but due to backwards compatibility is\nallowed to be empty. Instances of this type with an empty value here are\nalmost certainly wrong.\nMore info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names',
+                                        type: 'string',
+                                      },
+                                      optional: {
+                                        description: 'Specify whether the Secret or its key must be defined',
+                                        type: 'boolean',
+                                      },
+                                    },
+                                    required: [
+                                      'key',
+                                    ],
+                                    type: 'object',
+                                    'x-kubernetes-map-type': 'atomic',
+                                  },
+                                  type: {
+                                    description: 'Defines the authentication type. The value is case-insensitive.\n\n"Basic" is not a supported value.\n\nDefault: "Bearer"',
+                                    type: 'string',
+                                  },
+                                },
+                                type: 'object',
+                              },
+                              basicAuth: {
+                                description: 'BasicAuth for the client.\nThis is mutually exclusive with Authorization. If both are defined, BasicAuth takes precedence.',
+                                properties: {
+                                  password: {
+                                    description: '`password` specifies a key of a Secret containing the password for\nauthentication.',
+                                    properties: {
+                                      key: {
+                                        description: 'The key of the secret to select from.  Must be a valid secret key.',
+                                        type: 'string',
+                                      },
+                                      name: {
+                                        default: '',
+                                        description: 'Name of the referent.\nThis field is effectively required, but due to backwards compatibility is\nallowed to be empty. Instances of this type with an empty value here are\nalmost certainly wrong.\nMore info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names',
+                                        type: 'string',
+                                      },
+                                      optional: {
+                                        description: 'Specify whether the Secret or its key must be defined',
+                                        type: 'boolean',
+                                      },
+                                    },
+                                    required: [
+                                      'key',
+                                    ],
+                                    type: 'object',
+                                    'x-kubernetes-map-type': 'atomic',
+                                  },
+                                  username: {
+                                    description: '`username` specifies a key of a Secret containing the username for\nauthentication.',
+                                    properties: {
+                                      key: {
+                                        description: 'The key of the secret to select from.  Must be a valid secret key.',
+                                        type: 'string',
+                                      },
+                                      name: {
+                                        default: '',
+                                        description: 'Name of the referent.\nThis field is effectively required, but due to backwards compatibility is\nallowed to be empty. Instances of this type with an empty value here are\nalmost certainly wrong.\nMore info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names',
+                                        type: 'string',
+                                      },
+                                      optional: {
+                                        description: 'Specify whether the Secret or its key must be defined',
+                                        type: 'boolean',
+                                      },
+                                    },
+                                    required: [
+                                      'key',
+                                    ],
+                                    type: 'object',
+                                    'x-kubernetes-map-type': 'atomic',
+                                  },
+                                },
+                                type: 'object',
+                              },
+                              bearerTokenSecret: {
+                                description: "The secret's key that contains the bearer token to be used by the client\nfor authentication.\nThe secret needs to be in the same namespace as the AlertmanagerConfig\nobject and accessible by the Prometheus Operator.",
+                                properties: {
+                                  key: {
+                                    description: 'The key of the secret to select from.  Must be a valid secret key.',
+                                    minLength: 1,
+                                    type: 'string',
+                                  },
+                                  name: {
+                                    description: "The name of the secret in the object's namespace to select from.",
+                                    minLength: 1,
+                                    type: 'string',
+                                  },
+                                },
+                                required: [
+                                  'key',
+                                  'name',
+                                ],
+                                type: 'object',
+                              },
+                              followRedirects: {
+                                description: 'FollowRedirects specifies whether the client should follow HTTP 3xx redirects.',
+                                type: 'boolean',
+                              },
+                              noProxy: {
+                                description: '`noProxy` is a comma-separated string that can contain IPs, CIDR notation, domain names\nthat should be excluded from proxying. IP and domain names can\ncontain port numbers.\n\nIt requires Prometheus >= v2.43.0, Alertmanager >= v0.25.0 or Thanos >= v0.32.0.',
+                                type: 'string',
+                              },
+                              oauth2: {
+                                description: 'OAuth2 client credentials used to fetch a token for the targets.',
+                                properties: {
+                                  clientId: {
+                                    description: "`clientId` specifies a key of a Secret or ConfigMap containing the\nOAuth2 client's ID.",
+                                    properties: {
+                                      configMap: {
+                                        description: 'ConfigMap containing data to use for the targets.',
+                                        properties: {
+                                          key: {
+                                            description: 'The key to select.',
+                                            type: 'string',
+                                          },
+                                          name: {
+                                            default: '',
+                                            description: 'Name of the referent.\nThis field is effectively required, but due to backwards compatibility is\nallowed to be empty. Instances of this type with an empty value here are\nalmost certainly wrong.\nMore info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names',
+                                            type: 'string',
+                                          },
+                                          optional: {
+                                            description: 'Specify whether the ConfigMap or its key must be defined',
+                                            type: 'boolean',
+                                          },
+                                        },
+                                        required: [
+                                          'key',
+                                        ],
+                                        type: 'object',
+                                        'x-kubernetes-map-type': 'atomic',
+                                      },
+                                      secret: {
+                                        description: 'Secret containing data to use for the targets.',
+                                        properties: {
+                                          key: {
+                                            description: 'The key of the secret to select from.  Must be a valid secret key.',
+                                            type: 'string',
+                                          },
+                                          name: {
+                                            default: '',
+                                            description: 'Name of the referent.\nThis field is effectively required, but due to backwards compatibility is\nallowed to be empty. Instances of this type with an empty value here are\nalmost certainly wrong.\nMore info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names',
+                                            type: 'string',
+                                          },
+                                          optional: {
+                                            description: 'Specify whether the Secret or its key must be defined',
+                                            type: 'boolean',
+                                          },
+                                        },
+                                        required: [
+                                          'key',
+                                        ],
+                                        type: 'object',
+                                        'x-kubernetes-map-type': 'atomic',
+                                      },
+                                    },
+                                    type: 'object',
+                                  },
+                                  clientSecret: {
+                                    description: "`clientSecret` specifies a key of a Secret containing the OAuth2\nclient's secret.",
+                                    properties: {
+                                      key: {
+                                        description: 'The key of the secret to select from.  Must be a valid secret key.',
+                                        type: 'string',
+                                      },
+                                      name: {
+                                        default: '',
+                                        description: 'Name of the referent.\nThis field is effectively required, but due to backwards compatibility is\nallowed to be empty. Instances of this type with an empty value here are\nalmost certainly wrong.\nMore info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names',
+                                        type: 'string',
+                                      },
+                                      optional: {
+                                        description: 'Specify whether the Secret or its key must be defined',
+                                        type: 'boolean',
+                                      },
+                                    },
+                                    required: [
+                                      'key',
+                                    ],
+                                    type: 'object',
+                                    'x-kubernetes-map-type': 'atomic',
+                                  },
+                                  endpointParams: {
+                                    additionalProperties: {
+                                      type: 'string',
+                                    },
+                                    description: '`endpointParams` configures the HTTP parameters to append to the token\nURL.',
+                                    type: 'object',
+                                  },
+                                  noProxy: {
+                                    description: '`noProxy` is a comma-separated string that can contain IPs, CIDR notation, domain names\nthat should be excluded from proxying. IP and domain names can\ncontain port numbers.\n\nIt requires Prometheus >= v2.43.0, Alertmanager >= v0.25.0 or Thanos >= v0.32.0.',
+                                    type: 'string',
+                                  },
+                                  proxyConnectHeader: {
+                                    additionalProperties: {
+                                      items: {
+                                        description: 'SecretKeySelector selects a key of a Secret.',
+                                        properties: {
+                                          key: {
+                                            description: 'The key of the secret to select from.  Must be a valid secret key.',
+                                            type: 'string',
+                                          },
+                                          name: {
+                                            default: '',
+                                            description: 'Name of the referent.\nThis field is effectively required, but due to backwards compatibility is\nallowed to be empty. Instances of this type with an empty value here are\nalmost certainly wrong.\nMore info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names',
+                                            type: 'string',
+                                          },
+                                          optional: {
+                                            description: 'Specify whether the Secret or its key must be defined',
+                                            type: 'boolean',
+                                          },
+                                        },
+                                        required: [
+                                          'key',
+                                        ],
+                                        type: 'object',
+                                        'x-kubernetes-map-type': 'atomic',
+                                      },
+                                      type: 'array',
+                                    },
+                                    description: 'ProxyConnectHeader optionally specifies headers to send to\nproxies during CONNECT requests.\n\nIt requires Prometheus >= v2.43.0, Alertmanager >= v0.25.0 or Thanos >= v0.32.0.',
+                                    type: 'object',
+                                    'x-kubernetes-map-type': 'atomic',
+                                  },
+                                  proxyFromEnvironment: {
+                                    description: 'Whether to use the proxy configuration defined by environment variables (HTTP_PROXY, HTTPS_PROXY, and NO_PROXY).\n\nIt requires Prometheus >= v2.43.0, Alertmanager >= v0.25.0 or Thanos >= v0.32.0.',
+                                    type: 'boolean',
+                                  },
+                                  proxyUrl: {
+                                    description: '`proxyURL` defines the HTTP proxy server to use.',
+                                    pattern: '^(http|https|socks5)://.+$',
+                                    type: 'string',
+                                  },
+                                  scopes: {
+                                    description: '`scopes` defines the OAuth2 scopes used for the token request.',
+                                    items: {
+                                      type: 'string',
+                                    },
+                                    type: 'array',
+                                  },
+                                  tlsConfig: {
+                                    description: 'TLS configuration to use when connecting to the OAuth2 server.\nIt requires Prometheus >= v2.43.0.',
+                                    properties: {
+                                      ca: {
+                                        description: 'Certificate authority used when verifying server certificates.',
+                                        properties: {
+                                          configMap: {
+                                            description: 'ConfigMap containing data to use for the targets.',
+                                            properties: {
+                                              key: {
+                                                description: 'The key to select.',
+                                                type: 'string',
+                                              },
+                                              name: {
+                                                default: '',
+                                                description: 'Name of the referent.\nThis field is effectively required, but due to backwards compatibility is\nallowed to be empty. Instances of this type with an empty value here are\nalmost certainly wrong.\nMore info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names',
+                                                type: 'string',
+                                              },
+                                              optional: {
+                                                description: 'Specify whether the ConfigMap or its key must be defined',
+                                                type: 'boolean',
+                                              },
+                                            },
+                                            required: [
+                                              'key',
+                                            ],
+                                            type: 'object',
+                                            'x-kubernetes-map-type': 'atomic',
+                                          },
+                                          secret: {
+                                            description: 'Secret containing data to use for the targets.',
+                                            properties: {
+                                              key: {
+                                                description: 'The key of the secret to select from.  Must be a valid secret key.',
+                                                type: 'string',
+                                              },
+                                              name: {
+                                                default: '',
+                                                description: 'Name of the referent.\nThis field is effectively required, but due to backwards compatibility is\nallowed to be empty. Instances of this type with an empty value here are\nalmost certainly wrong.\nMore info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names',
+                                                type: 'string',
+                                              },
+                                              optional: {
+                                                description: 'Specify whether the Secret or its key must be defined',
+                                                type: 'boolean',
+                                              },
+                                            },
+                                            required: [
+                                              'key',
+                                            ],
+                                            type: 'object',
+                                            'x-kubernetes-map-type': 'atomic',
+                                          },
+                                        },
+                                        type: 'object',
+                                      },
+                                      cert: {
+                                        description: 'Client certificate to present when doing client-authentication.',
+                                        properties: {
+                                          configMap: {
+                                            description: 'ConfigMap containing data to use for the targets.',
+                                            properties: {
+                                              key: {
+                                                description: 'The key to select.',
+                                                type: 'string',
+                                              },
+                                              name: {
+                                                default: '',
+                                                description: 'Name of the referent.\nThis field is effectively required, but due to backwards compatibility is\nallowed to be empty. Instances of this type with an empty value here are\nalmost certainly wrong.\nMore info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names',
+                                                type: 'string',
+                                              },
+                                              optional: {
+                                                description: 'Specify whether the ConfigMap or its key must be defined',
+                                                type: 'boolean',
+                                              },
+                                            },
+                                            required: [
+                                              'key',
+                                            ],
+                                            type: 'object',
+                                            'x-kubernetes-map-type': 'atomic',
+                                          },
+                                          secret: {
+                                            description: 'Secret containing data to use for the targets.',
+                                            properties: {
+                                              key: {
+                                                description: 'The key of the secret to select from.  Must be a valid secret key.',
+                                                type: 'string',
+                                              },
+                                              name: {
+                                                default: '',
+                                                description: 'Name of the referent.\nThis field is effectively required, but due to backwards compatibility is\nallowed to be empty. Instances of this type with an empty value here are\nalmost certainly wrong.\nMore info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names',
+                                                type: 'string',
+                                              },
+                                              optional: {
+                                                description: 'Specify whether the Secret or its key must be defined',
+                                                type: 'boolean',
+                                              },
+                                            },
+                                            required: [
+                                              'key',
+                                            ],
+                                            type: 'object',
+                                            'x-kubernetes-map-type': 'atomic',
+                                          },
+                                        },
+                                        type: 'object',
+                                      },
+                                      insecureSkipVerify: {
+                                        description: 'Disable target certificate validation.',
+                                        type: 'boolean',
+                                      },
+                                      keySecret: {
+                                        description: 'Secret containing the client key file for the targets.',
+                                        properties: {
+                                          key: {
+                                            description: 'The key of the secret to select from.  Must be a valid secret key.',
+                                            type: 'string',
+                                          },
+                                          name: {
+                                            default: '',
+                                            description: 'Name of the referent.\nThis field is effectively required, but due to backwards compatibility is\nallowed to be empty. Instances of this type with an empty value here are\nalmost certainly wrong.\nMore info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names',
+                                            type: 'string',
+                                          },
+                                          optional: {
+                                            description: 'Specify whether the Secret or its key must be defined',
+                                            type: 'boolean',
+                                          },
+                                        },
+                                        required: [
+                                          'key',
+                                        ],
+                                        type: 'object',
+                                        'x-kubernetes-map-type': 'atomic',
+                                      },
+                                      maxVersion: {
+                                        description: 'Maximum acceptable TLS version.\n\nIt requires Prometheus >= v2.41.0 or Thanos >= v0.31.0.',
+                                        enum: [
+                                          'TLS10',
+                                          'TLS11',
+                                          'TLS12',
+                                          'TLS13',
+                                        ],
+                                        type: 'string',
+                                      },
+                                      minVersion: {
+                                        description: 'Minimum acceptable TLS version.\n\nIt requires Prometheus >= v2.35.0 or Thanos >= v0.28.0.',
+                                        enum: [
+                                          'TLS10',
+                                          'TLS11',
+                                          'TLS12',
+                                          'TLS13',
+                                        ],
+                                        type: 'string',
+                                      },
+                                      serverName: {
+                                        description: 'Used to verify the hostname for the targets.',
+                                        type: 'string',
+                                      },
+                                    },
+                                    type: 'object',
+                                  },
+                                  tokenUrl: {
+                                    description: '`tokenURL` configures the URL to fetch the token from.',
+                                    minLength: 1,
+                                    type: 'string',
+                                  },
+                                },
+                                required: [
+                                  'clientId',
+                                  'clientSecret',
+                                  'tokenUrl',
+                                ],
+                                type: 'object',
+                              },
+                              proxyConnectHeader: {
+                                additionalProperties: {
+                                  items: {
+                                    description: 'SecretKeySelector selects a key of a Secret.',
+                                    properties: {
+                                      key: {
+                                        description: 'The key of the secret to select from.  Must be a valid secret key.',
+                                        type: 'string',
+                                      },
+                                      name: {
+                                        default: '',
+                                        description: 'Name of the referent.\nThis field is effectively required, but due to backwards compatibility is\nallowed to be empty. Instances of this type with an empty value here are\nalmost certainly wrong.\nMore info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names',
+                                        type: 'string',
+                                      },
+                                      optional: {
+                                        description: 'Specify whether the Secret or its key must be defined',
+                                        type: 'boolean',
+                                      },
+                                    },
+                                    required: [
+                                      'key',
+                                    ],
+                                    type: 'object',
+                                    'x-kubernetes-map-type': 'atomic',
+                                  },
+                                  type: 'array',
+                                },
+                                description: 'ProxyConnectHeader optionally specifies headers to send to\nproxies during CONNECT requests.\n\nIt requires Prometheus >= v2.43.0, Alertmanager >= v0.25.0 or Thanos >= v0.32.0.',
+                                type: 'object',
+                                'x-kubernetes-map-type': 'atomic',
+                              },
+                              proxyFromEnvironment: {
+                                description: 'Whether to use the proxy configuration defined by environment variables (HTTP_PROXY, HTTPS_PROXY, and NO_PROXY).\n\nIt requires Prometheus >= v2.43.0, Alertmanager >= v0.25.0 or Thanos >= v0.32.0.',
+                                type: 'boolean',
+                              },
+                              proxyURL: {
+                                description: 'Optional proxy URL.\n\nIf defined, this field takes precedence over `proxyUrl`.',
+                                type: 'string',
+                              },
+                              proxyUrl: {
+                                description: '`proxyURL` defines the HTTP proxy server to use.',
+                                pattern: '^(http|https|socks5)://.+$',
+                                type: 'string',
+                              },
+                              tlsConfig: {
+                                description: 'TLS configuration for the client.',
+                                properties: {
+                                  ca: {
+                                    description: 'Certificate authority used when verifying server certificates.',
+                                    properties: {
+                                      configMap: {
+                                        description: 'ConfigMap containing data to use for the targets.',
+                                        properties: {
+                                          key: {
+                                            description: 'The key to select.',
+                                            type: 'string',
+                                          },
+                                          name: {
+                                            default: '',
+                                            description: 'Name of the referent.\nThis field is effectively required, but due to backwards compatibility is\nallowed to be empty. Instances of this type with an empty value here are\nalmost certainly wrong.\nMore info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names',
+                                            type: 'string',
+                                          },
+                                          optional: {
+                                            description: 'Specify whether the ConfigMap or its key must be defined',
+                                            type: 'boolean',
+                                          },
+                                        },
+                                        required: [
+                                          'key',
+                                        ],
+                                        type: 'object',
+                                        'x-kubernetes-map-type': 'atomic',
+                                      },
+                                      secret: {
+                                        description: 'Secret containing data to use for the targets.',
+                                        properties: {
+                                          key: {
+                                            description: 'The key of the secret to select from.  Must be a valid secret key.',
+                                            type: 'string',
+                                          },
+                                          name: {
+                                            default: '',
+                                            description: 'Name of the referent.\nThis field is effectively required, but due to backwards compatibility is\nallowed to be empty. Instances of this type with an empty value here are\nalmost certainly wrong.\nMore info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names',
+                                            type: 'string',
+                                          },
+                                          optional: {
+                                            description: 'Specify whether the Secret or its key must be defined',
+                                            type: 'boolean',
+                                          },
+                                        },
+                                        required: [
+                                          'key',
+                                        ],
+                                        type: 'object',
+                                        'x-kubernetes-map-type': 'atomic',
+                                      },
+                                    },
+                                    type: 'object',
+                                  },
+                                  cert: {
+                                    description: 'Client certificate to present when doing client-authentication.',
+                                    properties: {
+                                      configMap: {
+                                        description: 'ConfigMap containing data to use for the targets.',
+                                        properties: {
+                                          key: {
+                                            description: 'The key to select.',
+                                            type: 'string',
+                                          },
+                                          name: {
+                                            default: '',
+                                            description: 'Name of the referent.\nThis field is effectively required, but due to backwards compatibility is\nallowed to be empty. Instances of this type with an empty value here are\nalmost certainly wrong.\nMore info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names',
+                                            type: 'string',
+                                          },
+                                          optional: {
+                                            description: 'Specify whether the ConfigMap or its key must be defined',
+                                            type: 'boolean',
+                                          },
+                                        },
+                                        required: [
+                                          'key',
+                                        ],
+                                        type: 'object',
+                                        'x-kubernetes-map-type': 'atomic',
+                                      },
+                                      secret: {
+                                        description: 'Secret containing data to use for the targets.',
+                                        properties: {
+                                          key: {
+                                            description: 'The key of the secret to select from.  Must be a valid secret key.',
+                                            type: 'string',
+                                          },
+                                          name: {
+                                            default: '',
+                                            description: 'Name of the referent.\nThis field is effectively required, but due to backwards compatibility is\nallowed to be empty. Instances of this type with an empty value here are\nalmost certainly wrong.\nMore info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names',
+                                            type: 'string',
+                                          },
+                                          optional: {
+                                            description: 'Specify whether the Secret or its key must be defined',
+                                            type: 'boolean',
+                                          },
+                                        },
+                                        required: [
+                                          'key',
+                                        ],
+                                        type: 'object',
+                                        'x-kubernetes-map-type': 'atomic',
+                                      },
+                                    },
+                                    type: 'object',
+                                  },
+                                  insecureSkipVerify: {
+                                    description: 'Disable target certificate validation.',
+                                    type: 'boolean',
+                                  },
+                                  keySecret: {
+                                    description: 'Secret containing the client key file for the targets.',
+                                    properties: {
+                                      key: {
+                                        description: 'The key of the secret to select from.  Must be a valid secret key.',
+                                        type: 'string',
+                                      },
+                                      name: {
+                                        default: '',
+                                        description: 'Name of the referent.\nThis field is effectively required, but due to backwards compatibility is\nallowed to be empty. Instances of this type with an empty value here are\nalmost certainly wrong.\nMore info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names',
+                                        type: 'string',
+                                      },
+                                      optional: {
+                                        description: 'Specify whether the Secret or its key must be defined',
+                                        type: 'boolean',
+                                      },
+                                    },
+                                    required: [
+                                      'key',
+                                    ],
+                                    type: 'object',
+                                    'x-kubernetes-map-type': 'atomic',
+                                  },
+                                  maxVersion: {
+                                    description: 'Maximum acceptable TLS version.\n\nIt requires Prometheus >= v2.41.0 or Thanos >= v0.31.0.',
+                                    enum: [
+                                      'TLS10',
+                                      'TLS11',
+                                      'TLS12',
+                                      'TLS13',
+                                    ],
+                                    type: 'string',
+                                  },
+                                  minVersion: {
+                                    description: 'Minimum acceptable TLS version.\n\nIt requires Prometheus >= v2.35.0 or Thanos >= v0.28.0.',
+                                    enum: [
+                                      'TLS10',
+                                      'TLS11',
+                                      'TLS12',
+                                      'TLS13',
+                                    ],
+                                    type: 'string',
+                                  },
+                                  serverName: {
+                                    description: 'Used to verify the hostname for the targets.',
+                                    type: 'string',
+                                  },
+                                },
+                                type: 'object',
+                              },
+                            },
+                            type: 'object',
+                          },
+                          message: {
+                            description: 'Notification message.',
+                            type: 'string',
+                          },
+                          priority: {
+                            description: 'Priority, see https://pushover.net/api#priority',
+                            type: 'string',
+                          },
+                          retry: {
+                            description: 'How often the Pushover servers will send the same notification to the user.\nMust be at least 30 seconds.',
+                            pattern: '^(([0-9]+)y)?(([0-9]+)w)?(([0-9]+)d)?(([0-9]+)h)?(([0-9]+)m)?(([0-9]+)s)?(([0-9]+)ms)?$',
+                            type: 'string',
+                          },
+                          sendResolved: {
+                            description: 'Whether or not to notify about resolved alerts.',
+                            type: 'boolean',
+                          },
+                          sound: {
+                            description: "The name of one of the sounds supported by device clients to override the user's default sound choice",
+                            type: 'string',
+                          },
+                          title: {
+                            description: 'Notification title.',
+                            type: 'string',
+                          },
+                          token: {
+                            description: "The secret's key that contains the registered application's API token, see https://pushover.net/apps.\nThe secret needs to be in the same namespace as the AlertmanagerConfig\nobject and accessible by the Prometheus Operator.\nEither `token` or `tokenFile` is required.",
                             properties: {
                               key: {
                                 description: 'The key of the secret to select from.  Must be a valid secret key.',
@@ -3959,12 +5432,25 @@
                             ],
                             type: 'object',
                           },
-                          sendResolved: {
-                            description: 'Whether or not to notify about resolved alerts.',
-                            type: 'boolean',
+                          tokenFile: {
+                            description: "The token file that contains the registered application's API token, see https://pushover.net/apps.\nEither `token` or `tokenFile` is required.\nIt requires Alertmanager >= v0.26.0.",
+                            type: 'string',
                           },
-                          serviceKey: {
-                            description: "The secret's key that contains the PagerDuty service key (when using\nintegration type \"Prometheus\"). Either this field or `routingKey` needs to\nbe defined.\nThe secret needs to be in the same namespace as the AlertmanagerConfig\nobject and accessible by the Prometheus Operator.",
+                          ttl: {
+                            description: 'The time to live definition for the alert notification',
+                            pattern: '^(0|(([0-9]+)y)?(([0-9]+)w)?(([0-9]+)d)?(([0-9]+)h)?(([0-9]+)m)?(([0-9]+)s)?(([0-9]+)ms)?)$',
+                            type: 'string',
+                          },
+                          url: {
+                            description: 'A supplementary URL shown alongside the message.',
+                            type: 'string',
+                          },
+                          urlTitle: {
+                            description: 'A title for supplementary URL, otherwise just the URL is shown',
+                            type: 'string',
+                          },
+                          userKey: {
+                            description: "The secret's key that contains the recipient user's user key.\nThe secret needs to be in the same namespace as the AlertmanagerConfig\nobject and accessible by the Prometheus Operator.\nEither `userKey` or `userKeyFile` is required.",
                             properties: {
                               key: {
                                 description: 'The key of the secret to select from.  Must be a valid secret key.',
@@ -3983,16 +5469,8 @@
                             ],
                             type: 'object',
                           },
-                          severity: {
-                            description: 'Severity of the incident.',
-                            type: 'string',
-                          },
-                          source: {
-                            description: 'Unique location of the affected system.',
-                            type: 'string',
-                          },
-                          url: {
-                            description: 'The URL to send requests to.',
+                          userKeyFile: {
+                            description: "The user key file that contains the recipient user's user key.\nEither `userKey` or `userKeyFile` is required.\nIt requires Alertmanager >= v0.26.0.",
                             type: 'string',
                           },
                         },
@@ -4000,23 +5478,77 @@
                       },
                       type: 'array',
                     },
-                    pushoverConfigs: {
-                      description: 'List of Pushover configurations.',
+                    rocketchatConfigs: {
+                      description: 'List of RocketChat configurations.\nIt requires Alertmanager >= 0.28.0.',
                       items: {
-                        description: 'PushoverConfig configures notifications via Pushover.\nSee https://prometheus.io/docs/alerting/latest/configuration/#pushover_config',
+                        description: 'RocketChatConfig configures notifications via RocketChat.\nSee https://prometheus.io/docs/alerting/latest/configuration/#rocketchat_config\nIt requires Alertmanager >= 0.28.0.',
                         properties: {
-                          device: {
-                            description: 'The name of a device to send the notification to',
+                          actions: {
+                            description: 'Actions displayed as buttons in the message attachment.',
+                            items: {
+                              description: 'RocketChatActionConfig defines a button action for RocketChat messages.',
+                              properties: {
+                                msg: {
+                                  description: 'The message sent when the button is clicked.',
+                                  minLength: 1,
+                                  type: 'string',
+                                },
+                                text: {
+                                  description: 'The button text.',
+                                  minLength: 1,
+                                  type: 'string',
+                                },
+                                url: {
+                                  description: 'The URL opened when the button is clicked.',
+                                  pattern: '^https?://.+$',
+                                  type: 'string',
+                                },
+                              },
+                              type: 'object',
+                            },
+                            type: 'array',
+                          },
+                          apiURL: {
+                            description: 'The URL of the RocketChat API.\nIf not specified, the value of the `rocketchat_api_url` global setting is used.',
+                            pattern: '^https?://.+$',
                             type: 'string',
                           },
-                          expire: {
-                            description: 'How long your notification will continue to be retried for, unless the user\nacknowledges the notification.',
-                            pattern: '^(([0-9]+)y)?(([0-9]+)w)?(([0-9]+)d)?(([0-9]+)h)?(([0-9]+)m)?(([0-9]+)s)?(([0-9]+)ms)?$',
+                          channel: {
+                            description: 'The channel or user to send notifications to (e.g. "#other-channel" or\n"@username").',
+                            minLength: 1,
                             type: 'string',
                           },
-                          html: {
-                            description: 'Whether notification message is HTML or plain text.',
-                            type: 'boolean',
+                          color: {
+                            description: 'The message color.',
+                            type: 'string',
+                          },
+                          emoji: {
+                            description: 'The emoji used as the message avatar.',
+                            type: 'string',
+                          },
+                          fields: {
+                            description: 'Additional fields of the message attachment.',
+                            items: {
+                              description: 'RocketChatFieldConfig defines an additional field for RocketChat messages.',
+                              properties: {
+                                short: {
+                                  description: 'Whether the field is displayed side by side with other fields.',
+                                  type: 'boolean',
+                                },
+                                title: {
+                                  description: 'The title of the field.',
+                                  minLength: 1,
+                                  type: 'string',
+                                },
+                                value: {
+                                  description: 'The value of the field, displayed as a table.',
+                                  minLength: 1,
+                                  type: 'string',
+                                },
+                              },
+                              type: 'object',
+                            },
+                            type: 'array',
                           },
                           httpConfig: {
                             description: 'HTTP client configuration.',
@@ -4652,33 +6184,47 @@
                             },
                             type: 'object',
                           },
-                          message: {
-                            description: 'Notification message.',
+                          iconURL: {
+                            description: 'The URL of the icon used as the message avatar.',
+                            pattern: '^https?://.+$',
                             type: 'string',
                           },
-                          priority: {
-                            description: 'Priority, see https://pushover.net/api#priority',
+                          imageURL: {
+                            description: 'The URL of the image displayed in the message attachment.',
+                            pattern: '^https?://.+$',
                             type: 'string',
                           },
-                          retry: {
-                            description: 'How often the Pushover servers will send the same notification to the user.\nMust be at least 30 seconds.',
-                            pattern: '^(([0-9]+)y)?(([0-9]+)w)?(([0-9]+)d)?(([0-9]+)h)?(([0-9]+)m)?(([0-9]+)s)?(([0-9]+)ms)?$',
-                            type: 'string',
+                          linkNames: {
+                            description: 'Whether to notify the users and channels mentioned in the message.',
+                            type: 'boolean',
                           },
                           sendResolved: {
-                            description: 'Whether or not to notify about resolved alerts.',
+                            description: 'Whether to notify about resolved alerts.',
                             type: 'boolean',
                           },
-                          sound: {
-                            description: "The name of one of the sounds supported by device clients to override the user's default sound choice",
+                          shortFields: {
+                            description: 'Whether the fields are displayed side by side.',
+                            type: 'boolean',
+                          },
+                          text: {
+                            description: 'The message body template.',
+                            type: 'string',
+                          },
+                          thumbURL: {
+                            description: 'The URL of the thumbnail displayed in the message attachment.',
+                            pattern: '^https?://.+$',
                             type: 'string',
                           },
                           title: {
-                            description: 'Notification title.',
+                            description: 'The message title template.',
+                            type: 'string',
+                          },
+                          titleLink: {
+                            description: 'The message title link.',
                             type: 'string',
                           },
                           token: {
-                            description: "The secret's key that contains the registered application's API token, see https://pushover.net/apps.\nThe secret needs to be in the same namespace as the AlertmanagerConfig\nobject and accessible by the Prometheus Operator.\nEither `token` or `tokenFile` is required.",
+                            description: "The secret's key that contains the RocketChat token.\nThe secret needs to be in the same namespace as the AlertmanagerConfig\nobject and accessible by the Prometheus Operator.",
                             properties: {
                               key: {
                                 description: 'The key of the secret to select from.  Must be a valid secret key.',
@@ -4697,25 +6243,8 @@
                             ],
                             type: 'object',
                           },
-                          tokenFile: {
-                            description: "The token file that contains the registered application's API token, see https://pushover.net/apps.\nEither `token` or `tokenFile` is required.\nIt requires Alertmanager >= v0.26.0.",
-                            type: 'string',
-                          },
-                          ttl: {
-                            description: 'The time to live definition for the alert notification',
-                            pattern: '^(0|(([0-9]+)y)?(([0-9]+)w)?(([0-9]+)d)?(([0-9]+)h)?(([0-9]+)m)?(([0-9]+)s)?(([0-9]+)ms)?)$',
-                            type: 'string',
-                          },
-                          url: {
-                            description: 'A supplementary URL shown alongside the message.',
-                            type: 'string',
-                          },
-                          urlTitle: {
-                            description: 'A title for supplementary URL, otherwise just the URL is shown',
-                            type: 'string',
-                          },
-                          userKey: {
-                            description: "The secret's key that contains the recipient user's user key.\nThe secret needs to be in the same namespace as the AlertmanagerConfig\nobject and accessible by the Prometheus Operator.\nEither `userKey` or `userKeyFile` is required.",
+                          tokenID: {
+                            description: "The secret's key that contains the RocketChat token ID.\nThe secret needs to be in the same namespace as the AlertmanagerConfig\nobject and accessible by the Prometheus Operator.",
                             properties: {
                               key: {
                                 description: 'The key of the secret to select from.  Must be a valid secret key.',
@@ -4734,11 +6263,11 @@
                             ],
                             type: 'object',
                           },
-                          userKeyFile: {
-                            description: "The user key file that contains the recipient user's user key.\nEither `userKey` or `userKeyFile` is required.\nIt requires Alertmanager >= v0.26.0.",
-                            type: 'string',
-                          },
                         },
+                        required: [
+                          'token',
+                          'tokenID',
+                        ],
                         type: 'object',
                       },
                       type: 'array',
@@ -9848,11 +11377,84 @@
                 },
                 type: 'object',
               },
+              templates: {
+                description: 'List of Go templates which can be used by the receivers of this\nresource to customize the notifications.\n\nThe operator prefixes the names of the templates defined by\n`{{ define "<name>" }}` blocks with `<namespace>/<resource name>/` to\navoid collisions with the templates of other resources. The references\nto these templates from the receivers of the same resource (e.g.\n`{{ template "<name>" . }}`) are rewritten accordingly.',
+                items: {
+                  description: 'NotificationTemplate defines a file of Go templates for notifications.\nSee https://prometheus.io/docs/alerting/latest/notifications/ for details.',
+                  properties: {
+                    content: {
+                      description: 'Content of the template file, usually a list of `{{ define "<name>" }}`\nblocks.',
+                      minLength: 1,
+                      type: 'string',
+                    },
+                    name: {
+                      description: 'Name of the template file. It must be unique in the resource.',
+                      maxLength: 64,
+                      pattern: '^[a-zA-Z0-9][a-zA-Z0-9.-]*$',
+                      type: 'string',
+                    },
+                  },
+                  required: [
+                    'content',
+                    'name',
+                  ],
+                  type: 'object',
+                },
+                type: 'array',
+                'x-kubernetes-list-map-keys': [
+                  'name',
+                ],
+                'x-kubernetes-list-type': 'map',
+              },
               timeIntervals: {
                 description: 'List of TimeInterval specifying when the routes should be muted or active.',
                 items: {
                   description: 'TimeInterval specifies the periods in time when notifications will be muted or active.',
                   properties: {
+                    calendar: {
+                      description: 'Calendar references an iCalendar feed from which the operator generates\nadditional time intervals, one for each event occurring in the\nupcoming days. The time intervals are regenerated periodically.\n\nOnly the `VEVENT` components are considered. Recurring events support\nthe `FREQ`, `INTERVAL`, `COUNT`, `UNTIL` and `BYDAY` (weekly rules only)\nparts of `RRULE` as well as `EXDATE` and `RECURRENCE-ID`.',
+                      properties: {
+                        configMap: {
+                          description: 'The ConfigMap key containing the iCalendar data. The ConfigMap must be\nin the same namespace as the AlertmanagerConfig object.',
+                          properties: {
+                            key: {
+                              description: 'The key to select.',
+                              type: 'string',
+                            },
+                            name: {
+                              default: '',
+                              description: 'Name of the referent.\nThis field is effectively required, but due to backwards compatibility is\nallowed to be empty. Instances of this type with an empty value here are\nalmost certainly wrong.\nMore info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names',
+                              type: 'string',
+                            },
+                            optional: {
+                              description: 'Specify whether the ConfigMap or its key must be defined',
+                              type: 'boolean',
+                            },
+                          },
+                          required: [
+                            'key',
+                          ],
+                          type: 'object',
+                          'x-kubernetes-map-type': 'atomic',
+                        },
+                        days: {
+                          description: 'Number of days, starting from the current day, for which the calendar\nevents are expanded into time intervals.\nDefaults to 30.',
+                          format: 'int32',
+                          maximum: 366,
+                          minimum: 1,
+                          type: 'integer',
+                        },
+                        timeZone: {
+                          description: 'Time zone (IANA name) of the events without explicit time zone (e.g.\nall-day events). Events with a `TZID` parameter use their own time zone.\nDefaults to UTC.',
+                          minLength: 1,
+                          type: 'string',
+                        },
+                      },
+                      required: [
+                        'configMap',
+                      ],
+                      type: 'object',
+                    },
                     name: {
                       description: 'Name of the time interval.',
                       type: 'string',
@@ -9947,6 +11549,110 @@
             },
             type: 'object',
           },
+          status: {
+            description: 'The status of the AlertmanagerConfig resource.\nIt is only populated when the `StatusForConfigurationResources`\nfeature gate is enabled.',
+            properties: {
+              alertmanagers: {
+                description: 'The list of Alertmanager resources which load the configuration.',
+                items: {
+                  description: 'AlertmanagerConfigBinding is the status of the configuration for an\nAlertmanager resource.',
+                  properties: {
+                    name: {
+                      description: 'The name of the Alertmanager object.',
+                      minLength: 1,
+                      type: 'string',
+                    },
+                    namespace: {
+                      description: 'The namespace of the Alertmanager object.',
+                      minLength: 1,
+                      type: 'string',
+                    },
+                    receivers: {
+                      description: 'The notification delivery status of the receivers.',
+                      items: {
+                        description: 'ReceiverStatus is the notification delivery status of a receiver.',
+                        properties: {
+                          conditions: {
+                            description: 'The current state of the receiver.\nCurrently, only the "Delivering" condition is supported.',
+                            items: {
+                              description: 'Condition represents the state of the resources associated with the\nPrometheus, Alertmanager or ThanosRuler resource.',
+                              properties: {
+                                lastTransitionTime: {
+                                  description: 'lastTransitionTime is the time of the last update to the current status property.',
+                                  format: 'date-time',
+                                  type: 'string',
+                                },
+                                message: {
+                                  description: "Human-readable message indicating details for the condition's last transition.",
+                                  type: 'string',
+                                },
+                                observedGeneration: {
+                                  description: 'ObservedGeneration represents the .metadata.generation that the\ncondition was set based upon. For instance, if `.metadata.generation` is\ncurrently 12, but the `.status.conditions[].observedGeneration` is 9, the\ncondition is out of date with respect to the current state of the\ninstance.',
+                                  format: 'int64',
+                                  type: 'integer',
+                                },
+                                reason: {
+                                  description: "Reason for the condition's last transition.",
+                                  type: 'string',
+                                },
+                                status: {
+                                  description: 'Status of the condition.',
+                                  minLength: 1,
+                                  type: 'string',
+                                },
+                                type: {
+                                  description: 'Type of the condition being reported.',
+                                  minLength: 1,
+                                  type: 'string',
+                                },
+                              },
+                              required: [
+                                'lastTransitionTime',
+                                'status',
+                                'type',
+                              ],
+                              type: 'object',
+                            },
+                            type: 'array',
+                            'x-kubernetes-list-map-keys': [
+                              'type',
+                            ],
+                            'x-kubernetes-list-type': 'map',
+                          },
+                          name: {
+                            description: 'The name of the receiver.',
+                            minLength: 1,
+                            type: 'string',
+                          },
+                        },
+                        required: [
+                          'name',
+                        ],
+                        type: 'object',
+                      },
+                      type: 'array',
+                      'x-kubernetes-list-map-keys': [
+                        'name',
+                      ],
+                      'x-kubernetes-list-type': 'map',
+                    },
+                  },
+                  required: [
+                    'name',
+                    'namespace',
+                  ],
+                  type: 'object',
+                },
+                type: 'array',
+                'x-kubernetes-list-map-keys': [
+                  'name',
+                  'namespace',
+                ],
+                'x-kubernetes-list-type': 'map',
+              },
+            },
+            type: 'object',
+          },
         },
         required: [
           'spec',
@@ -9956,5 +11662,8 @@
     },
     served: true,
     storage: false,
+    subresources: {
+      status: {},
+    },
   },
 ] } }
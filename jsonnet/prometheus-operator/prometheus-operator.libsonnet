@@ -42,6 +42,8 @@ function(params) {
                                                  if po.config.enableAlertmanagerConfigV1beta1 then
                                                    (import 'alertmanagerconfigs-v1beta1-crd.libsonnet')
                                                  else {},
+  '0clusterAlertmanagerConfigCustomResourceDefinition': import 'clusteralertmanagerconfigs-crd.json',
+  '0alertmanagerSilenceCustomResourceDefinition': import 'alertmanagersilences-crd.json',
   '0prometheusagentCustomResourceDefinition': import 'prometheusagents-crd.json',
   '0prometheusCustomResourceDefinition': import 'prometheuses-crd.json',
   '0servicemonitorCustomResourceDefinition': import 'servicemonitors-crd.json',
@@ -50,6 +52,11 @@ function(params) {
   '0prometheusruleCustomResourceDefinition': import 'prometheusrules-crd.json',
   '0thanosrulerCustomResourceDefinition': import 'thanosrulers-crd.json',
   '0scrapeconfigCustomResourceDefinition': import 'scrapeconfigs-crd.json',
+  '0otlptenantCustomResourceDefinition': import 'otlptenants-crd.json',
+  '0remotewriteCustomResourceDefinition': import 'remotewrites-crd.json',
+  '0thanosquerierCustomResourceDefinition': import 'thanosqueriers-crd.json',
+  '0thanosstoreCustomResourceDefinition': import 'thanosstores-crd.json',
+  '0thanoscompactorCustomResourceDefinition': import 'thanoscompactors-crd.json',
 
   clusterRoleBinding: {
     apiVersion: 'rbac.authorization.k8s.io/v1',
@@ -85,6 +92,10 @@ function(params) {
                  'alertmanagers/finalizers',
                  'alertmanagers/status',
                  'alertmanagerconfigs',
+                 'alertmanagerconfigs/status',
+                 'clusteralertmanagerconfigs',
+                 'alertmanagersilences',
+                 'alertmanagersilences/status',
                  'prometheuses',
                  'prometheuses/finalizers',
                  'prometheuses/status',
@@ -94,11 +105,27 @@ function(params) {
                  'thanosrulers',
                  'thanosrulers/finalizers',
                  'thanosrulers/status',
+                 'thanosqueriers',
+                 'thanosqueriers/finalizers',
+                 'thanosqueriers/status',
+                 'thanosstores',
+                 'thanosstores/finalizers',
+                 'thanosstores/status',
+                 'thanoscompactors',
+                 'thanoscompactors/finalizers',
+                 'thanoscompactors/status',
                  'scrapeconfigs',
+                 'scrapeconfigs/status',
+                 'otlptenants',
+                 'remotewrites',
                  'servicemonitors',
+                 'servicemonitors/status',
                  'podmonitors',
+                 'podmonitors/status',
                  'probes',
+                 'probes/status',
                  'prometheusrules',
+                 'prometheusrules/status',
                ],
                verbs: ['*'],
              },
@@ -107,6 +134,11 @@ function(params) {
                resources: ['statefulsets'],
                verbs: ['*'],
              },
+             {
+               apiGroups: ['apps'],
+               resources: ['deployments'],
+               verbs: ['get', 'create', 'update', 'delete'],
+             },
              {
                apiGroups: [''],
                resources: ['configmaps', 'secrets'],
@@ -123,7 +155,7 @@ function(params) {
                  'services',
                  'services/finalizers',
                ],
-               verbs: ['get', 'create', 'update', 'delete'],
+               verbs: ['get', 'list', 'watch', 'create', 'update', 'delete'],
              },
              {
                apiGroups: [''],
@@ -145,6 +177,11 @@ function(params) {
                resources: ['ingresses'],
                verbs: ['get', 'list', 'watch'],
              },
+             {
+               apiGroups: ['gateway.networking.k8s.io'],
+               resources: ['httproutes'],
+               verbs: ['get', 'list', 'watch'],
+             },
              {
                apiGroups: ['storage.k8s.io'],
                resources: ['storageclasses'],
@@ -54,6 +54,7 @@ const (
 	prometheusRuleValidatePath     = "/admission-prometheusrules/validate"
 	prometheusRuleMutatePath       = "/admission-prometheusrules/mutate"
 	alertmanagerConfigValidatePath = "/admission-alertmanagerconfigs/validate"
+	alertmanagerConfigSimulatePath = "/alertmanagerconfigs/simulate"
 	convertPath                    = "/convert"
 )
 
@@ -93,6 +94,7 @@ func (a *Admission) Register(mux *http.ServeMux) {
 	mux.HandleFunc(prometheusRuleValidatePath, a.servePrometheusRulesValidate)
 	mux.HandleFunc(prometheusRuleMutatePath, a.servePrometheusRulesMutate)
 	mux.HandleFunc(alertmanagerConfigValidatePath, a.serveAlertmanagerConfigValidate)
+	mux.HandleFunc(alertmanagerConfigSimulatePath, a.serveAlertmanagerConfigSimulate)
 	mux.HandleFunc(convertPath, a.serveConvert)
 }
 
@@ -310,6 +310,167 @@ func TestAlertmanagerConfigConversion(t *testing.T) {
 	}
 }
 
+func TestAlertmanagerConfigLossyConversion(t *testing.T) {
+	ts := server(api().serveConvert)
+	t.Cleanup(ts.Close)
+
+	resp := sendConversionReview(t, ts, buildConversionReviewFromAlertmanagerConfigSpec(t, "v1alpha1", "v1beta1", string(golden.Get(t, "lossy_v1alpha1_v1beta1.golden"))))
+	require.Equal(t, "Failure", resp.Response.Result.Status)
+	require.Contains(t, resp.Response.Result.Message, "spec.receivers[0].opsgenieConfigs[0].apiKey.optional")
+	require.Empty(t, resp.Response.ConvertedObjects)
+}
+
+func TestAlertmanagerConfigRouteSimulation(t *testing.T) {
+	const amConfigs = `[
+  {
+    "apiVersion": "monitoring.coreos.com/v1alpha1",
+    "kind": "AlertmanagerConfig",
+    "metadata": {"name": "team", "namespace": "team-a"},
+    "spec": {
+      "route": {
+        "receiver": "default",
+        "routes": [
+          {"receiver": "pager", "matchers": [{"name": "severity", "value": "critical"}]}
+        ]
+      },
+      "receivers": [
+        {"name": "default"},
+        {"name": "pager", "webhookConfigs": [{"urlSecret": {"name": "pager", "key": "url"}}]}
+      ],
+      "inhibitRules": [
+        {
+          "sourceMatch": [{"name": "severity", "value": "critical"}],
+          "targetMatch": [{"name": "severity", "value": "warning"}],
+          "equal": ["alertname"]
+        }
+      ]
+    }
+  },
+  {
+    "apiVersion": "monitoring.coreos.com/v1beta1",
+    "kind": "AlertmanagerConfig",
+    "metadata": {"name": "team"},
+    "spec": {
+      "route": {"receiver": "default"},
+      "receivers": [{"name": "default"}]
+    }
+  }
+]`
+
+	for _, tc := range []struct {
+		name         string
+		request      string
+		expectedCode int
+		expected     *RouteSimulationResponse
+	}{
+		{
+			name:         "critical alert",
+			request:      `{"namespace": "team-b", "labels": {"namespace": "team-a", "severity": "critical"}, "alertmanagerConfigs": ` + amConfigs + `}`,
+			expectedCode: http.StatusOK,
+			expected: &RouteSimulationResponse{
+				Receivers: []string{"team-a/team/pager"},
+				InhibitRules: []SimulatedInhibitRule{{
+					SourceMatchers: []string{`namespace="team-a"`, `severity="critical"`},
+					TargetMatchers: []string{`namespace="team-a"`, `severity="warning"`},
+					Equal:          []string{"alertname"},
+					Source:         true,
+				}},
+			},
+		},
+		{
+			name:         "warning alert",
+			request:      `{"namespace": "team-b", "labels": {"namespace": "team-a", "severity": "warning"}, "alertmanagerConfigs": ` + amConfigs + `}`,
+			expectedCode: http.StatusOK,
+			expected: &RouteSimulationResponse{
+				Receivers: []string{"team-a/team/default"},
+				InhibitRules: []SimulatedInhibitRule{{
+					SourceMatchers: []string{`namespace="team-a"`, `severity="critical"`},
+					TargetMatchers: []string{`namespace="team-a"`, `severity="warning"`},
+					Equal:          []string{"alertname"},
+					Target:         true,
+				}},
+			},
+		},
+		{
+			name:         "v1beta1 object with default namespace",
+			request:      `{"namespace": "team-b", "labels": {"namespace": "team-b"}, "alertmanagerConfigs": ` + amConfigs + `}`,
+			expectedCode: http.StatusOK,
+			expected: &RouteSimulationResponse{
+				Receivers:    []string{"team-b/team/default"},
+				InhibitRules: []SimulatedInhibitRule{},
+			},
+		},
+		{
+			name:         "no matching route",
+			request:      `{"namespace": "team-b", "labels": {"namespace": "other"}, "alertmanagerConfigs": ` + amConfigs + `}`,
+			expectedCode: http.StatusOK,
+			expected: &RouteSimulationResponse{
+				Receivers:    []string{"null"},
+				InhibitRules: []SimulatedInhibitRule{},
+			},
+		},
+		{
+			name:         "no matcher enforcement",
+			request:      `{"namespace": "team-b", "labels": {"severity": "critical"}, "alertmanagerConfigMatcherStrategy": {"type": "None"}, "alertmanagerConfigs": ` + amConfigs + `}`,
+			expectedCode: http.StatusOK,
+			expected: &RouteSimulationResponse{
+				Receivers: []string{"team-a/team/pager", "team-b/team/default"},
+				InhibitRules: []SimulatedInhibitRule{{
+					SourceMatchers: []string{`severity="critical"`},
+					TargetMatchers: []string{`severity="warning"`},
+					Equal:          []string{"alertname"},
+					Source:         true,
+				}},
+			},
+		},
+		{
+			name:         "mute time interval with calendar",
+			request:      `{"labels": {"namespace": "team-a"}, "alertmanagerConfigs": [{"metadata": {"name": "team", "namespace": "team-a"}, "spec": {"route": {"receiver": "default", "muteTimeIntervals": ["holidays"]}, "receivers": [{"name": "default"}], "muteTimeIntervals": [{"name": "holidays", "calendar": {"configMap": {"name": "holidays", "key": "calendar.ics"}}}]}}]}`,
+			expectedCode: http.StatusOK,
+			expected: &RouteSimulationResponse{
+				Receivers:    []string{"team-a/team/default"},
+				InhibitRules: []SimulatedInhibitRule{},
+			},
+		},
+		{
+			name:         "missing namespace",
+			request:      `{"labels": {"namespace": "team-b"}, "alertmanagerConfigs": ` + amConfigs + `}`,
+			expectedCode: http.StatusUnprocessableEntity,
+		},
+		{
+			name:         "invalid AlertmanagerConfig",
+			request:      `{"labels": {"namespace": "team-a"}, "alertmanagerConfigs": [{"metadata": {"name": "team", "namespace": "team-a"}, "spec": {"route": {"receiver": "unknown"}}}]}`,
+			expectedCode: http.StatusUnprocessableEntity,
+		},
+		{
+			name:         "invalid request",
+			request:      `{"labels": []}`,
+			expectedCode: http.StatusBadRequest,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := server(api().serveAlertmanagerConfigSimulate)
+			t.Cleanup(ts.Close)
+
+			resp, err := http.Post(ts.URL, "application/json", strings.NewReader(tc.request))
+			require.NoError(t, err)
+			defer resp.Body.Close()
+
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedCode, resp.StatusCode, string(body))
+
+			if tc.expected == nil {
+				return
+			}
+
+			got := &RouteSimulationResponse{}
+			require.NoError(t, json.Unmarshal(body, got))
+			require.Equal(t, tc.expected, got)
+		})
+	}
+}
+
 func api() *Admission {
 	a := New(
 		slog.New(slog.DiscardHandler),
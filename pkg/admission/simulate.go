@@ -0,0 +1,289 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admission
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+
+	"github.com/blang/semver/v4"
+	"github.com/prometheus/alertmanager/config"
+	"github.com/prometheus/alertmanager/dispatch"
+	"github.com/prometheus/alertmanager/inhibit"
+	"github.com/prometheus/alertmanager/pkg/labels"
+	"github.com/prometheus/common/model"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/prometheus-operator/prometheus-operator/pkg/alertmanager"
+	validationv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/alertmanager/validation/v1alpha1"
+	validationv1beta1 "github.com/prometheus-operator/prometheus-operator/pkg/alertmanager/validation/v1beta1"
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	monitoringv1beta1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1beta1"
+	promoperator "github.com/prometheus-operator/prometheus-operator/pkg/operator"
+)
+
+// simulationBaseConfiguration is the configuration to which the
+// AlertmanagerConfig objects are added. It is the same as the default
+// configuration used by the operator when no configuration is provided.
+const simulationBaseConfiguration = `route:
+  receiver: 'null'
+receivers:
+- name: 'null'`
+
+// RouteSimulationRequest is the payload of the route simulation endpoint.
+type RouteSimulationRequest struct {
+	// Labels of the alert to simulate. The `namespace` label needs to be set
+	// for the alert to match the routes of the AlertmanagerConfig objects
+	// (unless the `None` matcher strategy is used).
+	Labels map[string]string `json:"labels"`
+	// Namespace of the AlertmanagerConfig objects which don't define
+	// `metadata.namespace`.
+	Namespace string `json:"namespace,omitempty"`
+	// AlertmanagerConfig objects (either v1alpha1 or v1beta1) to add to the
+	// Alertmanager configuration.
+	AlertmanagerConfigs []json.RawMessage `json:"alertmanagerConfigs"`
+	// Strategy used to enforce the namespace matcher on the routes and
+	// inhibition rules (same as `spec.alertmanagerConfigMatcherStrategy` in
+	// the Alertmanager resource).
+	AlertmanagerConfigMatcherStrategy monitoringv1.AlertmanagerConfigMatcherStrategy `json:"alertmanagerConfigMatcherStrategy,omitempty"`
+	// Version of Alertmanager. It defaults to the operator's default version.
+	Version string `json:"version,omitempty"`
+}
+
+// RouteSimulationResponse is the result of the route simulation.
+type RouteSimulationResponse struct {
+	// Receivers to which the alert would be routed, in order.
+	Receivers []string `json:"receivers"`
+	// Inhibition rules for which the alert matches either the source or the
+	// target matchers.
+	InhibitRules []SimulatedInhibitRule `json:"inhibitRules"`
+}
+
+// SimulatedInhibitRule describes an inhibition rule matching the simulated
+// alert.
+type SimulatedInhibitRule struct {
+	SourceMatchers []string `json:"sourceMatchers,omitempty"`
+	TargetMatchers []string `json:"targetMatchers,omitempty"`
+	Equal          []string `json:"equal,omitempty"`
+	// True if the alert matches the source matchers (e.g. it would inhibit
+	// other alerts).
+	Source bool `json:"source"`
+	// True if the alert matches the target matchers (e.g. it would be
+	// inhibited by other alerts).
+	Target bool `json:"target"`
+}
+
+func (a *Admission) serveAlertmanagerConfigSimulate(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed, want POST", http.StatusMethodNotAllowed)
+		return
+	}
+
+	if contentType := r.Header.Get("Content-Type"); contentType != "application/json" {
+		a.logger.Warn(fmt.Sprintf("invalid Content-Type %s, want `application/json`", contentType))
+		http.Error(w, "invalid Content-Type, want `application/json`", http.StatusUnsupportedMediaType)
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil || len(body) == 0 {
+		a.logger.Warn("request has no body")
+		http.Error(w, "request has no body", http.StatusBadRequest)
+		return
+	}
+
+	var req RouteSimulationRequest
+	if err := json.Unmarshal(body, &req); err != nil {
+		http.Error(w, fmt.Sprintf("cannot unmarshal request: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	resp, err := a.simulateRoutes(r.Context(), &req)
+	if err != nil {
+		a.logger.Debug("route simulation failed", "err", err)
+		http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+		return
+	}
+
+	respBytes, err := json.Marshal(resp)
+	if err != nil {
+		a.logger.Error("Cannot serialize response", "err", err)
+		http.Error(w, fmt.Sprintf("could not serialize response: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if _, err := w.Write(respBytes); err != nil {
+		a.logger.Error("Cannot write response", "err", err)
+	}
+}
+
+// simulateRoutes generates the Alertmanager configuration from the
+// AlertmanagerConfig objects and returns the receivers and inhibition rules
+// matching the alert, like `amtool config routes test` would do.
+func (a *Admission) simulateRoutes(ctx context.Context, req *RouteSimulationRequest) (*RouteSimulationResponse, error) {
+	version, err := semver.ParseTolerant(promoperator.StringValOrDefault(req.Version, promoperator.DefaultAlertmanagerVersion))
+	if err != nil {
+		return nil, fmt.Errorf("invalid Alertmanager version: %w", err)
+	}
+
+	lset := make(model.LabelSet, len(req.Labels))
+	for k, v := range req.Labels {
+		lset[model.LabelName(k)] = model.LabelValue(v)
+	}
+	if err := lset.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid labels: %w", err)
+	}
+
+	amConfigs := make(map[string]*monitoringv1alpha1.AlertmanagerConfig, len(req.AlertmanagerConfigs))
+	for i, raw := range req.AlertmanagerConfigs {
+		amConfig, err := decodeAlertmanagerConfig(raw)
+		if err != nil {
+			return nil, fmt.Errorf("alertmanagerConfigs[%d]: %w", i, err)
+		}
+
+		if amConfig.Namespace == "" {
+			amConfig.Namespace = req.Namespace
+		}
+		if amConfig.Namespace == "" {
+			return nil, fmt.Errorf("alertmanagerConfigs[%d]: namespace is required", i)
+		}
+
+		key := amConfig.Namespace + "/" + amConfig.Name
+		if _, found := amConfigs[key]; found {
+			return nil, fmt.Errorf("alertmanagerConfigs[%d]: duplicate AlertmanagerConfig %q", i, key)
+		}
+
+		// The receivers' integrations aren't relevant for routing and they
+		// may reference secrets which can't be resolved here.
+		for j := range amConfig.Spec.Receivers {
+			amConfig.Spec.Receivers[j] = monitoringv1alpha1.Receiver{Name: amConfig.Spec.Receivers[j].Name}
+		}
+		amConfig.Spec.Templates = nil
+
+		// The same goes for the calendars of the mute time intervals which
+		// are stored in config maps.
+		for j := range amConfig.Spec.MuteTimeIntervals {
+			amConfig.Spec.MuteTimeIntervals[j].Calendar = nil
+		}
+
+		amConfigs[key] = amConfig
+	}
+
+	cb := alertmanager.NewConfigBuilder(a.logger, version, nil, req.AlertmanagerConfigMatcherStrategy)
+	if err := cb.InitializeFromRawConfiguration([]byte(simulationBaseConfiguration)); err != nil {
+		return nil, err
+	}
+
+	if err := cb.AddAlertmanagerConfigs(ctx, amConfigs); err != nil {
+		return nil, err
+	}
+
+	b, err := cb.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+
+	cfg, err := config.Load(string(b))
+	if err != nil {
+		return nil, fmt.Errorf("invalid Alertmanager configuration: %w", err)
+	}
+
+	resp := &RouteSimulationResponse{
+		Receivers:    []string{},
+		InhibitRules: []SimulatedInhibitRule{},
+	}
+
+	for _, r := range dispatch.NewRoute(cfg.Route, nil).Match(lset) {
+		resp.Receivers = append(resp.Receivers, r.RouteOpts.Receiver)
+	}
+
+	for _, cr := range cfg.InhibitRules {
+		ir := inhibit.NewInhibitRule(cr)
+
+		source, target := ir.SourceMatchers.Matches(lset), ir.TargetMatchers.Matches(lset)
+		if !source && !target {
+			continue
+		}
+
+		resp.InhibitRules = append(resp.InhibitRules, SimulatedInhibitRule{
+			SourceMatchers: matchersToStrings(ir.SourceMatchers),
+			TargetMatchers: matchersToStrings(ir.TargetMatchers),
+			Equal:          cr.Equal,
+			Source:         source,
+			Target:         target,
+		})
+	}
+
+	return resp, nil
+}
+
+// decodeAlertmanagerConfig decodes and validates a v1alpha1 or v1beta1
+// AlertmanagerConfig object and returns it as v1alpha1.
+func decodeAlertmanagerConfig(raw json.RawMessage) (*monitoringv1alpha1.AlertmanagerConfig, error) {
+	var tm metav1.TypeMeta
+	if err := json.Unmarshal(raw, &tm); err != nil {
+		return nil, err
+	}
+
+	switch tm.APIVersion {
+	case "", monitoringv1alpha1.SchemeGroupVersion.String():
+		amConfig := &monitoringv1alpha1.AlertmanagerConfig{}
+		if err := json.Unmarshal(raw, amConfig); err != nil {
+			return nil, err
+		}
+
+		if err := validationv1alpha1.ValidateAlertmanagerConfig(amConfig); err != nil {
+			return nil, err
+		}
+
+		return amConfig, nil
+
+	case monitoringv1beta1.SchemeGroupVersion.String():
+		v1beta1Config := &monitoringv1beta1.AlertmanagerConfig{}
+		if err := json.Unmarshal(raw, v1beta1Config); err != nil {
+			return nil, err
+		}
+
+		if err := validationv1beta1.ValidateAlertmanagerConfig(v1beta1Config); err != nil {
+			return nil, err
+		}
+
+		amConfig := &monitoringv1alpha1.AlertmanagerConfig{}
+		if err := v1beta1Config.ConvertTo(amConfig); err != nil {
+			return nil, err
+		}
+
+		return amConfig, nil
+	}
+
+	return nil, fmt.Errorf("expected apiVersion to be %q or %q, got %q", monitoringv1alpha1.SchemeGroupVersion, monitoringv1beta1.SchemeGroupVersion, tm.APIVersion)
+}
+
+func matchersToStrings(matchers labels.Matchers) []string {
+	s := make([]string, 0, len(matchers))
+	for _, m := range matchers {
+		s = append(s, m.String())
+	}
+	// The deprecated match fields are converted in random order.
+	slices.Sort(s)
+
+	return s
+}
@@ -17,6 +17,7 @@ package alertmanager
 import (
 	"context"
 	"crypto/tls"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"log/slog"
@@ -24,6 +25,7 @@ import (
 	"net/url"
 	"path"
 	"strings"
+	"time"
 
 	"github.com/blang/semver/v4"
 	"github.com/prometheus/alertmanager/config"
@@ -210,6 +212,16 @@ type ConfigBuilder struct {
 	amVersion semver.Version
 	store     *assets.StoreBuilder
 	enforcer  enforcer
+
+	// Namespace of the secrets and configmaps referenced by the
+	// ClusterAlertmanagerConfig objects.
+	clusterConfigNamespace string
+
+	// Template files of the AlertmanagerConfig objects indexed by file name.
+	templateFiles map[string][]byte
+
+	// Returns the current time (used to expand the calendar events).
+	now func() time.Time
 }
 
 func NewConfigBuilder(logger *slog.Logger, amVersion semver.Version, store *assets.StoreBuilder, matcherStrategy monitoringv1.AlertmanagerConfigMatcherStrategy) *ConfigBuilder {
@@ -218,6 +230,7 @@ func NewConfigBuilder(logger *slog.Logger, amVersion semver.Version, store *asse
 		amVersion: amVersion,
 		store:     store,
 		enforcer:  getEnforcer(matcherStrategy, amVersion),
+		now:       time.Now,
 	}
 	return cg
 }
@@ -274,8 +287,14 @@ func (cb *ConfigBuilder) initializeFromAlertmanagerConfig(ctx context.Context, g
 	// Add routes to globalAlertmanagerConfig.Route without enforce namespace
 	globalAlertmanagerConfig.Route = cb.convertRoute(amConfig.Spec.Route, crKey)
 
+	templates, tr, err := cb.convertTemplates(amConfig.Spec.Templates, crKey)
+	if err != nil {
+		return err
+	}
+	globalAlertmanagerConfig.Templates = append(globalAlertmanagerConfig.Templates, templates...)
+
 	for _, receiver := range amConfig.Spec.Receivers {
-		receivers, err := cb.convertReceiver(ctx, &receiver, crKey)
+		receivers, err := cb.convertReceiver(ctx, tr.renameReceiver(&receiver), crKey)
 		if err != nil {
 			return err
 		}
@@ -283,7 +302,7 @@ func (cb *ConfigBuilder) initializeFromAlertmanagerConfig(ctx context.Context, g
 	}
 
 	for _, muteTimeInterval := range amConfig.Spec.MuteTimeIntervals {
-		mti, err := convertMuteTimeInterval(&muteTimeInterval, crKey)
+		mti, err := cb.convertMuteTimeInterval(ctx, &muteTimeInterval, crKey)
 		if err != nil {
 			return err
 		}
@@ -349,8 +368,14 @@ func (cb *ConfigBuilder) AddAlertmanagerConfigs(ctx context.Context, amConfigs m
 			),
 		)
 
+		templates, tr, err := cb.convertTemplates(amConfigs[amConfigIdentifier].Spec.Templates, crKey)
+		if err != nil {
+			return fmt.Errorf("AlertmanagerConfig %s: %w", crKey.String(), err)
+		}
+		cb.cfg.Templates = append(cb.cfg.Templates, templates...)
+
 		for _, receiver := range amConfigs[amConfigIdentifier].Spec.Receivers {
-			receivers, err := cb.convertReceiver(ctx, &receiver, crKey)
+			receivers, err := cb.convertReceiver(ctx, tr.renameReceiver(&receiver), crKey)
 			if err != nil {
 				return fmt.Errorf("AlertmanagerConfig %s: %w", crKey.String(), err)
 			}
@@ -358,7 +383,7 @@ func (cb *ConfigBuilder) AddAlertmanagerConfigs(ctx context.Context, amConfigs m
 		}
 
 		for _, muteTimeInterval := range amConfigs[amConfigIdentifier].Spec.MuteTimeIntervals {
-			mti, err := convertMuteTimeInterval(&muteTimeInterval, crKey)
+			mti, err := cb.convertMuteTimeInterval(ctx, &muteTimeInterval, crKey)
 			if err != nil {
 				return fmt.Errorf("AlertmanagerConfig %s: %w", crKey.String(), err)
 			}
@@ -375,6 +400,62 @@ func (cb *ConfigBuilder) AddAlertmanagerConfigs(ctx context.Context, amConfigs m
 	return cb.cfg.sanitize(cb.amVersion, cb.logger)
 }
 
+// AddClusterAlertmanagerConfigs adds ClusterAlertmanagerConfig objects to the
+// current configuration. The secrets and configmaps referenced by the objects
+// are read from the given namespace.
+//
+// No namespace matcher is enforced on the routes and inhibition rules. The
+// first-level routes are added before the existing first-level routes (e.g.
+// the ones from AlertmanagerConfig objects).
+func (cb *ConfigBuilder) AddClusterAlertmanagerConfigs(ctx context.Context, namespace string, amConfigs map[string]*monitoringv1alpha1.ClusterAlertmanagerConfig) error {
+	cb.clusterConfigNamespace = namespace
+
+	e := &continueToNextRoute{e: &noopEnforcer{}}
+	subRoutes := make([]*route, 0, len(amConfigs))
+	for _, amConfigIdentifier := range util.SortedKeys(amConfigs) {
+		amConfig := amConfigs[amConfigIdentifier]
+		// Cluster-scoped objects have no namespace.
+		crKey := types.NamespacedName{Name: amConfig.Name}
+
+		for _, inhibitRule := range amConfig.Spec.InhibitRules {
+			cb.cfg.InhibitRules = append(cb.cfg.InhibitRules, cb.convertInhibitRule(&inhibitRule))
+		}
+
+		// Skip early if there's no route definition.
+		if amConfig.Spec.Route == nil {
+			continue
+		}
+
+		subRoutes = append(subRoutes, e.processRoute(crKey, cb.convertRoute(amConfig.Spec.Route, crKey)))
+
+		templates, tr, err := cb.convertTemplates(amConfig.Spec.Templates, crKey)
+		if err != nil {
+			return fmt.Errorf("ClusterAlertmanagerConfig %s: %w", amConfig.Name, err)
+		}
+		cb.cfg.Templates = append(cb.cfg.Templates, templates...)
+
+		for _, receiver := range amConfig.Spec.Receivers {
+			receivers, err := cb.convertReceiver(ctx, tr.renameReceiver(&receiver), crKey)
+			if err != nil {
+				return fmt.Errorf("ClusterAlertmanagerConfig %s: %w", amConfig.Name, err)
+			}
+			cb.cfg.Receivers = append(cb.cfg.Receivers, receivers)
+		}
+
+		for _, muteTimeInterval := range amConfig.Spec.MuteTimeIntervals {
+			mti, err := cb.convertMuteTimeInterval(ctx, &muteTimeInterval, crKey)
+			if err != nil {
+				return fmt.Errorf("ClusterAlertmanagerConfig %s: %w", amConfig.Name, err)
+			}
+			cb.cfg.MuteTimeIntervals = append(cb.cfg.MuteTimeIntervals, mti)
+		}
+	}
+
+	cb.cfg.Route.Routes = append(subRoutes, cb.cfg.Route.Routes...)
+
+	return cb.cfg.sanitize(cb.amVersion, cb.logger)
+}
+
 func (cb *ConfigBuilder) getValidURLFromSecret(ctx context.Context, namespace string, selector v1.SecretKeySelector) (string, error) {
 	url, err := cb.store.GetSecretKey(ctx, namespace, selector)
 	if err != nil {
@@ -427,7 +508,7 @@ func (cb *ConfigBuilder) convertGlobalConfig(ctx context.Context, in *monitoring
 	}
 
 	if in.SlackAPIURL != nil {
-		slackAPIURL, err := cb.store.GetSecretKey(ctx, crKey.Namespace, *in.SlackAPIURL)
+		slackAPIURL, err := cb.store.GetSecretKey(ctx, cb.secretNamespace(crKey), *in.SlackAPIURL)
 		if err != nil {
 			return nil, fmt.Errorf("failed to get Slack API URL: %w", err)
 		}
@@ -439,7 +520,7 @@ func (cb *ConfigBuilder) convertGlobalConfig(ctx context.Context, in *monitoring
 	}
 
 	if in.OpsGenieAPIURL != nil {
-		opsgenieAPIURL, err := cb.store.GetSecretKey(ctx, crKey.Namespace, *in.OpsGenieAPIURL)
+		opsgenieAPIURL, err := cb.store.GetSecretKey(ctx, cb.secretNamespace(crKey), *in.OpsGenieAPIURL)
 		if err != nil {
 			return nil, fmt.Errorf("failed to get OpsGenie API URL: %w", err)
 		}
@@ -451,7 +532,7 @@ func (cb *ConfigBuilder) convertGlobalConfig(ctx context.Context, in *monitoring
 	}
 
 	if in.OpsGenieAPIKey != nil {
-		opsGenieAPIKey, err := cb.store.GetSecretKey(ctx, crKey.Namespace, *in.OpsGenieAPIKey)
+		opsGenieAPIKey, err := cb.store.GetSecretKey(ctx, cb.secretNamespace(crKey), *in.OpsGenieAPIKey)
 		if err != nil {
 			return nil, fmt.Errorf("failed to get OpsGenie API KEY: %w", err)
 		}
@@ -466,9 +547,107 @@ func (cb *ConfigBuilder) convertGlobalConfig(ctx context.Context, in *monitoring
 		out.PagerdutyURL = &config.URL{URL: u}
 	}
 
+	if in.TelegramConfig != nil {
+		u, err := parseGlobalURL(in.TelegramConfig.APIURL)
+		if err != nil {
+			return nil, fmt.Errorf("parse Telegram API URL: %w", err)
+		}
+		out.TelegramAPIURL = u
+	}
+
+	if in.WebexConfig != nil {
+		u, err := parseGlobalURL(in.WebexConfig.APIURL)
+		if err != nil {
+			return nil, fmt.Errorf("parse Webex API URL: %w", err)
+		}
+		out.WebexAPIURL = u
+	}
+
+	if in.VictorOpsConfig != nil {
+		u, err := parseGlobalURL(in.VictorOpsConfig.APIURL)
+		if err != nil {
+			return nil, fmt.Errorf("parse VictorOps API URL: %w", err)
+		}
+		out.VictorOpsAPIURL = u
+
+		if in.VictorOpsConfig.APIKey != nil {
+			apiKey, err := cb.store.GetSecretKey(ctx, cb.secretNamespace(crKey), *in.VictorOpsConfig.APIKey)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get VictorOps API Key: %w", err)
+			}
+			out.VictorOpsAPIKey = apiKey
+		}
+	}
+
+	if in.WeChatConfig != nil {
+		u, err := parseGlobalURL(in.WeChatConfig.APIURL)
+		if err != nil {
+			return nil, fmt.Errorf("parse WeChat API URL: %w", err)
+		}
+		out.WeChatAPIURL = u
+
+		if in.WeChatConfig.APISecret != nil {
+			apiSecret, err := cb.store.GetSecretKey(ctx, cb.secretNamespace(crKey), *in.WeChatConfig.APISecret)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get WeChat API Secret: %w", err)
+			}
+			out.WeChatAPISecret = apiSecret
+		}
+
+		if in.WeChatConfig.APICorpID != nil {
+			out.WeChatAPICorpID = *in.WeChatConfig.APICorpID
+		}
+	}
+
+	if in.JiraConfig != nil {
+		u, err := parseGlobalURL(in.JiraConfig.APIURL)
+		if err != nil {
+			return nil, fmt.Errorf("parse Jira API URL: %w", err)
+		}
+		out.JiraAPIURL = u
+	}
+
+	if in.RocketChatConfig != nil {
+		u, err := parseGlobalURL(in.RocketChatConfig.APIURL)
+		if err != nil {
+			return nil, fmt.Errorf("parse Rocket.Chat API URL: %w", err)
+		}
+		out.RocketChatAPIURL = u
+
+		if in.RocketChatConfig.Token != nil {
+			token, err := cb.store.GetSecretKey(ctx, cb.secretNamespace(crKey), *in.RocketChatConfig.Token)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get Rocket.Chat token: %w", err)
+			}
+			out.RocketChatToken = token
+		}
+
+		if in.RocketChatConfig.TokenID != nil {
+			tokenID, err := cb.store.GetSecretKey(ctx, cb.secretNamespace(crKey), *in.RocketChatConfig.TokenID)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get Rocket.Chat token ID: %w", err)
+			}
+			out.RocketChatTokenID = tokenID
+		}
+	}
+
 	return out, nil
 }
 
+// parseGlobalURL returns nil if the URL isn't defined.
+func parseGlobalURL(in *monitoringv1.URL) (*config.URL, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	u, err := url.Parse(string(*in))
+	if err != nil {
+		return nil, err
+	}
+
+	return &config.URL{URL: u}, nil
+}
+
 func (cb *ConfigBuilder) convertRoute(in *monitoringv1alpha1.Route, crKey types.NamespacedName) *route {
 	if in == nil {
 		return nil
@@ -698,6 +877,38 @@ func (cb *ConfigBuilder) convertReceiver(ctx context.Context, in *monitoringv1al
 		}
 	}
 
+	var jiraConfigs []*jiraConfig
+	if l := len(in.JiraConfigs); l > 0 {
+		if cb.amVersion.LT(semver.MustParse("0.28.0")) {
+			return nil, fmt.Errorf("JiraConfig: jira integration is only available in Alertmanager >= 0.28.0")
+		}
+
+		jiraConfigs = make([]*jiraConfig, l)
+		for i := range in.JiraConfigs {
+			receiver, err := cb.convertJiraConfig(ctx, in.JiraConfigs[i], crKey)
+			if err != nil {
+				return nil, fmt.Errorf("JiraConfig[%d]: %w", i, err)
+			}
+			jiraConfigs[i] = receiver
+		}
+	}
+
+	var rocketChatConfigs []*rocketChatConfig
+	if l := len(in.RocketChatConfigs); l > 0 {
+		if cb.amVersion.LT(semver.MustParse("0.28.0")) {
+			return nil, fmt.Errorf("RocketChatConfig: rocketchat integration is only available in Alertmanager >= 0.28.0")
+		}
+
+		rocketChatConfigs = make([]*rocketChatConfig, l)
+		for i := range in.RocketChatConfigs {
+			receiver, err := cb.convertRocketChatConfig(ctx, in.RocketChatConfigs[i], crKey)
+			if err != nil {
+				return nil, fmt.Errorf("RocketChatConfig[%d]: %w", i, err)
+			}
+			rocketChatConfigs[i] = receiver
+		}
+	}
+
 	var webexConfigs []*webexConfig
 	if l := len(in.WebexConfigs); l > 0 {
 		webexConfigs = make([]*webexConfig, l)
@@ -711,21 +922,23 @@ func (cb *ConfigBuilder) convertReceiver(ctx context.Context, in *monitoringv1al
 	}
 
 	return &receiver{
-		Name:             makeNamespacedString(in.Name, crKey),
-		OpsgenieConfigs:  opsgenieConfigs,
-		PagerdutyConfigs: pagerdutyConfigs,
-		DiscordConfigs:   discordConfigs,
-		SlackConfigs:     slackConfigs,
-		WebhookConfigs:   webhookConfigs,
-		WeChatConfigs:    weChatConfigs,
-		EmailConfigs:     emailConfigs,
-		VictorOpsConfigs: victorOpsConfigs,
-		PushoverConfigs:  pushoverConfigs,
-		SNSConfigs:       snsConfigs,
-		TelegramConfigs:  telegramConfigs,
-		WebexConfigs:     webexConfigs,
-		MSTeamsConfigs:   msTeamsConfigs,
-		MSTeamsV2Configs: msTeamsV2Configs,
+		Name:              makeNamespacedString(in.Name, crKey),
+		OpsgenieConfigs:   opsgenieConfigs,
+		PagerdutyConfigs:  pagerdutyConfigs,
+		DiscordConfigs:    discordConfigs,
+		SlackConfigs:      slackConfigs,
+		WebhookConfigs:    webhookConfigs,
+		WeChatConfigs:     weChatConfigs,
+		EmailConfigs:      emailConfigs,
+		VictorOpsConfigs:  victorOpsConfigs,
+		PushoverConfigs:   pushoverConfigs,
+		SNSConfigs:        snsConfigs,
+		TelegramConfigs:   telegramConfigs,
+		WebexConfigs:      webexConfigs,
+		MSTeamsConfigs:    msTeamsConfigs,
+		MSTeamsV2Configs:  msTeamsV2Configs,
+		JiraConfigs:       jiraConfigs,
+		RocketChatConfigs: rocketChatConfigs,
 	}, nil
 }
 
@@ -735,7 +948,7 @@ func (cb *ConfigBuilder) convertWebhookConfig(ctx context.Context, in monitoring
 	}
 
 	if in.URLSecret != nil {
-		url, err := cb.getValidURLFromSecret(ctx, crKey.Namespace, *in.URLSecret)
+		url, err := cb.getValidURLFromSecret(ctx, cb.secretNamespace(crKey), *in.URLSecret)
 		if err != nil {
 			return nil, err
 		}
@@ -796,7 +1009,7 @@ func (cb *ConfigBuilder) convertDiscordConfig(ctx context.Context, in monitoring
 		out.AvatarURL = (string)(*in.AvatarURL)
 	}
 
-	url, err := cb.getValidURLFromSecret(ctx, crKey.Namespace, in.APIURL)
+	url, err := cb.getValidURLFromSecret(ctx, cb.secretNamespace(crKey), in.APIURL)
 	if err != nil {
 		return nil, err
 	}
@@ -834,7 +1047,7 @@ func (cb *ConfigBuilder) convertSlackConfig(ctx context.Context, in monitoringv1
 	}
 
 	if in.APIURL != nil {
-		url, err := cb.getValidURLFromSecret(ctx, crKey.Namespace, *in.APIURL)
+		url, err := cb.getValidURLFromSecret(ctx, cb.secretNamespace(crKey), *in.APIURL)
 		if err != nil {
 			return nil, err
 		}
@@ -907,7 +1120,7 @@ func (cb *ConfigBuilder) convertPagerdutyConfig(ctx context.Context, in monitori
 	}
 
 	if in.RoutingKey != nil {
-		routingKey, err := cb.store.GetSecretKey(ctx, crKey.Namespace, *in.RoutingKey)
+		routingKey, err := cb.store.GetSecretKey(ctx, cb.secretNamespace(crKey), *in.RoutingKey)
 		if err != nil {
 			return nil, fmt.Errorf("failed to get routing key: %w", err)
 		}
@@ -915,7 +1128,7 @@ func (cb *ConfigBuilder) convertPagerdutyConfig(ctx context.Context, in monitori
 	}
 
 	if in.ServiceKey != nil {
-		serviceKey, err := cb.store.GetSecretKey(ctx, crKey.Namespace, *in.ServiceKey)
+		serviceKey, err := cb.store.GetSecretKey(ctx, cb.secretNamespace(crKey), *in.ServiceKey)
 		if err != nil {
 			return nil, fmt.Errorf("failed to get service key: %w", err)
 		}
@@ -985,7 +1198,7 @@ func (cb *ConfigBuilder) convertOpsgenieConfig(ctx context.Context, in monitorin
 	}
 
 	if in.APIKey != nil {
-		apiKey, err := cb.store.GetSecretKey(ctx, crKey.Namespace, *in.APIKey)
+		apiKey, err := cb.store.GetSecretKey(ctx, cb.secretNamespace(crKey), *in.APIKey)
 		if err != nil {
 			return nil, fmt.Errorf("failed to get API key: %w", err)
 		}
@@ -1039,7 +1252,7 @@ func (cb *ConfigBuilder) convertWeChatConfig(ctx context.Context, in monitoringv
 	}
 
 	if in.APISecret != nil {
-		apiSecret, err := cb.store.GetSecretKey(ctx, crKey.Namespace, *in.APISecret)
+		apiSecret, err := cb.store.GetSecretKey(ctx, cb.secretNamespace(crKey), *in.APISecret)
 		if err != nil {
 			return nil, fmt.Errorf("failed to get API secret: %w", err)
 		}
@@ -1108,7 +1321,7 @@ func (cb *ConfigBuilder) convertEmailConfig(ctx context.Context, in monitoringv1
 	}
 
 	if in.AuthPassword != nil {
-		authPassword, err := cb.store.GetSecretKey(ctx, crKey.Namespace, *in.AuthPassword)
+		authPassword, err := cb.store.GetSecretKey(ctx, cb.secretNamespace(crKey), *in.AuthPassword)
 		if err != nil {
 			return nil, fmt.Errorf("failed to get auth password: %w", err)
 		}
@@ -1116,7 +1329,7 @@ func (cb *ConfigBuilder) convertEmailConfig(ctx context.Context, in monitoringv1
 	}
 
 	if in.AuthSecret != nil {
-		authSecret, err := cb.store.GetSecretKey(ctx, crKey.Namespace, *in.AuthSecret)
+		authSecret, err := cb.store.GetSecretKey(ctx, cb.secretNamespace(crKey), *in.AuthSecret)
 		if err != nil {
 			return nil, fmt.Errorf("failed to get auth secret: %w", err)
 		}
@@ -1150,7 +1363,7 @@ func (cb *ConfigBuilder) convertVictorOpsConfig(ctx context.Context, in monitori
 	}
 
 	if in.APIKey != nil {
-		apiKey, err := cb.store.GetSecretKey(ctx, crKey.Namespace, *in.APIKey)
+		apiKey, err := cb.store.GetSecretKey(ctx, cb.secretNamespace(crKey), *in.APIKey)
 		if err != nil {
 			return nil, fmt.Errorf("failed to get API key: %w", err)
 		}
@@ -1208,7 +1421,7 @@ func (cb *ConfigBuilder) convertPushoverConfig(ctx context.Context, in monitorin
 	}
 
 	{
-		userKey, err := cb.store.GetSecretKey(ctx, crKey.Namespace, *in.UserKey)
+		userKey, err := cb.store.GetSecretKey(ctx, cb.secretNamespace(crKey), *in.UserKey)
 		if err != nil {
 			return nil, fmt.Errorf("failed to get user key: %w", err)
 		}
@@ -1219,7 +1432,7 @@ func (cb *ConfigBuilder) convertPushoverConfig(ctx context.Context, in monitorin
 	}
 
 	{
-		token, err := cb.store.GetSecretKey(ctx, crKey.Namespace, *in.Token)
+		token, err := cb.store.GetSecretKey(ctx, cb.secretNamespace(crKey), *in.Token)
 		if err != nil {
 			return nil, fmt.Errorf("failed to get token: %w", err)
 		}
@@ -1277,7 +1490,7 @@ func (cb *ConfigBuilder) convertTelegramConfig(ctx context.Context, in monitorin
 	}
 
 	if in.BotToken != nil {
-		botToken, err := cb.store.GetSecretKey(ctx, crKey.Namespace, *in.BotToken)
+		botToken, err := cb.store.GetSecretKey(ctx, cb.secretNamespace(crKey), *in.BotToken)
 		if err != nil {
 			return nil, fmt.Errorf("failed to get bot token: %w", err)
 		}
@@ -1316,12 +1529,12 @@ func (cb *ConfigBuilder) convertSnsConfig(ctx context.Context, in monitoringv1al
 		}
 
 		if in.Sigv4.AccessKey != nil && in.Sigv4.SecretKey != nil {
-			accessKey, err := cb.store.GetSecretKey(ctx, crKey.Namespace, *in.Sigv4.AccessKey)
+			accessKey, err := cb.store.GetSecretKey(ctx, cb.secretNamespace(crKey), *in.Sigv4.AccessKey)
 			if err != nil {
 				return nil, fmt.Errorf("failed to get access key: %w", err)
 			}
 
-			secretKey, err := cb.store.GetSecretKey(ctx, crKey.Namespace, *in.Sigv4.SecretKey)
+			secretKey, err := cb.store.GetSecretKey(ctx, cb.secretNamespace(crKey), *in.Sigv4.SecretKey)
 			if err != nil {
 				return nil, fmt.Errorf("failed to get AWS secret key: %w", err)
 
@@ -1353,7 +1566,7 @@ func (cb *ConfigBuilder) convertMSTeamsConfig(
 		out.Summary = *in.Summary
 	}
 
-	webHookURL, err := cb.store.GetSecretKey(ctx, crKey.Namespace, in.WebhookURL)
+	webHookURL, err := cb.store.GetSecretKey(ctx, cb.secretNamespace(crKey), in.WebhookURL)
 	if err != nil {
 		return nil, err
 	}
@@ -1385,7 +1598,7 @@ func (cb *ConfigBuilder) convertMSTeamsV2Config(
 	}
 
 	if in.WebhookURL != nil {
-		webHookURL, err := cb.store.GetSecretKey(ctx, crKey.Namespace, *in.WebhookURL)
+		webHookURL, err := cb.store.GetSecretKey(ctx, cb.secretNamespace(crKey), *in.WebhookURL)
 		if err != nil {
 			return nil, err
 		}
@@ -1402,6 +1615,128 @@ func (cb *ConfigBuilder) convertMSTeamsV2Config(
 	return out, nil
 }
 
+func (cb *ConfigBuilder) convertJiraConfig(
+	ctx context.Context, in monitoringv1alpha1.JiraConfig, crKey types.NamespacedName,
+) (*jiraConfig, error) {
+	out := &jiraConfig{
+		VSendResolved:     in.SendResolved,
+		Project:           in.Project,
+		IssueType:         in.IssueType,
+		Summary:           ptr.Deref(in.Summary, ""),
+		Description:       ptr.Deref(in.Description, ""),
+		Labels:            in.Labels,
+		Priority:          ptr.Deref(in.Priority, ""),
+		ReopenTransition:  ptr.Deref(in.ReopenTransition, ""),
+		ResolveTransition: ptr.Deref(in.ResolveTransition, ""),
+		WontFixResolution: ptr.Deref(in.WontFixResolution, ""),
+	}
+
+	if in.APIURL != nil {
+		out.APIURL = string(*in.APIURL)
+	}
+
+	if in.ReopenDuration != nil {
+		reopenDuration, err := model.ParseDuration(string(*in.ReopenDuration))
+		if err != nil {
+			return nil, fmt.Errorf("invalid reopenDuration: %w", err)
+		}
+		out.ReopenDuration = reopenDuration
+	}
+
+	if l := len(in.Fields); l > 0 {
+		out.Fields = make(map[string]any, l)
+		for _, f := range in.Fields {
+			var v any
+			if err := json.Unmarshal(f.Value.Raw, &v); err != nil {
+				return nil, fmt.Errorf("invalid value for field %q: %w", f.Key, err)
+			}
+			out.Fields[f.Key] = v
+		}
+	}
+
+	httpConfig, err := cb.convertHTTPConfig(ctx, in.HTTPConfig, crKey)
+	if err != nil {
+		return nil, err
+	}
+	out.HTTPConfig = httpConfig
+
+	return out, nil
+}
+
+func (cb *ConfigBuilder) convertRocketChatConfig(
+	ctx context.Context, in monitoringv1alpha1.RocketChatConfig, crKey types.NamespacedName,
+) (*rocketChatConfig, error) {
+	out := &rocketChatConfig{
+		VSendResolved: in.SendResolved,
+		Channel:       ptr.Deref(in.Channel, ""),
+		Color:         ptr.Deref(in.Color, ""),
+		Title:         ptr.Deref(in.Title, ""),
+		TitleLink:     ptr.Deref(in.TitleLink, ""),
+		Text:          ptr.Deref(in.Text, ""),
+		ShortFields:   ptr.Deref(in.ShortFields, false),
+		Emoji:         ptr.Deref(in.Emoji, ""),
+		LinkNames:     ptr.Deref(in.LinkNames, false),
+	}
+
+	if in.APIURL != nil {
+		out.APIURL = string(*in.APIURL)
+	}
+
+	if in.IconURL != nil {
+		out.IconURL = string(*in.IconURL)
+	}
+
+	if in.ImageURL != nil {
+		out.ImageURL = string(*in.ImageURL)
+	}
+
+	if in.ThumbURL != nil {
+		out.ThumbURL = string(*in.ThumbURL)
+	}
+
+	tokenID, err := cb.store.GetSecretKey(ctx, cb.secretNamespace(crKey), in.TokenID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get token ID: %w", err)
+	}
+	out.TokenID = &tokenID
+
+	token, err := cb.store.GetSecretKey(ctx, cb.secretNamespace(crKey), in.Token)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get token: %w", err)
+	}
+	out.Token = &token
+
+	for _, f := range in.Fields {
+		out.Fields = append(out.Fields, &rocketchatAttachmentField{
+			Short: f.Short,
+			Title: ptr.Deref(f.Title, ""),
+			Value: ptr.Deref(f.Value, ""),
+		})
+	}
+
+	for _, a := range in.Actions {
+		action := &rocketchatAttachmentAction{
+			Type: "button",
+			Text: ptr.Deref(a.Text, ""),
+			Msg:  ptr.Deref(a.Msg, ""),
+		}
+
+		if a.URL != nil {
+			action.URL = string(*a.URL)
+		}
+
+		out.Actions = append(out.Actions, action)
+	}
+
+	httpConfig, err := cb.convertHTTPConfig(ctx, in.HTTPConfig, crKey)
+	if err != nil {
+		return nil, err
+	}
+	out.HTTPConfig = httpConfig
+
+	return out, nil
+}
+
 func (cb *ConfigBuilder) convertInhibitRule(in *monitoringv1alpha1.InhibitRule) *inhibitRule {
 	matchersV2Allowed := cb.amVersion.GTE(semver.MustParse("0.22.0"))
 	var sourceMatchers []string
@@ -1471,8 +1806,10 @@ func (cb *ConfigBuilder) convertInhibitRule(in *monitoringv1alpha1.InhibitRule)
 	}
 }
 
-func convertMuteTimeInterval(in *monitoringv1alpha1.MuteTimeInterval, crKey types.NamespacedName) (*timeInterval, error) {
-	muteTimeInterval := &timeInterval{}
+func (cb *ConfigBuilder) convertMuteTimeInterval(ctx context.Context, in *monitoringv1alpha1.MuteTimeInterval, crKey types.NamespacedName) (*timeInterval, error) {
+	muteTimeInterval := &timeInterval{
+		Name: makeNamespacedString(in.Name, crKey),
+	}
 
 	for _, timeInterval := range in.TimeIntervals {
 		ti := timeinterval.TimeInterval{}
@@ -1536,20 +1873,74 @@ func convertMuteTimeInterval(in *monitoringv1alpha1.MuteTimeInterval, crKey type
 			})
 		}
 
-		muteTimeInterval.Name = makeNamespacedString(in.Name, crKey)
 		muteTimeInterval.TimeIntervals = append(muteTimeInterval.TimeIntervals, ti)
 	}
 
+	if in.Calendar != nil {
+		tis, err := cb.convertCalendar(ctx, in.Calendar, crKey)
+		if err != nil {
+			return nil, fmt.Errorf("mute time interval %q: %w", in.Name, err)
+		}
+		muteTimeInterval.TimeIntervals = append(muteTimeInterval.TimeIntervals, tis...)
+	}
+
 	return muteTimeInterval, nil
 }
 
+// convertCalendar expands the calendar events occurring between the start of
+// the current day (UTC) and the end of the configured number of days.
+func (cb *ConfigBuilder) convertCalendar(ctx context.Context, in *monitoringv1alpha1.CalendarSource, crKey types.NamespacedName) ([]timeinterval.TimeInterval, error) {
+	if cb.store == nil {
+		return nil, errors.New("failed to get calendar: no store available")
+	}
+
+	data, err := cb.store.GetConfigMapKey(ctx, cb.secretNamespace(crKey), in.ConfigMap)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get calendar: %w", err)
+	}
+
+	loc, err := calendarLocation(in)
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := parseCalendar(data, loc)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse calendar: %w", err)
+	}
+
+	// Aligning the period on days avoids changing the configuration at
+	// each resync.
+	from := cb.now().UTC().Truncate(24 * time.Hour)
+	to := from.AddDate(0, 0, calendarDays(in))
+
+	return calendarTimeIntervals(expandCalendar(events, from, to)), nil
+}
+
+// makeNamespacedString prefixes the name with the namespace and name of the
+// object (or only the name for cluster-scoped objects).
 func makeNamespacedString(in string, crKey types.NamespacedName) string {
 	if in == "" {
 		return ""
 	}
+
+	if crKey.Namespace == "" {
+		return crKey.Name + "/" + in
+	}
+
 	return crKey.Namespace + "/" + crKey.Name + "/" + in
 }
 
+// secretNamespace returns the namespace of the secrets and configmaps
+// referenced by the given object.
+func (cb *ConfigBuilder) secretNamespace(crKey types.NamespacedName) string {
+	if crKey.Namespace == "" {
+		return cb.clusterConfigNamespace
+	}
+
+	return crKey.Namespace
+}
+
 func (cb *ConfigBuilder) convertSMTPConfig(ctx context.Context, out *globalConfig, in monitoringv1.GlobalSMTPConfig, crKey types.NamespacedName) error {
 	if in.From != nil {
 		out.SMTPFrom = *in.From
@@ -1575,7 +1966,7 @@ func (cb *ConfigBuilder) convertSMTPConfig(ctx context.Context, out *globalConfi
 	}
 
 	if in.AuthPassword != nil {
-		authPassword, err := cb.store.GetSecretKey(ctx, crKey.Namespace, *in.AuthPassword)
+		authPassword, err := cb.store.GetSecretKey(ctx, cb.secretNamespace(crKey), *in.AuthPassword)
 		if err != nil {
 			return err
 		}
@@ -1583,7 +1974,7 @@ func (cb *ConfigBuilder) convertSMTPConfig(ctx context.Context, out *globalConfi
 	}
 
 	if in.AuthSecret != nil {
-		authSecret, err := cb.store.GetSecretKey(ctx, crKey.Namespace, *in.AuthSecret)
+		authSecret, err := cb.store.GetSecretKey(ctx, cb.secretNamespace(crKey), *in.AuthSecret)
 		if err != nil {
 			return err
 		}
@@ -1619,12 +2010,12 @@ func (cb *ConfigBuilder) convertHTTPConfig(ctx context.Context, in *monitoringv1
 	}
 
 	if in.BasicAuth != nil {
-		username, err := cb.store.GetSecretKey(ctx, crKey.Namespace, in.BasicAuth.Username)
+		username, err := cb.store.GetSecretKey(ctx, cb.secretNamespace(crKey), in.BasicAuth.Username)
 		if err != nil {
 			return nil, fmt.Errorf("failed to get BasicAuth username: %w", err)
 		}
 
-		password, err := cb.store.GetSecretKey(ctx, crKey.Namespace, in.BasicAuth.Password)
+		password, err := cb.store.GetSecretKey(ctx, cb.secretNamespace(crKey), in.BasicAuth.Password)
 		if err != nil {
 			return nil, fmt.Errorf("failed to get BasicAuth password: %w", err)
 		}
@@ -1635,7 +2026,7 @@ func (cb *ConfigBuilder) convertHTTPConfig(ctx context.Context, in *monitoringv1
 	}
 
 	if in.Authorization != nil {
-		credentials, err := cb.store.GetSecretKey(ctx, crKey.Namespace, *in.Authorization.Credentials)
+		credentials, err := cb.store.GetSecretKey(ctx, cb.secretNamespace(crKey), *in.Authorization.Credentials)
 		if err != nil {
 			return nil, fmt.Errorf("failed to get Authorization credentials: %w", err)
 		}
@@ -1654,7 +2045,7 @@ func (cb *ConfigBuilder) convertHTTPConfig(ctx context.Context, in *monitoringv1
 	}
 
 	if in.BearerTokenSecret != nil {
-		bearerToken, err := cb.store.GetSecretKey(ctx, crKey.Namespace, *in.BearerTokenSecret)
+		bearerToken, err := cb.store.GetSecretKey(ctx, cb.secretNamespace(crKey), *in.BearerTokenSecret)
 		if err != nil {
 			return nil, fmt.Errorf("failed to get bearer token: %w", err)
 		}
@@ -1662,12 +2053,12 @@ func (cb *ConfigBuilder) convertHTTPConfig(ctx context.Context, in *monitoringv1
 	}
 
 	if in.OAuth2 != nil {
-		clientID, err := cb.store.GetKey(ctx, crKey.Namespace, in.OAuth2.ClientID)
+		clientID, err := cb.store.GetKey(ctx, cb.secretNamespace(crKey), in.OAuth2.ClientID)
 		if err != nil {
 			return nil, fmt.Errorf("failed to get clientID: %w", err)
 		}
 
-		clientSecret, err := cb.store.GetSecretKey(ctx, crKey.Namespace, in.OAuth2.ClientSecret)
+		clientSecret, err := cb.store.GetSecretKey(ctx, cb.secretNamespace(crKey), in.OAuth2.ClientSecret)
 		if err != nil {
 			return nil, fmt.Errorf("failed to get client secret: %w", err)
 		}
@@ -1699,7 +2090,7 @@ func (cb *ConfigBuilder) convertTLSConfig(in *monitoringv1.SafeTLSConfig, crKey
 		out.InsecureSkipVerify = *in.InsecureSkipVerify
 	}
 
-	s := cb.store.ForNamespace(crKey.Namespace)
+	s := cb.store.ForNamespace(cb.secretNamespace(crKey))
 
 	if in.CA != (monitoringv1.SecretOrConfigMap{}) {
 		out.CAFile = path.Join(tlsAssetsDir, s.TLSAsset(in.CA))
@@ -1744,7 +2135,7 @@ func (cb *ConfigBuilder) convertProxyConfig(ctx context.Context, in monitoringv1
 		for k, v := range in.ProxyConnectHeader {
 			proxyConnectHeader[k] = []string{}
 			for _, vv := range v {
-				value, err := cb.store.GetSecretKey(ctx, crKey.Namespace, vv)
+				value, err := cb.store.GetSecretKey(ctx, cb.secretNamespace(crKey), vv)
 				if err != nil {
 					return out, fmt.Errorf("failed to get proxyConnectHeader secretKey: %w", err)
 				}
@@ -1873,6 +2264,44 @@ func (gc *globalConfig) sanitize(amVersion semver.Version, logger *slog.Logger)
 		gc.VictorOpsAPIKeyFile = ""
 	}
 
+	if gc.TelegramAPIURL != nil && amVersion.LT(semver.MustParse("0.24.0")) {
+		msg := "'telegram_api_url' supported in Alertmanager >= 0.24.0 only - dropping field from provided config"
+		logger.Warn(msg, "current_version", amVersion.String())
+		gc.TelegramAPIURL = nil
+	}
+
+	if gc.WebexAPIURL != nil && amVersion.LT(semver.MustParse("0.25.0")) {
+		msg := "'webex_api_url' supported in Alertmanager >= 0.25.0 only - dropping field from provided config"
+		logger.Warn(msg, "current_version", amVersion.String())
+		gc.WebexAPIURL = nil
+	}
+
+	if gc.JiraAPIURL != nil && amVersion.LT(semver.MustParse("0.28.0")) {
+		msg := "'jira_api_url' supported in Alertmanager >= 0.28.0 only - dropping field from provided config"
+		logger.Warn(msg, "current_version", amVersion.String())
+		gc.JiraAPIURL = nil
+	}
+
+	if amVersion.LT(semver.MustParse("0.28.0")) {
+		if gc.RocketChatAPIURL != nil {
+			msg := "'rocketchat_api_url' supported in Alertmanager >= 0.28.0 only - dropping field from provided config"
+			logger.Warn(msg, "current_version", amVersion.String())
+			gc.RocketChatAPIURL = nil
+		}
+
+		if gc.RocketChatToken != "" {
+			msg := "'rocketchat_token' supported in Alertmanager >= 0.28.0 only - dropping field from provided config"
+			logger.Warn(msg, "current_version", amVersion.String())
+			gc.RocketChatToken = ""
+		}
+
+		if gc.RocketChatTokenID != "" {
+			msg := "'rocketchat_token_id' supported in Alertmanager >= 0.28.0 only - dropping field from provided config"
+			logger.Warn(msg, "current_version", amVersion.String())
+			gc.RocketChatTokenID = ""
+		}
+	}
+
 	return nil
 }
 
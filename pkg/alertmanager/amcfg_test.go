@@ -470,6 +470,108 @@ func TestInitializeFromAlertmanagerConfig(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name:      "valid global config with receiver defaults",
+			amVersion: &version28,
+			globalConfig: &monitoringv1.AlertmanagerGlobalConfig{
+				TelegramConfig: &monitoringv1.GlobalTelegramConfig{
+					APIURL: ptr.To(monitoringv1.URL("https://telegram.example.com")),
+				},
+				WebexConfig: &monitoringv1.GlobalWebexConfig{
+					APIURL: ptr.To(monitoringv1.URL("https://webex.example.com")),
+				},
+				VictorOpsConfig: &monitoringv1.GlobalVictorOpsConfig{
+					APIURL: ptr.To(monitoringv1.URL("https://victorops.example.com")),
+					APIKey: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{
+							Name: "receivers",
+						},
+						Key: "victorops-api-key",
+					},
+				},
+				WeChatConfig: &monitoringv1.GlobalWeChatConfig{
+					APIURL: ptr.To(monitoringv1.URL("https://wechat.example.com")),
+					APISecret: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{
+							Name: "receivers",
+						},
+						Key: "wechat-api-secret",
+					},
+					APICorpID: ptr.To("corp-id"),
+				},
+				JiraConfig: &monitoringv1.GlobalJiraConfig{
+					APIURL: ptr.To(monitoringv1.URL("https://jira.example.com")),
+				},
+				RocketChatConfig: &monitoringv1.GlobalRocketChatConfig{
+					APIURL: ptr.To(monitoringv1.URL("https://rocketchat.example.com")),
+					Token: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{
+							Name: "receivers",
+						},
+						Key: "rocketchat-token",
+					},
+					TokenID: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{
+							Name: "receivers",
+						},
+						Key: "rocketchat-token-id",
+					},
+				},
+			},
+			amConfig: &monitoringv1alpha1.AlertmanagerConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "global-config",
+					Namespace: "mynamespace",
+				},
+				Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+					Receivers: []monitoringv1alpha1.Receiver{
+						{
+							Name: "null",
+						},
+					},
+					Route: &monitoringv1alpha1.Route{
+						Receiver: "null",
+					},
+				},
+			},
+			matcherStrategy: monitoringv1.AlertmanagerConfigMatcherStrategy{
+				Type: "OnNamespace",
+			},
+			golden: "valid_global_config_with_receiver_defaults.golden",
+		},
+		{
+			name: "global config with missing Rocket.Chat token",
+			globalConfig: &monitoringv1.AlertmanagerGlobalConfig{
+				RocketChatConfig: &monitoringv1.GlobalRocketChatConfig{
+					Token: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{
+							Name: "not_existing",
+						},
+						Key: "rocketchat-token",
+					},
+				},
+			},
+			amConfig: &monitoringv1alpha1.AlertmanagerConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "global-config",
+					Namespace: "mynamespace",
+				},
+				Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+					Receivers: []monitoringv1alpha1.Receiver{
+						{
+							Name: "null",
+						},
+					},
+					Route: &monitoringv1alpha1.Route{
+						Receiver: "null",
+					},
+				},
+			},
+			matcherStrategy: monitoringv1.AlertmanagerConfigMatcherStrategy{
+				Type: "OnNamespace",
+			},
+			wantErr: true,
+		},
 		{
 			name: "valid global config with Pagerduty URL",
 			globalConfig: &monitoringv1.AlertmanagerGlobalConfig{
@@ -867,6 +969,18 @@ func TestInitializeFromAlertmanagerConfig(t *testing.T) {
 					"proxy-header": []byte("value"),
 				},
 			},
+			&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "receivers",
+					Namespace: "mynamespace",
+				},
+				Data: map[string][]byte{
+					"victorops-api-key":   []byte("victorops-key"),
+					"wechat-api-secret":   []byte("wechat-secret"),
+					"rocketchat-token":    []byte("rocketchat-token"),
+					"rocketchat-token-id": []byte("rocketchat-token-id"),
+				},
+			},
 		)
 		cb := NewConfigBuilder(
 			newNopLogger(t),
@@ -2406,6 +2520,193 @@ func TestGenerateConfig(t *testing.T) {
 			},
 			golden: "CR_with_MSTeamsV2_Receiver_Partial_Conf.golden",
 		},
+		{
+			name:      "CR with Jira Receiver",
+			amVersion: &version28,
+			kclient: fake.NewSimpleClientset(
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "jira",
+						Namespace: "mynamespace",
+					},
+					Data: map[string][]byte{
+						"password": []byte("secret"),
+					},
+				},
+			),
+			baseConfig: alertmanagerConfig{
+				Route: &route{
+					Receiver: "null",
+				},
+				Receivers: []*receiver{{Name: "null"}},
+			},
+			amConfigs: map[string]*monitoringv1alpha1.AlertmanagerConfig{
+				"mynamespace": {
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "myamc",
+						Namespace: "mynamespace",
+					},
+					Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+						Route: &monitoringv1alpha1.Route{
+							Receiver: "test",
+						},
+						Receivers: []monitoringv1alpha1.Receiver{
+							{
+								Name: "test",
+								JiraConfigs: []monitoringv1alpha1.JiraConfig{
+									{
+										APIURL:            ptr.To(monitoringv1alpha1.URL("https://jira.example.com")),
+										Project:           "PROJ",
+										IssueType:         "Bug",
+										Summary:           ptr.To("summary"),
+										Labels:            []string{"alertmanager"},
+										Priority:          ptr.To("High"),
+										ReopenTransition:  ptr.To("REOPEN"),
+										ResolveTransition: ptr.To("CLOSE"),
+										WontFixResolution: ptr.To("WONTFIX"),
+										ReopenDuration:    ptr.To(monitoringv1.Duration("1h")),
+										Fields: []monitoringv1alpha1.JiraField{
+											{
+												Key:   "customfield_10000",
+												Value: apiextensionsv1.JSON{Raw: []byte(`{"value":"foo"}`)},
+											},
+										},
+										HTTPConfig: &monitoringv1alpha1.HTTPConfig{
+											BasicAuth: &monitoringv1.BasicAuth{
+												Username: corev1.SecretKeySelector{
+													LocalObjectReference: corev1.LocalObjectReference{
+														Name: "jira",
+													},
+													Key: "password",
+												},
+												Password: corev1.SecretKeySelector{
+													LocalObjectReference: corev1.LocalObjectReference{
+														Name: "jira",
+													},
+													Key: "password",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			golden: "CR_with_Jira_Receiver.golden",
+		},
+		{
+			name:      "CR with RocketChat Receiver",
+			amVersion: &version28,
+			kclient: fake.NewSimpleClientset(
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "rocketchat",
+						Namespace: "mynamespace",
+					},
+					Data: map[string][]byte{
+						"token":   []byte("token"),
+						"tokenID": []byte("token-id"),
+					},
+				},
+			),
+			baseConfig: alertmanagerConfig{
+				Route: &route{
+					Receiver: "null",
+				},
+				Receivers: []*receiver{{Name: "null"}},
+			},
+			amConfigs: map[string]*monitoringv1alpha1.AlertmanagerConfig{
+				"mynamespace": {
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "myamc",
+						Namespace: "mynamespace",
+					},
+					Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+						Route: &monitoringv1alpha1.Route{
+							Receiver: "test",
+						},
+						Receivers: []monitoringv1alpha1.Receiver{
+							{
+								Name: "test",
+								RocketChatConfigs: []monitoringv1alpha1.RocketChatConfig{
+									{
+										APIURL: ptr.To(monitoringv1alpha1.URL("https://rocketchat.example.com")),
+										TokenID: corev1.SecretKeySelector{
+											LocalObjectReference: corev1.LocalObjectReference{
+												Name: "rocketchat",
+											},
+											Key: "tokenID",
+										},
+										Token: corev1.SecretKeySelector{
+											LocalObjectReference: corev1.LocalObjectReference{
+												Name: "rocketchat",
+											},
+											Key: "token",
+										},
+										Channel: ptr.To("#alerts"),
+										Title:   ptr.To("title"),
+										Text:    ptr.To("text"),
+										Fields: []monitoringv1alpha1.RocketChatFieldConfig{
+											{
+												Title: ptr.To("field"),
+												Value: ptr.To("value"),
+												Short: ptr.To(true),
+											},
+										},
+										Actions: []monitoringv1alpha1.RocketChatActionConfig{
+											{
+												Text: ptr.To("Open"),
+												URL:  ptr.To(monitoringv1alpha1.URL("https://example.com")),
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			golden: "CR_with_RocketChat_Receiver.golden",
+		},
+		{
+			name:      "CR with Jira Receiver with unsupported Alertmanager version",
+			amVersion: &version27,
+			kclient:   fake.NewSimpleClientset(),
+			baseConfig: alertmanagerConfig{
+				Route: &route{
+					Receiver: "null",
+				},
+				Receivers: []*receiver{{Name: "null"}},
+			},
+			amConfigs: map[string]*monitoringv1alpha1.AlertmanagerConfig{
+				"mynamespace": {
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "myamc",
+						Namespace: "mynamespace",
+					},
+					Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+						Route: &monitoringv1alpha1.Route{
+							Receiver: "test",
+						},
+						Receivers: []monitoringv1alpha1.Receiver{
+							{
+								Name: "test",
+								JiraConfigs: []monitoringv1alpha1.JiraConfig{
+									{
+										APIURL:    ptr.To(monitoringv1alpha1.URL("https://jira.example.com")),
+										Project:   "PROJ",
+										IssueType: "Bug",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedError: true,
+		},
 		{
 			name:      "CR with EmailConfig with Required Fields specified at Receiver level",
 			amVersion: &version26,
@@ -3997,6 +4298,56 @@ func TestSanitizeEmailConfig(t *testing.T) {
 	}
 }
 
+func TestSanitizeGlobalReceiverConfig(t *testing.T) {
+	logger := newNopLogger(t)
+
+	u, err := url.Parse("https://example.com")
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		name           string
+		againstVersion semver.Version
+		golden         string
+	}{
+		{
+			name:           "Test receiver defaults are dropped for unsupported versions",
+			againstVersion: semver.Version{Major: 0, Minor: 23},
+			golden:         "test_global_receiver_defaults_are_dropped_for_unsupported_versions.golden",
+		},
+		{
+			name:           "Test Jira and Rocket.Chat defaults are dropped for unsupported versions",
+			againstVersion: semver.Version{Major: 0, Minor: 27},
+			golden:         "test_global_jira_and_rocketchat_defaults_are_dropped_for_unsupported_versions.golden",
+		},
+		{
+			name:           "Test receiver defaults are kept for supported versions",
+			againstVersion: semver.Version{Major: 0, Minor: 28},
+			golden:         "test_global_receiver_defaults_are_kept_for_supported_versions.golden",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			in := &alertmanagerConfig{
+				Global: &globalConfig{
+					TelegramAPIURL:    &config.URL{URL: u},
+					WebexAPIURL:       &config.URL{URL: u},
+					JiraAPIURL:        &config.URL{URL: u},
+					RocketChatAPIURL:  &config.URL{URL: u},
+					RocketChatToken:   "token",
+					RocketChatTokenID: "token-id",
+				},
+			}
+
+			err := in.sanitize(tc.againstVersion, logger)
+			require.NoError(t, err)
+
+			amConfigs, err := yaml.Marshal(in)
+			require.NoError(t, err)
+
+			golden.Assert(t, string(amConfigs), tc.golden)
+		})
+	}
+}
+
 func TestSanitizeVictorOpsConfig(t *testing.T) {
 	logger := newNopLogger(t)
 
@@ -4992,3 +5343,182 @@ func newNopLogger(t *testing.T) *slog.Logger {
 	t.Helper()
 	return slog.New(slog.DiscardHandler)
 }
+
+func TestGenerateConfigWithClusterAlertmanagerConfigs(t *testing.T) {
+	kclient := fake.NewSimpleClientset(
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "pager",
+				Namespace: "monitoring",
+			},
+			Data: map[string][]byte{
+				"url": []byte("https://pager.example.com/"),
+			},
+		},
+	)
+	store := assets.NewStoreBuilder(kclient.CoreV1(), kclient.CoreV1())
+
+	version, err := semver.ParseTolerant("v0.28.0")
+	require.NoError(t, err)
+
+	cb := NewConfigBuilder(newNopLogger(t), version, store, monitoringv1.AlertmanagerConfigMatcherStrategy{})
+	cb.cfg = &alertmanagerConfig{
+		Route: &route{
+			Receiver: "null",
+			Routes: []*route{{
+				Receiver: "null",
+				Matchers: []string{"alertname=Watchdog"},
+			}},
+		},
+		Receivers: []*receiver{{Name: "null"}},
+	}
+
+	require.NoError(t, cb.AddAlertmanagerConfigs(context.Background(), map[string]*monitoringv1alpha1.AlertmanagerConfig{
+		"team/team": {
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "team",
+				Namespace: "team",
+			},
+			Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+				Route: &monitoringv1alpha1.Route{
+					Receiver: "team",
+				},
+				Receivers: []monitoringv1alpha1.Receiver{{Name: "team"}},
+			},
+		},
+	}))
+
+	require.NoError(t, cb.AddClusterAlertmanagerConfigs(context.Background(), "monitoring", map[string]*monitoringv1alpha1.ClusterAlertmanagerConfig{
+		"platform": {
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "platform",
+			},
+			Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+				Route: &monitoringv1alpha1.Route{
+					Receiver: "pager",
+					Matchers: []monitoringv1alpha1.Matcher{{
+						Name:      "severity",
+						Value:     "critical",
+						MatchType: monitoringv1alpha1.MatchEqual,
+					}},
+					MuteTimeIntervals: []string{"maintenance"},
+				},
+				Receivers: []monitoringv1alpha1.Receiver{{
+					Name: "pager",
+					WebhookConfigs: []monitoringv1alpha1.WebhookConfig{{
+						URLSecret: &corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{Name: "pager"},
+							Key:                  "url",
+						},
+					}},
+				}},
+				InhibitRules: []monitoringv1alpha1.InhibitRule{{
+					SourceMatch: []monitoringv1alpha1.Matcher{{
+						Name:      "severity",
+						Value:     "critical",
+						MatchType: monitoringv1alpha1.MatchEqual,
+					}},
+					TargetMatch: []monitoringv1alpha1.Matcher{{
+						Name:      "severity",
+						Value:     "warning",
+						MatchType: monitoringv1alpha1.MatchEqual,
+					}},
+					Equal: []string{"cluster"},
+				}},
+				MuteTimeIntervals: []monitoringv1alpha1.MuteTimeInterval{{
+					Name: "maintenance",
+					TimeIntervals: []monitoringv1alpha1.TimeInterval{{
+						Weekdays: []monitoringv1alpha1.WeekdayRange{"saturday"},
+					}},
+				}},
+			},
+		},
+	}))
+
+	cfgBytes, err := cb.MarshalJSON()
+	require.NoError(t, err)
+
+	golden.Assert(t, string(cfgBytes), "cluster_alertmanager_configs.golden")
+
+	_, err = alertmanagerConfigFromBytes(cfgBytes)
+	require.NoError(t, err)
+}
+
+func TestGenerateConfigWithTemplates(t *testing.T) {
+	kclient := fake.NewSimpleClientset(
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "slack",
+				Namespace: "team",
+			},
+			Data: map[string][]byte{
+				"url": []byte("https://slack.example.com/"),
+			},
+		},
+	)
+	store := assets.NewStoreBuilder(kclient.CoreV1(), kclient.CoreV1())
+
+	version, err := semver.ParseTolerant("v0.28.0")
+	require.NoError(t, err)
+
+	cb := NewConfigBuilder(newNopLogger(t), version, store, monitoringv1.AlertmanagerConfigMatcherStrategy{})
+	cb.cfg = &alertmanagerConfig{
+		Route:     &route{Receiver: "null"},
+		Receivers: []*receiver{{Name: "null"}},
+		Templates: []string{"/etc/alertmanager/templates/global.tmpl"},
+	}
+
+	require.NoError(t, cb.AddAlertmanagerConfigs(context.Background(), map[string]*monitoringv1alpha1.AlertmanagerConfig{
+		"team/team": {
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "team",
+				Namespace: "team",
+			},
+			Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+				Route: &monitoringv1alpha1.Route{
+					Receiver: "slack",
+				},
+				Receivers: []monitoringv1alpha1.Receiver{{
+					Name: "slack",
+					SlackConfigs: []monitoringv1alpha1.SlackConfig{{
+						APIURL: &corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{Name: "slack"},
+							Key:                  "url",
+						},
+						Title:  `{{ template "slack.title" . }}`,
+						Text:   `{{- template "slack.text" . -}}`,
+						Footer: `{{ template "slack.default.footer" . }}`,
+					}},
+				}},
+				Templates: []monitoringv1alpha1.NotificationTemplate{
+					{
+						Name:    "title",
+						Content: `{{ define "slack.title" }}[{{ .Status | toUpper }}] {{ template "slack.alertname" . }}{{ end }}`,
+					},
+					{
+						Name: "text",
+						Content: `{{ define "slack.alertname" }}{{ .CommonLabels.alertname }}{{ end }}
+{{ define "slack.text" }}{{ range .Alerts }}{{ .Annotations.description }}{{ end }}{{ end }}`,
+					},
+				},
+			},
+		},
+	}))
+
+	cfgBytes, err := cb.MarshalJSON()
+	require.NoError(t, err)
+
+	golden.Assert(t, string(cfgBytes), "alertmanager_config_templates.golden")
+
+	_, err = alertmanagerConfigFromBytes(cfgBytes)
+	require.NoError(t, err)
+
+	require.Equal(t,
+		map[string][]byte{
+			"alertmanagerconfig_team_team_title.tmpl": []byte(`{{ define "team/team/slack.title" }}[{{ .Status | toUpper }}] {{ template "team/team/slack.alertname" . }}{{ end }}`),
+			"alertmanagerconfig_team_team_text.tmpl": []byte(`{{ define "team/team/slack.alertname" }}{{ .CommonLabels.alertname }}{{ end }}
+{{ define "team/team/slack.text" }}{{ range .Alerts }}{{ .Annotations.description }}{{ end }}{{ end }}`),
+		},
+		cb.TemplateFiles(),
+	)
+}
@@ -0,0 +1,202 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alertmanager
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/prometheus/alertmanager/api/v2/models"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+	"k8s.io/utils/ptr"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+)
+
+const alertmanagerWebPort = 9093
+
+// clusterStatusClient retrieves the status of the Alertmanager cluster.
+type clusterStatusClient interface {
+	// clusterStatus returns the status of the gossip cluster.
+	clusterStatus(ctx context.Context, am *monitoringv1.Alertmanager) (*models.ClusterStatus, error)
+}
+
+// httpAPIClient implements silenceClient, clusterStatusClient and
+// podMetricsClient with the Alertmanager HTTP API.
+// Because the state is replicated between the members of the Alertmanager
+// cluster, the API requests are sent to the first pod which responds.
+type httpAPIClient struct {
+	client        *http.Client
+	clusterDomain string
+}
+
+func newHTTPAPIClient(clusterDomain string) *httpAPIClient {
+	return &httpAPIClient{
+		client:        &http.Client{Timeout: 10 * time.Second},
+		clusterDomain: clusterDomain,
+	}
+}
+
+func (hc *httpAPIClient) clusterStatus(ctx context.Context, am *monitoringv1.Alertmanager) (*models.ClusterStatus, error) {
+	b, err := hc.do(ctx, am, http.MethodGet, "status", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var status models.AlertmanagerStatus
+	if err := json.Unmarshal(b, &status); err != nil {
+		return nil, fmt.Errorf("failed to decode status: %w", err)
+	}
+
+	if status.Cluster == nil {
+		return nil, errors.New("missing cluster status")
+	}
+
+	return status.Cluster, nil
+}
+
+// do sends the request to the Alertmanager pods until one of them returns a
+// successful response.
+func (hc *httpAPIClient) do(ctx context.Context, am *monitoringv1.Alertmanager, method, endpoint string, body []byte) ([]byte, error) {
+	hosts, err := hc.podHosts(am)
+	if err != nil {
+		return nil, err
+	}
+
+	var errs []error
+	for _, host := range hosts {
+		u := url.URL{
+			Scheme: "http",
+			Host:   host,
+			Path:   path.Join("/", am.Spec.RoutePrefix, "/api/v2", endpoint),
+		}
+
+		b, err := hc.doRequest(ctx, method, u.String(), body)
+		if err == nil {
+			return b, nil
+		}
+
+		errs = append(errs, err)
+	}
+
+	return nil, errors.Join(errs...)
+}
+
+// podMetrics scrapes the metrics endpoint of each Alertmanager pod. It
+// returns an error only if none of the pods could be scraped.
+func (hc *httpAPIClient) podMetrics(ctx context.Context, am *monitoringv1.Alertmanager) (map[string]map[string]*dto.MetricFamily, error) {
+	hosts, err := hc.podHosts(am)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		res  = make(map[string]map[string]*dto.MetricFamily, len(hosts))
+		errs []error
+	)
+	for _, host := range hosts {
+		u := url.URL{
+			Scheme: "http",
+			Host:   host,
+			Path:   path.Join("/", am.Spec.RoutePrefix, "/metrics"),
+		}
+
+		b, err := hc.doRequest(ctx, http.MethodGet, u.String(), nil)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		var parser expfmt.TextParser
+		mfs, err := parser.TextToMetricFamilies(bytes.NewReader(b))
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: failed to parse metrics: %w", u.String(), err))
+			continue
+		}
+
+		res[host] = mfs
+	}
+
+	if len(res) == 0 {
+		return nil, errors.Join(errs...)
+	}
+
+	return res, nil
+}
+
+// podHosts returns the addresses of the Alertmanager pods' web endpoint.
+func (hc *httpAPIClient) podHosts(am *monitoringv1.Alertmanager) ([]string, error) {
+	if am.Spec.ListenLocal {
+		return nil, errors.New("the Alertmanager API isn't reachable when listenLocal is true")
+	}
+
+	if am.Spec.Web != nil && am.Spec.Web.TLSConfig != nil {
+		return nil, errors.New("the Alertmanager API with TLS isn't supported")
+	}
+
+	replicas := ptr.Deref(am.Spec.Replicas, 1)
+	if replicas == 0 {
+		return nil, errors.New("no Alertmanager replica")
+	}
+
+	domain := fmt.Sprintf("%s.%s.svc", getServiceName(am), am.Namespace)
+	if hc.clusterDomain != "" {
+		domain += "." + hc.clusterDomain
+	}
+
+	hosts := make([]string, 0, replicas)
+	for i := range replicas {
+		hosts = append(hosts, fmt.Sprintf("%s-%d.%s:%d", prefixedName(am.Name), i, domain, alertmanagerWebPort))
+	}
+
+	return hosts, nil
+}
+
+func (hc *httpAPIClient) doRequest(ctx context.Context, method, u string, body []byte) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, method, u, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := hc.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("%s %s: %w", method, u, err)
+	}
+
+	if resp.StatusCode/100 != 2 {
+		return nil, fmt.Errorf("%s %s: unexpected status code %d: %s", method, u, resp.StatusCode, strings.TrimSpace(string(b)))
+	}
+
+	return b, nil
+}
@@ -0,0 +1,759 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alertmanager
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/prometheus/alertmanager/timeinterval"
+	"k8s.io/apimachinery/pkg/labels"
+
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+)
+
+const (
+	// defaultCalendarDays is the default number of days for which the
+	// calendar events are expanded.
+	defaultCalendarDays = 30
+
+	// calendarResyncInterval is the interval at which the Alertmanager
+	// configurations using calendars are regenerated.
+	calendarResyncInterval = 15 * time.Minute
+
+	// maxCalendarOccurrences bounds the expansion of recurring events.
+	maxCalendarOccurrences = 100000
+
+	icsDateLayout     = "20060102"
+	icsDateTimeLayout = "20060102T150405"
+)
+
+var icsDurationRe = regexp.MustCompile(`^([+-])?P(?:(\d+)W)?(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+)S)?)?$`)
+
+// icsProperty is a content line of an iCalendar object.
+type icsProperty struct {
+	name   string
+	params map[string]string
+	value  string
+}
+
+// recurrenceRule is the supported subset of the iCalendar RRULE property.
+type recurrenceRule struct {
+	freq     string
+	interval int
+	count    int
+	until    *time.Time
+	byDay    []time.Weekday
+}
+
+// calendarEvent is a VEVENT component of an iCalendar object.
+type calendarEvent struct {
+	uid   string
+	start time.Time
+	// For all-day events, the duration is expressed in days to be
+	// independent of DST changes.
+	allDay   bool
+	days     int
+	duration time.Duration
+
+	rrule   *recurrenceRule
+	exdates []time.Time
+	// Set when the event overrides an occurrence of a recurring event.
+	recurrenceID *time.Time
+	cancelled    bool
+}
+
+// calendarOccurrence is a concrete time period during which an event
+// happens.
+type calendarOccurrence struct {
+	start, end time.Time
+}
+
+// parseCalendar parses the VEVENT components of the iCalendar data. The
+// events without explicit time zone are interpreted in the given location.
+func parseCalendar(data string, loc *time.Location) ([]calendarEvent, error) {
+	props, err := parseICSProperties(data)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		events  []calendarEvent
+		inEvent bool
+		current []icsProperty
+		depth   int
+	)
+	for _, p := range props {
+		switch p.name {
+		case "BEGIN":
+			if inEvent {
+				// Nested components (e.g. VALARM) are ignored.
+				depth++
+				continue
+			}
+
+			if p.value == "VEVENT" {
+				inEvent = true
+				current = nil
+			}
+			continue
+
+		case "END":
+			if !inEvent {
+				continue
+			}
+
+			if depth > 0 {
+				depth--
+				continue
+			}
+
+			inEvent = false
+			ev, err := parseCalendarEvent(current, loc)
+			if err != nil {
+				return nil, fmt.Errorf("event %d: %w", len(events), err)
+			}
+
+			events = append(events, ev)
+			continue
+		}
+
+		if inEvent && depth == 0 {
+			current = append(current, p)
+		}
+	}
+
+	if inEvent {
+		return nil, errors.New("unterminated VEVENT component")
+	}
+
+	return events, nil
+}
+
+// parseICSProperties unfolds and parses the content lines.
+func parseICSProperties(data string) ([]icsProperty, error) {
+	var (
+		lines   []string
+		scanner = bufio.NewScanner(strings.NewReader(data))
+	)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if line == "" {
+			continue
+		}
+
+		if (line[0] == ' ' || line[0] == '\t') && len(lines) > 0 {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+
+		lines = append(lines, line)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	props := make([]icsProperty, 0, len(lines))
+	for i, line := range lines {
+		p, err := parseICSProperty(line)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", i+1, err)
+		}
+		props = append(props, p)
+	}
+
+	return props, nil
+}
+
+func parseICSProperty(line string) (icsProperty, error) {
+	// Find the colon separating the name and parameters from the value,
+	// ignoring the colons in quoted parameter values.
+	var (
+		quoted bool
+		sep    = -1
+	)
+	for i, c := range line {
+		if c == '"' {
+			quoted = !quoted
+		}
+
+		if c == ':' && !quoted {
+			sep = i
+			break
+		}
+	}
+
+	if sep < 0 {
+		return icsProperty{}, fmt.Errorf("invalid content line %q", line)
+	}
+
+	parts := strings.Split(line[:sep], ";")
+	p := icsProperty{
+		name:   strings.ToUpper(parts[0]),
+		params: map[string]string{},
+		value:  line[sep+1:],
+	}
+
+	for _, param := range parts[1:] {
+		k, v, found := strings.Cut(param, "=")
+		if !found {
+			return icsProperty{}, fmt.Errorf("invalid parameter %q", param)
+		}
+		p.params[strings.ToUpper(k)] = strings.Trim(v, `"`)
+	}
+
+	return p, nil
+}
+
+func parseCalendarEvent(props []icsProperty, loc *time.Location) (calendarEvent, error) {
+	var (
+		ev       calendarEvent
+		dtstart  *icsProperty
+		dtend    *icsProperty
+		duration string
+	)
+
+	for i, p := range props {
+		switch p.name {
+		case "UID":
+			ev.uid = p.value
+		case "STATUS":
+			ev.cancelled = strings.EqualFold(p.value, "CANCELLED")
+		case "DTSTART":
+			dtstart = &props[i]
+		case "DTEND":
+			dtend = &props[i]
+		case "DURATION":
+			duration = p.value
+		case "RRULE":
+			rrule, err := parseRecurrenceRule(p.value, loc)
+			if err != nil {
+				return ev, fmt.Errorf("invalid RRULE: %w", err)
+			}
+			ev.rrule = rrule
+		case "EXDATE":
+			for _, v := range strings.Split(p.value, ",") {
+				t, _, err := parseICSTime(v, p.params, loc)
+				if err != nil {
+					return ev, fmt.Errorf("invalid EXDATE: %w", err)
+				}
+				ev.exdates = append(ev.exdates, t)
+			}
+		case "RECURRENCE-ID":
+			t, _, err := parseICSTime(p.value, p.params, loc)
+			if err != nil {
+				return ev, fmt.Errorf("invalid RECURRENCE-ID: %w", err)
+			}
+			ev.recurrenceID = &t
+		}
+	}
+
+	if dtstart == nil {
+		return ev, errors.New("missing DTSTART")
+	}
+
+	start, allDay, err := parseICSTime(dtstart.value, dtstart.params, loc)
+	if err != nil {
+		return ev, fmt.Errorf("invalid DTSTART: %w", err)
+	}
+	ev.start = start
+	ev.allDay = allDay
+
+	switch {
+	case dtend != nil:
+		end, _, err := parseICSTime(dtend.value, dtend.params, loc)
+		if err != nil {
+			return ev, fmt.Errorf("invalid DTEND: %w", err)
+		}
+
+		if allDay {
+			ev.days = int(end.Sub(start).Round(24*time.Hour) / (24 * time.Hour))
+		} else {
+			ev.duration = end.Sub(start)
+		}
+
+	case duration != "":
+		d, days, err := parseICSDuration(duration)
+		if err != nil {
+			return ev, fmt.Errorf("invalid DURATION: %w", err)
+		}
+
+		if allDay {
+			ev.days = days
+		} else {
+			ev.duration = d
+		}
+
+	case allDay:
+		ev.days = 1
+	}
+
+	if ev.days < 0 || ev.duration < 0 {
+		return ev, errors.New("event ends before it starts")
+	}
+
+	return ev, nil
+}
+
+// parseICSTime parses DATE and DATE-TIME values. It returns true for DATE
+// values.
+func parseICSTime(value string, params map[string]string, loc *time.Location) (time.Time, bool, error) {
+	if tzid, found := params["TZID"]; found {
+		l, err := time.LoadLocation(tzid)
+		if err != nil {
+			return time.Time{}, false, fmt.Errorf("unknown time zone %q: %w", tzid, err)
+		}
+		loc = l
+	}
+
+	if params["VALUE"] == "DATE" || len(value) == len(icsDateLayout) {
+		t, err := time.ParseInLocation(icsDateLayout, value, loc)
+		return t, true, err
+	}
+
+	if strings.HasSuffix(value, "Z") {
+		t, err := time.ParseInLocation(icsDateTimeLayout, strings.TrimSuffix(value, "Z"), time.UTC)
+		return t, false, err
+	}
+
+	t, err := time.ParseInLocation(icsDateTimeLayout, value, loc)
+	return t, false, err
+}
+
+// parseICSDuration returns the duration and the equivalent number of days.
+func parseICSDuration(value string) (time.Duration, int, error) {
+	m := icsDurationRe.FindStringSubmatch(value)
+	if m == nil {
+		return 0, 0, fmt.Errorf("invalid duration %q", value)
+	}
+
+	n := func(s string) int {
+		if s == "" {
+			return 0
+		}
+		i, _ := strconv.Atoi(s)
+		return i
+	}
+
+	days := n(m[2])*7 + n(m[3])
+	d := time.Duration(days)*24*time.Hour +
+		time.Duration(n(m[4]))*time.Hour +
+		time.Duration(n(m[5]))*time.Minute +
+		time.Duration(n(m[6]))*time.Second
+
+	if m[1] == "-" {
+		return -d, -days, nil
+	}
+
+	return d, days, nil
+}
+
+var icsWeekdays = map[string]time.Weekday{
+	"SU": time.Sunday,
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+}
+
+func parseRecurrenceRule(value string, loc *time.Location) (*recurrenceRule, error) {
+	rule := &recurrenceRule{interval: 1}
+
+	for _, part := range strings.Split(value, ";") {
+		k, v, found := strings.Cut(part, "=")
+		if !found {
+			return nil, fmt.Errorf("invalid rule part %q", part)
+		}
+
+		switch strings.ToUpper(k) {
+		case "FREQ":
+			switch v {
+			case "DAILY", "WEEKLY", "MONTHLY", "YEARLY":
+				rule.freq = v
+			default:
+				return nil, fmt.Errorf("unsupported frequency %q", v)
+			}
+
+		case "INTERVAL":
+			i, err := strconv.Atoi(v)
+			if err != nil || i < 1 {
+				return nil, fmt.Errorf("invalid interval %q", v)
+			}
+			rule.interval = i
+
+		case "COUNT":
+			i, err := strconv.Atoi(v)
+			if err != nil || i < 1 {
+				return nil, fmt.Errorf("invalid count %q", v)
+			}
+			rule.count = i
+
+		case "UNTIL":
+			t, _, err := parseICSTime(v, nil, loc)
+			if err != nil {
+				return nil, fmt.Errorf("invalid until %q: %w", v, err)
+			}
+			rule.until = &t
+
+		case "BYDAY":
+			for _, d := range strings.Split(v, ",") {
+				wd, found := icsWeekdays[d]
+				if !found {
+					return nil, fmt.Errorf("unsupported day %q", d)
+				}
+				rule.byDay = append(rule.byDay, wd)
+			}
+
+		case "WKST":
+			// The week start only matters for BYWEEKNO and weekly rules
+			// with an interval greater than 1, weeks starting on Monday is
+			// a good enough approximation.
+
+		default:
+			return nil, fmt.Errorf("unsupported rule part %q", k)
+		}
+	}
+
+	if rule.freq == "" {
+		return nil, errors.New("missing frequency")
+	}
+
+	if len(rule.byDay) > 0 && rule.freq != "WEEKLY" {
+		return nil, errors.New("BYDAY is only supported with weekly frequency")
+	}
+
+	return rule, nil
+}
+
+// end returns the end of the event occurrence starting at the given time.
+func (ev *calendarEvent) end(start time.Time) time.Time {
+	if ev.allDay {
+		return start.AddDate(0, 0, ev.days)
+	}
+
+	return start.Add(ev.duration)
+}
+
+// occurrences returns the start times of the event until the given time.
+func (ev *calendarEvent) occurrences(until time.Time) []time.Time {
+	if ev.rrule == nil {
+		return []time.Time{ev.start}
+	}
+
+	var (
+		rule   = ev.rrule
+		starts []time.Time
+		count  int
+	)
+
+	// emit returns false when the expansion should stop.
+	emit := func(t time.Time) bool {
+		if t.Before(ev.start) {
+			return true
+		}
+
+		if !t.Before(until) || (rule.until != nil && t.After(*rule.until)) {
+			return false
+		}
+
+		count++
+		if rule.count > 0 && count > rule.count {
+			return false
+		}
+
+		if !slices.ContainsFunc(ev.exdates, t.Equal) {
+			starts = append(starts, t)
+		}
+
+		return true
+	}
+
+	y, m, d := ev.start.Date()
+	hh, mm, ss := ev.start.Clock()
+	loc := ev.start.Location()
+
+	for i := 0; i < maxCalendarOccurrences; i++ {
+		n := i * rule.interval
+
+		switch rule.freq {
+		case "DAILY":
+			if !emit(time.Date(y, m, d+n, hh, mm, ss, 0, loc)) {
+				return starts
+			}
+
+		case "WEEKLY":
+			if len(rule.byDay) == 0 {
+				if !emit(time.Date(y, m, d+7*n, hh, mm, ss, 0, loc)) {
+					return starts
+				}
+				continue
+			}
+
+			// Start of the week (Monday) containing the first occurrence.
+			weekStart := d - (int(ev.start.Weekday())+6)%7 + 7*n
+			for offset := 0; offset < 7; offset++ {
+				t := time.Date(y, m, weekStart+offset, hh, mm, ss, 0, loc)
+				if !slices.Contains(rule.byDay, t.Weekday()) {
+					continue
+				}
+
+				if !emit(t) {
+					return starts
+				}
+			}
+
+		case "MONTHLY":
+			t := time.Date(y, m+time.Month(n), d, hh, mm, ss, 0, loc)
+			// Invalid dates (e.g. February 30th) are ignored.
+			if t.Day() != d {
+				if !t.Before(until) {
+					return starts
+				}
+				continue
+			}
+
+			if !emit(t) {
+				return starts
+			}
+
+		case "YEARLY":
+			t := time.Date(y+n, m, d, hh, mm, ss, 0, loc)
+			if t.Day() != d {
+				if !t.Before(until) {
+					return starts
+				}
+				continue
+			}
+
+			if !emit(t) {
+				return starts
+			}
+		}
+	}
+
+	return starts
+}
+
+// expandCalendar returns the occurrences of the events overlapping with the
+// [from, to) period.
+func expandCalendar(events []calendarEvent, from, to time.Time) []calendarOccurrence {
+	// Occurrences of recurring events which have been modified.
+	overridden := map[string][]time.Time{}
+	for _, ev := range events {
+		if ev.recurrenceID != nil {
+			overridden[ev.uid] = append(overridden[ev.uid], *ev.recurrenceID)
+		}
+	}
+
+	var occurrences []calendarOccurrence
+	for _, ev := range events {
+		if ev.cancelled {
+			continue
+		}
+
+		for _, start := range ev.occurrences(to) {
+			if ev.rrule != nil && slices.ContainsFunc(overridden[ev.uid], start.Equal) {
+				continue
+			}
+
+			end := ev.end(start)
+			if !end.After(from) || !start.Before(to) || !end.After(start) {
+				continue
+			}
+
+			occurrences = append(occurrences, calendarOccurrence{
+				start: maxTime(start, from),
+				end:   minTime(end, to),
+			})
+		}
+	}
+
+	slices.SortFunc(occurrences, func(a, b calendarOccurrence) int {
+		return a.start.Compare(b.start)
+	})
+
+	return occurrences
+}
+
+// calendarTimeIntervals converts the occurrences into Alertmanager time
+// intervals. The time intervals are expressed in UTC and each one covers (part
+// of) a single day except for consecutive full days of the same month.
+func calendarTimeIntervals(occurrences []calendarOccurrence) []timeinterval.TimeInterval {
+	var tis []timeinterval.TimeInterval
+
+	for _, o := range occurrences {
+		var (
+			start = o.start.UTC()
+			end   = o.end.UTC()
+		)
+
+		for start.Before(end) {
+			dayStart := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, time.UTC)
+			dayEnd := dayStart.AddDate(0, 0, 1)
+			segmentEnd := minTime(end, dayEnd)
+
+			var ti timeinterval.TimeInterval
+			if start.Equal(dayStart) && segmentEnd.Equal(dayEnd) {
+				// Merge the full day with the previous time interval if it
+				// ends on the day before in the same month.
+				if n := len(tis); n > 0 && dayStart.Day() > 1 && isFullDaysInterval(tis[n-1], dayStart.AddDate(0, 0, -1)) {
+					tis[n-1].DaysOfMonth[0].End = dayStart.Day()
+					start = segmentEnd
+					continue
+				}
+			} else {
+				ti.Times = []timeinterval.TimeRange{{
+					StartMinute: minuteOfDay(start, dayStart, false),
+					EndMinute:   minuteOfDay(segmentEnd, dayStart, true),
+				}}
+			}
+
+			ti.Years = []timeinterval.YearRange{{InclusiveRange: timeinterval.InclusiveRange{Begin: dayStart.Year(), End: dayStart.Year()}}}
+			ti.Months = []timeinterval.MonthRange{{InclusiveRange: timeinterval.InclusiveRange{Begin: int(dayStart.Month()), End: int(dayStart.Month())}}}
+			ti.DaysOfMonth = []timeinterval.DayOfMonthRange{{InclusiveRange: timeinterval.InclusiveRange{Begin: dayStart.Day(), End: dayStart.Day()}}}
+			tis = append(tis, ti)
+
+			start = segmentEnd
+		}
+	}
+
+	return tis
+}
+
+// isFullDaysInterval returns true if the time interval covers full days of
+// the same month, the last one being the given day.
+func isFullDaysInterval(ti timeinterval.TimeInterval, day time.Time) bool {
+	return len(ti.Times) == 0 &&
+		len(ti.Years) == 1 && ti.Years[0].Begin == day.Year() &&
+		len(ti.Months) == 1 && ti.Months[0].Begin == int(day.Month()) &&
+		len(ti.DaysOfMonth) == 1 && ti.DaysOfMonth[0].End == day.Day()
+}
+
+// minuteOfDay returns the number of minutes since the start of the day,
+// rounded up if roundUp is true.
+func minuteOfDay(t, dayStart time.Time, roundUp bool) int {
+	d := t.Sub(dayStart)
+	if roundUp {
+		return int((d + time.Minute - 1) / time.Minute)
+	}
+
+	return int(d / time.Minute)
+}
+
+func minTime(a, b time.Time) time.Time {
+	if a.Before(b) {
+		return a
+	}
+	return b
+}
+
+func maxTime(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
+
+// calendarLocation returns the location of the events without time zone.
+func calendarLocation(cs *monitoringv1alpha1.CalendarSource) (*time.Location, error) {
+	if cs.TimeZone == nil {
+		return time.UTC, nil
+	}
+
+	return time.LoadLocation(*cs.TimeZone)
+}
+
+// calendarDays returns the number of days for which the events are expanded.
+func calendarDays(cs *monitoringv1alpha1.CalendarSource) int {
+	if cs.Days == nil {
+		return defaultCalendarDays
+	}
+
+	return int(*cs.Days)
+}
+
+// usesCalendar returns true if any of the mute time intervals is generated
+// from a calendar.
+func usesCalendar(mtis []monitoringv1alpha1.MuteTimeInterval) bool {
+	return slices.ContainsFunc(mtis, func(mti monitoringv1alpha1.MuteTimeInterval) bool {
+		return mti.Calendar != nil
+	})
+}
+
+// calendarPoller periodically enqueues the Alertmanager objects when
+// AlertmanagerConfig or ClusterAlertmanagerConfig objects generate time
+// intervals from calendars. It ensures that the time intervals always cover
+// the upcoming days and that the changes of the calendar data are taken
+// into account.
+func (c *Operator) calendarPoller(ctx context.Context) {
+	ticker := time.NewTicker(calendarResyncInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			c.enqueueForCalendars()
+		}
+	}
+}
+
+func (c *Operator) enqueueForCalendars() {
+	namespaces := map[string]struct{}{}
+	err := c.alrtCfgInfs.ListAll(labels.Everything(), func(obj interface{}) {
+		amc := obj.(*monitoringv1alpha1.AlertmanagerConfig)
+		if usesCalendar(amc.Spec.MuteTimeIntervals) {
+			namespaces[amc.Namespace] = struct{}{}
+		}
+	})
+	if err != nil {
+		c.logger.Error("listing all AlertmanagerConfig objects from cache failed", "err", err)
+		return
+	}
+
+	for ns := range namespaces {
+		c.enqueueForNamespace(ns)
+	}
+
+	if c.clusterAlrtCfgInfs == nil {
+		return
+	}
+
+	var found bool
+	err = c.clusterAlrtCfgInfs.ListAll(labels.Everything(), func(obj interface{}) {
+		camc := obj.(*monitoringv1alpha1.ClusterAlertmanagerConfig)
+		found = found || usesCalendar(camc.Spec.MuteTimeIntervals)
+	})
+	if err != nil {
+		c.logger.Error("listing all ClusterAlertmanagerConfig objects from cache failed", "err", err)
+		return
+	}
+
+	if found {
+		c.enqueueForClusterAlertmanagerConfigs()
+	}
+}
@@ -0,0 +1,338 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alertmanager
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/blang/semver/v4"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v2"
+	"gotest.tools/v3/golden"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/ptr"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/assets"
+)
+
+func makeCalendar(events ...string) string {
+	var sb strings.Builder
+	sb.WriteString("BEGIN:VCALENDAR\r\nVERSION:2.0\r\nPRODID:-//test//test//EN\r\n")
+	for _, ev := range events {
+		sb.WriteString("BEGIN:VEVENT\r\n")
+		sb.WriteString(strings.ReplaceAll(strings.TrimSpace(ev), "\n", "\r\n"))
+		sb.WriteString("\r\nEND:VEVENT\r\n")
+	}
+	sb.WriteString("END:VCALENDAR\r\n")
+
+	return sb.String()
+}
+
+func TestParseCalendarErrors(t *testing.T) {
+	for _, tc := range []struct {
+		name  string
+		event string
+	}{
+		{
+			name:  "missing DTSTART",
+			event: "UID:1\nDTEND:20250101T100000Z",
+		},
+		{
+			name:  "invalid DTSTART",
+			event: "UID:1\nDTSTART:2025-01-01",
+		},
+		{
+			name:  "unknown time zone",
+			event: "UID:1\nDTSTART;TZID=W. Europe Standard Time:20250101T100000",
+		},
+		{
+			name:  "unsupported frequency",
+			event: "UID:1\nDTSTART:20250101T100000Z\nRRULE:FREQ=HOURLY",
+		},
+		{
+			name:  "unsupported rule part",
+			event: "UID:1\nDTSTART:20250101T100000Z\nRRULE:FREQ=MONTHLY;BYMONTHDAY=1",
+		},
+		{
+			name:  "BYDAY with monthly frequency",
+			event: "UID:1\nDTSTART:20250101T100000Z\nRRULE:FREQ=MONTHLY;BYDAY=MO",
+		},
+		{
+			name:  "event ending before it starts",
+			event: "UID:1\nDTSTART:20250101T100000Z\nDTEND:20250101T090000Z",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := parseCalendar(makeCalendar(tc.event), time.UTC)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestExpandCalendar(t *testing.T) {
+	paris, err := time.LoadLocation("Europe/Paris")
+	require.NoError(t, err)
+
+	from := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, 31)
+
+	for _, tc := range []struct {
+		name     string
+		loc      *time.Location
+		events   []string
+		expected []calendarOccurrence
+	}{
+		{
+			name: "single event",
+			events: []string{`
+UID:1
+SUMMARY:Change freeze
+DTSTART:20250310T080000Z
+DTEND:20250310T180000Z`},
+			expected: []calendarOccurrence{
+				{start: time.Date(2025, 3, 10, 8, 0, 0, 0, time.UTC), end: time.Date(2025, 3, 10, 18, 0, 0, 0, time.UTC)},
+			},
+		},
+		{
+			name: "event outside of the period",
+			events: []string{`
+UID:1
+DTSTART:20250410T080000Z
+DTEND:20250410T180000Z`},
+		},
+		{
+			name: "event overlapping with the start of the period",
+			events: []string{`
+UID:1
+DTSTART:20250227T000000Z
+DURATION:P3D`},
+			expected: []calendarOccurrence{
+				{start: from, end: time.Date(2025, 3, 2, 0, 0, 0, 0, time.UTC)},
+			},
+		},
+		{
+			name: "cancelled event",
+			events: []string{`
+UID:1
+STATUS:CANCELLED
+DTSTART:20250310T080000Z
+DTEND:20250310T180000Z`},
+		},
+		{
+			name: "all-day event with time zone",
+			loc:  paris,
+			events: []string{`
+UID:1
+SUMMARY:Holiday
+DTSTART;VALUE=DATE:20250310
+DTEND;VALUE=DATE:20250312`},
+			expected: []calendarOccurrence{
+				{start: time.Date(2025, 3, 10, 0, 0, 0, 0, paris), end: time.Date(2025, 3, 12, 0, 0, 0, 0, paris)},
+			},
+		},
+		{
+			name: "event with TZID and folded line",
+			events: []string{`
+UID:1
+SUMMARY:Maintenance of the
+  database
+DTSTART;TZID=Europe/Paris:20250310T220000
+DTEND;TZID="Europe/Paris":20250311T020000`},
+			expected: []calendarOccurrence{
+				{start: time.Date(2025, 3, 10, 21, 0, 0, 0, time.UTC), end: time.Date(2025, 3, 11, 1, 0, 0, 0, time.UTC)},
+			},
+		},
+		{
+			name: "weekly event across DST change",
+			events: []string{`
+UID:1
+DTSTART;TZID=Europe/Paris:20250301T100000
+DURATION:PT1H
+RRULE:FREQ=WEEKLY;BYDAY=SA;COUNT=5
+EXDATE;TZID=Europe/Paris:20250308T100000`},
+			expected: []calendarOccurrence{
+				{start: time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC), end: time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC)},
+				{start: time.Date(2025, 3, 15, 9, 0, 0, 0, time.UTC), end: time.Date(2025, 3, 15, 10, 0, 0, 0, time.UTC)},
+				{start: time.Date(2025, 3, 22, 9, 0, 0, 0, time.UTC), end: time.Date(2025, 3, 22, 10, 0, 0, 0, time.UTC)},
+				// Daylight saving time starts on March 30th.
+				{start: time.Date(2025, 3, 29, 9, 0, 0, 0, time.UTC), end: time.Date(2025, 3, 29, 10, 0, 0, 0, time.UTC)},
+			},
+		},
+		{
+			name: "weekly event on several days with interval",
+			events: []string{`
+UID:1
+DTSTART:20250303T120000Z
+DTEND:20250303T130000Z
+RRULE:FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,WE;UNTIL=20250320T000000Z`},
+			expected: []calendarOccurrence{
+				{start: time.Date(2025, 3, 3, 12, 0, 0, 0, time.UTC), end: time.Date(2025, 3, 3, 13, 0, 0, 0, time.UTC)},
+				{start: time.Date(2025, 3, 5, 12, 0, 0, 0, time.UTC), end: time.Date(2025, 3, 5, 13, 0, 0, 0, time.UTC)},
+				{start: time.Date(2025, 3, 17, 12, 0, 0, 0, time.UTC), end: time.Date(2025, 3, 17, 13, 0, 0, 0, time.UTC)},
+				{start: time.Date(2025, 3, 19, 12, 0, 0, 0, time.UTC), end: time.Date(2025, 3, 19, 13, 0, 0, 0, time.UTC)},
+			},
+		},
+		{
+			name: "yearly all-day event started years ago",
+			events: []string{`
+UID:1
+DTSTART;VALUE=DATE:20000317
+RRULE:FREQ=YEARLY`},
+			expected: []calendarOccurrence{
+				{start: time.Date(2025, 3, 17, 0, 0, 0, 0, time.UTC), end: time.Date(2025, 3, 18, 0, 0, 0, 0, time.UTC)},
+			},
+		},
+		{
+			name: "monthly event skipping invalid dates",
+			events: []string{`
+UID:1
+DTSTART:20250131T000000Z
+DTEND:20250131T010000Z
+RRULE:FREQ=MONTHLY`},
+			expected: []calendarOccurrence{
+				{start: time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC), end: time.Date(2025, 3, 31, 1, 0, 0, 0, time.UTC)},
+			},
+		},
+		{
+			name: "modified occurrence of a recurring event",
+			events: []string{`
+UID:1
+DTSTART:20250310T080000Z
+DTEND:20250310T090000Z
+RRULE:FREQ=DAILY;COUNT=3`, `
+UID:1
+RECURRENCE-ID:20250311T080000Z
+DTSTART:20250311T140000Z
+DTEND:20250311T150000Z`, `
+UID:1
+RECURRENCE-ID:20250312T080000Z
+STATUS:CANCELLED
+DTSTART:20250312T080000Z
+DTEND:20250312T090000Z`},
+			expected: []calendarOccurrence{
+				{start: time.Date(2025, 3, 10, 8, 0, 0, 0, time.UTC), end: time.Date(2025, 3, 10, 9, 0, 0, 0, time.UTC)},
+				{start: time.Date(2025, 3, 11, 14, 0, 0, 0, time.UTC), end: time.Date(2025, 3, 11, 15, 0, 0, 0, time.UTC)},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			loc := tc.loc
+			if loc == nil {
+				loc = time.UTC
+			}
+
+			events, err := parseCalendar(makeCalendar(tc.events...), loc)
+			require.NoError(t, err)
+
+			occurrences := expandCalendar(events, from, to)
+			require.Len(t, occurrences, len(tc.expected))
+			for i := range tc.expected {
+				require.True(t, tc.expected[i].start.Equal(occurrences[i].start), "occurrence %d: expected start %s, got %s", i, tc.expected[i].start, occurrences[i].start)
+				require.True(t, tc.expected[i].end.Equal(occurrences[i].end), "occurrence %d: expected end %s, got %s", i, tc.expected[i].end, occurrences[i].end)
+			}
+		})
+	}
+}
+
+func TestConvertMuteTimeIntervalWithCalendar(t *testing.T) {
+	calendar := makeCalendar(`
+UID:freeze
+SUMMARY:Change freeze
+DTSTART;TZID=Europe/Paris:20250328T180000
+DTEND;TZID=Europe/Paris:20250402T090000`, `
+UID:holiday
+SUMMARY:Holiday
+DTSTART;VALUE=DATE:20250421
+DTEND;VALUE=DATE:20250422`, `
+UID:standup
+SUMMARY:Standup
+DTSTART:20250325T093000Z
+DURATION:PT15M
+RRULE:FREQ=DAILY;COUNT=2`)
+
+	kclient := fake.NewSimpleClientset(
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "calendars",
+				Namespace: "ns1",
+			},
+			Data: map[string]string{
+				"oncall.ics":  calendar,
+				"invalid.ics": makeCalendar("UID:1\nDTSTART:20250101T100000Z\nRRULE:FREQ=SECONDLY"),
+			},
+		},
+	)
+
+	cb := NewConfigBuilder(
+		newNopLogger(t),
+		semver.MustParse("0.28.0"),
+		assets.NewStoreBuilder(kclient.CoreV1(), kclient.CoreV1()),
+		monitoringv1.AlertmanagerConfigMatcherStrategy{},
+	)
+	cb.now = func() time.Time { return time.Date(2025, 3, 25, 10, 0, 0, 0, time.UTC) }
+
+	mti := &monitoringv1alpha1.MuteTimeInterval{
+		Name: "freeze",
+		TimeIntervals: []monitoringv1alpha1.TimeInterval{
+			{
+				Weekdays: []monitoringv1alpha1.WeekdayRange{"sunday"},
+			},
+		},
+		Calendar: &monitoringv1alpha1.CalendarSource{
+			ConfigMap: corev1.ConfigMapKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: "calendars"},
+				Key:                  "oncall.ics",
+			},
+			TimeZone: ptr.To("Europe/Paris"),
+		},
+	}
+
+	out, err := cb.convertMuteTimeInterval(context.Background(), mti, types.NamespacedName{Namespace: "ns1", Name: "cfg"})
+	require.NoError(t, err)
+
+	b, err := yaml.Marshal(out)
+	require.NoError(t, err)
+	golden.Assert(t, string(b), "mute_time_interval_with_calendar.golden")
+
+	// Only the first day is included.
+	mti.Calendar.Days = ptr.To(int32(1))
+	out, err = cb.convertMuteTimeInterval(context.Background(), mti, types.NamespacedName{Namespace: "ns1", Name: "cfg"})
+	require.NoError(t, err)
+	// The static time interval + 1 occurrence of the standup.
+	require.Len(t, out.TimeIntervals, 2)
+
+	amc := &monitoringv1alpha1.AlertmanagerConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: "cfg", Namespace: "ns1"},
+		Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+			MuteTimeIntervals: []monitoringv1alpha1.MuteTimeInterval{*mti},
+		},
+	}
+	store := assets.NewStoreBuilder(kclient.CoreV1(), kclient.CoreV1())
+	require.NoError(t, checkMuteTimeIntervals(context.Background(), amc, store))
+
+	amc.Spec.MuteTimeIntervals[0].Calendar.ConfigMap.Key = "invalid.ics"
+	require.Error(t, checkMuteTimeIntervals(context.Background(), amc, store))
+
+	amc.Spec.MuteTimeIntervals[0].Calendar.ConfigMap.Name = "not-found"
+	require.Error(t, checkMuteTimeIntervals(context.Background(), amc, store))
+}
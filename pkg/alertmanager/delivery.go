@@ -0,0 +1,575 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alertmanager
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	dto "github.com/prometheus/client_model/go"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+)
+
+const (
+	// deliveryStatusInterval is the interval at which the notification
+	// metrics of the Alertmanager pods are collected.
+	deliveryStatusInterval = time.Minute
+
+	notificationsTotalMetric       = "alertmanager_notifications_total"
+	notificationsFailedTotalMetric = "alertmanager_notifications_failed_total"
+
+	receiverNameNotInMetricsReason = "ReceiverNameNotInMetrics"
+)
+
+// podMetricsClient retrieves the metrics exposed by the Alertmanager pods.
+type podMetricsClient interface {
+	// podMetrics returns the metric families of the Alertmanager pods, keyed
+	// by pod address.
+	podMetrics(ctx context.Context, am *monitoringv1.Alertmanager) (map[string]map[string]*dto.MetricFamily, error)
+}
+
+// integrationKey identifies the notification counters of a receiver's
+// integration (e.g. "slack").
+type integrationKey struct {
+	receiver    string
+	integration string
+}
+
+// notificationCounters holds the number of attempted and failed
+// notifications.
+type notificationCounters struct {
+	total  float64
+	failed float64
+}
+
+// parseNotificationCounters returns the notification counters by receiver
+// and integration.
+// Alertmanager exposes the receiver name only when the
+// `receiver-name-in-metrics` feature flag is enabled (Alertmanager >= 0.28.0).
+func parseNotificationCounters(mfs map[string]*dto.MetricFamily) map[integrationKey]notificationCounters {
+	res := map[integrationKey]notificationCounters{}
+
+	for _, name := range []string{notificationsTotalMetric, notificationsFailedTotalMetric} {
+		mf, found := mfs[name]
+		if !found {
+			continue
+		}
+
+		for _, m := range mf.GetMetric() {
+			var k integrationKey
+			for _, lp := range m.GetLabel() {
+				switch lp.GetName() {
+				case "receiver_name":
+					k.receiver = lp.GetValue()
+				case "integration":
+					k.integration = lp.GetValue()
+				}
+			}
+
+			if k.receiver == "" {
+				continue
+			}
+
+			nc := res[k]
+			if name == notificationsTotalMetric {
+				nc.total += m.GetCounter().GetValue()
+			} else {
+				// The failures are broken down by reason.
+				nc.failed += m.GetCounter().GetValue()
+			}
+			res[k] = nc
+		}
+	}
+
+	return res
+}
+
+// receiverNameInMetrics returns false when the Alertmanager pods expose
+// notification metrics without the receiver name (e.g. when the
+// `receiver-name-in-metrics` feature flag isn't enabled).
+func receiverNameInMetrics(pods map[string]map[string]*dto.MetricFamily) bool {
+	var found bool
+	for _, mfs := range pods {
+		mf, ok := mfs[notificationsTotalMetric]
+		if !ok {
+			continue
+		}
+
+		for _, m := range mf.GetMetric() {
+			found = true
+			for _, lp := range m.GetLabel() {
+				if lp.GetName() == "receiver_name" {
+					return true
+				}
+			}
+		}
+	}
+
+	return !found
+}
+
+// notificationDeltas records the notification counters of the Alertmanager
+// pods and returns their increase since the previous collection by receiver
+// and integration. All the receivers exposed by the pods are present in the
+// returned map. The counters of a pod seen for the first time only serve as
+// the baseline for the next collection.
+func (c *Operator) notificationDeltas(key string, pods map[string]map[string]*dto.MetricFamily) map[string]map[string]notificationCounters {
+	if c.deliveryCounters == nil {
+		c.deliveryCounters = map[string]map[string]map[integrationKey]notificationCounters{}
+	}
+	if c.deliveryCounters[key] == nil {
+		c.deliveryCounters[key] = map[string]map[integrationKey]notificationCounters{}
+	}
+
+	res := map[string]map[string]notificationCounters{}
+	for pod, mfs := range pods {
+		current := parseNotificationCounters(mfs)
+		previous, seen := c.deliveryCounters[key][pod]
+		c.deliveryCounters[key][pod] = current
+
+		for k, cur := range current {
+			if res[k.receiver] == nil {
+				res[k.receiver] = map[string]notificationCounters{}
+			}
+
+			if !seen {
+				continue
+			}
+
+			prev := previous[k]
+			delta := res[k.receiver][k.integration]
+			delta.total += counterDelta(prev.total, cur.total)
+			delta.failed += counterDelta(prev.failed, cur.failed)
+			res[k.receiver][k.integration] = delta
+		}
+	}
+
+	return res
+}
+
+// counterDelta returns the increase of a counter, taking resets (e.g. after
+// a restart or a configuration reload) into account.
+func counterDelta(prev, cur float64) float64 {
+	if cur < prev {
+		return cur
+	}
+
+	return cur - prev
+}
+
+// deliveryCondition returns the Delivering condition of a receiver given the
+// increase of its notification counters by integration. When no notification
+// has been sent, the existing condition is preserved.
+func deliveryCondition(existing *monitoringv1.Condition, deltas map[string]notificationCounters, generation int64) monitoringv1.Condition {
+	var (
+		total, failed float64
+		failing       []string
+	)
+	for integration, d := range deltas {
+		total += d.total
+		failed += d.failed
+		if d.failed > 0 {
+			failing = append(failing, integration)
+		}
+	}
+	slices.Sort(failing)
+
+	cond := monitoringv1.Condition{
+		Type:               monitoringv1.Delivering,
+		ObservedGeneration: generation,
+	}
+
+	switch {
+	case total == 0 && failed == 0:
+		if existing != nil {
+			cond = *existing
+			cond.ObservedGeneration = generation
+			return cond
+		}
+
+		cond.Status = monitoringv1.ConditionUnknown
+		cond.Reason = "NoNotifications"
+		cond.Message = "No notification has been sent since the operator started."
+	case failed > 0:
+		cond.Status = monitoringv1.ConditionFalse
+		cond.Reason = "Failing"
+		cond.Message = fmt.Sprintf(
+			"%d out of %d notifications failed during the last %s for the following integrations: %s.",
+			int64(failed),
+			int64(total),
+			deliveryStatusInterval,
+			strings.Join(failing, ", "),
+		)
+	default:
+		cond.Status = monitoringv1.ConditionTrue
+	}
+
+	cond.LastTransitionTime = metav1.Now()
+	if existing != nil && existing.Status == cond.Status {
+		cond.LastTransitionTime = existing.LastTransitionTime
+	}
+
+	return cond
+}
+
+// unknownDeliveryCondition returns the Delivering condition of a receiver
+// when the notification metrics aren't broken down by receiver.
+func unknownDeliveryCondition(existing *monitoringv1.Condition, generation int64) monitoringv1.Condition {
+	cond := monitoringv1.Condition{
+		Type:               monitoringv1.Delivering,
+		Status:             monitoringv1.ConditionUnknown,
+		Reason:             receiverNameNotInMetricsReason,
+		Message:            "The notification metrics of Alertmanager don't expose the receiver name: the 'receiver-name-in-metrics' feature flag needs to be enabled in the Alertmanager resource (requires Alertmanager >= 0.28.0).",
+		ObservedGeneration: generation,
+		LastTransitionTime: metav1.Now(),
+	}
+
+	if existing != nil && existing.Status == cond.Status {
+		cond.LastTransitionTime = existing.LastTransitionTime
+	}
+
+	return cond
+}
+
+// deliveryStatusPoller periodically updates the delivery status of the
+// AlertmanagerConfig objects.
+func (c *Operator) deliveryStatusPoller(ctx context.Context) {
+	ticker := time.NewTicker(deliveryStatusInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			c.syncDeliveryStatuses(ctx)
+		}
+	}
+}
+
+func (c *Operator) syncDeliveryStatuses(ctx context.Context) {
+	var configs []*monitoringv1alpha1.AlertmanagerConfig
+	if err := c.alrtCfgInfs.ListAll(labels.Everything(), func(obj interface{}) {
+		configs = append(configs, obj.(*monitoringv1alpha1.AlertmanagerConfig))
+	}); err != nil {
+		c.logger.Error("failed to list AlertmanagerConfig objects", "err", err)
+		return
+	}
+
+	var ams []*monitoringv1.Alertmanager
+	if err := c.alrtInfs.ListAll(labels.Everything(), func(obj interface{}) {
+		ams = append(ams, obj.(*monitoringv1.Alertmanager))
+	}); err != nil {
+		c.logger.Error("failed to list Alertmanager objects", "err", err)
+		return
+	}
+
+	active := make(map[string]struct{}, len(ams))
+	for _, am := range ams {
+		key, ok := c.accessor.MetaNamespaceKey(am)
+		if !ok {
+			continue
+		}
+		active[key] = struct{}{}
+
+		// The operator can't reach the Alertmanager pods.
+		if am.Spec.ListenLocal || (am.Spec.Web != nil && am.Spec.Web.TLSConfig != nil) {
+			continue
+		}
+
+		if err := c.syncDeliveryStatus(ctx, am, configs); err != nil {
+			c.logger.Warn("failed to update the delivery status of AlertmanagerConfig objects", "err", err, "alertmanager", am.Name, "namespace", am.Namespace)
+		}
+	}
+
+	// Forget about the Alertmanager objects which have been deleted.
+	for key := range c.deliveryCounters {
+		if _, found := active[key]; !found {
+			delete(c.deliveryCounters, key)
+		}
+	}
+}
+
+// syncDeliveryStatus updates the delivery status of the AlertmanagerConfig
+// objects loaded by the Alertmanager from the notification metrics of its
+// pods. The receivers are attributed to the AlertmanagerConfig objects using
+// the names generated by makeNamespacedString().
+func (c *Operator) syncDeliveryStatus(ctx context.Context, am *monitoringv1.Alertmanager, configs []*monitoringv1alpha1.AlertmanagerConfig) error {
+	key, ok := c.accessor.MetaNamespaceKey(am)
+	if !ok {
+		return nil
+	}
+
+	pods, err := c.metricsClient.podMetrics(ctx, am)
+	if err != nil {
+		return fmt.Errorf("failed to collect the Alertmanager metrics: %w", err)
+	}
+
+	if !receiverNameInMetrics(pods) {
+		return c.syncUnknownDeliveryStatus(ctx, am, configs)
+	}
+
+	deltas := c.notificationDeltas(key, pods)
+
+	var errs []error
+	for _, amc := range configs {
+		var (
+			crKey     = types.NamespacedName{Namespace: amc.Namespace, Name: amc.Name}
+			i         = findConfigBinding(amc.Status.Alertmanagers, am.Namespace, am.Name)
+			receivers []monitoringv1alpha1.ReceiverStatus
+		)
+
+		for _, r := range amc.Spec.Receivers {
+			d, found := deltas[makeNamespacedString(r.Name, crKey)]
+			if !found {
+				continue
+			}
+
+			var existing *monitoringv1.Condition
+			if i >= 0 {
+				existing = findReceiverCondition(amc.Status.Alertmanagers[i].Receivers, r.Name)
+			}
+
+			receivers = append(receivers, monitoringv1alpha1.ReceiverStatus{
+				Name:       r.Name,
+				Conditions: []monitoringv1.Condition{deliveryCondition(existing, d, amc.Generation)},
+			})
+		}
+
+		switch {
+		case len(receivers) > 0:
+			err = c.updateConfigBinding(ctx, am, amc, receivers)
+		case i >= 0:
+			// The configuration isn't loaded by the Alertmanager anymore.
+			err = c.removeConfigBinding(ctx, am.Namespace, am.Name, amc)
+		default:
+			continue
+		}
+
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to update the status of AlertmanagerConfig %s/%s: %w", amc.Namespace, amc.Name, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// syncUnknownDeliveryStatus reports the delivery status of the receivers of
+// the AlertmanagerConfig objects selected by the Alertmanager as unknown
+// because the notification metrics can't be attributed to receivers.
+func (c *Operator) syncUnknownDeliveryStatus(ctx context.Context, am *monitoringv1.Alertmanager, configs []*monitoringv1alpha1.AlertmanagerConfig) error {
+	var errs []error
+	for _, amc := range configs {
+		selected, err := c.isAlertmanagerConfigSelected(am, amc)
+		if err != nil {
+			return err
+		}
+
+		i := findConfigBinding(amc.Status.Alertmanagers, am.Namespace, am.Name)
+
+		switch {
+		case selected && len(amc.Spec.Receivers) > 0:
+			receivers := make([]monitoringv1alpha1.ReceiverStatus, 0, len(amc.Spec.Receivers))
+			for _, r := range amc.Spec.Receivers {
+				var existing *monitoringv1.Condition
+				if i >= 0 {
+					existing = findReceiverCondition(amc.Status.Alertmanagers[i].Receivers, r.Name)
+				}
+
+				receivers = append(receivers, monitoringv1alpha1.ReceiverStatus{
+					Name:       r.Name,
+					Conditions: []monitoringv1.Condition{unknownDeliveryCondition(existing, amc.Generation)},
+				})
+			}
+			err = c.updateConfigBinding(ctx, am, amc, receivers)
+		case i >= 0:
+			err = c.removeConfigBinding(ctx, am.Namespace, am.Name, amc)
+		default:
+			continue
+		}
+
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to update the status of AlertmanagerConfig %s/%s: %w", amc.Namespace, amc.Name, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// isAlertmanagerConfigSelected returns whether the AlertmanagerConfig object
+// matches the selectors of the Alertmanager.
+func (c *Operator) isAlertmanagerConfigSelected(am *monitoringv1.Alertmanager, amc *monitoringv1alpha1.AlertmanagerConfig) (bool, error) {
+	if am.Spec.AlertmanagerConfiguration != nil && amc.Namespace == am.Namespace && amc.Name == am.Spec.AlertmanagerConfiguration.Name {
+		// The global AlertmanagerConfig object.
+		return false, nil
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(am.Spec.AlertmanagerConfigSelector)
+	if err != nil {
+		return false, err
+	}
+
+	if !selector.Matches(labels.Set(amc.Labels)) {
+		return false, nil
+	}
+
+	if am.Spec.AlertmanagerConfigNamespaceSelector == nil {
+		return amc.Namespace == am.Namespace, nil
+	}
+
+	nsSelector, err := metav1.LabelSelectorAsSelector(am.Spec.AlertmanagerConfigNamespaceSelector)
+	if err != nil {
+		return false, err
+	}
+
+	obj, found, err := c.nsAlrtCfgInf.GetStore().GetByKey(amc.Namespace)
+	if err != nil || !found {
+		return false, err
+	}
+
+	return nsSelector.Matches(labels.Set(obj.(*v1.Namespace).Labels)), nil
+}
+
+func findConfigBinding(bindings []monitoringv1alpha1.AlertmanagerConfigBinding, namespace, name string) int {
+	for i, b := range bindings {
+		if b.Namespace == namespace && b.Name == name {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func findReceiverCondition(receivers []monitoringv1alpha1.ReceiverStatus, name string) *monitoringv1.Condition {
+	for _, r := range receivers {
+		if r.Name != name {
+			continue
+		}
+
+		for i := range r.Conditions {
+			if r.Conditions[i].Type == monitoringv1.Delivering {
+				return &r.Conditions[i]
+			}
+		}
+	}
+
+	return nil
+}
+
+// updateConfigBinding ensures that the status of the AlertmanagerConfig
+// object contains the binding for the Alertmanager with the given receiver
+// statuses.
+func (c *Operator) updateConfigBinding(ctx context.Context, am *monitoringv1.Alertmanager, amc *monitoringv1alpha1.AlertmanagerConfig, receivers []monitoringv1alpha1.ReceiverStatus) error {
+	binding := monitoringv1alpha1.AlertmanagerConfigBinding{
+		Name:      am.Name,
+		Namespace: am.Namespace,
+		Receivers: receivers,
+	}
+
+	// As stated in the RetryOnConflict's documentation, the returned error shouldn't be wrapped.
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		i := findConfigBinding(amc.Status.Alertmanagers, am.Namespace, am.Name)
+		if i >= 0 && equality.Semantic.DeepEqual(amc.Status.Alertmanagers[i], binding) {
+			return nil
+		}
+
+		amc = amc.DeepCopy()
+		if i >= 0 {
+			amc.Status.Alertmanagers[i] = binding
+		} else {
+			amc.Status.Alertmanagers = append(amc.Status.Alertmanagers, binding)
+		}
+
+		_, err := c.mclient.MonitoringV1alpha1().AlertmanagerConfigs(amc.Namespace).UpdateStatus(ctx, amc, metav1.UpdateOptions{FieldManager: operator.PrometheusOperatorFieldManager})
+		if apierrors.IsConflict(err) {
+			// Refresh the object before retrying.
+			if latest, getErr := c.mclient.MonitoringV1alpha1().AlertmanagerConfigs(amc.Namespace).Get(ctx, amc.Name, metav1.GetOptions{}); getErr == nil {
+				amc = latest
+			}
+		}
+
+		return err
+	})
+}
+
+// removeConfigBinding removes the Alertmanager's binding from the status of
+// the AlertmanagerConfig object.
+func (c *Operator) removeConfigBinding(ctx context.Context, namespace, name string, amc *monitoringv1alpha1.AlertmanagerConfig) error {
+	// As stated in the RetryOnConflict's documentation, the returned error shouldn't be wrapped.
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		i := findConfigBinding(amc.Status.Alertmanagers, namespace, name)
+		if i < 0 {
+			return nil
+		}
+
+		amc = amc.DeepCopy()
+		amc.Status.Alertmanagers = slices.Delete(amc.Status.Alertmanagers, i, i+1)
+
+		_, err := c.mclient.MonitoringV1alpha1().AlertmanagerConfigs(amc.Namespace).UpdateStatus(ctx, amc, metav1.UpdateOptions{FieldManager: operator.PrometheusOperatorFieldManager})
+		switch {
+		case apierrors.IsNotFound(err):
+			return nil
+		case apierrors.IsConflict(err):
+			// Refresh the object before retrying.
+			if latest, getErr := c.mclient.MonitoringV1alpha1().AlertmanagerConfigs(amc.Namespace).Get(ctx, amc.Name, metav1.GetOptions{}); getErr == nil {
+				amc = latest
+			}
+		}
+
+		return err
+	})
+}
+
+// removeConfigBindings removes the Alertmanager's binding from the status of
+// all the AlertmanagerConfig objects.
+func (c *Operator) removeConfigBindings(ctx context.Context, namespace, name string) error {
+	if !c.configResourcesStatusEnabled {
+		return nil
+	}
+
+	var stale []*monitoringv1alpha1.AlertmanagerConfig
+	err := c.alrtCfgInfs.ListAll(labels.Everything(), func(obj interface{}) {
+		amc := obj.(*monitoringv1alpha1.AlertmanagerConfig)
+		if findConfigBinding(amc.Status.Alertmanagers, namespace, name) >= 0 {
+			stale = append(stale, amc)
+		}
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list AlertmanagerConfig objects: %w", err)
+	}
+
+	var errs []error
+	for _, amc := range stale {
+		if err := c.removeConfigBinding(ctx, namespace, name, amc); err != nil {
+			errs = append(errs, fmt.Errorf("failed to update the status of AlertmanagerConfig %s/%s: %w", amc.Namespace, amc.Name, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,327 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alertmanager
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+	"testing"
+
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	monitoringfake "github.com/prometheus-operator/prometheus-operator/pkg/client/versioned/fake"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+)
+
+// fakePodMetricsClient returns the notification counters of a single pod.
+type fakePodMetricsClient struct {
+	// Counters by receiver name and integration.
+	total  map[[2]string]int
+	failed map[[2]string]int
+	// Whether the receiver name is omitted like when the
+	// `receiver-name-in-metrics` feature flag isn't enabled.
+	withoutReceiverName bool
+}
+
+func (f *fakePodMetricsClient) podMetrics(_ context.Context, _ *monitoringv1.Alertmanager) (map[string]map[string]*dto.MetricFamily, error) {
+	var sb strings.Builder
+	sb.WriteString("# TYPE alertmanager_notifications_total counter\n")
+	for k, v := range f.total {
+		if f.withoutReceiverName {
+			fmt.Fprintf(&sb, "alertmanager_notifications_total{integration=%q} %d\n", k[1], v)
+			continue
+		}
+		fmt.Fprintf(&sb, "alertmanager_notifications_total{integration=%q,receiver_name=%q} %d\n", k[1], k[0], v)
+	}
+	sb.WriteString("# TYPE alertmanager_notifications_failed_total counter\n")
+	for k, v := range f.failed {
+		fmt.Fprintf(&sb, "alertmanager_notifications_failed_total{integration=%q,reason=\"other\",receiver_name=%q} %d\n", k[1], k[0], v)
+	}
+
+	var parser expfmt.TextParser
+	mfs, err := parser.TextToMetricFamilies(strings.NewReader(sb.String()))
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]map[string]*dto.MetricFamily{"alertmanager-main-0": mfs}, nil
+}
+
+func TestParseNotificationCounters(t *testing.T) {
+	var parser expfmt.TextParser
+	mfs, err := parser.TextToMetricFamilies(strings.NewReader(`# TYPE alertmanager_notifications_total counter
+alertmanager_notifications_total{integration="slack",receiver_name="ns1/cfg/team"} 10
+alertmanager_notifications_total{integration="webhook",receiver_name="ns1/cfg/team"} 3
+alertmanager_notifications_total{integration="email"} 7
+# TYPE alertmanager_notifications_failed_total counter
+alertmanager_notifications_failed_total{integration="slack",reason="clientError",receiver_name="ns1/cfg/team"} 2
+alertmanager_notifications_failed_total{integration="slack",reason="serverError",receiver_name="ns1/cfg/team"} 1
+alertmanager_notifications_failed_total{integration="webhook",reason="other",receiver_name="ns1/cfg/team"} 0
+`))
+	require.NoError(t, err)
+
+	require.Equal(t, map[integrationKey]notificationCounters{
+		{receiver: "ns1/cfg/team", integration: "slack"}:   {total: 10, failed: 3},
+		{receiver: "ns1/cfg/team", integration: "webhook"}: {total: 3},
+	}, parseNotificationCounters(mfs))
+}
+
+func TestCounterDelta(t *testing.T) {
+	require.Equal(t, 3.0, counterDelta(2, 5))
+	require.Equal(t, 0.0, counterDelta(5, 5))
+	// Counter reset.
+	require.Equal(t, 1.0, counterDelta(5, 1))
+}
+
+func TestDeliveryCondition(t *testing.T) {
+	lastTransition := metav1.NewTime(metav1.Now().Add(-1e12))
+
+	for _, tc := range []struct {
+		name     string
+		existing *monitoringv1.Condition
+		deltas   map[string]notificationCounters
+
+		status             monitoringv1.ConditionStatus
+		reason             string
+		keepTransitionTime bool
+	}{
+		{
+			name:   "no notification",
+			status: monitoringv1.ConditionUnknown,
+			reason: "NoNotifications",
+		},
+		{
+			name: "no new notification",
+			existing: &monitoringv1.Condition{
+				Type:               monitoringv1.Delivering,
+				Status:             monitoringv1.ConditionFalse,
+				Reason:             "Failing",
+				LastTransitionTime: lastTransition,
+			},
+			deltas:             map[string]notificationCounters{"slack": {}},
+			status:             monitoringv1.ConditionFalse,
+			reason:             "Failing",
+			keepTransitionTime: true,
+		},
+		{
+			name:   "successful notifications",
+			deltas: map[string]notificationCounters{"slack": {total: 2}},
+			status: monitoringv1.ConditionTrue,
+		},
+		{
+			name: "still successful",
+			existing: &monitoringv1.Condition{
+				Type:               monitoringv1.Delivering,
+				Status:             monitoringv1.ConditionTrue,
+				LastTransitionTime: lastTransition,
+			},
+			deltas:             map[string]notificationCounters{"slack": {total: 2}},
+			status:             monitoringv1.ConditionTrue,
+			keepTransitionTime: true,
+		},
+		{
+			name: "failed notifications",
+			existing: &monitoringv1.Condition{
+				Type:               monitoringv1.Delivering,
+				Status:             monitoringv1.ConditionTrue,
+				LastTransitionTime: lastTransition,
+			},
+			deltas: map[string]notificationCounters{"slack": {total: 2}, "webhook": {total: 2, failed: 2}},
+			status: monitoringv1.ConditionFalse,
+			reason: "Failing",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			cond := deliveryCondition(tc.existing, tc.deltas, 3)
+
+			require.Equal(t, monitoringv1.Delivering, cond.Type)
+			require.Equal(t, tc.status, cond.Status)
+			require.Equal(t, tc.reason, cond.Reason)
+			require.Equal(t, int64(3), cond.ObservedGeneration)
+			require.Equal(t, tc.keepTransitionTime, cond.LastTransitionTime.Equal(&lastTransition))
+		})
+	}
+}
+
+func TestSyncDeliveryStatus(t *testing.T) {
+	am := &monitoringv1.Alertmanager{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "main",
+			Namespace: "monitoring",
+		},
+	}
+
+	newConfig := func(name string, receivers ...string) *monitoringv1alpha1.AlertmanagerConfig {
+		amc := &monitoringv1alpha1.AlertmanagerConfig{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       name,
+				Namespace:  "ns1",
+				Generation: 1,
+			},
+		}
+		for _, r := range receivers {
+			amc.Spec.Receivers = append(amc.Spec.Receivers, monitoringv1alpha1.Receiver{Name: r})
+		}
+
+		return amc
+	}
+
+	team := newConfig("team", "slack", "pager")
+	other := newConfig("other", "webhook")
+	// The configuration isn't loaded by the Alertmanager anymore.
+	other.Status.Alertmanagers = []monitoringv1alpha1.AlertmanagerConfigBinding{{Name: "main", Namespace: "monitoring"}}
+
+	mclient := monitoringfake.NewSimpleClientset(team, other)
+	fmc := &fakePodMetricsClient{
+		total: map[[2]string]int{
+			{"ns1/team/slack", "slack"}:   5,
+			{"ns1/team/pager", "webhook"}: 1,
+		},
+		failed: map[[2]string]int{
+			{"ns1/team/slack", "slack"}:   0,
+			{"ns1/team/pager", "webhook"}: 0,
+		},
+	}
+	o := &Operator{
+		mclient:       mclient,
+		logger:        slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelInfo})),
+		accessor:      operator.NewAccessor(slog.New(slog.DiscardHandler)),
+		metricsClient: fmc,
+	}
+
+	getStatus := func(name string) monitoringv1alpha1.AlertmanagerConfigStatus {
+		amc, err := mclient.MonitoringV1alpha1().AlertmanagerConfigs("ns1").Get(context.Background(), name, metav1.GetOptions{})
+		require.NoError(t, err)
+		return amc.Status
+	}
+	sync := func() {
+		configs := []*monitoringv1alpha1.AlertmanagerConfig{}
+		for _, name := range []string{"team", "other"} {
+			amc, err := mclient.MonitoringV1alpha1().AlertmanagerConfigs("ns1").Get(context.Background(), name, metav1.GetOptions{})
+			require.NoError(t, err)
+			configs = append(configs, amc)
+		}
+		require.NoError(t, o.syncDeliveryStatus(context.Background(), am, configs))
+	}
+
+	// First collection: no baseline yet.
+	sync()
+	status := getStatus("team")
+	require.Len(t, status.Alertmanagers, 1)
+	require.Len(t, status.Alertmanagers[0].Receivers, 2)
+	for _, r := range status.Alertmanagers[0].Receivers {
+		require.Equal(t, monitoringv1.ConditionUnknown, r.Conditions[0].Status)
+	}
+	require.Empty(t, getStatus("other").Alertmanagers)
+
+	// The Slack notifications succeed while the webhook notifications fail.
+	fmc.total[[2]string{"ns1/team/slack", "slack"}] = 8
+	fmc.total[[2]string{"ns1/team/pager", "webhook"}] = 3
+	fmc.failed[[2]string{"ns1/team/pager", "webhook"}] = 2
+	sync()
+	status = getStatus("team")
+	require.Equal(t, "slack", status.Alertmanagers[0].Receivers[0].Name)
+	require.Equal(t, monitoringv1.ConditionTrue, status.Alertmanagers[0].Receivers[0].Conditions[0].Status)
+	require.Equal(t, "pager", status.Alertmanagers[0].Receivers[1].Name)
+	require.Equal(t, monitoringv1.ConditionFalse, status.Alertmanagers[0].Receivers[1].Conditions[0].Status)
+	require.Equal(t, "Failing", status.Alertmanagers[0].Receivers[1].Conditions[0].Reason)
+	require.Contains(t, status.Alertmanagers[0].Receivers[1].Conditions[0].Message, "2 out of 2 notifications failed")
+
+	// No new notification: the conditions are preserved.
+	sync()
+	require.Equal(t, status, getStatus("team"))
+
+	// The Alertmanager is deleted.
+	latest, err := mclient.MonitoringV1alpha1().AlertmanagerConfigs("ns1").Get(context.Background(), "team", metav1.GetOptions{})
+	require.NoError(t, err)
+	require.NoError(t, o.removeConfigBinding(context.Background(), am.Namespace, am.Name, latest))
+	require.Empty(t, getStatus("team").Alertmanagers)
+}
+
+func TestSyncDeliveryStatusWithoutReceiverName(t *testing.T) {
+	am := &monitoringv1.Alertmanager{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "main",
+			Namespace: "monitoring",
+		},
+		Spec: monitoringv1.AlertmanagerSpec{
+			AlertmanagerConfigSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "a"}},
+		},
+	}
+
+	selected := &monitoringv1alpha1.AlertmanagerConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "selected",
+			Namespace:  "monitoring",
+			Generation: 1,
+			Labels:     map[string]string{"team": "a"},
+		},
+		Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+			Receivers: []monitoringv1alpha1.Receiver{{Name: "slack"}},
+		},
+	}
+	other := &monitoringv1alpha1.AlertmanagerConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "other",
+			Namespace: "monitoring",
+			Labels:    map[string]string{"team": "b"},
+		},
+		Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+			Receivers: []monitoringv1alpha1.Receiver{{Name: "webhook"}},
+		},
+	}
+
+	mclient := monitoringfake.NewSimpleClientset(selected, other)
+	o := &Operator{
+		mclient:  mclient,
+		logger:   slog.New(slog.DiscardHandler),
+		accessor: operator.NewAccessor(slog.New(slog.DiscardHandler)),
+		metricsClient: &fakePodMetricsClient{
+			total:               map[[2]string]int{{"", "slack"}: 1},
+			withoutReceiverName: true,
+		},
+	}
+
+	sync := func() {
+		require.NoError(t, o.syncDeliveryStatus(context.Background(), am, []*monitoringv1alpha1.AlertmanagerConfig{selected, other}))
+
+		var err error
+		selected, err = mclient.MonitoringV1alpha1().AlertmanagerConfigs("monitoring").Get(context.Background(), "selected", metav1.GetOptions{})
+		require.NoError(t, err)
+		other, err = mclient.MonitoringV1alpha1().AlertmanagerConfigs("monitoring").Get(context.Background(), "other", metav1.GetOptions{})
+		require.NoError(t, err)
+	}
+
+	sync()
+	require.Empty(t, other.Status.Alertmanagers)
+	require.Len(t, selected.Status.Alertmanagers, 1)
+	require.Len(t, selected.Status.Alertmanagers[0].Receivers, 1)
+	cond := selected.Status.Alertmanagers[0].Receivers[0].Conditions[0]
+	require.Equal(t, monitoringv1.ConditionUnknown, cond.Status)
+	require.Equal(t, receiverNameNotInMetricsReason, cond.Reason)
+
+	// The status isn't updated again.
+	status := selected.Status
+	sync()
+	require.Equal(t, status, selected.Status)
+}
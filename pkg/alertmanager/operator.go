@@ -20,6 +20,8 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"maps"
+	"net"
 	"path"
 	"strings"
 	"time"
@@ -40,6 +42,7 @@ import (
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
 
 	"github.com/prometheus-operator/prometheus-operator/pkg/alertmanager/clustertlsconfig"
 	"github.com/prometheus-operator/prometheus-operator/pkg/alertmanager/validation"
@@ -59,6 +62,10 @@ import (
 const (
 	resyncPeriod   = 5 * time.Minute
 	controllerName = "alertmanager-controller"
+
+	// defaultClusterPushpullInterval is the default interval of the full
+	// state synchronization between the Alertmanager peers.
+	defaultClusterPushpullInterval = time.Minute
 )
 
 // Config defines the operator's parameters for the Alertmanager controller.
@@ -91,8 +98,24 @@ type Operator struct {
 
 	alrtInfs    *informers.ForResource
 	alrtCfgInfs *informers.ForResource
-	secrInfs    *informers.ForResource
-	ssetInfs    *informers.ForResource
+	// Informers for ClusterAlertmanagerConfig objects (nil if not supported).
+	clusterAlrtCfgInfs *informers.ForResource
+	// Informers for AlertmanagerSilence objects (nil if not supported).
+	silenceInfs *informers.ForResource
+	// Informers for Service objects (nil if not supported).
+	svcInfs  *informers.ForResource
+	secrInfs *informers.ForResource
+	ssetInfs *informers.ForResource
+
+	silenceClient silenceClient
+	statusClient  clusterStatusClient
+	metricsClient podMetricsClient
+	srvResolver   srvResolver
+	srvRecords    resolvedSRVRecords
+
+	// Notification counters of the Alertmanager pods by Alertmanager key and
+	// pod address (only accessed by the delivery status poller).
+	deliveryCounters map[string]map[string]map[integrationKey]notificationCounters
 
 	rr *operator.ResourceReconciler
 
@@ -101,7 +124,11 @@ type Operator struct {
 
 	eventRecorder record.EventRecorder
 
-	canReadStorageClass bool
+	canReadStorageClass                bool
+	clusterAlertmanagerConfigSupported bool
+	alertmanagerSilenceSupported       bool
+	peerServiceDiscoverySupported      bool
+	configResourcesStatusEnabled       bool
 
 	config Config
 }
@@ -116,6 +143,30 @@ func WithStorageClassValidation() ControllerOption {
 	}
 }
 
+// WithClusterAlertmanagerConfig tells that the controller manages
+// ClusterAlertmanagerConfig objects.
+func WithClusterAlertmanagerConfig() ControllerOption {
+	return func(o *Operator) {
+		o.clusterAlertmanagerConfigSupported = true
+	}
+}
+
+// WithAlertmanagerSilence tells that the controller manages
+// AlertmanagerSilence objects.
+func WithAlertmanagerSilence() ControllerOption {
+	return func(o *Operator) {
+		o.alertmanagerSilenceSupported = true
+	}
+}
+
+// WithPeerServiceDiscovery tells that the controller can discover the
+// Alertmanager peers from Service objects.
+func WithPeerServiceDiscovery() ControllerOption {
+	return func(o *Operator) {
+		o.peerServiceDiscoverySupported = true
+	}
+}
+
 // New creates a new controller.
 func New(ctx context.Context, restConfig *rest.Config, c operator.Config, logger *slog.Logger, r prometheus.Registerer, options ...ControllerOption) (*Operator, error) {
 	logger = logger.With("component", controllerName)
@@ -138,6 +189,7 @@ func New(ctx context.Context, restConfig *rest.Config, c operator.Config, logger
 	// All the metrics exposed by the controller get the controller="alertmanager" label.
 	r = prometheus.WrapRegistererWith(prometheus.Labels{"controller": "alertmanager"}, r)
 
+	apiClient := newHTTPAPIClient(c.ClusterDomain)
 	o := &Operator{
 		kclient:    client,
 		mdClient:   mdClient,
@@ -150,8 +202,13 @@ func New(ctx context.Context, restConfig *rest.Config, c operator.Config, logger
 		metrics:         operator.NewMetrics(r),
 		reconciliations: &operator.ReconciliationTracker{},
 		eventRecorder:   c.EventRecorderFactory(client, controllerName),
+		silenceClient:   apiClient,
+		statusClient:    apiClient,
+		metricsClient:   apiClient,
+		srvResolver:     net.DefaultResolver,
 
-		controllerID: c.ControllerID,
+		controllerID:                 c.ControllerID,
+		configResourcesStatusEnabled: c.Gates.Enabled(operator.StatusForConfigurationResourcesFeature),
 
 		config: Config{
 			LocalHost:                    c.LocalHost,
@@ -223,6 +280,55 @@ func (c *Operator) bootstrap(ctx context.Context, config operator.Config) error
 		return fmt.Errorf("error creating alertmanagerconfig informers: %w", err)
 	}
 
+	if c.clusterAlertmanagerConfigSupported {
+		// ClusterAlertmanagerConfig objects are cluster-scoped.
+		c.clusterAlrtCfgInfs, err = informers.NewInformersForResource(
+			informers.NewMonitoringInformerFactories(
+				map[string]struct{}{v1.NamespaceAll: {}},
+				nil,
+				c.mclient,
+				resyncPeriod,
+				nil,
+			),
+			monitoringv1alpha1.SchemeGroupVersion.WithResource(monitoringv1alpha1.ClusterAlertmanagerConfigName),
+		)
+		if err != nil {
+			return fmt.Errorf("error creating clusteralertmanagerconfig informers: %w", err)
+		}
+	}
+
+	if c.alertmanagerSilenceSupported {
+		c.silenceInfs, err = informers.NewInformersForResource(
+			informers.NewMonitoringInformerFactories(
+				config.Namespaces.AlertmanagerConfigAllowList,
+				config.Namespaces.DenyList,
+				c.mclient,
+				resyncPeriod,
+				nil,
+			),
+			monitoringv1alpha1.SchemeGroupVersion.WithResource(monitoringv1alpha1.AlertmanagerSilenceName),
+		)
+		if err != nil {
+			return fmt.Errorf("error creating alertmanagersilence informers: %w", err)
+		}
+	}
+
+	if c.peerServiceDiscoverySupported {
+		c.svcInfs, err = informers.NewInformersForResource(
+			informers.NewKubeInformerFactories(
+				config.Namespaces.AlertmanagerConfigAllowList,
+				config.Namespaces.DenyList,
+				c.kclient,
+				resyncPeriod,
+				nil,
+			),
+			v1.SchemeGroupVersion.WithResource("services"),
+		)
+		if err != nil {
+			return fmt.Errorf("error creating service informers: %w", err)
+		}
+	}
+
 	c.secrInfs, err = informers.NewInformersForResourceWithTransform(
 		informers.NewMetadataInformerFactory(
 			config.Namespaces.AlertmanagerConfigAllowList,
@@ -302,9 +408,18 @@ func (c *Operator) waitForCacheSync(ctx context.Context) error {
 	}{
 		{"Alertmanager", c.alrtInfs},
 		{"AlertmanagerConfig", c.alrtCfgInfs},
+		{"ClusterAlertmanagerConfig", c.clusterAlrtCfgInfs},
+		{"AlertmanagerSilence", c.silenceInfs},
+		{"Service", c.svcInfs},
 		{"Secret", c.secrInfs},
 		{"StatefulSet", c.ssetInfs},
 	} {
+		// Skipping informers that were not started (e.g. the
+		// ClusterAlertmanagerConfig CRD isn't installed).
+		if infs.informersForResource == nil {
+			continue
+		}
+
 		for _, inf := range infs.informersForResource.GetInformers() {
 			if !operator.WaitForNamedCacheSync(ctx, "alertmanager", c.logger.With("informer", infs.name), inf.Informer()) {
 				return fmt.Errorf("failed to sync cache for %s informer", infs.name)
@@ -340,8 +455,43 @@ func (c *Operator) addHandlers() {
 		c.metrics,
 		monitoringv1alpha1.AlertmanagerConfigKind,
 		c.enqueueForNamespace,
+		// The operator updates the status with the delivery status of the
+		// receivers.
+		operator.WithoutStatusUpdates(),
 	))
 
+	if c.clusterAlrtCfgInfs != nil {
+		c.clusterAlrtCfgInfs.AddEventHandler(operator.NewEventHandler(
+			c.logger,
+			c.accessor,
+			c.metrics,
+			monitoringv1alpha1.ClusterAlertmanagerConfigKind,
+			func(string) { c.enqueueForClusterAlertmanagerConfigs() },
+		))
+	}
+
+	if c.silenceInfs != nil {
+		c.silenceInfs.AddEventHandler(operator.NewEventHandler(
+			c.logger,
+			c.accessor,
+			c.metrics,
+			monitoringv1alpha1.AlertmanagerSilenceKind,
+			c.enqueueForNamespace,
+			// The operator updates the status with the silence IDs.
+			operator.WithoutStatusUpdates(),
+		))
+	}
+
+	if c.svcInfs != nil {
+		c.svcInfs.AddEventHandler(operator.NewEventHandler(
+			c.logger,
+			c.accessor,
+			c.metrics,
+			"Service",
+			c.enqueueForPeerServiceNamespace,
+		))
+	}
+
 	c.secrInfs.AddEventHandler(operator.NewEventHandler(
 		c.logger,
 		c.accessor,
@@ -400,6 +550,42 @@ func (c *Operator) enqueueForNamespace(nsName string) {
 			c.rr.EnqueueForReconciliation(am)
 			return
 		}
+
+		// Check for Alertmanager instances selecting AlertmanagerSilences in
+		// the namespace.
+		if c.silenceInfs == nil || am.Spec.SilenceSelector == nil || am.Spec.SilenceNamespaceSelector == nil {
+			return
+		}
+
+		silenceNSSelector, err := metav1.LabelSelectorAsSelector(am.Spec.SilenceNamespaceSelector)
+		if err != nil {
+			c.logger.Error(
+				fmt.Sprintf("failed to convert SilenceNamespaceSelector of %q to selector", am.Name),
+				"err", err,
+			)
+			return
+		}
+
+		if silenceNSSelector.Matches(labels.Set(ns.Labels)) {
+			c.rr.EnqueueForReconciliation(am)
+		}
+	})
+	if err != nil {
+		c.logger.Error(
+			"listing all Alertmanager instances from cache failed",
+			"err", err,
+		)
+	}
+}
+
+// enqueueForClusterAlertmanagerConfigs enqueues all Alertmanager object keys
+// selecting ClusterAlertmanagerConfig objects.
+func (c *Operator) enqueueForClusterAlertmanagerConfigs() {
+	err := c.alrtInfs.ListAll(labels.Everything(), func(obj interface{}) {
+		am := obj.(*monitoringv1.Alertmanager)
+		if am.Spec.ClusterAlertmanagerConfigSelector != nil {
+			c.rr.EnqueueForReconciliation(am)
+		}
 	})
 	if err != nil {
 		c.logger.Error(
@@ -416,6 +602,15 @@ func (c *Operator) Run(ctx context.Context) error {
 
 	go c.alrtInfs.Start(ctx.Done())
 	go c.alrtCfgInfs.Start(ctx.Done())
+	if c.clusterAlrtCfgInfs != nil {
+		go c.clusterAlrtCfgInfs.Start(ctx.Done())
+	}
+	if c.silenceInfs != nil {
+		go c.silenceInfs.Start(ctx.Done())
+	}
+	if c.svcInfs != nil {
+		go c.svcInfs.Start(ctx.Done())
+	}
 	go c.secrInfs.Start(ctx.Done())
 	go c.ssetInfs.Start(ctx.Done())
 	go c.nsAlrtCfgInf.Run(ctx.Done())
@@ -436,6 +631,11 @@ func (c *Operator) Run(ctx context.Context) error {
 
 	// TODO(simonpasquier): watch for Alertmanager pods instead of polling.
 	go operator.StatusPoller(ctx, c)
+	go c.calendarPoller(ctx)
+
+	if c.configResourcesStatusEnabled {
+		go c.deliveryStatusPoller(ctx)
+	}
 
 	c.metrics.Ready().Set(1)
 	<-ctx.Done()
@@ -477,23 +677,35 @@ func (c *Operator) handleNamespaceUpdate(oldo, curo interface{}) {
 	c.logger.Debug("Namespace updated", "namespace", cur.GetName())
 	c.metrics.TriggerByCounter("Namespace", operator.UpdateEvent).Inc()
 
-	// Check for Alertmanager instances selecting AlertmanagerConfigs in the namespace.
+	// Check for Alertmanager instances selecting AlertmanagerConfigs,
+	// AlertmanagerSilences or peer Services in the namespace.
 	err := c.alrtInfs.ListAll(labels.Everything(), func(obj interface{}) {
 		a := obj.(*monitoringv1.Alertmanager)
 
-		sync, err := k8sutil.LabelSelectionHasChanged(old.Labels, cur.Labels, a.Spec.AlertmanagerConfigNamespaceSelector)
-		if err != nil {
-			c.logger.Error(
-				"failed to detect label selection change",
-				"err", err,
-				"name", a.Name,
-				"namespace", a.Namespace,
-			)
-			return
+		nsSelectors := []*metav1.LabelSelector{a.Spec.AlertmanagerConfigNamespaceSelector}
+		if c.silenceInfs != nil && a.Spec.SilenceSelector != nil && a.Spec.SilenceNamespaceSelector != nil {
+			nsSelectors = append(nsSelectors, a.Spec.SilenceNamespaceSelector)
+		}
+		if c.svcInfs != nil && a.Spec.PeerDiscovery != nil && a.Spec.PeerDiscovery.ServiceSelector != nil && a.Spec.PeerDiscovery.ServiceNamespaceSelector != nil {
+			nsSelectors = append(nsSelectors, a.Spec.PeerDiscovery.ServiceNamespaceSelector)
 		}
 
-		if sync {
-			c.rr.EnqueueForReconciliation(a)
+		for _, nsSelector := range nsSelectors {
+			sync, err := k8sutil.LabelSelectionHasChanged(old.Labels, cur.Labels, nsSelector)
+			if err != nil {
+				c.logger.Error(
+					"failed to detect label selection change",
+					"err", err,
+					"name", a.Name,
+					"namespace", a.Namespace,
+				)
+				return
+			}
+
+			if sync {
+				c.rr.EnqueueForReconciliation(a)
+				return
+			}
 		}
 	})
 	if err != nil {
@@ -518,6 +730,19 @@ func (c *Operator) sync(ctx context.Context, key string) error {
 
 	if apierrors.IsNotFound(err) {
 		c.reconciliations.ForgetObject(key)
+
+		// The silences disappear with the Alertmanager pods but the
+		// AlertmanagerSilence objects may still reference the Alertmanager.
+		if ns, name, err := cache.SplitMetaNamespaceKey(key); err == nil {
+			if err := c.removeSilenceBindings(ctx, ns, name, nil); err != nil {
+				c.logger.Warn("failed to update the status of AlertmanagerSilence objects", "err", err, "key", key)
+			}
+
+			if err := c.removeConfigBindings(ctx, ns, name); err != nil {
+				c.logger.Warn("failed to update the status of AlertmanagerConfig objects", "err", err, "key", key)
+			}
+		}
+
 		// Dependent resources are cleaned up by K8s via OwnerReferences
 		return nil
 	}
@@ -584,6 +809,27 @@ func (c *Operator) sync(ctx context.Context, key string) error {
 		}
 	}
 
+	// Failing to synchronize the silences shouldn't prevent the
+	// reconciliation of the statefulset (e.g. when the pods aren't running
+	// yet). The errors are reported in the AlertmanagerSilence status and the
+	// silences are synchronized again at the next resync.
+	if err := c.syncSilences(ctx, am); err != nil {
+		logger.Warn("failed to synchronize silences", "err", err)
+	}
+
+	peers, err := c.discoverPeers(ctx, am)
+	if err != nil {
+		return fmt.Errorf("failed to discover the Alertmanager peers: %w", err)
+	}
+	if am.Spec.PeerDiscovery != nil {
+		am.Spec.AdditionalPeers = mergePeers(am.Spec.AdditionalPeers, peers)
+
+		// Changes of the DNS SRV records don't trigger any event.
+		if len(am.Spec.PeerDiscovery.DNSSRVRecords) > 0 {
+			c.rr.EnqueueForReconciliationAfter(am, dnsSRVResolutionInterval)
+		}
+	}
+
 	existingStatefulSet, err := c.getStatefulSetFromAlertmanagerKey(key)
 	if err != nil {
 		return err
@@ -610,7 +856,14 @@ func (c *Operator) sync(ctx context.Context, key string) error {
 	}
 	operator.SanitizeSTS(sset)
 
-	if newSSetInputHash == existingStatefulSet.Annotations[operator.InputHashAnnotationName] {
+	if !shouldCreate {
+		if err := c.holdScaleDown(ctx, logger, am, existingStatefulSet, sset); err != nil {
+			return err
+		}
+	}
+
+	if newSSetInputHash == existingStatefulSet.Annotations[operator.InputHashAnnotationName] &&
+		ptr.Deref(sset.Spec.Replicas, 1) == ptr.Deref(existingStatefulSet.Spec.Replicas, 1) {
 		logger.Debug("new statefulset generation inputs match current, skipping any actions")
 		return nil
 	}
@@ -667,6 +920,124 @@ func (c *Operator) getAlertmanagerFromKey(key string) (*monitoringv1.Alertmanage
 	return obj.(*monitoringv1.Alertmanager).DeepCopy(), nil
 }
 
+// holdScaleDown keeps the current number of replicas when the Alertmanager is
+// scaled down until the remaining pods have been restarted with the new list
+// of cluster peers and the gossip has settled. Otherwise the pods would be
+// removed before the notification log and silences are fully propagated to
+// the remaining peers.
+func (c *Operator) holdScaleDown(ctx context.Context, logger *slog.Logger, am *monitoringv1.Alertmanager, existing, sset *appsv1.StatefulSet) error {
+	current, desired := ptr.Deref(existing.Spec.Replicas, 1), ptr.Deref(sset.Spec.Replicas, 1)
+	if desired == 0 || desired >= current {
+		return nil
+	}
+
+	settled, requeueAfter, err := c.peersSettled(ctx, existing, sset.Annotations[operator.InputHashAnnotationName], gossipSettleDuration(am))
+	if err != nil {
+		return fmt.Errorf("failed to check the state of the Alertmanager peers: %w", err)
+	}
+
+	if settled {
+		logger.Info("scaling down the statefulset", "current", current, "desired", desired)
+		return nil
+	}
+
+	logger.Debug("waiting for the peers to settle before scaling down", "current", current, "desired", desired)
+	sset.Spec.Replicas = ptr.To(current)
+	if requeueAfter > 0 {
+		c.rr.EnqueueForReconciliationAfter(am, requeueAfter)
+	}
+
+	return nil
+}
+
+// peersSettled returns true when the statefulset's pods have been updated
+// with the given input hash and have all been ready for at least the settle
+// duration. Otherwise it returns the duration after which the check should be
+// done again (zero if the statefulset's events will trigger the check).
+func (c *Operator) peersSettled(ctx context.Context, sset *appsv1.StatefulSet, inputHash string, settle time.Duration) (bool, time.Duration, error) {
+	if sset.Annotations[operator.InputHashAnnotationName] != inputHash {
+		return false, 0, nil
+	}
+
+	replicas := ptr.Deref(sset.Spec.Replicas, 1)
+	if sset.Status.ObservedGeneration < sset.Generation ||
+		sset.Status.CurrentRevision != sset.Status.UpdateRevision ||
+		sset.Status.UpdatedReplicas != replicas ||
+		sset.Status.ReadyReplicas != replicas {
+		return false, 0, nil
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(sset.Spec.Selector)
+	if err != nil {
+		return false, 0, err
+	}
+
+	pods, err := c.kclient.CoreV1().Pods(sset.Namespace).List(ctx, metav1.ListOptions{LabelSelector: selector.String()})
+	if err != nil {
+		return false, 0, err
+	}
+
+	// Find the last time a pod became ready.
+	var lastReady time.Time
+	for _, p := range pods.Items {
+		ready := false
+		for _, cond := range p.Status.Conditions {
+			if cond.Type != v1.PodReady || cond.Status != v1.ConditionTrue {
+				continue
+			}
+
+			ready = true
+			if cond.LastTransitionTime.After(lastReady) {
+				lastReady = cond.LastTransitionTime.Time
+			}
+		}
+
+		if !ready {
+			return false, 0, nil
+		}
+	}
+
+	if elapsed := time.Since(lastReady); elapsed < settle {
+		return false, settle - elapsed, nil
+	}
+
+	return true, 0, nil
+}
+
+// gossipSettleDuration returns how long to wait for the gossip to settle
+// between the Alertmanager peers. It corresponds to the interval of the full
+// state synchronization.
+func gossipSettleDuration(am *monitoringv1.Alertmanager) time.Duration {
+	settle := defaultClusterPushpullInterval
+	if am.Spec.ClusterPushpullInterval != "" {
+		if d, err := model.ParseDuration(string(am.Spec.ClusterPushpullInterval)); err == nil {
+			settle = time.Duration(d)
+		}
+	}
+
+	return settle
+}
+
+// clusterSize returns the number of members in the gossip cluster as reported
+// by the Alertmanager API or nil if it can't be determined.
+func (c *Operator) clusterSize(ctx context.Context, a *monitoringv1.Alertmanager) *int32 {
+	if c.statusClient == nil || a.Status.AvailableReplicas == 0 {
+		return nil
+	}
+
+	if ptr.Deref(a.Spec.Replicas, 1) == 1 && !a.Spec.ForceEnableClusterMode {
+		return nil
+	}
+
+	status, err := c.statusClient.clusterStatus(ctx, a)
+	if err != nil {
+		c.logger.Debug("failed to get the Alertmanager cluster status", "err", err, "alertmanager", a.Name, "namespace", a.Namespace)
+		return nil
+	}
+
+	return ptr.To(int32(len(status.Peers)))
+}
+
 // getStatefulSetFromAlertmanagerKey returns a copy of the StatefulSet object
 // corresponding to the Alertmanager object identified by key.
 // If the object is not found, it returns a nil pointer without error.
@@ -720,6 +1091,7 @@ func (c *Operator) UpdateStatus(ctx context.Context, key string) error {
 
 	a.Status.Selector = selector.String()
 	availableCondition := stsReporter.Update(a)
+	a.Status.ClusterSize = c.clusterSize(ctx, a)
 	reconciledCondition := c.reconciliations.GetCondition(key, a.Generation)
 	a.Status.Conditions = operator.UpdateConditions(a.Status.Conditions, availableCondition, reconciledCondition)
 	a.Status.Paused = a.Spec.Paused
@@ -760,6 +1132,7 @@ func createSSetInputHash(a monitoringv1.Alertmanager, c Config, tlsAssets *opera
 		AlertmanagerAnnotations map[string]string
 		AlertmanagerGeneration  int64
 		AlertmanagerWebHTTP2    *bool
+		AlertmanagerPeers       []string
 		Config                  Config
 		StatefulSetSpec         appsv1.StatefulSetSpec
 		ShardedSecret           *operator.ShardedSecret
@@ -768,6 +1141,7 @@ func createSSetInputHash(a monitoringv1.Alertmanager, c Config, tlsAssets *opera
 		AlertmanagerAnnotations: a.Annotations,
 		AlertmanagerGeneration:  a.Generation,
 		AlertmanagerWebHTTP2:    http2,
+		AlertmanagerPeers:       a.Spec.AdditionalPeers,
 		Config:                  c,
 		StatefulSetSpec:         s,
 		ShardedSecret:           tlsAssets,
@@ -837,8 +1211,8 @@ func (c *Operator) provisionAlertmanagerConfiguration(ctx context.Context, am *m
 	namespacedLogger := c.logger.With("alertmanager", am.Name, "namespace", am.Namespace)
 	// If no AlertmanagerConfig selectors and AlertmanagerConfiguration are
 	// configured, the user wants to manage configuration themselves.
-	if am.Spec.AlertmanagerConfigSelector == nil && am.Spec.AlertmanagerConfiguration == nil {
-		namespacedLogger.Debug("AlertmanagerConfigSelector and AlertmanagerConfiguration not specified, using the configuration from secret as-is",
+	if am.Spec.AlertmanagerConfigSelector == nil && am.Spec.ClusterAlertmanagerConfigSelector == nil && am.Spec.AlertmanagerConfiguration == nil {
+		namespacedLogger.Debug("AlertmanagerConfigSelector, ClusterAlertmanagerConfigSelector and AlertmanagerConfiguration not specified, using the configuration from secret as-is",
 			"secret", defaultConfigSecretName(am))
 
 		amRawConfiguration, additionalData, err := c.loadConfigurationFromSecret(ctx, am)
@@ -859,6 +1233,11 @@ func (c *Operator) provisionAlertmanagerConfiguration(ctx context.Context, am *m
 		return fmt.Errorf("failed to select AlertmanagerConfig objects: %w", err)
 	}
 
+	clusterAmConfigs, err := c.selectClusterAlertmanagerConfigs(ctx, am, version, store)
+	if err != nil {
+		return fmt.Errorf("failed to select ClusterAlertmanagerConfig objects: %w", err)
+	}
+
 	var (
 		additionalData map[string][]byte
 		cfgBuilder     = NewConfigBuilder(namespacedLogger, version, store, am.Spec.AlertmanagerConfigMatcherStrategy)
@@ -907,11 +1286,26 @@ func (c *Operator) provisionAlertmanagerConfiguration(ctx context.Context, am *m
 		return fmt.Errorf("failed to generate Alertmanager configuration: %w", err)
 	}
 
+	// The ClusterAlertmanagerConfig routes are added last so they come
+	// before the AlertmanagerConfig routes.
+	if err := cfgBuilder.AddClusterAlertmanagerConfigs(ctx, am.Namespace, clusterAmConfigs); err != nil {
+		return fmt.Errorf("failed to generate Alertmanager configuration: %w", err)
+	}
+
 	generatedConfig, err := cfgBuilder.MarshalJSON()
 	if err != nil {
 		return fmt.Errorf("failed to marshal configuration: %w", err)
 	}
 
+	// The template files of the AlertmanagerConfig objects are stored in the
+	// generated configuration secret.
+	if templateFiles := cfgBuilder.TemplateFiles(); len(templateFiles) > 0 {
+		if additionalData == nil {
+			additionalData = make(map[string][]byte, len(templateFiles))
+		}
+		maps.Copy(additionalData, templateFiles)
+	}
+
 	err = c.createOrUpdateGeneratedConfigSecret(ctx, am, generatedConfig, additionalData)
 	if err != nil {
 		return fmt.Errorf("failed to create or update the generated configuration secret: %w", err)
@@ -1038,6 +1432,59 @@ func (c *Operator) selectAlertmanagerConfigs(ctx context.Context, am *monitoring
 	return res, nil
 }
 
+// selectClusterAlertmanagerConfigs returns the valid ClusterAlertmanagerConfig
+// objects selected by the Alertmanager, keyed by name.
+func (c *Operator) selectClusterAlertmanagerConfigs(ctx context.Context, am *monitoringv1.Alertmanager, amVersion semver.Version, store *assets.StoreBuilder) (map[string]*monitoringv1alpha1.ClusterAlertmanagerConfig, error) {
+	res := map[string]*monitoringv1alpha1.ClusterAlertmanagerConfig{}
+	if c.clusterAlrtCfgInfs == nil || am.Spec.ClusterAlertmanagerConfigSelector == nil {
+		return res, nil
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(am.Spec.ClusterAlertmanagerConfigSelector)
+	if err != nil {
+		return nil, err
+	}
+
+	var rejected int
+	err = c.clusterAlrtCfgInfs.ListAll(selector, func(obj interface{}) {
+		camc := obj.(*monitoringv1alpha1.ClusterAlertmanagerConfig)
+
+		// The validation of the AlertmanagerConfig objects is reused, the
+		// references being resolved in the Alertmanager's namespace.
+		amc := &monitoringv1alpha1.AlertmanagerConfig{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      camc.Name,
+				Namespace: am.Namespace,
+			},
+			Spec: camc.Spec,
+		}
+		if err := checkAlertmanagerConfigResource(ctx, amc, amVersion, store); err != nil {
+			rejected++
+			c.logger.Warn(
+				"skipping clusteralertmanagerconfig",
+				"error", err.Error(),
+				"clusteralertmanagerconfig", camc.Name,
+				"namespace", am.Namespace,
+				"alertmanager", am.Name,
+			)
+			c.eventRecorder.Eventf(camc, v1.EventTypeWarning, operator.InvalidConfigurationEvent, "ClusterAlertmanagerConfig %s was rejected due to invalid configuration: %v", camc.GetName(), err)
+			return
+		}
+
+		res[camc.Name] = camc
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list cluster alertmanager configs: %w", err)
+	}
+
+	if amKey, ok := c.accessor.MetaNamespaceKey(am); ok {
+		c.metrics.SetSelectedResources(amKey, monitoringv1alpha1.ClusterAlertmanagerConfigKind, len(res))
+		c.metrics.SetRejectedResources(amKey, monitoringv1alpha1.ClusterAlertmanagerConfigKind, rejected)
+	}
+
+	return res, nil
+}
+
 // checkAlertmanagerConfigResource verifies that an AlertmanagerConfig object is valid
 // for the given Alertmanager version and has no missing references to other objects.
 func checkAlertmanagerConfigResource(ctx context.Context, amc *monitoringv1alpha1.AlertmanagerConfig, amVersion semver.Version, store *assets.StoreBuilder) error {
@@ -1057,9 +1504,43 @@ func checkAlertmanagerConfigResource(ctx context.Context, amc *monitoringv1alpha
 		return err
 	}
 
+	if err := checkMuteTimeIntervals(ctx, amc, store); err != nil {
+		return err
+	}
+
 	return checkInhibitRules(amc, amVersion)
 }
 
+// checkMuteTimeIntervals verifies that the calendars referenced by the mute
+// time intervals exist and can be parsed.
+func checkMuteTimeIntervals(ctx context.Context, amc *monitoringv1alpha1.AlertmanagerConfig, store *assets.StoreBuilder) error {
+	for _, mti := range amc.Spec.MuteTimeIntervals {
+		if mti.Calendar == nil {
+			continue
+		}
+
+		if store == nil {
+			return fmt.Errorf("mute time interval %q: failed to get calendar: no store available", mti.Name)
+		}
+
+		data, err := store.GetConfigMapKey(ctx, amc.GetNamespace(), mti.Calendar.ConfigMap)
+		if err != nil {
+			return fmt.Errorf("mute time interval %q: failed to get calendar: %w", mti.Name, err)
+		}
+
+		loc, err := calendarLocation(mti.Calendar)
+		if err != nil {
+			return fmt.Errorf("mute time interval %q: %w", mti.Name, err)
+		}
+
+		if _, err := parseCalendar(data, loc); err != nil {
+			return fmt.Errorf("mute time interval %q: failed to parse calendar: %w", mti.Name, err)
+		}
+	}
+
+	return nil
+}
+
 func checkRoute(ctx context.Context, route *monitoringv1alpha1.Route, amVersion semver.Version) error {
 	if route == nil {
 		return nil
@@ -1189,6 +1670,16 @@ func checkReceivers(ctx context.Context, amc *monitoringv1alpha1.AlertmanagerCon
 		if err != nil {
 			return err
 		}
+
+		err = checkJiraConfigs(ctx, receiver.JiraConfigs, amc.GetNamespace(), store, amVersion)
+		if err != nil {
+			return err
+		}
+
+		err = checkRocketChatConfigs(ctx, receiver.RocketChatConfigs, amc.GetNamespace(), store, amVersion)
+		if err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -1639,6 +2130,70 @@ func checkMSTeamsV2Configs(
 	return nil
 }
 
+func checkJiraConfigs(
+	ctx context.Context,
+	configs []monitoringv1alpha1.JiraConfig,
+	namespace string,
+	store *assets.StoreBuilder,
+	amVersion semver.Version,
+) error {
+	if len(configs) == 0 {
+		return nil
+	}
+
+	if amVersion.LT(semver.MustParse("0.28.0")) {
+		return fmt.Errorf(`invalid syntax in receivers config; jira integration is only available in Alertmanager >= 0.28.0`)
+	}
+
+	for _, config := range configs {
+		if err := checkHTTPConfig(config.HTTPConfig, amVersion); err != nil {
+			return err
+		}
+
+		if err := configureHTTPConfigInStore(ctx, config.HTTPConfig, namespace, store); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func checkRocketChatConfigs(
+	ctx context.Context,
+	configs []monitoringv1alpha1.RocketChatConfig,
+	namespace string,
+	store *assets.StoreBuilder,
+	amVersion semver.Version,
+) error {
+	if len(configs) == 0 {
+		return nil
+	}
+
+	if amVersion.LT(semver.MustParse("0.28.0")) {
+		return fmt.Errorf(`invalid syntax in receivers config; rocketchat integration is only available in Alertmanager >= 0.28.0`)
+	}
+
+	for _, config := range configs {
+		if _, err := store.GetSecretKey(ctx, namespace, config.TokenID); err != nil {
+			return err
+		}
+
+		if _, err := store.GetSecretKey(ctx, namespace, config.Token); err != nil {
+			return err
+		}
+
+		if err := checkHTTPConfig(config.HTTPConfig, amVersion); err != nil {
+			return err
+		}
+
+		if err := configureHTTPConfigInStore(ctx, config.HTTPConfig, namespace, store); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func checkInhibitRules(amc *monitoringv1alpha1.AlertmanagerConfig, version semver.Version) error {
 	matchersV2Allowed := version.GTE(semver.MustParse("0.22.0"))
 
@@ -1819,6 +2374,10 @@ func ApplyConfigurationFromAlertmanager(a *monitoringv1.Alertmanager, updateScal
 		asac = asac.WithSelector(a.Status.Selector)
 	}
 
+	if a.Status.ClusterSize != nil {
+		asac = asac.WithClusterSize(*a.Status.ClusterSize)
+	}
+
 	for _, condition := range a.Status.Conditions {
 		asac.WithConditions(
 			monitoringv1ac.Condition().
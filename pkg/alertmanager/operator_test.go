@@ -19,6 +19,7 @@ import (
 	"log/slog"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/blang/semver/v4"
 	"github.com/prometheus/client_golang/prometheus"
@@ -1421,6 +1422,150 @@ func TestProvisionAlertmanagerConfiguration(t *testing.T) {
 	}
 }
 
+func TestPeersSettled(t *testing.T) {
+	makeStatefulSet := func(hash string, replicas int32) *appsv1.StatefulSet {
+		return &appsv1.StatefulSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "alertmanager-test",
+				Namespace:   "ns",
+				Generation:  2,
+				Annotations: map[string]string{operator.InputHashAnnotationName: hash},
+			},
+			Spec: appsv1.StatefulSetSpec{
+				Replicas: ptr.To(replicas),
+				Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "test"}},
+			},
+			Status: appsv1.StatefulSetStatus{
+				ObservedGeneration: 2,
+				CurrentRevision:    "rev-2",
+				UpdateRevision:     "rev-2",
+				UpdatedReplicas:    replicas,
+				ReadyReplicas:      replicas,
+			},
+		}
+	}
+
+	makePod := func(name string, readySince time.Duration) *v1.Pod {
+		return &v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "ns",
+				Labels:    map[string]string{"app": "test"},
+			},
+			Status: v1.PodStatus{
+				Conditions: []v1.PodCondition{{
+					Type:               v1.PodReady,
+					Status:             v1.ConditionTrue,
+					LastTransitionTime: metav1.NewTime(time.Now().Add(-readySince)),
+				}},
+			},
+		}
+	}
+
+	for _, tc := range []struct {
+		name    string
+		sset    *appsv1.StatefulSet
+		hash    string
+		objects []runtime.Object
+
+		settled  bool
+		requeued bool
+	}{
+		{
+			name: "peers not updated",
+			sset: makeStatefulSet("old", 3),
+			hash: "new",
+		},
+		{
+			name: "rollout in progress",
+			sset: func() *appsv1.StatefulSet {
+				sset := makeStatefulSet("new", 3)
+				sset.Status.UpdatedReplicas = 2
+				return sset
+			}(),
+			hash: "new",
+		},
+		{
+			name: "gossip not settled",
+			sset: makeStatefulSet("new", 2),
+			hash: "new",
+			objects: []runtime.Object{
+				makePod("alertmanager-test-0", 10*time.Minute),
+				makePod("alertmanager-test-1", 10*time.Second),
+			},
+			requeued: true,
+		},
+		{
+			name: "gossip settled",
+			sset: makeStatefulSet("new", 2),
+			hash: "new",
+			objects: []runtime.Object{
+				makePod("alertmanager-test-0", 10*time.Minute),
+				makePod("alertmanager-test-1", 2*time.Minute),
+			},
+			settled: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			o := &Operator{kclient: fake.NewSimpleClientset(tc.objects...)}
+
+			settled, requeueAfter, err := o.peersSettled(context.Background(), tc.sset, tc.hash, time.Minute)
+			require.NoError(t, err)
+			require.Equal(t, tc.settled, settled)
+			require.Equal(t, tc.requeued, requeueAfter > 0)
+			require.LessOrEqual(t, requeueAfter, time.Minute)
+		})
+	}
+}
+
+func TestHoldScaleDown(t *testing.T) {
+	am := &monitoringv1.Alertmanager{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test",
+			Namespace: "ns",
+		},
+	}
+
+	existing := &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Annotations: map[string]string{operator.InputHashAnnotationName: "old"},
+		},
+		Spec: appsv1.StatefulSetSpec{Replicas: ptr.To(int32(3))},
+	}
+
+	o := &Operator{kclient: fake.NewSimpleClientset()}
+	logger := slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelInfo}))
+
+	for _, tc := range []struct {
+		desired  int32
+		expected int32
+	}{
+		{desired: 5, expected: 5},
+		{desired: 3, expected: 3},
+		// The peers need to be updated first.
+		{desired: 2, expected: 3},
+		{desired: 0, expected: 0},
+	} {
+		sset := &appsv1.StatefulSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Annotations: map[string]string{operator.InputHashAnnotationName: "new"},
+			},
+			Spec: appsv1.StatefulSetSpec{Replicas: ptr.To(tc.desired)},
+		}
+
+		require.NoError(t, o.holdScaleDown(context.Background(), logger, am, existing, sset))
+		require.Equal(t, tc.expected, *sset.Spec.Replicas)
+	}
+}
+
+func TestGossipSettleDuration(t *testing.T) {
+	am := &monitoringv1.Alertmanager{}
+	require.Equal(t, time.Minute, gossipSettleDuration(am))
+
+	am.Spec.ClusterPushpullInterval = "30s"
+	require.Equal(t, 30*time.Second, gossipSettleDuration(am))
+}
+
 // alwaysAllowed implements SelfSubjectAccessReviewInterface.
 type alwaysAllowed struct{}
 
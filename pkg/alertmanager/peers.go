@@ -0,0 +1,270 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alertmanager
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/utils/ptr"
+
+	"github.com/prometheus-operator/prometheus-operator/internal/util"
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+)
+
+const (
+	defaultPeerServicePort = "tcp-mesh"
+
+	// dnsSRVResolutionInterval is the interval at which the DNS SRV records
+	// of the peer discovery configuration are resolved.
+	dnsSRVResolutionInterval = time.Minute
+)
+
+// srvResolver resolves DNS SRV records (implemented by *net.Resolver).
+type srvResolver interface {
+	LookupSRV(ctx context.Context, service, proto, name string) (string, []*net.SRV, error)
+}
+
+// resolvedSRVRecords holds the peers last resolved for each DNS SRV record.
+// The zero value is ready to use.
+type resolvedSRVRecords struct {
+	mtx   sync.Mutex
+	peers map[string][]string
+}
+
+func (r *resolvedSRVRecords) get(name string) ([]string, bool) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	peers, found := r.peers[name]
+	return peers, found
+}
+
+func (r *resolvedSRVRecords) set(name string, peers []string) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	if r.peers == nil {
+		r.peers = map[string][]string{}
+	}
+	r.peers[name] = peers
+}
+
+// discoverPeers returns the sorted list of additional peers discovered from
+// the Services and DNS SRV records defined in the peer discovery
+// configuration.
+func (c *Operator) discoverPeers(ctx context.Context, am *monitoringv1.Alertmanager) ([]string, error) {
+	pd := am.Spec.PeerDiscovery
+	if pd == nil {
+		return nil, nil
+	}
+
+	peers := map[string]struct{}{}
+
+	if pd.ServiceSelector != nil {
+		if c.svcInfs == nil {
+			return nil, errors.New("peer discovery from Services requires the permissions to list and watch Services")
+		}
+
+		svcs, err := c.selectPeerServices(am)
+		if err != nil {
+			return nil, err
+		}
+
+		portName := ptr.Deref(pd.ServicePort, defaultPeerServicePort)
+		for _, svc := range svcs {
+			peer, found := c.servicePeer(svc, portName)
+			if !found {
+				c.logger.Warn("ignoring Service without cluster port", "service", svc.Name, "namespace", svc.Namespace, "port", portName, "alertmanager", am.Name)
+				continue
+			}
+
+			peers[peer] = struct{}{}
+		}
+	}
+
+	for _, name := range pd.DNSSRVRecords {
+		for _, peer := range c.resolveSRVPeers(ctx, am, name) {
+			peers[peer] = struct{}{}
+		}
+	}
+
+	return util.SortedKeys(peers), nil
+}
+
+// mergePeers returns the sorted and deduplicated list of the additional and
+// discovered peers. Because the peers are passed as arguments to the
+// Alertmanager containers, the order of the list mustn't depend on the
+// discovery (e.g. the order of the DNS answers), otherwise the pods would be
+// restarted needlessly.
+func mergePeers(additional, discovered []string) []string {
+	peers := slices.Concat(additional, discovered)
+	slices.Sort(peers)
+
+	return slices.Compact(peers)
+}
+
+// resolveSRVPeers returns the peers from the DNS SRV record.
+// A resolution failure (e.g. a DNS outage in a remote cluster) shouldn't
+// block the reconciliation nor remove peers from the cluster: in this case,
+// the peers from the last successful resolution are returned.
+func (c *Operator) resolveSRVPeers(ctx context.Context, am *monitoringv1.Alertmanager, name string) []string {
+	_, srvs, err := c.srvResolver.LookupSRV(ctx, "", "", name)
+	if err != nil {
+		var dnsErr *net.DNSError
+		if !errors.As(err, &dnsErr) || !dnsErr.IsNotFound {
+			peers, found := c.srvRecords.get(name)
+			c.logger.Warn("failed to resolve DNS SRV record, using the last resolved peers", "err", err, "record", name, "peers", len(peers), "resolved", found, "alertmanager", am.Name, "namespace", am.Namespace)
+			return peers
+		}
+
+		c.logger.Warn("DNS SRV record not found", "record", name, "alertmanager", am.Name, "namespace", am.Namespace)
+	}
+
+	peers := make([]string, 0, len(srvs))
+	for _, srv := range srvs {
+		peers = append(peers, net.JoinHostPort(strings.TrimSuffix(srv.Target, "."), strconv.Itoa(int(srv.Port))))
+	}
+	c.srvRecords.set(name, peers)
+
+	return peers
+}
+
+// selectPeerServices returns the Services selected by the peer discovery
+// configuration, excluding the governing service of the Alertmanager.
+func (c *Operator) selectPeerServices(am *monitoringv1.Alertmanager) ([]*v1.Service, error) {
+	pd := am.Spec.PeerDiscovery
+	namespaces := []string{}
+
+	// If 'ServiceNamespaceSelector' is nil, only check own namespace.
+	if pd.ServiceNamespaceSelector == nil {
+		namespaces = append(namespaces, am.Namespace)
+	} else {
+		nsSelector, err := metav1.LabelSelectorAsSelector(pd.ServiceNamespaceSelector)
+		if err != nil {
+			return nil, err
+		}
+
+		err = cache.ListAll(c.nsAlrtCfgInf.GetStore(), nsSelector, func(obj interface{}) {
+			namespaces = append(namespaces, obj.(*v1.Namespace).Name)
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list namespaces: %w", err)
+		}
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(pd.ServiceSelector)
+	if err != nil {
+		return nil, err
+	}
+
+	var svcs []*v1.Service
+	for _, ns := range namespaces {
+		err := c.svcInfs.ListAllByNamespace(ns, selector, func(obj interface{}) {
+			svc := obj.(*v1.Service)
+			if svc.Namespace == am.Namespace && svc.Name == getServiceName(am) {
+				return
+			}
+
+			svcs = append(svcs, svc)
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list services in namespace %s: %w", ns, err)
+		}
+	}
+
+	return svcs, nil
+}
+
+// servicePeer returns the peer address of the Service for the given port
+// name.
+func (c *Operator) servicePeer(svc *v1.Service, portName string) (string, bool) {
+	for _, p := range svc.Spec.Ports {
+		if p.Name != portName {
+			continue
+		}
+
+		host := fmt.Sprintf("%s.%s.svc", svc.Name, svc.Namespace)
+		if c.config.ClusterDomain != "" {
+			host = fmt.Sprintf("%s.%s", host, c.config.ClusterDomain)
+		}
+
+		return net.JoinHostPort(host, strconv.Itoa(int(p.Port))), true
+	}
+
+	return "", false
+}
+
+// enqueueForPeerServiceNamespace enqueues the Alertmanager objects which
+// discover peers from the Services in the given namespace.
+func (c *Operator) enqueueForPeerServiceNamespace(nsName string) {
+	nsObject, exists, err := c.nsAlrtCfgInf.GetStore().GetByKey(nsName)
+	if err != nil {
+		c.logger.Error(
+			"get namespace to enqueue Alertmanager instances failed",
+			"err", err,
+		)
+		return
+	}
+	if !exists {
+		c.logger.Error(fmt.Sprintf("get namespace to enqueue Alertmanager instances failed: namespace %q does not exist", nsName))
+		return
+	}
+	ns := nsObject.(*v1.Namespace)
+
+	err = c.alrtInfs.ListAll(labels.Everything(), func(obj interface{}) {
+		am := obj.(*monitoringv1.Alertmanager)
+		if am.Spec.PeerDiscovery == nil || am.Spec.PeerDiscovery.ServiceSelector == nil {
+			return
+		}
+
+		if am.Spec.PeerDiscovery.ServiceNamespaceSelector == nil {
+			if am.Namespace == nsName {
+				c.rr.EnqueueForReconciliation(am)
+			}
+			return
+		}
+
+		nsSelector, err := metav1.LabelSelectorAsSelector(am.Spec.PeerDiscovery.ServiceNamespaceSelector)
+		if err != nil {
+			c.logger.Error(
+				fmt.Sprintf("failed to convert ServiceNamespaceSelector of %q to selector", am.Name),
+				"err", err,
+			)
+			return
+		}
+
+		if nsSelector.Matches(labels.Set(ns.Labels)) {
+			c.rr.EnqueueForReconciliation(am)
+		}
+	})
+	if err != nil {
+		c.logger.Error(
+			"listing all Alertmanager instances from cache failed",
+			"err", err,
+		)
+	}
+}
@@ -0,0 +1,345 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alertmanager
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net"
+	"os"
+	"testing"
+
+	"github.com/prometheus/alertmanager/api/v2/models"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubeinformers "k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/ptr"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringfake "github.com/prometheus-operator/prometheus-operator/pkg/client/versioned/fake"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+)
+
+type fakeSRVResolver struct {
+	records map[string][]*net.SRV
+	err     error
+}
+
+func (f *fakeSRVResolver) LookupSRV(_ context.Context, _, _, name string) (string, []*net.SRV, error) {
+	if f.err != nil {
+		return "", nil, f.err
+	}
+
+	srvs, found := f.records[name]
+	if !found {
+		return "", nil, &net.DNSError{Err: "no such host", Name: name, IsNotFound: true}
+	}
+
+	return name, srvs, nil
+}
+
+type fakeClusterStatusClient struct {
+	peers int
+	err   error
+}
+
+func (f *fakeClusterStatusClient) clusterStatus(_ context.Context, _ *monitoringv1.Alertmanager) (*models.ClusterStatus, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+
+	return &models.ClusterStatus{Peers: make([]*models.PeerStatus, f.peers)}, nil
+}
+
+func TestDiscoverPeers(t *testing.T) {
+	makeService := func(name, ns string, labels map[string]string, ports ...string) *v1.Service {
+		svc := &v1.Service{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: ns,
+				Labels:    labels,
+			},
+			Spec: v1.ServiceSpec{ClusterIP: v1.ClusterIPNone},
+		}
+		for _, p := range ports {
+			svc.Spec.Ports = append(svc.Spec.Ports, v1.ServicePort{Name: p, Port: 9094})
+		}
+
+		return svc
+	}
+
+	objects := []runtime.Object{
+		&v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "monitoring"}},
+		&v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "remote", Labels: map[string]string{"alertmanager-peers": "true"}}},
+		&v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "other"}},
+		// The governing service is never selected.
+		makeService("alertmanager-operated", "monitoring", map[string]string{"peer": "true"}, "tcp-mesh"),
+		makeService("cluster-b", "monitoring", map[string]string{"peer": "true"}, "tcp-mesh"),
+		makeService("cluster-c", "remote", map[string]string{"peer": "true"}, "tcp-mesh"),
+		makeService("cluster-d", "remote", map[string]string{"peer": "true"}, "gossip"),
+		makeService("cluster-e", "other", map[string]string{"peer": "true"}, "tcp-mesh"),
+		makeService("not-a-peer", "monitoring", nil, "tcp-mesh"),
+	}
+
+	kclient := fake.NewSimpleClientset(objects...)
+	o := &Operator{
+		kclient:                       kclient,
+		mclient:                       monitoringfake.NewSimpleClientset(),
+		ssarClient:                    &alwaysAllowed{},
+		logger:                        slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelInfo})),
+		metrics:                       operator.NewMetrics(prometheus.NewRegistry()),
+		peerServiceDiscoverySupported: true,
+		srvResolver: &fakeSRVResolver{
+			records: map[string][]*net.SRV{
+				"_mesh._tcp.alertmanager.example.com": {
+					{Target: "am-1.example.com.", Port: 9094},
+					{Target: "am-0.example.com.", Port: 9094},
+				},
+			},
+		},
+	}
+
+	err := o.bootstrap(
+		context.Background(),
+		operator.Config{
+			Namespaces: operator.Namespaces{
+				AlertmanagerConfigAllowList: map[string]struct{}{v1.NamespaceAll: {}},
+				AlertmanagerAllowList:       map[string]struct{}{v1.NamespaceAll: {}},
+			},
+		},
+	)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	go o.svcInfs.Start(ctx.Done())
+	for _, inf := range o.svcInfs.GetInformers() {
+		require.True(t, operator.WaitForNamedCacheSync(ctx, "alertmanager", o.logger, inf.Informer()))
+	}
+
+	o.nsAlrtCfgInf = kubeinformers.NewSharedInformerFactory(kclient, 0).Core().V1().Namespaces().Informer()
+	go o.nsAlrtCfgInf.Run(ctx.Done())
+	require.True(t, operator.WaitForNamedCacheSync(ctx, "alertmanager", o.logger, o.nsAlrtCfgInf))
+
+	for _, tc := range []struct {
+		name          string
+		peerDiscovery *monitoringv1.AlertmanagerPeerDiscovery
+		clusterDomain string
+
+		expected []string
+		err      bool
+	}{
+		{
+			name: "no peer discovery",
+		},
+		{
+			name: "services in own namespace",
+			peerDiscovery: &monitoringv1.AlertmanagerPeerDiscovery{
+				ServiceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"peer": "true"}},
+			},
+			expected: []string{"cluster-b.monitoring.svc:9094"},
+		},
+		{
+			name: "services with cluster domain",
+			peerDiscovery: &monitoringv1.AlertmanagerPeerDiscovery{
+				ServiceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"peer": "true"}},
+			},
+			clusterDomain: "cluster.local",
+			expected:      []string{"cluster-b.monitoring.svc.cluster.local:9094"},
+		},
+		{
+			name: "services in selected namespaces",
+			peerDiscovery: &monitoringv1.AlertmanagerPeerDiscovery{
+				ServiceSelector:          &metav1.LabelSelector{MatchLabels: map[string]string{"peer": "true"}},
+				ServiceNamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"alertmanager-peers": "true"}},
+			},
+			expected: []string{"cluster-c.remote.svc:9094"},
+		},
+		{
+			name: "custom service port",
+			peerDiscovery: &monitoringv1.AlertmanagerPeerDiscovery{
+				ServiceSelector:          &metav1.LabelSelector{MatchLabels: map[string]string{"peer": "true"}},
+				ServiceNamespaceSelector: &metav1.LabelSelector{},
+				ServicePort:              ptr.To("gossip"),
+			},
+			expected: []string{"cluster-d.remote.svc:9094"},
+		},
+		{
+			name: "DNS SRV records",
+			peerDiscovery: &monitoringv1.AlertmanagerPeerDiscovery{
+				DNSSRVRecords: []string{"_mesh._tcp.alertmanager.example.com"},
+			},
+			expected: []string{"am-0.example.com:9094", "am-1.example.com:9094"},
+		},
+		{
+			name: "unknown DNS SRV record",
+			peerDiscovery: &monitoringv1.AlertmanagerPeerDiscovery{
+				DNSSRVRecords: []string{"_mesh._tcp.unknown.example.com"},
+			},
+			expected: []string{},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			o.config.ClusterDomain = tc.clusterDomain
+
+			am := &monitoringv1.Alertmanager{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "main",
+					Namespace: "monitoring",
+				},
+				Spec: monitoringv1.AlertmanagerSpec{
+					PeerDiscovery: tc.peerDiscovery,
+				},
+			}
+
+			peers, err := o.discoverPeers(context.Background(), am)
+			if tc.err {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, peers)
+		})
+	}
+}
+
+func TestDiscoverPeersWithDNSFailure(t *testing.T) {
+	resolver := &fakeSRVResolver{
+		records: map[string][]*net.SRV{
+			"_mesh._tcp.a.example.com": {{Target: "am-0.a.example.com.", Port: 9094}},
+			"_mesh._tcp.b.example.com": {{Target: "am-0.b.example.com.", Port: 9094}},
+		},
+	}
+	o := &Operator{
+		logger:      slog.New(slog.DiscardHandler),
+		srvResolver: resolver,
+	}
+	am := &monitoringv1.Alertmanager{
+		Spec: monitoringv1.AlertmanagerSpec{
+			PeerDiscovery: &monitoringv1.AlertmanagerPeerDiscovery{
+				DNSSRVRecords: []string{"_mesh._tcp.a.example.com"},
+			},
+		},
+	}
+
+	peers, err := o.discoverPeers(context.Background(), am)
+	require.NoError(t, err)
+	require.Equal(t, []string{"am-0.a.example.com:9094"}, peers)
+
+	// The peers of the last successful resolution are kept and the records
+	// which have never been resolved are ignored.
+	resolver.err = &net.DNSError{Err: "i/o timeout", Name: "example.com", IsTimeout: true}
+	am.Spec.PeerDiscovery.DNSSRVRecords = append(am.Spec.PeerDiscovery.DNSSRVRecords, "_mesh._tcp.b.example.com")
+
+	peers, err = o.discoverPeers(context.Background(), am)
+	require.NoError(t, err)
+	require.Equal(t, []string{"am-0.a.example.com:9094"}, peers)
+
+	resolver.err = nil
+	peers, err = o.discoverPeers(context.Background(), am)
+	require.NoError(t, err)
+	require.Equal(t, []string{"am-0.a.example.com:9094", "am-0.b.example.com:9094"}, peers)
+}
+
+func TestMergePeers(t *testing.T) {
+	require.Empty(t, mergePeers(nil, nil))
+	require.Equal(t,
+		[]string{"am-0.a.example.com:9094", "am-0.b.example.com:9094", "am-1.a.example.com:9094"},
+		mergePeers(
+			[]string{"am-1.a.example.com:9094", "am-0.a.example.com:9094"},
+			[]string{"am-0.b.example.com:9094", "am-0.a.example.com:9094"},
+		),
+	)
+}
+
+func TestDiscoverPeersWithoutServicePermissions(t *testing.T) {
+	o := &Operator{}
+
+	_, err := o.discoverPeers(context.Background(), &monitoringv1.Alertmanager{
+		Spec: monitoringv1.AlertmanagerSpec{
+			PeerDiscovery: &monitoringv1.AlertmanagerPeerDiscovery{
+				ServiceSelector: &metav1.LabelSelector{},
+			},
+		},
+	})
+	require.Error(t, err)
+}
+
+func TestClusterSize(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		am     *monitoringv1.Alertmanager
+		client *fakeClusterStatusClient
+
+		expected *int32
+	}{
+		{
+			name: "cluster mode disabled",
+			am: &monitoringv1.Alertmanager{
+				Spec:   monitoringv1.AlertmanagerSpec{Replicas: ptr.To(int32(1))},
+				Status: monitoringv1.AlertmanagerStatus{AvailableReplicas: 1},
+			},
+			client: &fakeClusterStatusClient{peers: 1},
+		},
+		{
+			name: "no available replica",
+			am: &monitoringv1.Alertmanager{
+				Spec: monitoringv1.AlertmanagerSpec{Replicas: ptr.To(int32(3))},
+			},
+			client: &fakeClusterStatusClient{peers: 3},
+		},
+		{
+			name: "API error",
+			am: &monitoringv1.Alertmanager{
+				Spec:   monitoringv1.AlertmanagerSpec{Replicas: ptr.To(int32(3))},
+				Status: monitoringv1.AlertmanagerStatus{AvailableReplicas: 3},
+			},
+			client: &fakeClusterStatusClient{err: errors.New("connection refused")},
+		},
+		{
+			name: "cluster with external peers",
+			am: &monitoringv1.Alertmanager{
+				Spec:   monitoringv1.AlertmanagerSpec{Replicas: ptr.To(int32(3))},
+				Status: monitoringv1.AlertmanagerStatus{AvailableReplicas: 3},
+			},
+			client:   &fakeClusterStatusClient{peers: 5},
+			expected: ptr.To(int32(5)),
+		},
+		{
+			name: "single replica with cluster mode",
+			am: &monitoringv1.Alertmanager{
+				Spec:   monitoringv1.AlertmanagerSpec{Replicas: ptr.To(int32(1)), ForceEnableClusterMode: true},
+				Status: monitoringv1.AlertmanagerStatus{AvailableReplicas: 1},
+			},
+			client:   &fakeClusterStatusClient{peers: 2},
+			expected: ptr.To(int32(2)),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			o := &Operator{
+				logger:       slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelInfo})),
+				statusClient: tc.client,
+			}
+
+			require.Equal(t, tc.expected, o.clusterSize(context.Background(), tc.am))
+		})
+	}
+}
@@ -0,0 +1,146 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alertmanager
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/prometheus/client_golang/prometheus"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubeinformers "k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/assets"
+	monitoringclient "github.com/prometheus-operator/prometheus-operator/pkg/client/versioned"
+	"github.com/prometheus-operator/prometheus-operator/pkg/informers"
+	"github.com/prometheus-operator/prometheus-operator/pkg/k8sutil"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+)
+
+// RenderConfiguration returns the configuration files that the controller
+// would generate for the given Alertmanager object, keyed by file name.
+//
+// The Alertmanager configuration is returned as "alertmanager.yaml" along
+// with the additional keys of the user-provided configuration secret (e.g.
+// templates).
+//
+// The function doesn't require a Kubernetes cluster: the clients are expected
+// to be fake clients populated with the namespaces, the AlertmanagerConfig
+// and ClusterAlertmanagerConfig resources, the secrets and the configmaps.
+// The generated objects are written back to the clients.
+func RenderConfiguration(ctx context.Context, logger *slog.Logger, kclient kubernetes.Interface, mclient monitoringclient.Interface, am *monitoringv1.Alertmanager) (map[string][]byte, error) {
+	if logger == nil {
+		logger = slog.New(slog.DiscardHandler)
+	}
+
+	am = am.DeepCopy()
+	if err := k8sutil.AddTypeInformationToObject(am); err != nil {
+		return nil, fmt.Errorf("failed to set Alertmanager type information: %w", err)
+	}
+
+	c := &Operator{
+		kclient:       kclient,
+		mclient:       mclient,
+		logger:        logger,
+		accessor:      operator.NewAccessor(logger),
+		metrics:       operator.NewMetrics(prometheus.NewRegistry()),
+		eventRecorder: &record.FakeRecorder{},
+
+		clusterAlertmanagerConfigSupported: true,
+	}
+
+	var err error
+	c.alrtCfgInfs, err = informers.NewInformersForResource(
+		informers.NewMonitoringInformerFactories(
+			map[string]struct{}{v1.NamespaceAll: {}},
+			nil,
+			mclient,
+			0,
+			nil,
+		),
+		monitoringv1alpha1.SchemeGroupVersion.WithResource(monitoringv1alpha1.AlertmanagerConfigName),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error creating alertmanagerconfig informers: %w", err)
+	}
+
+	c.alrtCfgInfs.Start(ctx.Done())
+	if !cache.WaitForCacheSync(ctx.Done(), c.alrtCfgInfs.HasSynced) {
+		return nil, fmt.Errorf("failed to sync cache for alertmanagerconfig informers")
+	}
+
+	c.clusterAlrtCfgInfs, err = informers.NewInformersForResource(
+		informers.NewMonitoringInformerFactories(
+			map[string]struct{}{v1.NamespaceAll: {}},
+			nil,
+			mclient,
+			0,
+			nil,
+		),
+		monitoringv1alpha1.SchemeGroupVersion.WithResource(monitoringv1alpha1.ClusterAlertmanagerConfigName),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error creating clusteralertmanagerconfig informers: %w", err)
+	}
+
+	c.clusterAlrtCfgInfs.Start(ctx.Done())
+	if !cache.WaitForCacheSync(ctx.Done(), c.clusterAlrtCfgInfs.HasSynced) {
+		return nil, fmt.Errorf("failed to sync cache for clusteralertmanagerconfig informers")
+	}
+
+	kinfs := kubeinformers.NewSharedInformerFactory(kclient, 0)
+	c.nsAlrtCfgInf = kinfs.Core().V1().Namespaces().Informer()
+	c.nsAlrtInf = c.nsAlrtCfgInf
+	kinfs.Start(ctx.Done())
+	for inf, synced := range kinfs.WaitForCacheSync(ctx.Done()) {
+		if !synced {
+			return nil, fmt.Errorf("failed to sync cache for %s informer", inf)
+		}
+	}
+
+	store := assets.NewStoreBuilder(kclient.CoreV1(), kclient.CoreV1())
+	if err := c.provisionAlertmanagerConfiguration(ctx, am, store); err != nil {
+		return nil, fmt.Errorf("provision alertmanager configuration: %w", err)
+	}
+
+	s, err := kclient.CoreV1().Secrets(am.Namespace).Get(ctx, generatedConfigSecretName(am.Name), metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the generated configuration secret: %w", err)
+	}
+
+	files := make(map[string][]byte, len(s.Data))
+	for k, v := range s.Data {
+		if k == alertmanagerConfigFileCompressed {
+			conf, err := operator.GunzipConfig(v)
+			if err != nil {
+				return nil, fmt.Errorf("failed to decompress the configuration: %w", err)
+			}
+
+			files[alertmanagerConfigFile] = []byte(conf)
+			continue
+		}
+
+		files[k] = v
+	}
+
+	return files, nil
+}
@@ -0,0 +1,531 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alertmanager
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/prometheus/alertmanager/api/v2/models"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/utils/ptr"
+
+	"github.com/prometheus-operator/prometheus-operator/internal/util"
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+)
+
+const (
+	// silenceCreatedByPrefix identifies the silences managed by the operator.
+	silenceCreatedByPrefix = "prometheus-operator/"
+
+	silenceStateExpired = "expired"
+)
+
+// silenceClient manages the silences of an Alertmanager resource.
+type silenceClient interface {
+	// list returns all the silences.
+	list(ctx context.Context, am *monitoringv1.Alertmanager) (models.GettableSilences, error)
+	// post creates or updates a silence and returns its identifier.
+	post(ctx context.Context, am *monitoringv1.Alertmanager, s *models.PostableSilence) (string, error)
+	// expire expires the silence identified by id.
+	expire(ctx context.Context, am *monitoringv1.Alertmanager, id string) error
+}
+
+func (hc *httpAPIClient) list(ctx context.Context, am *monitoringv1.Alertmanager) (models.GettableSilences, error) {
+	b, err := hc.do(ctx, am, http.MethodGet, "silences", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var silences models.GettableSilences
+	if err := json.Unmarshal(b, &silences); err != nil {
+		return nil, fmt.Errorf("failed to decode silences: %w", err)
+	}
+
+	return silences, nil
+}
+
+func (hc *httpAPIClient) post(ctx context.Context, am *monitoringv1.Alertmanager, s *models.PostableSilence) (string, error) {
+	body, err := json.Marshal(s)
+	if err != nil {
+		return "", err
+	}
+
+	b, err := hc.do(ctx, am, http.MethodPost, "silences", body)
+	if err != nil {
+		return "", err
+	}
+
+	var resp struct {
+		SilenceID string `json:"silenceID"`
+	}
+	if err := json.Unmarshal(b, &resp); err != nil {
+		return "", fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return resp.SilenceID, nil
+}
+
+func (hc *httpAPIClient) expire(ctx context.Context, am *monitoringv1.Alertmanager, id string) error {
+	_, err := hc.do(ctx, am, http.MethodDelete, "silence/"+url.PathEscape(id), nil)
+	return err
+}
+
+// silenceCreatedBy returns the value of the silence's createdBy field which
+// identifies the AlertmanagerSilence resource.
+func silenceCreatedBy(s *monitoringv1alpha1.AlertmanagerSilence) string {
+	return silenceCreatedByPrefix + s.Namespace + "/" + s.Name
+}
+
+// makeSilence returns the Alertmanager silence for the AlertmanagerSilence
+// resource. The namespace matcher is added when enforceNamespace is true.
+func makeSilence(s *monitoringv1alpha1.AlertmanagerSilence, enforceNamespace bool) (*models.PostableSilence, error) {
+	startsAt := s.CreationTimestamp
+	if s.Spec.StartsAt != nil {
+		startsAt = *s.Spec.StartsAt
+	}
+
+	if !s.Spec.EndsAt.After(startsAt.Time) {
+		return nil, fmt.Errorf("endsAt (%s) should be after startsAt (%s)", s.Spec.EndsAt.UTC().Format(time.RFC3339), startsAt.UTC().Format(time.RFC3339))
+	}
+
+	var matchers models.Matchers
+	if enforceNamespace {
+		matchers = append(matchers, &models.Matcher{
+			Name:    ptr.To("namespace"),
+			Value:   ptr.To(s.Namespace),
+			IsEqual: ptr.To(true),
+			IsRegex: ptr.To(false),
+		})
+	}
+
+	for i, m := range s.Spec.Matchers {
+		matchType := m.MatchType
+		if matchType == "" {
+			matchType = monitoringv1alpha1.MatchEqual
+			if m.Regex {
+				matchType = monitoringv1alpha1.MatchRegexp
+			}
+		}
+
+		m.MatchType = matchType
+		if err := m.Validate(); err != nil {
+			return nil, fmt.Errorf("matchers[%d]: %w", i, err)
+		}
+
+		matchers = append(matchers, &models.Matcher{
+			Name:    ptr.To(m.Name),
+			Value:   ptr.To(m.Value),
+			IsEqual: ptr.To(matchType == monitoringv1alpha1.MatchEqual || matchType == monitoringv1alpha1.MatchRegexp),
+			IsRegex: ptr.To(matchType == monitoringv1alpha1.MatchRegexp || matchType == monitoringv1alpha1.MatchNotRegexp),
+		})
+	}
+
+	return &models.PostableSilence{
+		Silence: models.Silence{
+			Comment:   ptr.To(s.Spec.Comment),
+			CreatedBy: ptr.To(silenceCreatedBy(s)),
+			Matchers:  matchers,
+			StartsAt:  ptr.To(strfmt.DateTime(startsAt.UTC())),
+			EndsAt:    ptr.To(strfmt.DateTime(s.Spec.EndsAt.UTC())),
+		},
+	}, nil
+}
+
+// silenceUpToDate returns true if the existing silence matches the desired
+// silence.
+func silenceUpToDate(existing *models.GettableSilence, desired *models.PostableSilence, now time.Time) bool {
+	if ptr.Deref(existing.Comment, "") != ptr.Deref(desired.Comment, "") {
+		return false
+	}
+
+	if !time.Time(*existing.EndsAt).Equal(time.Time(*desired.EndsAt)) {
+		return false
+	}
+
+	// Alertmanager resets the start time of the silences starting in the
+	// past to the current time.
+	if desiredStart := time.Time(*desired.StartsAt); desiredStart.After(now) && !time.Time(*existing.StartsAt).Equal(desiredStart) {
+		return false
+	}
+
+	return slices.Equal(matchersToStrings(existing.Matchers), matchersToStrings(desired.Matchers))
+}
+
+func matchersToStrings(matchers models.Matchers) []string {
+	s := make([]string, 0, len(matchers))
+	for _, m := range matchers {
+		op := "="
+		switch {
+		case ptr.Deref(m.IsRegex, false) && !ptr.Deref(m.IsEqual, true):
+			op = "!~"
+		case ptr.Deref(m.IsRegex, false):
+			op = "=~"
+		case !ptr.Deref(m.IsEqual, true):
+			op = "!="
+		}
+
+		s = append(s, fmt.Sprintf("%s%s%q", ptr.Deref(m.Name, ""), op, ptr.Deref(m.Value, "")))
+	}
+	slices.Sort(s)
+
+	return s
+}
+
+// selectSilences returns the AlertmanagerSilence objects selected by the
+// Alertmanager, keyed by `<namespace>/<name>`.
+func (c *Operator) selectSilences(am *monitoringv1.Alertmanager) (map[string]*monitoringv1alpha1.AlertmanagerSilence, error) {
+	namespaces := []string{}
+
+	// If 'SilenceNamespaceSelector' is nil, only check own namespace.
+	if am.Spec.SilenceNamespaceSelector == nil {
+		namespaces = append(namespaces, am.Namespace)
+	} else {
+		nsSelector, err := metav1.LabelSelectorAsSelector(am.Spec.SilenceNamespaceSelector)
+		if err != nil {
+			return nil, err
+		}
+
+		err = cache.ListAll(c.nsAlrtCfgInf.GetStore(), nsSelector, func(obj interface{}) {
+			namespaces = append(namespaces, obj.(*v1.Namespace).Name)
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list namespaces: %w", err)
+		}
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(am.Spec.SilenceSelector)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make(map[string]*monitoringv1alpha1.AlertmanagerSilence)
+	for _, ns := range namespaces {
+		err := c.silenceInfs.ListAllByNamespace(ns, selector, func(obj interface{}) {
+			k, ok := c.accessor.MetaNamespaceKey(obj)
+			if !ok {
+				return
+			}
+
+			res[k] = obj.(*monitoringv1alpha1.AlertmanagerSilence)
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list alertmanager silences in namespace %s: %w", ns, err)
+		}
+	}
+
+	return res, nil
+}
+
+// syncSilences creates, updates and expires the silences of the Alertmanager
+// according to the selected AlertmanagerSilence objects, then updates the
+// status of these objects.
+func (c *Operator) syncSilences(ctx context.Context, am *monitoringv1.Alertmanager) error {
+	if c.silenceInfs == nil {
+		return nil
+	}
+
+	if am.Spec.SilenceSelector == nil {
+		return c.removeSilenceBindings(ctx, am.Namespace, am.Name, nil)
+	}
+
+	selected, err := c.selectSilences(am)
+	if err != nil {
+		return fmt.Errorf("failed to select AlertmanagerSilence objects: %w", err)
+	}
+
+	if amKey, ok := c.accessor.MetaNamespaceKey(am); ok {
+		c.metrics.SetSelectedResources(amKey, monitoringv1alpha1.AlertmanagerSilenceKind, len(selected))
+	}
+
+	existing, err := c.silenceClient.list(ctx, am)
+	if err != nil {
+		err = fmt.Errorf("failed to list silences: %w", err)
+		for _, s := range selected {
+			binding := monitoringv1alpha1.AlertmanagerSilenceBinding{
+				Conditions: []monitoringv1.ConfigResourceCondition{operator.NewConfigResourceCondition(s, "SyncFailed", err)},
+			}
+			if i := findSilenceBinding(s.Status.Alertmanagers, am.Namespace, am.Name); i >= 0 {
+				// Keep the last known state of the silence.
+				binding = s.Status.Alertmanagers[i]
+				binding.Conditions = []monitoringv1.ConfigResourceCondition{operator.NewConfigResourceCondition(s, "SyncFailed", err)}
+			}
+
+			if updateErr := c.updateSilenceBinding(ctx, am, s, binding); updateErr != nil {
+				c.logger.Warn("failed to update AlertmanagerSilence status", "err", updateErr, "alertmanagersilence", s.Namespace+"/"+s.Name)
+			}
+		}
+
+		return err
+	}
+
+	// Index the non-expired silences managed by the operator.
+	managed := map[string][]*models.GettableSilence{}
+	for _, s := range existing {
+		createdBy := ptr.Deref(s.CreatedBy, "")
+		if !strings.HasPrefix(createdBy, silenceCreatedByPrefix) {
+			continue
+		}
+
+		if s.Status != nil && ptr.Deref(s.Status.State, "") == silenceStateExpired {
+			continue
+		}
+
+		managed[createdBy] = append(managed[createdBy], s)
+	}
+
+	var (
+		errs             []error
+		enforceNamespace = am.Spec.AlertmanagerConfigMatcherStrategy.Type != monitoringv1.NoneConfigMatcherStrategyType
+	)
+	for _, k := range util.SortedKeys(selected) {
+		s := selected[k]
+		createdBy := silenceCreatedBy(s)
+
+		binding, err := c.syncSilence(ctx, am, s, managed[createdBy], enforceNamespace)
+		delete(managed, createdBy)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("AlertmanagerSilence %s: %w", k, err))
+		}
+
+		if err := c.updateSilenceBinding(ctx, am, s, binding); err != nil {
+			errs = append(errs, fmt.Errorf("failed to update the status of AlertmanagerSilence %s: %w", k, err))
+		}
+	}
+
+	// Expire the silences of the objects which have been deleted or aren't
+	// selected anymore.
+	for _, createdBy := range util.SortedKeys(managed) {
+		for _, s := range managed[createdBy] {
+			c.logger.Debug("expiring silence", "id", ptr.Deref(s.ID, ""), "createdBy", createdBy, "alertmanager", am.Name, "namespace", am.Namespace)
+			if err := c.silenceClient.expire(ctx, am, ptr.Deref(s.ID, "")); err != nil {
+				errs = append(errs, fmt.Errorf("failed to expire silence %s: %w", ptr.Deref(s.ID, ""), err))
+			}
+		}
+	}
+
+	if err := c.removeSilenceBindings(ctx, am.Namespace, am.Name, selected); err != nil {
+		errs = append(errs, err)
+	}
+
+	return errors.Join(errs...)
+}
+
+// syncSilence reconciles the Alertmanager silence of an AlertmanagerSilence
+// object given the existing non-expired silences created for the object. It
+// returns the status binding of the object.
+func (c *Operator) syncSilence(ctx context.Context, am *monitoringv1.Alertmanager, s *monitoringv1alpha1.AlertmanagerSilence, existing []*models.GettableSilence, enforceNamespace bool) (monitoringv1alpha1.AlertmanagerSilenceBinding, error) {
+	binding := monitoringv1alpha1.AlertmanagerSilenceBinding{
+		EndsAt: ptr.To(s.Spec.EndsAt),
+	}
+
+	desired, err := makeSilence(s, enforceNamespace)
+	if err != nil {
+		// Leave the existing silences untouched.
+		binding.Conditions = []monitoringv1.ConfigResourceCondition{operator.NewConfigResourceCondition(s, operator.InvalidConfigurationEvent, err)}
+		return binding, err
+	}
+
+	now := time.Now()
+	if !s.Spec.EndsAt.After(now) {
+		// The silence has expired already.
+		for _, es := range existing {
+			if err := c.silenceClient.expire(ctx, am, ptr.Deref(es.ID, "")); err != nil {
+				binding.Conditions = []monitoringv1.ConfigResourceCondition{operator.NewConfigResourceCondition(s, "SyncFailed", err)}
+				return binding, err
+			}
+		}
+
+		binding.State = silenceStateExpired
+		binding.Conditions = []monitoringv1.ConfigResourceCondition{operator.NewConfigResourceCondition(s, "", nil)}
+		return binding, nil
+	}
+
+	var current *models.GettableSilence
+	if len(existing) > 0 {
+		current = existing[0]
+
+		// Expire the duplicates (if any).
+		for _, es := range existing[1:] {
+			if err := c.silenceClient.expire(ctx, am, ptr.Deref(es.ID, "")); err != nil {
+				binding.Conditions = []monitoringv1.ConfigResourceCondition{operator.NewConfigResourceCondition(s, "SyncFailed", err)}
+				return binding, err
+			}
+		}
+	}
+
+	id := ""
+	if current != nil && silenceUpToDate(current, desired, now) {
+		id = ptr.Deref(current.ID, "")
+	} else {
+		if current != nil {
+			desired.ID = ptr.Deref(current.ID, "")
+		}
+
+		id, err = c.silenceClient.post(ctx, am, desired)
+		if err != nil {
+			binding.Conditions = []monitoringv1.ConfigResourceCondition{operator.NewConfigResourceCondition(s, "SyncFailed", err)}
+			return binding, err
+		}
+	}
+
+	binding.SilenceID = id
+	binding.State = string(models.SilenceStatusStateActive)
+	if startsAt := time.Time(*desired.StartsAt); startsAt.After(now) {
+		binding.State = string(models.SilenceStatusStatePending)
+	}
+	binding.Conditions = []monitoringv1.ConfigResourceCondition{operator.NewConfigResourceCondition(s, "", nil)}
+
+	return binding, nil
+}
+
+func findSilenceBinding(bindings []monitoringv1alpha1.AlertmanagerSilenceBinding, namespace, name string) int {
+	for i, b := range bindings {
+		if b.Namespace == namespace && b.Name == name {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// updateSilenceBinding ensures that the status of the AlertmanagerSilence
+// object contains the binding for the Alertmanager.
+func (c *Operator) updateSilenceBinding(ctx context.Context, am *monitoringv1.Alertmanager, s *monitoringv1alpha1.AlertmanagerSilence, binding monitoringv1alpha1.AlertmanagerSilenceBinding) error {
+	binding.Name = am.Name
+	binding.Namespace = am.Namespace
+
+	// As stated in the RetryOnConflict's documentation, the returned error shouldn't be wrapped.
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		i := findSilenceBinding(s.Status.Alertmanagers, am.Namespace, am.Name)
+		if i >= 0 {
+			// Preserve the transition time if the condition hasn't changed.
+			binding.Conditions = mergeSilenceConditions(s.Status.Alertmanagers[i].Conditions, binding.Conditions)
+			if equality.Semantic.DeepEqual(s.Status.Alertmanagers[i], binding) {
+				return nil
+			}
+		} else {
+			binding.Conditions = mergeSilenceConditions(nil, binding.Conditions)
+		}
+
+		s = s.DeepCopy()
+		if i >= 0 {
+			s.Status.Alertmanagers[i] = binding
+		} else {
+			s.Status.Alertmanagers = append(s.Status.Alertmanagers, binding)
+		}
+
+		_, err := c.mclient.MonitoringV1alpha1().AlertmanagerSilences(s.Namespace).UpdateStatus(ctx, s, metav1.UpdateOptions{FieldManager: operator.PrometheusOperatorFieldManager})
+		if apierrors.IsConflict(err) {
+			// Refresh the object before retrying.
+			if latest, getErr := c.mclient.MonitoringV1alpha1().AlertmanagerSilences(s.Namespace).Get(ctx, s.Name, metav1.GetOptions{}); getErr == nil {
+				s = latest
+			}
+		}
+
+		return err
+	})
+}
+
+// removeSilenceBindings removes the Alertmanager's binding from the status of
+// the AlertmanagerSilence objects which aren't in the selected map.
+func (c *Operator) removeSilenceBindings(ctx context.Context, namespace, name string, selected map[string]*monitoringv1alpha1.AlertmanagerSilence) error {
+	if c.silenceInfs == nil {
+		return nil
+	}
+
+	var stale []*monitoringv1alpha1.AlertmanagerSilence
+	err := c.silenceInfs.ListAll(labels.Everything(), func(obj interface{}) {
+		s := obj.(*monitoringv1alpha1.AlertmanagerSilence)
+		if _, found := selected[s.Namespace+"/"+s.Name]; found {
+			return
+		}
+
+		if findSilenceBinding(s.Status.Alertmanagers, namespace, name) >= 0 {
+			stale = append(stale, s)
+		}
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list AlertmanagerSilence objects: %w", err)
+	}
+
+	var errs []error
+	for _, s := range stale {
+		// As stated in the RetryOnConflict's documentation, the returned error shouldn't be wrapped.
+		err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+			i := findSilenceBinding(s.Status.Alertmanagers, namespace, name)
+			if i < 0 {
+				return nil
+			}
+
+			s = s.DeepCopy()
+			s.Status.Alertmanagers = slices.Delete(s.Status.Alertmanagers, i, i+1)
+
+			_, err := c.mclient.MonitoringV1alpha1().AlertmanagerSilences(s.Namespace).UpdateStatus(ctx, s, metav1.UpdateOptions{FieldManager: operator.PrometheusOperatorFieldManager})
+			switch {
+			case apierrors.IsNotFound(err):
+				return nil
+			case apierrors.IsConflict(err):
+				// Refresh the object before retrying.
+				if latest, getErr := c.mclient.MonitoringV1alpha1().AlertmanagerSilences(s.Namespace).Get(ctx, s.Name, metav1.GetOptions{}); getErr == nil {
+					s = latest
+				}
+			}
+
+			return err
+		})
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to update the status of AlertmanagerSilence %s/%s: %w", s.Namespace, s.Name, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// mergeSilenceConditions sets the last transition time of the new conditions,
+// preserving the existing one when the status hasn't changed.
+func mergeSilenceConditions(existing, conditions []monitoringv1.ConfigResourceCondition) []monitoringv1.ConfigResourceCondition {
+	now := metav1.Now()
+
+	res := make([]monitoringv1.ConfigResourceCondition, 0, len(conditions))
+	for _, cond := range conditions {
+		cond.LastTransitionTime = now
+		for _, ec := range existing {
+			if ec.Type == cond.Type && ec.Status == cond.Status {
+				cond.LastTransitionTime = ec.LastTransitionTime
+			}
+		}
+
+		res = append(res, cond)
+	}
+
+	return res
+}
@@ -0,0 +1,254 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alertmanager
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/prometheus/alertmanager/api/v2/models"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	monitoringfake "github.com/prometheus-operator/prometheus-operator/pkg/client/versioned/fake"
+)
+
+type fakeSilenceClient struct {
+	silences map[string]*models.GettableSilence
+	nextID   int
+
+	posted  []string
+	expired []string
+}
+
+func (f *fakeSilenceClient) list(_ context.Context, _ *monitoringv1.Alertmanager) (models.GettableSilences, error) {
+	var res models.GettableSilences
+	for _, s := range f.silences {
+		res = append(res, s)
+	}
+
+	return res, nil
+}
+
+func (f *fakeSilenceClient) post(_ context.Context, _ *monitoringv1.Alertmanager, s *models.PostableSilence) (string, error) {
+	id := s.ID
+	if id == "" {
+		f.nextID++
+		id = fmt.Sprintf("id-%d", f.nextID)
+	}
+
+	f.silences[id] = &models.GettableSilence{
+		ID:      ptr.To(id),
+		Status:  &models.SilenceStatus{State: ptr.To(models.SilenceStatusStateActive)},
+		Silence: s.Silence,
+	}
+	f.posted = append(f.posted, id)
+
+	return id, nil
+}
+
+func (f *fakeSilenceClient) expire(_ context.Context, _ *monitoringv1.Alertmanager, id string) error {
+	f.silences[id].Status.State = ptr.To(models.SilenceStatusStateExpired)
+	f.expired = append(f.expired, id)
+
+	return nil
+}
+
+func newTestSilence(endsAt time.Time) *monitoringv1alpha1.AlertmanagerSilence {
+	return &monitoringv1alpha1.AlertmanagerSilence{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "maintenance",
+			Namespace:         "ns1",
+			Generation:        1,
+			CreationTimestamp: metav1.NewTime(time.Now().Add(-time.Hour)),
+		},
+		Spec: monitoringv1alpha1.AlertmanagerSilenceSpec{
+			Matchers: []monitoringv1alpha1.Matcher{
+				{Name: "job", Value: "db"},
+				{Name: "instance", Value: "db-0|db-1", Regex: true},
+				{Name: "severity", Value: "critical", MatchType: monitoringv1alpha1.MatchNotEqual},
+			},
+			EndsAt:  metav1.NewTime(endsAt),
+			Comment: "database upgrade",
+		},
+	}
+}
+
+func TestMakeSilence(t *testing.T) {
+	s := newTestSilence(time.Now().Add(time.Hour))
+
+	ps, err := makeSilence(s, true)
+	require.NoError(t, err)
+	require.Equal(t, "prometheus-operator/ns1/maintenance", *ps.CreatedBy)
+	require.Equal(t, "database upgrade", *ps.Comment)
+	require.Equal(t, []string{
+		`instance=~"db-0|db-1"`,
+		`job="db"`,
+		`namespace="ns1"`,
+		`severity!="critical"`,
+	}, matchersToStrings(ps.Matchers))
+
+	ps, err = makeSilence(s, false)
+	require.NoError(t, err)
+	require.Len(t, ps.Matchers, 3)
+
+	s.Spec.EndsAt = metav1.NewTime(s.CreationTimestamp.Add(-time.Minute))
+	_, err = makeSilence(s, true)
+	require.Error(t, err)
+
+	s = newTestSilence(time.Now().Add(time.Hour))
+	s.Spec.Matchers[0].MatchType = "~"
+	_, err = makeSilence(s, true)
+	require.Error(t, err)
+}
+
+func TestSilenceUpToDate(t *testing.T) {
+	now := time.Now()
+	s := newTestSilence(now.Add(time.Hour))
+
+	desired, err := makeSilence(s, true)
+	require.NoError(t, err)
+
+	existing := &models.GettableSilence{
+		ID:      ptr.To("id"),
+		Silence: desired.Silence,
+	}
+	// Alertmanager resets the start time to the current time.
+	existing.StartsAt = ptr.To(strfmt.DateTime(now))
+	require.True(t, silenceUpToDate(existing, desired, now))
+
+	s.Spec.Comment = "database upgrade (extended)"
+	updated, err := makeSilence(s, true)
+	require.NoError(t, err)
+	require.False(t, silenceUpToDate(existing, updated, now))
+
+	s = newTestSilence(now.Add(2 * time.Hour))
+	updated, err = makeSilence(s, true)
+	require.NoError(t, err)
+	require.False(t, silenceUpToDate(existing, updated, now))
+
+	s = newTestSilence(now.Add(time.Hour))
+	s.Spec.Matchers = s.Spec.Matchers[1:]
+	updated, err = makeSilence(s, true)
+	require.NoError(t, err)
+	require.False(t, silenceUpToDate(existing, updated, now))
+
+	s = newTestSilence(now.Add(time.Hour))
+	s.Spec.StartsAt = ptr.To(metav1.NewTime(now.Add(30 * time.Minute)))
+	updated, err = makeSilence(s, true)
+	require.NoError(t, err)
+	require.False(t, silenceUpToDate(existing, updated, now))
+}
+
+func TestSyncSilence(t *testing.T) {
+	am := &monitoringv1.Alertmanager{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "main",
+			Namespace: "monitoring",
+		},
+	}
+
+	fsc := &fakeSilenceClient{silences: map[string]*models.GettableSilence{}}
+	o := &Operator{
+		logger:        slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelInfo})),
+		silenceClient: fsc,
+	}
+
+	existingFor := func(s *monitoringv1alpha1.AlertmanagerSilence) []*models.GettableSilence {
+		var res []*models.GettableSilence
+		for _, es := range fsc.silences {
+			if *es.CreatedBy == silenceCreatedBy(s) && *es.Status.State != models.SilenceStatusStateExpired {
+				res = append(res, es)
+			}
+		}
+
+		return res
+	}
+
+	// Create the silence.
+	s := newTestSilence(time.Now().Add(time.Hour))
+	binding, err := o.syncSilence(context.Background(), am, s, existingFor(s), true)
+	require.NoError(t, err)
+	require.Equal(t, "id-1", binding.SilenceID)
+	require.Equal(t, models.SilenceStatusStateActive, binding.State)
+	require.Equal(t, monitoringv1.ConditionTrue, binding.Conditions[0].Status)
+	require.Equal(t, []string{"id-1"}, fsc.posted)
+
+	// No change.
+	binding, err = o.syncSilence(context.Background(), am, s, existingFor(s), true)
+	require.NoError(t, err)
+	require.Equal(t, "id-1", binding.SilenceID)
+	require.Len(t, fsc.posted, 1)
+
+	// Update the silence.
+	s.Spec.Comment = "database upgrade (extended)"
+	binding, err = o.syncSilence(context.Background(), am, s, existingFor(s), true)
+	require.NoError(t, err)
+	require.Equal(t, "id-1", binding.SilenceID)
+	require.Equal(t, []string{"id-1", "id-1"}, fsc.posted)
+
+	// Invalid silence: the existing silence is left untouched.
+	s.Spec.Matchers[0].MatchType = "~"
+	binding, err = o.syncSilence(context.Background(), am, s, existingFor(s), true)
+	require.Error(t, err)
+	require.Equal(t, monitoringv1.ConditionFalse, binding.Conditions[0].Status)
+	require.Len(t, fsc.posted, 2)
+	require.Empty(t, fsc.expired)
+
+	// Past end time: the silence is expired.
+	s = newTestSilence(time.Now().Add(-time.Minute))
+	binding, err = o.syncSilence(context.Background(), am, s, existingFor(s), true)
+	require.NoError(t, err)
+	require.Equal(t, silenceStateExpired, binding.State)
+	require.Equal(t, []string{"id-1"}, fsc.expired)
+}
+
+func TestUpdateSilenceBinding(t *testing.T) {
+	am := &monitoringv1.Alertmanager{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "main",
+			Namespace: "monitoring",
+		},
+	}
+	s := newTestSilence(time.Now().Add(time.Hour))
+
+	mclient := monitoringfake.NewSimpleClientset(s)
+	o := &Operator{mclient: mclient}
+
+	binding := monitoringv1alpha1.AlertmanagerSilenceBinding{
+		SilenceID: "id-1",
+		State:     models.SilenceStatusStateActive,
+		Conditions: []monitoringv1.ConfigResourceCondition{
+			{Type: monitoringv1.Accepted, Status: monitoringv1.ConditionTrue, ObservedGeneration: 1},
+		},
+	}
+	require.NoError(t, o.updateSilenceBinding(context.Background(), am, s, binding))
+
+	got, err := mclient.MonitoringV1alpha1().AlertmanagerSilences(s.Namespace).Get(context.Background(), s.Name, metav1.GetOptions{})
+	require.NoError(t, err)
+	require.Len(t, got.Status.Alertmanagers, 1)
+	require.Equal(t, "main", got.Status.Alertmanagers[0].Name)
+	require.Equal(t, "monitoring", got.Status.Alertmanagers[0].Namespace)
+	require.Equal(t, "id-1", got.Status.Alertmanagers[0].SilenceID)
+	require.False(t, got.Status.Alertmanagers[0].Conditions[0].LastTransitionTime.IsZero())
+}
@@ -0,0 +1,187 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alertmanager
+
+import (
+	"fmt"
+	"path"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"text/template/parse"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+)
+
+// templateActionRe matches the actions referencing a named template such as
+// `{{ define "name" }}` or `{{- template "name" . }}`.
+var templateActionRe = regexp.MustCompile(`(\{\{-?\s*(?:define|template|block)\s+)"((?:[^"\\]|\\.)*)"`)
+
+// templateRenamer prefixes the names of the templates defined by an
+// AlertmanagerConfig (or ClusterAlertmanagerConfig) resource in the template
+// actions referencing them.
+type templateRenamer struct {
+	crKey types.NamespacedName
+	names map[string]struct{}
+}
+
+// rename returns the text with the references to the resource's templates
+// prefixed by the resource's namespace and name. The references to other
+// templates (e.g. the default Alertmanager templates) are left untouched.
+func (tr *templateRenamer) rename(text string) string {
+	if len(tr.names) == 0 || !strings.Contains(text, "{{") {
+		return text
+	}
+
+	return templateActionRe.ReplaceAllStringFunc(text, func(action string) string {
+		m := templateActionRe.FindStringSubmatch(action)
+
+		name, err := strconv.Unquote(`"` + m[2] + `"`)
+		if err != nil {
+			return action
+		}
+
+		if _, found := tr.names[name]; !found {
+			return action
+		}
+
+		return m[1] + strconv.Quote(makeNamespacedString(name, tr.crKey))
+	})
+}
+
+// renameReceiver returns a copy of the receiver where all the references to
+// the resource's templates are renamed.
+func (tr *templateRenamer) renameReceiver(in *monitoringv1alpha1.Receiver) *monitoringv1alpha1.Receiver {
+	out := in.DeepCopy()
+	if len(tr.names) > 0 {
+		tr.renameValue(reflect.ValueOf(out).Elem())
+	}
+
+	return out
+}
+
+func (tr *templateRenamer) renameValue(v reflect.Value) {
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if !v.IsNil() {
+			tr.renameValue(v.Elem())
+		}
+
+	case reflect.Struct:
+		for i := range v.NumField() {
+			tr.renameValue(v.Field(i))
+		}
+
+	case reflect.Slice, reflect.Array:
+		for i := range v.Len() {
+			tr.renameValue(v.Index(i))
+		}
+
+	case reflect.Map:
+		if v.Type().Elem().Kind() != reflect.String {
+			return
+		}
+
+		iter := v.MapRange()
+		for iter.Next() {
+			s := iter.Value().String()
+			if renamed := tr.rename(s); renamed != s {
+				v.SetMapIndex(iter.Key(), reflect.ValueOf(renamed).Convert(v.Type().Elem()))
+			}
+		}
+
+	case reflect.String:
+		if v.CanSet() {
+			v.SetString(tr.rename(v.String()))
+		}
+	}
+}
+
+// definedTemplateNames returns the names of the templates defined in the
+// text.
+func definedTemplateNames(text string) ([]string, error) {
+	treeSet := map[string]*parse.Tree{}
+
+	t := parse.New("")
+	t.Mode = parse.SkipFuncCheck
+	if _, err := t.Parse(text, "", "", treeSet); err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(treeSet))
+	for name := range treeSet {
+		if name == "" {
+			continue
+		}
+		names = append(names, name)
+	}
+
+	return names, nil
+}
+
+// templateFileName returns the key of the generated configuration secret
+// holding the given template. Kubernetes object names can't contain
+// underscores which guarantees that the keys are unique.
+func templateFileName(crKey types.NamespacedName, name string) string {
+	if crKey.Namespace == "" {
+		return fmt.Sprintf("clusteralertmanagerconfig_%s_%s.tmpl", crKey.Name, name)
+	}
+
+	return fmt.Sprintf("alertmanagerconfig_%s_%s_%s.tmpl", crKey.Namespace, crKey.Name, name)
+}
+
+// convertTemplates stores the templates of the resource identified by crKey
+// and returns the paths of the template files (to be added to the
+// configuration) as well as the renamer to apply to the resource's receivers.
+func (cb *ConfigBuilder) convertTemplates(templates []monitoringv1alpha1.NotificationTemplate, crKey types.NamespacedName) ([]string, *templateRenamer, error) {
+	tr := &templateRenamer{
+		crKey: crKey,
+		names: map[string]struct{}{},
+	}
+
+	for _, t := range templates {
+		names, err := definedTemplateNames(t.Content)
+		if err != nil {
+			return nil, nil, fmt.Errorf("template %q: %w", t.Name, err)
+		}
+
+		for _, name := range names {
+			tr.names[name] = struct{}{}
+		}
+	}
+
+	paths := make([]string, 0, len(templates))
+	for _, t := range templates {
+		if cb.templateFiles == nil {
+			cb.templateFiles = map[string][]byte{}
+		}
+
+		fileName := templateFileName(crKey, t.Name)
+		cb.templateFiles[fileName] = []byte(tr.rename(t.Content))
+		paths = append(paths, path.Join(alertmanagerConfigDir, fileName))
+	}
+
+	return paths, tr, nil
+}
+
+// TemplateFiles returns the template files of the AlertmanagerConfig
+// resources added to the configuration. The keys are the file names relative
+// to the directory of the configuration file.
+func (cb *ConfigBuilder) TemplateFiles() map[string][]byte {
+	return cb.templateFiles
+}
@@ -68,6 +68,10 @@ type globalConfig struct {
 	VictorOpsAPIKeyFile  string          `yaml:"victorops_api_key_file,omitempty" json:"victorops_api_key_file,omitempty"`
 	TelegramAPIURL       *config.URL     `yaml:"telegram_api_url,omitempty" json:"telegram_api_url,omitempty"`
 	WebexAPIURL          *config.URL     `yaml:"webex_api_url,omitempty" json:"webex_api_url,omitempty"`
+	JiraAPIURL           *config.URL     `yaml:"jira_api_url,omitempty" json:"jira_api_url,omitempty"`
+	RocketChatAPIURL     *config.URL     `yaml:"rocketchat_api_url,omitempty" json:"rocketchat_api_url,omitempty"`
+	RocketChatToken      string          `yaml:"rocketchat_token,omitempty" json:"rocketchat_token,omitempty"`
+	RocketChatTokenID    string          `yaml:"rocketchat_token_id,omitempty" json:"rocketchat_token_id,omitempty"`
 }
 
 type route struct {
@@ -423,6 +427,7 @@ type msTeamsV2Config struct {
 }
 
 type jiraConfig struct {
+	VSendResolved     *bool             `yaml:"send_resolved,omitempty"`
 	HTTPConfig        *httpClientConfig `yaml:"http_config,omitempty"`
 	APIURL            string            `yaml:"api_url,omitempty"`
 	Project           string            `yaml:"project,omitempty"`
@@ -444,25 +449,24 @@ type rocketchatAttachmentField struct {
 	Value string `yaml:"value,omitempty"`
 }
 
+// rocketchatAttachmentAction only supports the fields which can be
+// unmarshaled by Alertmanager: the upstream struct has no YAML tags and the
+// multi-word fields can't be expressed in the configuration file.
 type rocketchatAttachmentAction struct {
-	Type               string `yaml:"type,omitempty"`
-	Text               string `yaml:"text,omitempty"`
-	URL                string `yaml:"url,omitempty"`
-	ImageURL           string `yaml:"image_url,omitempty"`
-	IsWebView          bool   `yaml:"is_webview"`
-	WebviewHeightRatio string `yaml:"webview_height_ratio,omitempty"`
-	Msg                string `yaml:"msg,omitempty"`
-	MsgInChatWindow    bool   `yaml:"msg_in_chat_window"`
-	MsgProcessingType  string `yaml:"msg_processing_type,omitempty"`
+	Type string `yaml:"type,omitempty"`
+	Text string `yaml:"text,omitempty"`
+	URL  string `yaml:"url,omitempty"`
+	Msg  string `yaml:"msg,omitempty"`
 }
 
 type rocketChatConfig struct {
-	HTTPConfig  *httpClientConfig `yaml:"http_config,omitempty"`
-	APIURL      string            `yaml:"api_url,omitempty"`
-	TokenID     *string           `yaml:"token_id,omitempty"`
-	TokenIDFile string            `yaml:"token_id_file,omitempty"`
-	Token       *string           `yaml:"token,omitempty"`
-	TokenFile   string            `yaml:"token_file,omitempty"`
+	VSendResolved *bool             `yaml:"send_resolved,omitempty"`
+	HTTPConfig    *httpClientConfig `yaml:"http_config,omitempty"`
+	APIURL        string            `yaml:"api_url,omitempty"`
+	TokenID       *string           `yaml:"token_id,omitempty"`
+	TokenIDFile   string            `yaml:"token_id_file,omitempty"`
+	Token         *string           `yaml:"token,omitempty"`
+	TokenFile     string            `yaml:"token_file,omitempty"`
 	// RocketChat channel override, (like #other-channel or @username).
 	Channel     string                        `yaml:"channel,omitempty"`
 	Color       string                        `yaml:"color,omitempty"`
@@ -42,6 +42,10 @@ func ValidateAlertmanagerConfig(amc *monitoringv1alpha1.AlertmanagerConfig) erro
 		return err
 	}
 
+	if err := validateTemplates(amc.Spec.Templates); err != nil {
+		return err
+	}
+
 	return validateRoute(amc.Spec.Route, receivers, muteTimeIntervals, true)
 }
 
@@ -106,6 +110,14 @@ func validateReceivers(receivers []monitoringv1alpha1.Receiver) (map[string]stru
 		if err := validateMSTeamsConfigs(receiver.MSTeamsConfigs); err != nil {
 			return nil, fmt.Errorf("failed to validate 'msteamsConfig' - receiver %s: %w", receiver.Name, err)
 		}
+
+		if err := validateJiraConfigs(receiver.JiraConfigs); err != nil {
+			return nil, fmt.Errorf("failed to validate 'jiraConfig' - receiver %s: %w", receiver.Name, err)
+		}
+
+		if err := validateRocketChatConfigs(receiver.RocketChatConfigs); err != nil {
+			return nil, fmt.Errorf("failed to validate 'rocketchatConfig' - receiver %s: %w", receiver.Name, err)
+		}
 	}
 
 	return receiverNames, nil
@@ -345,6 +357,46 @@ func validateMSTeamsConfigs(configs []monitoringv1alpha1.MSTeamsConfig) error {
 	return nil
 }
 
+func validateJiraConfigs(configs []monitoringv1alpha1.JiraConfig) error {
+	for _, config := range configs {
+		if config.Project == "" {
+			return fmt.Errorf("mandatory field %q is empty", "project")
+		}
+
+		if config.IssueType == "" {
+			return fmt.Errorf("mandatory field %q is empty", "issueType")
+		}
+
+		if config.APIURL != nil && *config.APIURL != "" {
+			if _, err := validation.ValidateURL(string(*config.APIURL)); err != nil {
+				return fmt.Errorf("invalid 'apiURL': %w", err)
+			}
+		}
+
+		if err := config.HTTPConfig.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validateRocketChatConfigs(configs []monitoringv1alpha1.RocketChatConfig) error {
+	for _, config := range configs {
+		if config.APIURL != nil && *config.APIURL != "" {
+			if _, err := validation.ValidateURL(string(*config.APIURL)); err != nil {
+				return fmt.Errorf("invalid 'apiURL': %w", err)
+			}
+		}
+
+		if err := config.HTTPConfig.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // validateRoute verifies that the given route and all its children are
 // semantically valid.  because of the self-referential issues mentioned in
 // https://github.com/kubernetes/kubernetes/issues/62872 it is not currently
@@ -433,3 +485,20 @@ func validateMuteTimeIntervals(muteTimeIntervals []monitoringv1alpha1.MuteTimeIn
 	}
 	return muteTimeIntervalNames, nil
 }
+
+func validateTemplates(templates []monitoringv1alpha1.NotificationTemplate) error {
+	templateNames := make(map[string]struct{}, len(templates))
+
+	for _, t := range templates {
+		if _, found := templateNames[t.Name]; found {
+			return fmt.Errorf("%q template is not unique", t.Name)
+		}
+		templateNames[t.Name] = struct{}{}
+
+		if err := validation.ValidateTemplate(t.Content); err != nil {
+			return fmt.Errorf("failed to validate template %q: %w", t.Name, err)
+		}
+	}
+
+	return nil
+}
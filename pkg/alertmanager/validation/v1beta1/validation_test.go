@@ -530,6 +530,79 @@ func TestValidateAlertmanagerConfig(t *testing.T) {
 			},
 			expectErr: false,
 		},
+		{
+			name: "Test fail to validate on duplicate template",
+			in: &monitoringv1beta1.AlertmanagerConfig{
+				Spec: monitoringv1beta1.AlertmanagerConfigSpec{
+					Templates: []monitoringv1beta1.NotificationTemplate{
+						{
+							Name:    "slack",
+							Content: `{{ define "slack.title" }}{{ .CommonLabels.alertname }}{{ end }}`,
+						},
+						{
+							Name:    "slack",
+							Content: `{{ define "slack.text" }}{{ .CommonAnnotations.summary }}{{ end }}`,
+						},
+					},
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "Test fail to validate on invalid template",
+			in: &monitoringv1beta1.AlertmanagerConfig{
+				Spec: monitoringv1beta1.AlertmanagerConfigSpec{
+					Templates: []monitoringv1beta1.NotificationTemplate{
+						{
+							Name:    "slack",
+							Content: `{{ define "slack.title" }}{{ .CommonLabels.alertname }}`,
+						},
+					},
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "Test fail to validate on template with unknown function",
+			in: &monitoringv1beta1.AlertmanagerConfig{
+				Spec: monitoringv1beta1.AlertmanagerConfigSpec{
+					Templates: []monitoringv1beta1.NotificationTemplate{
+						{
+							Name:    "slack",
+							Content: `{{ define "slack.title" }}{{ .CommonLabels.alertname | unknown }}{{ end }}`,
+						},
+					},
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "Test happy path with templates",
+			in: &monitoringv1beta1.AlertmanagerConfig{
+				Spec: monitoringv1beta1.AlertmanagerConfigSpec{
+					Receivers: []monitoringv1beta1.Receiver{
+						{
+							Name: "slack",
+							SlackConfigs: []monitoringv1beta1.SlackConfig{
+								{
+									Title: `{{ template "slack.title" . }}`,
+								},
+							},
+						},
+					},
+					Route: &monitoringv1beta1.Route{
+						Receiver: "slack",
+					},
+					Templates: []monitoringv1beta1.NotificationTemplate{
+						{
+							Name:    "slack",
+							Content: `{{ define "slack.title" }}{{ .CommonLabels.alertname | toUpper }}{{ end }}`,
+						},
+					},
+				},
+			},
+			expectErr: false,
+		},
 	}
 
 	for _, tc := range testCases {
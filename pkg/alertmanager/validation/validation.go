@@ -17,8 +17,10 @@ package validation
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	"github.com/prometheus/alertmanager/config"
+	"github.com/prometheus/alertmanager/template"
 )
 
 // ValidateURL against the config.URL
@@ -47,3 +49,14 @@ func ValidateSecretURL(url string) error {
 
 	return nil
 }
+
+// ValidateTemplate checks that the given text can be parsed by the
+// Alertmanager template engine.
+func ValidateTemplate(text string) error {
+	tmpl, err := template.New()
+	if err != nil {
+		return err
+	}
+
+	return tmpl.Parse(strings.NewReader(text))
+}
@@ -102,3 +102,40 @@ func TestValidateSecretUrl(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateTemplate(t *testing.T) {
+	for _, tc := range []struct {
+		name      string
+		in        string
+		expectErr bool
+	}{
+		{
+			name: "valid template",
+			in:   `{{ define "title" }}[{{ .Status | toUpper }}] {{ .CommonLabels.alertname }}{{ end }}`,
+		},
+		{
+			name: "valid template referencing a default template",
+			in:   `{{ define "title" }}{{ template "__subject" . }}{{ end }}`,
+		},
+		{
+			name:      "missing end",
+			in:        `{{ define "title" }}{{ .CommonLabels.alertname }}`,
+			expectErr: true,
+		},
+		{
+			name:      "unknown function",
+			in:        `{{ define "title" }}{{ .CommonLabels.alertname | unknown }}{{ end }}`,
+			expectErr: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateTemplate(tc.in)
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
@@ -39,16 +39,36 @@ const (
 
 	ScrapeConfigsKind = "ScrapeConfig"
 	ScrapeConfigName  = "scrapeconfigs"
+
+	OTLPTenantsKind = "OTLPTenant"
+	OTLPTenantName  = "otlptenants"
+
+	RemoteWritesKind = "RemoteWrite"
+	RemoteWriteName  = "remotewrites"
+
+	ThanosQuerierKind = "ThanosQuerier"
+	ThanosQuerierName = "thanosqueriers"
+
+	ThanosStoreKind = "ThanosStore"
+	ThanosStoreName = "thanosstores"
+
+	ThanosCompactorKind = "ThanosCompactor"
+	ThanosCompactorName = "thanoscompactors"
 )
 
 var resourceToKindMap = map[string]string{
-	PrometheusName:     PrometheusesKind,
-	AlertmanagerName:   AlertmanagersKind,
-	ServiceMonitorName: ServiceMonitorsKind,
-	PodMonitorName:     PodMonitorsKind,
-	PrometheusRuleName: PrometheusRuleKind,
-	ProbeName:          ProbesKind,
-	ScrapeConfigName:   ScrapeConfigsKind,
+	PrometheusName:      PrometheusesKind,
+	AlertmanagerName:    AlertmanagersKind,
+	ServiceMonitorName:  ServiceMonitorsKind,
+	PodMonitorName:      PodMonitorsKind,
+	PrometheusRuleName:  PrometheusRuleKind,
+	ProbeName:           ProbesKind,
+	ScrapeConfigName:    ScrapeConfigsKind,
+	OTLPTenantName:      OTLPTenantsKind,
+	RemoteWriteName:     RemoteWritesKind,
+	ThanosQuerierName:   ThanosQuerierKind,
+	ThanosStoreName:     ThanosStoreKind,
+	ThanosCompactorName: ThanosCompactorKind,
 }
 
 func ResourceToKind(s string) string {
@@ -234,6 +234,19 @@ type AlertmanagerSpec struct {
 	PriorityClassName string `json:"priorityClassName,omitempty"`
 	// AdditionalPeers allows injecting a set of additional Alertmanagers to peer with to form a highly available cluster.
 	AdditionalPeers []string `json:"additionalPeers,omitempty"`
+	// PeerDiscovery defines how the operator discovers additional peers
+	// (e.g. Alertmanager instances running in other Kubernetes clusters) to
+	// form a highly available cluster. The discovered peers are added to
+	// `additionalPeers`.
+	//
+	// The peers are passed to Alertmanager as command-line arguments which
+	// can't be reloaded at runtime: any change of the discovered peers (e.g.
+	// a selected Service being added or removed, or a different DNS SRV
+	// answer) triggers a rolling restart of the Alertmanager pods. The list
+	// of peers is sorted and deduplicated so that a different order of the
+	// DNS answers alone doesn't restart the pods.
+	// +optional
+	PeerDiscovery *AlertmanagerPeerDiscovery `json:"peerDiscovery,omitempty"`
 	// ClusterAdvertiseAddress is the explicit address to advertise in cluster.
 	// Needs to be provided for non RFC1918 [1] (public) addresses.
 	// [1] RFC1918: https://tools.ietf.org/html/rfc1918
@@ -264,6 +277,27 @@ type AlertmanagerSpec struct {
 	// process incoming alerts.
 	AlertmanagerConfigMatcherStrategy AlertmanagerConfigMatcherStrategy `json:"alertmanagerConfigMatcherStrategy,omitempty"`
 
+	// ClusterAlertmanagerConfigs to be selected for to merge and configure
+	// Alertmanager with. Their routes are added before the routes of the
+	// AlertmanagerConfig resources and the operator doesn't enforce any
+	// `namespace` matcher on them.
+	//
+	// If nil, no ClusterAlertmanagerConfig is selected.
+	// +optional
+	ClusterAlertmanagerConfigSelector *metav1.LabelSelector `json:"clusterAlertmanagerConfigSelector,omitempty"`
+
+	// AlertmanagerSilence resources to be selected. The operator creates the
+	// corresponding silences through the Alertmanager API.
+	//
+	// If nil, no AlertmanagerSilence is selected and the operator doesn't
+	// manage any silence.
+	// +optional
+	SilenceSelector *metav1.LabelSelector `json:"silenceSelector,omitempty"`
+	// Namespaces to be selected for AlertmanagerSilence discovery. If nil,
+	// only check own namespace.
+	// +optional
+	SilenceNamespaceSelector *metav1.LabelSelector `json:"silenceNamespaceSelector,omitempty"`
+
 	// Minimum number of seconds for which a newly created pod should be ready
 	// without any of its container crashing for it to be considered available.
 	// Defaults to 0 (pod will be considered available as soon as it is ready)
@@ -390,6 +424,109 @@ type AlertmanagerGlobalConfig struct {
 
 	// The default Pagerduty URL.
 	PagerdutyURL *string `json:"pagerdutyUrl,omitempty"`
+
+	// The default configuration for Telegram.
+	// +optional
+	TelegramConfig *GlobalTelegramConfig `json:"telegram,omitempty"`
+
+	// The default configuration for Webex.
+	// +optional
+	WebexConfig *GlobalWebexConfig `json:"webex,omitempty"`
+
+	// The default configuration for VictorOps.
+	// +optional
+	VictorOpsConfig *GlobalVictorOpsConfig `json:"victorops,omitempty"`
+
+	// The default configuration for WeChat.
+	// +optional
+	WeChatConfig *GlobalWeChatConfig `json:"wechat,omitempty"`
+
+	// The default configuration for Jira.
+	// +optional
+	JiraConfig *GlobalJiraConfig `json:"jira,omitempty"`
+
+	// The default configuration for Rocket.Chat.
+	// +optional
+	RocketChatConfig *GlobalRocketChatConfig `json:"rocketChat,omitempty"`
+}
+
+// URL represents a valid URL.
+// +kubebuilder:validation:Pattern=`^https?://.+$`
+type URL string
+
+// GlobalTelegramConfig configures global Telegram parameters.
+type GlobalTelegramConfig struct {
+	// The default Telegram API URL.
+	//
+	// It requires Alertmanager >= v0.24.0.
+	// +optional
+	APIURL *URL `json:"apiURL,omitempty"`
+}
+
+// GlobalWebexConfig configures global Webex parameters.
+type GlobalWebexConfig struct {
+	// The default Webex API URL.
+	//
+	// It requires Alertmanager >= v0.25.0.
+	// +optional
+	APIURL *URL `json:"apiURL,omitempty"`
+}
+
+// GlobalVictorOpsConfig configures global VictorOps parameters.
+type GlobalVictorOpsConfig struct {
+	// The default VictorOps API URL.
+	// +optional
+	APIURL *URL `json:"apiURL,omitempty"`
+
+	// The default VictorOps API Key.
+	// +optional
+	APIKey *v1.SecretKeySelector `json:"apiKey,omitempty"`
+}
+
+// GlobalWeChatConfig configures global WeChat parameters.
+type GlobalWeChatConfig struct {
+	// The default WeChat API URL.
+	// +optional
+	APIURL *URL `json:"apiURL,omitempty"`
+
+	// The default WeChat API Secret.
+	// +optional
+	APISecret *v1.SecretKeySelector `json:"apiSecret,omitempty"`
+
+	// The default WeChat API Corporate ID.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	APICorpID *string `json:"apiCorpID,omitempty"`
+}
+
+// GlobalJiraConfig configures global Jira parameters.
+type GlobalJiraConfig struct {
+	// The default Jira API URL.
+	//
+	// It requires Alertmanager >= v0.28.0.
+	// +optional
+	APIURL *URL `json:"apiURL,omitempty"`
+}
+
+// GlobalRocketChatConfig configures global Rocket.Chat parameters.
+type GlobalRocketChatConfig struct {
+	// The default Rocket.Chat API URL.
+	//
+	// It requires Alertmanager >= v0.28.0.
+	// +optional
+	APIURL *URL `json:"apiURL,omitempty"`
+
+	// The default Rocket.Chat token.
+	//
+	// It requires Alertmanager >= v0.28.0.
+	// +optional
+	Token *v1.SecretKeySelector `json:"token,omitempty"`
+
+	// The default Rocket.Chat token ID.
+	//
+	// It requires Alertmanager >= v0.28.0.
+	// +optional
+	TokenID *v1.SecretKeySelector `json:"tokenID,omitempty"`
 }
 
 // AlertmanagerStatus is the most recent observed status of the Alertmanager cluster. Read-only.
@@ -418,6 +555,47 @@ type AlertmanagerStatus struct {
 	// +listMapKey=type
 	// +optional
 	Conditions []Condition `json:"conditions,omitempty"`
+	// The number of members in the Alertmanager gossip cluster (including
+	// the external peers) as reported by the Alertmanager API.
+	// It isn't set when the cluster mode is disabled or when the operator
+	// can't reach the Alertmanager API.
+	// +optional
+	ClusterSize *int32 `json:"clusterSize,omitempty"`
+}
+
+// AlertmanagerPeerDiscovery defines how to discover additional Alertmanager
+// peers.
+// +k8s:openapi-gen=true
+type AlertmanagerPeerDiscovery struct {
+	// Services to be selected as Alertmanager peers. For each selected
+	// Service, the operator adds the `<service>.<namespace>.svc:<port>` peer.
+	//
+	// The Services should be headless so that Alertmanager resolves their
+	// DNS name to the addresses of all the endpoints (for instance
+	// Services without selector backed by EndpointSlices which point to the
+	// Alertmanager instances running in another cluster).
+	//
+	// The operator needs the permissions to list and watch Services.
+	// +optional
+	ServiceSelector *metav1.LabelSelector `json:"serviceSelector,omitempty"`
+	// Namespaces to be selected for Service discovery. If nil, only check
+	// the Alertmanager's namespace.
+	// +optional
+	ServiceNamespaceSelector *metav1.LabelSelector `json:"serviceNamespaceSelector,omitempty"`
+	// Name of the Service port used for the cluster communication. Services
+	// without such port are ignored.
+	// Defaults to `tcp-mesh`.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	ServicePort *string `json:"servicePort,omitempty"`
+	// DNS SRV records (e.g. `_mesh._tcp.alertmanager.example.com`) resolved
+	// by the operator. The targets of the records are added as peers.
+	//
+	// The records are resolved at every reconciliation of the Alertmanager
+	// resource and at least every minute.
+	// +listType=set
+	// +optional
+	DNSSRVRecords []string `json:"dnsSRVRecords,omitempty"`
 }
 
 func (a *Alertmanager) ExpectedReplicas() int {
@@ -30,6 +30,7 @@ const (
 // +genclient
 // +k8s:openapi-gen=true
 // +kubebuilder:resource:categories="prometheus-operator",shortName="pmon"
+// +kubebuilder:subresource:status
 
 // The `PodMonitor` custom resource definition (CRD) defines how `Prometheus` and `PrometheusAgent` can scrape metrics from a group of pods.
 // Among other things, it allows to specify:
@@ -44,6 +45,11 @@ type PodMonitor struct {
 	metav1.ObjectMeta `json:"metadata,omitempty"`
 	// Specification of desired Pod selection for target discovery by Prometheus.
 	Spec PodMonitorSpec `json:"spec"`
+	// The status of the PodMonitor resource.
+	// It is only populated when the `StatusForConfigurationResources`
+	// feature gate is enabled.
+	// +optional
+	Status ConfigResourceStatus `json:"status,omitempty"`
 }
 
 // DeepCopyObject implements the runtime.Object interface.
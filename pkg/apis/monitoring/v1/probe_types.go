@@ -16,6 +16,7 @@ package v1
 
 import (
 	v1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
@@ -29,11 +30,12 @@ const (
 // +genclient
 // +k8s:openapi-gen=true
 // +kubebuilder:resource:categories="prometheus-operator",shortName="prb"
+// +kubebuilder:subresource:status
 
 // The `Probe` custom resource definition (CRD) defines how to scrape metrics from prober exporters such as the [blackbox exporter](https://github.com/prometheus/blackbox_exporter).
 //
 // The `Probe` resource needs 2 pieces of information:
-// * The list of probed addresses which can be defined statically or by discovering Kubernetes Ingress objects.
+// * The list of probed addresses which can be defined statically or by discovering Kubernetes Ingress, Service or Gateway API HTTPRoute objects.
 // * The prober which exposes the availability of probed endpoints (over various protocols such HTTP, TCP, ICMP, ...) as Prometheus metrics.
 //
 // `Prometheus` and `PrometheusAgent` objects select `Probe` objects using label and namespace selectors.
@@ -42,6 +44,11 @@ type Probe struct {
 	metav1.ObjectMeta `json:"metadata,omitempty"`
 	// Specification of desired Ingress selection for target discovery by Prometheus.
 	Spec ProbeSpec `json:"spec"`
+	// The status of the Probe resource.
+	// It is only populated when the `StatusForConfigurationResources`
+	// feature gate is enabled.
+	// +optional
+	Status ConfigResourceStatus `json:"status,omitempty"`
 }
 
 // DeepCopyObject implements the runtime.Object interface.
@@ -55,12 +62,28 @@ type ProbeSpec struct {
 	// The job name assigned to scraped metrics by default.
 	JobName string `json:"jobName,omitempty"`
 	// Specification for the prober to use for probing targets.
-	// The prober.URL parameter is required. Targets cannot be probed if left empty.
+	// The prober.URL parameter is required unless the Prometheus object
+	// selecting the probe defines a managed prober (`spec.managedProber`).
+	// Targets cannot be probed if left empty.
 	ProberSpec ProberSpec `json:"prober,omitempty"`
 	// The module to use for probing specifying how to probe the target.
 	// Example module configuring in the blackbox exporter:
 	// https://github.com/prometheus/blackbox_exporter/blob/master/example.yml
+	//
+	// When the targets are probed by the managed prober, the value can
+	// reference one of the modules defined in `modules`.
 	Module string `json:"module,omitempty"`
+	// Modules defines the blackbox exporter modules loaded by the prober
+	// managed by the operator (see `spec.managedProber` in the Prometheus
+	// CRD).
+	//
+	// The modules are only available to the Probe defining them. They are
+	// ignored when `prober.url` isn't empty.
+	//
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	Modules []ProbeModule `json:"modules,omitempty"`
 	// Targets defines a set of static or dynamically discovered targets to probe.
 	Targets ProbeTargets `json:"targets,omitempty"`
 	// Interval at which targets are probed using the configured prober.
@@ -135,8 +158,11 @@ type ProbeSpec struct {
 }
 
 // ProbeTargets defines how to discover the probed targets.
-// One of the `staticConfig` or `ingress` must be defined.
-// If both are defined, `staticConfig` takes precedence.
+// One of the `staticConfig`, `ingress`, `service` or `httpRoute` must be
+// defined.
+// If both `staticConfig` and `ingress` are defined, `staticConfig` takes
+// precedence. `service` and `httpRoute` can't be combined with other target
+// types.
 // +k8s:openapi-gen=true
 type ProbeTargets struct {
 	// staticConfig defines the static list of targets to probe and the
@@ -148,12 +174,34 @@ type ProbeTargets struct {
 	// configuration.
 	// If `staticConfig` is also defined, `staticConfig` takes precedence.
 	Ingress *ProbeTargetIngress `json:"ingress,omitempty"`
+	// service defines the Service objects to probe and the relabeling
+	// configuration.
+	// It can't be combined with the other target types.
+	// +optional
+	Service *ProbeTargetService `json:"service,omitempty"`
+	// httpRoute defines the Gateway API HTTPRoute objects to probe and the
+	// relabeling configuration.
+	// It can't be combined with the other target types.
+	//
+	// It requires the `gateway.networking.k8s.io/v1` API to be installed
+	// and the operator to be granted the permissions to list and watch the
+	// HTTPRoute objects.
+	// +optional
+	HTTPRoute *ProbeTargetHTTPRoute `json:"httpRoute,omitempty"`
 }
 
 // Validate semantically validates the given ProbeTargets.
 func (it *ProbeTargets) Validate() error {
-	if it.StaticConfig == nil && it.Ingress == nil {
-		return &ProbeTargetsValidationError{"at least one of .spec.targets.staticConfig and .spec.targets.ingress is required"}
+	if it.StaticConfig == nil && it.Ingress == nil && it.Service == nil && it.HTTPRoute == nil {
+		return &ProbeTargetsValidationError{"at least one of .spec.targets.staticConfig, .spec.targets.ingress, .spec.targets.service and .spec.targets.httpRoute is required"}
+	}
+
+	if it.Service != nil && (it.StaticConfig != nil || it.Ingress != nil || it.HTTPRoute != nil) {
+		return &ProbeTargetsValidationError{".spec.targets.service can't be combined with other target types"}
+	}
+
+	if it.HTTPRoute != nil && (it.StaticConfig != nil || it.Ingress != nil) {
+		return &ProbeTargetsValidationError{".spec.targets.httpRoute can't be combined with other target types"}
 	}
 
 	return nil
@@ -201,6 +249,78 @@ type ProbeTargetIngress struct {
 	RelabelConfigs []RelabelConfig `json:"relabelingConfigs,omitempty"`
 }
 
+// ProbeTargetService defines the set of Service objects considered for probing.
+// The operator configures a target for each port of each service object.
+// +k8s:openapi-gen=true
+type ProbeTargetService struct {
+	// Selector to select the Service objects.
+	Selector metav1.LabelSelector `json:"selector,omitempty"`
+	// From which namespaces to select Service objects.
+	NamespaceSelector NamespaceSelector `json:"namespaceSelector,omitempty"`
+	// Name of the Service port to probe.
+	// If empty, all the ports of the selected services are probed.
+	// +optional
+	Port *string `json:"port,omitempty"`
+	// RelabelConfigs to apply to the label set of the target before it gets
+	// scraped.
+	// The original service address (`<service>.<namespace>.svc:<port>`) is
+	// available via the `__tmp_prometheus_service_address` label. It can be
+	// used to customize the probed URL.
+	// The original scrape job's name is available via the `__tmp_prometheus_job_name` label.
+	// More info: https://prometheus.io/docs/prometheus/latest/configuration/configuration/#relabel_config
+	RelabelConfigs []RelabelConfig `json:"relabelingConfigs,omitempty"`
+}
+
+// ProbeTargetHTTPRoute defines the set of Gateway API HTTPRoute objects
+// considered for probing.
+// The operator configures a target for each hostname/path combination of
+// each HTTPRoute object. The paths are the values of the `PathPrefix` and
+// `Exact` path matches of the route rules (`/` if a rule has no path
+// match). HTTPRoute objects without hostnames are ignored.
+// +k8s:openapi-gen=true
+type ProbeTargetHTTPRoute struct {
+	// Selector to select the HTTPRoute objects.
+	Selector metav1.LabelSelector `json:"selector,omitempty"`
+	// From which namespaces to select HTTPRoute objects.
+	NamespaceSelector NamespaceSelector `json:"namespaceSelector,omitempty"`
+	// Scheme of the probed URLs.
+	// If empty, the operator uses `http`.
+	// +kubebuilder:validation:Enum=http;https
+	// +optional
+	Scheme *string `json:"scheme,omitempty"`
+	// RelabelConfigs to apply to the label set of the target before it gets
+	// scraped.
+	// The original HTTPRoute URL is available via the
+	// `__tmp_prometheus_httproute_url` label. It can be used to customize the
+	// probed URL.
+	// The original scrape job's name is available via the `__tmp_prometheus_job_name` label.
+	// More info: https://prometheus.io/docs/prometheus/latest/configuration/configuration/#relabel_config
+	RelabelConfigs []RelabelConfig `json:"relabelingConfigs,omitempty"`
+}
+
+// ProbeModule defines a blackbox exporter module.
+// +k8s:openapi-gen=true
+type ProbeModule struct {
+	// Name of the module.
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	Name string `json:"name"`
+	// Prober used by the module.
+	// +kubebuilder:validation:Enum=http;tcp;icmp;dns;grpc
+	// +required
+	Prober string `json:"prober"`
+	// Timeout of the probe.
+	// If not defined, the blackbox exporter derives it from the scrape timeout.
+	// +optional
+	Timeout *Duration `json:"timeout,omitempty"`
+	// Settings of the prober as defined by the blackbox exporter
+	// configuration: it is copied verbatim into the `http`, `tcp`, `icmp`,
+	// `dns` or `grpc` section of the module, depending on `prober`.
+	// More info: https://github.com/prometheus/blackbox_exporter/blob/master/CONFIGURATION.md
+	// +optional
+	Config *apiextensionsv1.JSON `json:"config,omitempty"`
+}
+
 // ProberSpec contains specification parameters for the Prober used for probing.
 // +k8s:openapi-gen=true
 type ProberSpec struct {
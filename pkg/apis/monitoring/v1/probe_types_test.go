@@ -59,6 +59,50 @@ func TestValidateProbeTargets(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "probe with service target",
+			probeTargets: ProbeTargets{
+				Service: &ProbeTargetService{
+					Selector: metav1.LabelSelector{
+						MatchLabels: map[string]string{
+							"app": "foo",
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "probe with httpRoute target",
+			probeTargets: ProbeTargets{
+				HTTPRoute: &ProbeTargetHTTPRoute{
+					Selector: metav1.LabelSelector{
+						MatchLabels: map[string]string{
+							"app": "foo",
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "service can't be combined with other targets",
+			probeTargets: ProbeTargets{
+				Service:   &ProbeTargetService{},
+				HTTPRoute: &ProbeTargetHTTPRoute{},
+			},
+			wantErr: true,
+		},
+		{
+			name: "httpRoute can't be combined with staticConfig",
+			probeTargets: ProbeTargets{
+				StaticConfig: &ProbeTargetStaticConfig{
+					Targets: []string{"/probe"},
+				},
+				HTTPRoute: &ProbeTargetHTTPRoute{},
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -89,7 +133,7 @@ func TestMarshallProbe(t *testing.T) {
 			},
 		},
 	}
-	expected := `{"metadata":{"name":"test","namespace":"default","creationTimestamp":null,"labels":{"group":"group1"}},"spec":{"prober":{"url":""},"targets":{"staticConfig":{"static":["prometheus.io"],"labels":{"env":"prometheus"}}},"bearerTokenSecret":{"key":""}}}`
+	expected := `{"metadata":{"name":"test","namespace":"default","creationTimestamp":null,"labels":{"group":"group1"}},"spec":{"prober":{"url":""},"targets":{"staticConfig":{"static":["prometheus.io"],"labels":{"env":"prometheus"}}},"bearerTokenSecret":{"key":""}},"status":{}}`
 
 	r, err := json.Marshal(sm)
 	if err != nil {
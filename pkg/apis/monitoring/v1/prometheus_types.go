@@ -96,6 +96,18 @@ const (
 	ShardAndResourceNameLabelSelector AdditionalLabelSelectors = "OnShard"
 )
 
+// +kubebuilder:validation:Enum=HashMod;Consistent
+type ShardingStrategy string
+
+const (
+	// Assign the targets to shards using the hash of the target modulo the number of shards.
+	HashModShardingStrategy ShardingStrategy = "HashMod"
+
+	// Assign the targets to shards using a fixed number of buckets which move
+	// as little as possible when the number of shards changes.
+	ConsistentShardingStrategy ShardingStrategy = "Consistent"
+)
+
 type CoreV1TopologySpreadConstraint v1.TopologySpreadConstraint
 
 type TopologySpreadConstraint struct {
@@ -270,6 +282,24 @@ type CommonPrometheusFields struct {
 	// the label value isn't empty, all Prometheus shards will scrape the target.
 	Shards *int32 `json:"shards,omitempty"`
 
+	// ShardingStrategy defines how the targets are distributed across the
+	// shards.
+	//
+	// * `HashMod` (default) assigns each target to the shard matching the
+	// hash of the target modulo the number of shards. Changing the number
+	// of shards reassigns most of the targets to a different shard.
+	// * `Consistent` hashes the targets into 1024 buckets which are evenly
+	// distributed across the shards. When a shard is added (resp. removed),
+	// only the buckets moving to (resp. from) this shard are reassigned which
+	// represents about 1/N of the targets. Shards above 1024 don't scrape any
+	// target.
+	//
+	// Both strategies honor the `__tmp_hash` and `__tmp_disable_sharding`
+	// labels.
+	//
+	// +optional
+	ShardingStrategy *ShardingStrategy `json:"shardingStrategy,omitempty"`
+
 	// Name of Prometheus external label used to denote the replica name.
 	// The external label will _not_ be added when the field is set to the
 	// empty string (`""`).
@@ -443,6 +473,29 @@ type CommonPrometheusFields struct {
 	// +optional
 	RemoteWrite []RemoteWriteSpec `json:"remoteWrite,omitempty"`
 
+	// RemoteWrite objects to be selected for the remote write configuration.
+	// An empty label selector matches all objects. A null label selector
+	// matches no objects.
+	//
+	// The endpoints of the selected objects are appended to the endpoints
+	// defined by `spec.remoteWrite`. The operator prepends a relabeling rule
+	// to the write relabeling configuration of each selected object so that
+	// only the series whose namespace label (see `spec.enforcedNamespaceLabel`,
+	// default: `namespace`) matches the namespace of the object are forwarded.
+	//
+	// Note that the RemoteWrite custom resource definition is currently at Alpha level.
+	//
+	// +optional
+	RemoteWriteSelector *metav1.LabelSelector `json:"remoteWriteSelector,omitempty"`
+	// Namespaces to match for RemoteWrite discovery. An empty label selector
+	// matches all namespaces. A null label selector matches the current
+	// namespace only.
+	//
+	// Note that the RemoteWrite custom resource definition is currently at Alpha level.
+	//
+	// +optional
+	RemoteWriteNamespaceSelector *metav1.LabelSelector `json:"remoteWriteNamespaceSelector,omitempty"`
+
 	// Settings related to the OTLP receiver feature.
 	// It requires Prometheus >= v2.55.0.
 	//
@@ -1082,6 +1135,18 @@ type PrometheusSpec struct {
 	// +optional
 	ShardRetentionPolicy *ShardRetentionPolicy `json:"shardRetentionPolicy,omitempty"`
 
+	// ShardAutoscaling defines how the operator computes the number of
+	// shards from the number of active series.
+	// (Alpha) Using this field requires the 'PrometheusShardAutoscaling' feature gate to be enabled.
+	//
+	// The operator periodically reads the `prometheus_tsdb_head_series`
+	// metric from the Prometheus pods through the governing service and
+	// publishes the recommended number of shards in
+	// `status.recommendedShards`.
+	//
+	// +optional
+	ShardAutoscaling *ShardAutoscalingSpec `json:"shardAutoscaling,omitempty"`
+
 	// When true, the Prometheus compaction is disabled.
 	// When `spec.thanos.objectStorageConfig` or `spec.objectStorageConfigFile` are defined, the operator automatically
 	// disables block compaction to avoid race conditions during block uploads (as the Thanos documentation recommends).
@@ -1106,6 +1171,27 @@ type PrometheusSpec struct {
 	// +optional
 	RuleNamespaceSelector *metav1.LabelSelector `json:"ruleNamespaceSelector,omitempty"`
 
+	// OTLPTenant objects to be selected for the OTLP receiver configuration.
+	// An empty label selector matches all objects. A null label selector
+	// matches no objects.
+	//
+	// The promoted resource attributes and the translation strategy of the
+	// selected objects are merged into the `otlp` section of the Prometheus
+	// configuration, together with `spec.otlp`.
+	//
+	// Note that the OTLPTenant custom resource definition is currently at Alpha level.
+	//
+	// +optional
+	OTLPTenantSelector *metav1.LabelSelector `json:"otlpTenantSelector,omitempty"`
+	// Namespaces to match for OTLPTenant discovery. An empty label selector
+	// matches all namespaces. A null label selector matches the current
+	// namespace only.
+	//
+	// Note that the OTLPTenant custom resource definition is currently at Alpha level.
+	//
+	// +optional
+	OTLPTenantNamespaceSelector *metav1.LabelSelector `json:"otlpTenantNamespaceSelector,omitempty"`
+
 	// QuerySpec defines the configuration of the Promethus query service.
 	// +optional
 	Query *QuerySpec `json:"query,omitempty"`
@@ -1155,6 +1241,17 @@ type PrometheusSpec struct {
 	// +optional
 	Thanos *ThanosSpec `json:"thanos,omitempty"`
 
+	// Defines a blackbox exporter deployed and managed by the operator.
+	//
+	// When defined, the operator deploys the blackbox exporter in the
+	// namespace of the Prometheus object and configures it with the modules
+	// defined by the selected Probe objects (see `spec.modules` in the Probe
+	// CRD). The selected Probe objects with an empty `spec.prober.url` field
+	// are probed by the managed blackbox exporter.
+	//
+	// +optional
+	ManagedProber *ManagedProberSpec `json:"managedProber,omitempty"`
+
 	// queryLogFile specifies where the file to which PromQL queries are logged.
 	//
 	// If the filename has an empty path, e.g. 'query.log', The Prometheus Pods
@@ -1201,6 +1298,33 @@ type PrometheusSpec struct {
 	EnableAdminAPI bool `json:"enableAdminAPI,omitempty"`
 }
 
+// ManagedProberSpec defines the blackbox exporter managed by the operator.
+// +k8s:openapi-gen=true
+type ManagedProberSpec struct {
+	// Container image of the blackbox exporter.
+	// If not defined, the operator uses a default image.
+	// +optional
+	Image *string `json:"image,omitempty"`
+
+	// Number of replicas of the blackbox exporter.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:default:=1
+	// +optional
+	Replicas *int32 `json:"replicas,omitempty"`
+
+	// Resource requirements of the blackbox exporter container.
+	// +optional
+	Resources v1.ResourceRequirements `json:"resources,omitempty"`
+
+	// Node selector of the blackbox exporter pods.
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+
+	// Tolerations of the blackbox exporter pods.
+	// +optional
+	Tolerations []v1.Toleration `json:"tolerations,omitempty"`
+}
+
 type WhenScaledRetentionType string
 
 var (
@@ -1228,6 +1352,58 @@ type ShardRetentionPolicy struct {
 	Retain *RetainConfig `json:"retain,omitempty"`
 }
 
+type ShardAutoscalingMode string
+
+var (
+	RecommendShardAutoscalingMode ShardAutoscalingMode = "Recommend"
+	ScaleShardAutoscalingMode     ShardAutoscalingMode = "Scale"
+)
+
+type ShardAutoscalingSpec struct {
+	// Defines what the operator does with the recommended number of shards.
+	// * `Recommend`, the operator only publishes the recommendation in `status.recommendedShards`.
+	// * `Scale`, the operator also updates `spec.shards`. Shards are only
+	//   removed when the shard retention policy is `Retain` (see
+	//   `spec.shardRetentionPolicy`) so that the data of the removed shards
+	//   remains queryable.
+	//
+	// If not defined, the operator assumes the `Recommend` value.
+	// +kubebuilder:validation:Enum=Recommend;Scale
+	// +optional
+	Mode *ShardAutoscalingMode `json:"mode,omitempty"`
+
+	// Number of active series that a shard should handle.
+	// +kubebuilder:validation:Minimum=1
+	// +required
+	TargetSeriesPerShard int64 `json:"targetSeriesPerShard"`
+
+	// Minimum number of shards.
+	// If not defined, the operator assumes 1.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MinShards *int32 `json:"minShards,omitempty"`
+
+	// Maximum number of shards.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxShards *int32 `json:"maxShards,omitempty"`
+
+	// Percentage by which the average number of series per shard can
+	// deviate from `targetSeriesPerShard` before the recommendation
+	// changes.
+	// If not defined, the operator assumes 10.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	TolerancePercent *int32 `json:"tolerancePercent,omitempty"`
+
+	// Duration during which a lower number of shards must be consistently
+	// computed before it is recommended.
+	// If not defined, the operator assumes 1h.
+	// +optional
+	ScaleDownDelay *Duration `json:"scaleDownDelay,omitempty"`
+}
+
 type PrometheusTracingConfig struct {
 	// Client used to export the traces. Supported values are `http` or `grpc`.
 	// +kubebuilder:validation:Enum=http;grpc
@@ -1296,6 +1472,10 @@ type PrometheusStatus struct {
 	ShardStatuses []ShardStatus `json:"shardStatuses,omitempty"`
 	// Shards is the most recently observed number of shards.
 	Shards int32 `json:"shards,omitempty"`
+	// RecommendedShards is the number of shards recommended by the operator
+	// when `spec.shardAutoscaling` is defined.
+	// +optional
+	RecommendedShards *int32 `json:"recommendedShards,omitempty"`
 	// The selector used to match the pods targeted by this Prometheus resource.
 	Selector string `json:"selector,omitempty"`
 }
@@ -29,6 +29,10 @@ const (
 // +genclient
 // +k8s:openapi-gen=true
 // +kubebuilder:resource:categories="prometheus-operator",shortName="promrule"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="Workloads",type="string",JSONPath=".status.bindings[*].name",description="The workloads which selected the resource",priority=1
+// +kubebuilder:printcolumn:name="Accepted",type="string",JSONPath=".status.bindings[*].conditions[?(@.type == 'Accepted')].status",description="Whether the resource has been accepted by the selecting workloads",priority=1
+// +kubebuilder:subresource:status
 
 // The `PrometheusRule` custom resource definition (CRD) defines [alerting](https://prometheus.io/docs/prometheus/latest/configuration/alerting_rules/) and [recording](https://prometheus.io/docs/prometheus/latest/configuration/recording_rules/) rules to be evaluated by `Prometheus` or `ThanosRuler` objects.
 //
@@ -38,6 +42,11 @@ type PrometheusRule struct {
 	metav1.ObjectMeta `json:"metadata,omitempty"`
 	// Specification of desired alerting rule definitions for Prometheus.
 	Spec PrometheusRuleSpec `json:"spec"`
+	// The status of the PrometheusRule resource.
+	// It is only populated when the `StatusForConfigurationResources`
+	// feature gate is enabled.
+	// +optional
+	Status PrometheusRuleStatus `json:"status,omitempty"`
 }
 
 // DeepCopyObject implements the runtime.Object interface.
@@ -54,6 +63,51 @@ type PrometheusRuleSpec struct {
 	Groups []RuleGroup `json:"groups,omitempty"`
 }
 
+// PrometheusRuleStatus is the most recent observed status of the
+// PrometheusRule resource.
+// Read-only.
+// More info:
+// https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#spec-and-status
+// +k8s:openapi-gen=true
+type PrometheusRuleStatus struct {
+	// The list of workload resources (Prometheus or ThanosRuler) which select
+	// the PrometheusRule resource.
+	// +listType=map
+	// +listMapKey=group
+	// +listMapKey=resource
+	// +listMapKey=name
+	// +listMapKey=namespace
+	// +optional
+	Bindings []RuleWorkloadBinding `json:"bindings,omitempty"`
+}
+
+// RuleWorkloadBinding is a link between a PrometheusRule resource and a
+// workload resource.
+// +k8s:openapi-gen=true
+type RuleWorkloadBinding struct {
+	WorkloadBinding `json:",inline"`
+	// The status of the rule groups when loaded by the referenced workload
+	// object.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	Groups []RuleGroupStatus `json:"groups,omitempty"`
+}
+
+// RuleGroupStatus describes the status of a rule group.
+// +k8s:openapi-gen=true
+type RuleGroupStatus struct {
+	// The name of the rule group.
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	Name string `json:"name"`
+	// The current state of the rule group.
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []ConfigResourceCondition `json:"conditions,omitempty"`
+}
+
 // RuleGroup and Rule are copied instead of vendored because the
 // upstream Prometheus struct definitions don't have json struct tags.
 
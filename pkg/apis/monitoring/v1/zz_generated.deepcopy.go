@@ -21,6 +21,7 @@ package v1
 import (
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 )
@@ -242,6 +243,36 @@ func (in *AlertmanagerGlobalConfig) DeepCopyInto(out *AlertmanagerGlobalConfig)
 		*out = new(string)
 		**out = **in
 	}
+	if in.TelegramConfig != nil {
+		in, out := &in.TelegramConfig, &out.TelegramConfig
+		*out = new(GlobalTelegramConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.WebexConfig != nil {
+		in, out := &in.WebexConfig, &out.WebexConfig
+		*out = new(GlobalWebexConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.VictorOpsConfig != nil {
+		in, out := &in.VictorOpsConfig, &out.VictorOpsConfig
+		*out = new(GlobalVictorOpsConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.WeChatConfig != nil {
+		in, out := &in.WeChatConfig, &out.WeChatConfig
+		*out = new(GlobalWeChatConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.JiraConfig != nil {
+		in, out := &in.JiraConfig, &out.JiraConfig
+		*out = new(GlobalJiraConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RocketChatConfig != nil {
+		in, out := &in.RocketChatConfig, &out.RocketChatConfig
+		*out = new(GlobalRocketChatConfig)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertmanagerGlobalConfig.
@@ -303,6 +334,41 @@ func (in *AlertmanagerList) DeepCopy() *AlertmanagerList {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertmanagerPeerDiscovery) DeepCopyInto(out *AlertmanagerPeerDiscovery) {
+	*out = *in
+	if in.ServiceSelector != nil {
+		in, out := &in.ServiceSelector, &out.ServiceSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServiceNamespaceSelector != nil {
+		in, out := &in.ServiceNamespaceSelector, &out.ServiceNamespaceSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServicePort != nil {
+		in, out := &in.ServicePort, &out.ServicePort
+		*out = new(string)
+		**out = **in
+	}
+	if in.DNSSRVRecords != nil {
+		in, out := &in.DNSSRVRecords, &out.DNSSRVRecords
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertmanagerPeerDiscovery.
+func (in *AlertmanagerPeerDiscovery) DeepCopy() *AlertmanagerPeerDiscovery {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertmanagerPeerDiscovery)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AlertmanagerSpec) DeepCopyInto(out *AlertmanagerSpec) {
 	*out = *in
@@ -431,6 +497,11 @@ func (in *AlertmanagerSpec) DeepCopyInto(out *AlertmanagerSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.PeerDiscovery != nil {
+		in, out := &in.PeerDiscovery, &out.PeerDiscovery
+		*out = new(AlertmanagerPeerDiscovery)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ClusterLabel != nil {
 		in, out := &in.ClusterLabel, &out.ClusterLabel
 		*out = new(string)
@@ -447,6 +518,21 @@ func (in *AlertmanagerSpec) DeepCopyInto(out *AlertmanagerSpec) {
 		(*in).DeepCopyInto(*out)
 	}
 	out.AlertmanagerConfigMatcherStrategy = in.AlertmanagerConfigMatcherStrategy
+	if in.ClusterAlertmanagerConfigSelector != nil {
+		in, out := &in.ClusterAlertmanagerConfigSelector, &out.ClusterAlertmanagerConfigSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SilenceSelector != nil {
+		in, out := &in.SilenceSelector, &out.SilenceSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SilenceNamespaceSelector != nil {
+		in, out := &in.SilenceNamespaceSelector, &out.SilenceNamespaceSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.MinReadySeconds != nil {
 		in, out := &in.MinReadySeconds, &out.MinReadySeconds
 		*out = new(uint32)
@@ -521,6 +607,11 @@ func (in *AlertmanagerStatus) DeepCopyInto(out *AlertmanagerStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.ClusterSize != nil {
+		in, out := &in.ClusterSize, &out.ClusterSize
+		*out = new(int32)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertmanagerStatus.
@@ -813,6 +904,11 @@ func (in *CommonPrometheusFields) DeepCopyInto(out *CommonPrometheusFields) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.ShardingStrategy != nil {
+		in, out := &in.ShardingStrategy, &out.ShardingStrategy
+		*out = new(ShardingStrategy)
+		**out = **in
+	}
 	if in.ReplicaExternalLabelName != nil {
 		in, out := &in.ReplicaExternalLabelName, &out.ReplicaExternalLabelName
 		*out = new(string)
@@ -928,6 +1024,16 @@ func (in *CommonPrometheusFields) DeepCopyInto(out *CommonPrometheusFields) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.RemoteWriteSelector != nil {
+		in, out := &in.RemoteWriteSelector, &out.RemoteWriteSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RemoteWriteNamespaceSelector != nil {
+		in, out := &in.RemoteWriteNamespaceSelector, &out.RemoteWriteNamespaceSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.OTLP != nil {
 		in, out := &in.OTLP, &out.OTLP
 		*out = new(OTLPConfig)
@@ -1170,6 +1276,44 @@ func (in *Condition) DeepCopy() *Condition {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigResourceCondition) DeepCopyInto(out *ConfigResourceCondition) {
+	*out = *in
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigResourceCondition.
+func (in *ConfigResourceCondition) DeepCopy() *ConfigResourceCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigResourceCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigResourceStatus) DeepCopyInto(out *ConfigResourceStatus) {
+	*out = *in
+	if in.Bindings != nil {
+		in, out := &in.Bindings, &out.Bindings
+		*out = make([]WorkloadBinding, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigResourceStatus.
+func (in *ConfigResourceStatus) DeepCopy() *ConfigResourceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigResourceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CoreV1TopologySpreadConstraint) DeepCopyInto(out *CoreV1TopologySpreadConstraint) {
 	*out = *in
@@ -1383,6 +1527,56 @@ func (in *Exemplars) DeepCopy() *Exemplars {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GlobalJiraConfig) DeepCopyInto(out *GlobalJiraConfig) {
+	*out = *in
+	if in.APIURL != nil {
+		in, out := &in.APIURL, &out.APIURL
+		*out = new(URL)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GlobalJiraConfig.
+func (in *GlobalJiraConfig) DeepCopy() *GlobalJiraConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(GlobalJiraConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GlobalRocketChatConfig) DeepCopyInto(out *GlobalRocketChatConfig) {
+	*out = *in
+	if in.APIURL != nil {
+		in, out := &in.APIURL, &out.APIURL
+		*out = new(URL)
+		**out = **in
+	}
+	if in.Token != nil {
+		in, out := &in.Token, &out.Token
+		*out = new(corev1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TokenID != nil {
+		in, out := &in.TokenID, &out.TokenID
+		*out = new(corev1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GlobalRocketChatConfig.
+func (in *GlobalRocketChatConfig) DeepCopy() *GlobalRocketChatConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(GlobalRocketChatConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GlobalSMTPConfig) DeepCopyInto(out *GlobalSMTPConfig) {
 	*out = *in
@@ -1443,6 +1637,101 @@ func (in *GlobalSMTPConfig) DeepCopy() *GlobalSMTPConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GlobalTelegramConfig) DeepCopyInto(out *GlobalTelegramConfig) {
+	*out = *in
+	if in.APIURL != nil {
+		in, out := &in.APIURL, &out.APIURL
+		*out = new(URL)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GlobalTelegramConfig.
+func (in *GlobalTelegramConfig) DeepCopy() *GlobalTelegramConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(GlobalTelegramConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GlobalVictorOpsConfig) DeepCopyInto(out *GlobalVictorOpsConfig) {
+	*out = *in
+	if in.APIURL != nil {
+		in, out := &in.APIURL, &out.APIURL
+		*out = new(URL)
+		**out = **in
+	}
+	if in.APIKey != nil {
+		in, out := &in.APIKey, &out.APIKey
+		*out = new(corev1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GlobalVictorOpsConfig.
+func (in *GlobalVictorOpsConfig) DeepCopy() *GlobalVictorOpsConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(GlobalVictorOpsConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GlobalWeChatConfig) DeepCopyInto(out *GlobalWeChatConfig) {
+	*out = *in
+	if in.APIURL != nil {
+		in, out := &in.APIURL, &out.APIURL
+		*out = new(URL)
+		**out = **in
+	}
+	if in.APISecret != nil {
+		in, out := &in.APISecret, &out.APISecret
+		*out = new(corev1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.APICorpID != nil {
+		in, out := &in.APICorpID, &out.APICorpID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GlobalWeChatConfig.
+func (in *GlobalWeChatConfig) DeepCopy() *GlobalWeChatConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(GlobalWeChatConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GlobalWebexConfig) DeepCopyInto(out *GlobalWebexConfig) {
+	*out = *in
+	if in.APIURL != nil {
+		in, out := &in.APIURL, &out.APIURL
+		*out = new(URL)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GlobalWebexConfig.
+func (in *GlobalWebexConfig) DeepCopy() *GlobalWebexConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(GlobalWebexConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HTTPConfig) DeepCopyInto(out *HTTPConfig) {
 	*out = *in
@@ -1539,6 +1828,46 @@ func (in *ManagedIdentity) DeepCopy() *ManagedIdentity {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedProberSpec) DeepCopyInto(out *ManagedProberSpec) {
+	*out = *in
+	if in.Image != nil {
+		in, out := &in.Image, &out.Image
+		*out = new(string)
+		**out = **in
+	}
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
+	in.Resources.DeepCopyInto(&out.Resources)
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]corev1.Toleration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedProberSpec.
+func (in *ManagedProberSpec) DeepCopy() *ManagedProberSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedProberSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MetadataConfig) DeepCopyInto(out *MetadataConfig) {
 	*out = *in
@@ -1883,6 +2212,7 @@ func (in *PodMonitor) DeepCopyInto(out *PodMonitor) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodMonitor.
@@ -2015,6 +2345,7 @@ func (in *Probe) DeepCopyInto(out *Probe) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Probe.
@@ -2051,10 +2382,42 @@ func (in *ProbeList) DeepCopy() *ProbeList {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProbeModule) DeepCopyInto(out *ProbeModule) {
+	*out = *in
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(Duration)
+		**out = **in
+	}
+	if in.Config != nil {
+		in, out := &in.Config, &out.Config
+		*out = new(apiextensionsv1.JSON)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProbeModule.
+func (in *ProbeModule) DeepCopy() *ProbeModule {
+	if in == nil {
+		return nil
+	}
+	out := new(ProbeModule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProbeSpec) DeepCopyInto(out *ProbeSpec) {
 	*out = *in
 	out.ProberSpec = in.ProberSpec
+	if in.Modules != nil {
+		in, out := &in.Modules, &out.Modules
+		*out = make([]ProbeModule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	in.Targets.DeepCopyInto(&out.Targets)
 	if in.TLSConfig != nil {
 		in, out := &in.TLSConfig, &out.TLSConfig
@@ -2142,6 +2505,35 @@ func (in *ProbeSpec) DeepCopy() *ProbeSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProbeTargetHTTPRoute) DeepCopyInto(out *ProbeTargetHTTPRoute) {
+	*out = *in
+	in.Selector.DeepCopyInto(&out.Selector)
+	in.NamespaceSelector.DeepCopyInto(&out.NamespaceSelector)
+	if in.Scheme != nil {
+		in, out := &in.Scheme, &out.Scheme
+		*out = new(string)
+		**out = **in
+	}
+	if in.RelabelConfigs != nil {
+		in, out := &in.RelabelConfigs, &out.RelabelConfigs
+		*out = make([]RelabelConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProbeTargetHTTPRoute.
+func (in *ProbeTargetHTTPRoute) DeepCopy() *ProbeTargetHTTPRoute {
+	if in == nil {
+		return nil
+	}
+	out := new(ProbeTargetHTTPRoute)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProbeTargetIngress) DeepCopyInto(out *ProbeTargetIngress) {
 	*out = *in
@@ -2166,6 +2558,35 @@ func (in *ProbeTargetIngress) DeepCopy() *ProbeTargetIngress {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProbeTargetService) DeepCopyInto(out *ProbeTargetService) {
+	*out = *in
+	in.Selector.DeepCopyInto(&out.Selector)
+	in.NamespaceSelector.DeepCopyInto(&out.NamespaceSelector)
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(string)
+		**out = **in
+	}
+	if in.RelabelConfigs != nil {
+		in, out := &in.RelabelConfigs, &out.RelabelConfigs
+		*out = make([]RelabelConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProbeTargetService.
+func (in *ProbeTargetService) DeepCopy() *ProbeTargetService {
+	if in == nil {
+		return nil
+	}
+	out := new(ProbeTargetService)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProbeTargetStaticConfig) DeepCopyInto(out *ProbeTargetStaticConfig) {
 	*out = *in
@@ -2213,6 +2634,16 @@ func (in *ProbeTargets) DeepCopyInto(out *ProbeTargets) {
 		*out = new(ProbeTargetIngress)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Service != nil {
+		in, out := &in.Service, &out.Service
+		*out = new(ProbeTargetService)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HTTPRoute != nil {
+		in, out := &in.HTTPRoute, &out.HTTPRoute
+		*out = new(ProbeTargetHTTPRoute)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProbeTargets.
@@ -2304,6 +2735,7 @@ func (in *PrometheusRule) DeepCopyInto(out *PrometheusRule) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrometheusRule.
@@ -2377,6 +2809,28 @@ func (in *PrometheusRuleSpec) DeepCopy() *PrometheusRuleSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrometheusRuleStatus) DeepCopyInto(out *PrometheusRuleStatus) {
+	*out = *in
+	if in.Bindings != nil {
+		in, out := &in.Bindings, &out.Bindings
+		*out = make([]RuleWorkloadBinding, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrometheusRuleStatus.
+func (in *PrometheusRuleStatus) DeepCopy() *PrometheusRuleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PrometheusRuleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PrometheusSpec) DeepCopyInto(out *PrometheusSpec) {
 	*out = *in
@@ -2386,6 +2840,11 @@ func (in *PrometheusSpec) DeepCopyInto(out *PrometheusSpec) {
 		*out = new(ShardRetentionPolicy)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ShardAutoscaling != nil {
+		in, out := &in.ShardAutoscaling, &out.ShardAutoscaling
+		*out = new(ShardAutoscalingSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	out.Rules = in.Rules
 	if in.PrometheusRulesExcludedFromEnforce != nil {
 		in, out := &in.PrometheusRulesExcludedFromEnforce, &out.PrometheusRulesExcludedFromEnforce
@@ -2402,6 +2861,16 @@ func (in *PrometheusSpec) DeepCopyInto(out *PrometheusSpec) {
 		*out = new(metav1.LabelSelector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.OTLPTenantSelector != nil {
+		in, out := &in.OTLPTenantSelector, &out.OTLPTenantSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OTLPTenantNamespaceSelector != nil {
+		in, out := &in.OTLPTenantNamespaceSelector, &out.OTLPTenantNamespaceSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Query != nil {
 		in, out := &in.Query, &out.Query
 		*out = new(QuerySpec)
@@ -2434,6 +2903,11 @@ func (in *PrometheusSpec) DeepCopyInto(out *PrometheusSpec) {
 		*out = new(ThanosSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ManagedProber != nil {
+		in, out := &in.ManagedProber, &out.ManagedProber
+		*out = new(ManagedProberSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Exemplars != nil {
 		in, out := &in.Exemplars, &out.Exemplars
 		*out = new(Exemplars)
@@ -2471,6 +2945,11 @@ func (in *PrometheusStatus) DeepCopyInto(out *PrometheusStatus) {
 		*out = make([]ShardStatus, len(*in))
 		copy(*out, *in)
 	}
+	if in.RecommendedShards != nil {
+		in, out := &in.RecommendedShards, &out.RecommendedShards
+		*out = new(int32)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrometheusStatus.
@@ -2983,6 +3462,51 @@ func (in *RuleGroup) DeepCopy() *RuleGroup {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RuleGroupStatus) DeepCopyInto(out *RuleGroupStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]ConfigResourceCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuleGroupStatus.
+func (in *RuleGroupStatus) DeepCopy() *RuleGroupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RuleGroupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RuleWorkloadBinding) DeepCopyInto(out *RuleWorkloadBinding) {
+	*out = *in
+	in.WorkloadBinding.DeepCopyInto(&out.WorkloadBinding)
+	if in.Groups != nil {
+		in, out := &in.Groups, &out.Groups
+		*out = make([]RuleGroupStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuleWorkloadBinding.
+func (in *RuleWorkloadBinding) DeepCopy() *RuleWorkloadBinding {
+	if in == nil {
+		return nil
+	}
+	out := new(RuleWorkloadBinding)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Rules) DeepCopyInto(out *Rules) {
 	*out = *in
@@ -3181,6 +3705,7 @@ func (in *ServiceMonitor) DeepCopyInto(out *ServiceMonitor) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceMonitor.
@@ -3312,6 +3837,46 @@ func (in *ServiceMonitorSpec) DeepCopy() *ServiceMonitorSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShardAutoscalingSpec) DeepCopyInto(out *ShardAutoscalingSpec) {
+	*out = *in
+	if in.Mode != nil {
+		in, out := &in.Mode, &out.Mode
+		*out = new(ShardAutoscalingMode)
+		**out = **in
+	}
+	if in.MinShards != nil {
+		in, out := &in.MinShards, &out.MinShards
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxShards != nil {
+		in, out := &in.MaxShards, &out.MaxShards
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TolerancePercent != nil {
+		in, out := &in.TolerancePercent, &out.TolerancePercent
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ScaleDownDelay != nil {
+		in, out := &in.ScaleDownDelay, &out.ScaleDownDelay
+		*out = new(Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShardAutoscalingSpec.
+func (in *ShardAutoscalingSpec) DeepCopy() *ShardAutoscalingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ShardAutoscalingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ShardRetentionPolicy) DeepCopyInto(out *ShardRetentionPolicy) {
 	*out = *in
@@ -3982,3 +4547,25 @@ func (in *WebTLSConfig) DeepCopy() *WebTLSConfig {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkloadBinding) DeepCopyInto(out *WorkloadBinding) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]ConfigResourceCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadBinding.
+func (in *WorkloadBinding) DeepCopy() *WorkloadBinding {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkloadBinding)
+	in.DeepCopyInto(out)
+	return out
+}
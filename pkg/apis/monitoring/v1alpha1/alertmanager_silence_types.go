@@ -0,0 +1,138 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+)
+
+const (
+	AlertmanagerSilenceKind    = "AlertmanagerSilence"
+	AlertmanagerSilenceName    = "alertmanagersilences"
+	AlertmanagerSilenceKindKey = "alertmanagersilence"
+)
+
+// +genclient
+// +k8s:openapi-gen=true
+// +kubebuilder:resource:categories="prometheus-operator",shortName="amsilence"
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Ends At",type="string",JSONPath=".spec.endsAt"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// AlertmanagerSilence defines a silence which is created by the operator in
+// the Alertmanager resources selecting it (see `spec.silenceSelector`).
+//
+// The operator manages the silence through the Alertmanager v2 API: it
+// creates the silence, updates it when the resource changes and expires it
+// when the resource is deleted or isn't selected anymore.
+//
+// Unless the `None` matcher strategy is configured in the Alertmanager
+// resource, the operator adds a `namespace` matcher to the silence so that it
+// only applies to alerts from the resource's namespace.
+type AlertmanagerSilence struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec AlertmanagerSilenceSpec `json:"spec"`
+	// Most recent observed status of the silence. Read-only.
+	// More info:
+	// https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#spec-and-status
+	// +optional
+	Status AlertmanagerSilenceStatus `json:"status,omitempty"`
+}
+
+// DeepCopyObject implements the runtime.Object interface.
+func (l *AlertmanagerSilence) DeepCopyObject() runtime.Object {
+	return l.DeepCopy()
+}
+
+// AlertmanagerSilenceSpec is the specification of the silence.
+type AlertmanagerSilenceSpec struct {
+	// List of matchers that the alerts' labels should match for the silence
+	// to apply.
+	// +kubebuilder:validation:MinItems=1
+	// +required
+	Matchers []Matcher `json:"matchers"`
+	// Time from which the silence is active.
+	// If not defined, it defaults to the creation time of the resource.
+	// +optional
+	StartsAt *metav1.Time `json:"startsAt,omitempty"`
+	// Time at which the silence expires.
+	// +required
+	EndsAt metav1.Time `json:"endsAt"`
+	// Comment describing the silence (e.g. the reason of the maintenance).
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	Comment string `json:"comment"`
+}
+
+// AlertmanagerSilenceStatus is the most recent observed status of the
+// silence.
+type AlertmanagerSilenceStatus struct {
+	// The list of Alertmanager resources which select the silence.
+	// +listType=map
+	// +listMapKey=name
+	// +listMapKey=namespace
+	// +optional
+	Alertmanagers []AlertmanagerSilenceBinding `json:"alertmanagers,omitempty"`
+}
+
+// AlertmanagerSilenceBinding is the status of the silence for an Alertmanager
+// resource.
+type AlertmanagerSilenceBinding struct {
+	// The name of the Alertmanager object.
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	Name string `json:"name"`
+	// The namespace of the Alertmanager object.
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	Namespace string `json:"namespace"`
+	// The identifier of the silence in Alertmanager.
+	// +optional
+	SilenceID string `json:"silenceID,omitempty"`
+	// The state of the silence in Alertmanager (`pending`, `active` or
+	// `expired`).
+	// +optional
+	State string `json:"state,omitempty"`
+	// Time at which the silence expires.
+	// +optional
+	EndsAt *metav1.Time `json:"endsAt,omitempty"`
+	// The current state of the silence when synchronized with the
+	// Alertmanager object.
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []monitoringv1.ConfigResourceCondition `json:"conditions,omitempty"`
+}
+
+// AlertmanagerSilenceList is a list of AlertmanagerSilence.
+// +k8s:openapi-gen=true
+type AlertmanagerSilenceList struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard list metadata
+	// More info: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#metadata
+	metav1.ListMeta `json:"metadata,omitempty"`
+	// List of AlertmanagerSilence
+	Items []AlertmanagerSilence `json:"items"`
+}
+
+// DeepCopyObject implements the runtime.Object interface.
+func (l *AlertmanagerSilenceList) DeepCopyObject() runtime.Object {
+	return l.DeepCopy()
+}
@@ -0,0 +1,72 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	ClusterAlertmanagerConfigKind    = "ClusterAlertmanagerConfig"
+	ClusterAlertmanagerConfigName    = "clusteralertmanagerconfigs"
+	ClusterAlertmanagerConfigKindKey = "clusteralertmanagerconfig"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:openapi-gen=true
+// +kubebuilder:resource:categories="prometheus-operator",shortName="camcfg",scope=Cluster
+
+// ClusterAlertmanagerConfig configures the Prometheus Alertmanager for all
+// namespaces, specifying how alerts should be grouped, inhibited and notified
+// to external systems.
+//
+// Contrary to the AlertmanagerConfig resource, the operator doesn't enforce
+// any `namespace` matcher on the routes and inhibition rules of the
+// ClusterAlertmanagerConfig resources selected by an Alertmanager resource
+// (see `spec.clusterAlertmanagerConfigSelector`). Their first-level routes are
+// added to the generated configuration before the routes of the
+// AlertmanagerConfig resources.
+//
+// The secrets referenced by the receivers are read from the namespace of the
+// Alertmanager resource.
+type ClusterAlertmanagerConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec AlertmanagerConfigSpec `json:"spec"`
+}
+
+// DeepCopyObject implements the runtime.Object interface.
+func (l *ClusterAlertmanagerConfig) DeepCopyObject() runtime.Object {
+	return l.DeepCopy()
+}
+
+// ClusterAlertmanagerConfigList is a list of ClusterAlertmanagerConfig.
+// +k8s:openapi-gen=true
+type ClusterAlertmanagerConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard list metadata
+	// More info: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#metadata
+	metav1.ListMeta `json:"metadata,omitempty"`
+	// List of ClusterAlertmanagerConfig
+	Items []ClusterAlertmanagerConfig `json:"items"`
+}
+
+// DeepCopyObject implements the runtime.Object interface.
+func (l *ClusterAlertmanagerConfigList) DeepCopyObject() runtime.Object {
+	return l.DeepCopy()
+}
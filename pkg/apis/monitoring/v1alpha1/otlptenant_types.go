@@ -0,0 +1,101 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	v1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+)
+
+const (
+	OTLPTenantsKind   = "OTLPTenant"
+	OTLPTenantName    = "otlptenants"
+	OTLPTenantKindKey = "otlptenant"
+)
+
+// +genclient
+// +k8s:openapi-gen=true
+// +kubebuilder:resource:categories="prometheus-operator",shortName="otlpt"
+// +kubebuilder:printcolumn:name="Translation Strategy",type="string",JSONPath=".spec.translationStrategy"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// OTLPTenant declares the OTLP ingestion settings required by the
+// applications pushing metrics to the OTLP receiver of Prometheus.
+//
+// The operator aggregates the OTLPTenant resources selected by a Prometheus
+// resource (see `spec.otlpTenantSelector` and
+// `spec.otlpTenantNamespaceSelector`) into the `otlp` section of the
+// Prometheus configuration.
+//
+// Prometheus exposes a single OTLP endpoint: the OTLPTenant resources define
+// how the pushed metrics are translated but they don't restrict which clients
+// can push metrics.
+type OTLPTenant struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec OTLPTenantSpec `json:"spec"`
+}
+
+// DeepCopyObject implements the runtime.Object interface.
+func (l *OTLPTenant) DeepCopyObject() runtime.Object {
+	return l.DeepCopy()
+}
+
+// OTLPTenantList is a list of OTLPTenants.
+// +k8s:openapi-gen=true
+type OTLPTenantList struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard list metadata
+	// More info: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#metadata
+	metav1.ListMeta `json:"metadata,omitempty"`
+	// List of OTLPTenants
+	Items []OTLPTenant `json:"items"`
+}
+
+// DeepCopyObject implements the runtime.Object interface.
+func (l *OTLPTenantList) DeepCopyObject() runtime.Object {
+	return l.DeepCopy()
+}
+
+// OTLPTenantSpec defines the OTLP ingestion settings of a tenant.
+// +k8s:openapi-gen=true
+type OTLPTenantSpec struct {
+	// List of OpenTelemetry resource attributes that should be promoted to
+	// metric labels.
+	//
+	// The attributes are merged with the attributes of the other selected
+	// OTLPTenant resources and with `spec.otlp.promoteResourceAttributes`
+	// of the Prometheus resource.
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:items:MinLength=1
+	// +listType=set
+	// +optional
+	PromoteResourceAttributes []string `json:"promoteResourceAttributes,omitempty"`
+
+	// Configures how the OTLP receiver endpoint translates the incoming metrics.
+	//
+	// Because the translation strategy applies to all the OTLP metrics
+	// received by Prometheus, the OTLPTenant resource is rejected if the value
+	// differs from `spec.otlp.translationStrategy` of the Prometheus resource
+	// or from the value of another selected OTLPTenant resource.
+	//
+	// It requires Prometheus >= v3.0.0.
+	// +optional
+	TranslationStrategy *v1.TranslationStrategyOption `json:"translationStrategy,omitempty"`
+}
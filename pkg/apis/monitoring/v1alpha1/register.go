@@ -49,10 +49,24 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 	scheme.AddKnownTypes(SchemeGroupVersion,
 		&AlertmanagerConfig{},
 		&AlertmanagerConfigList{},
+		&ClusterAlertmanagerConfig{},
+		&ClusterAlertmanagerConfigList{},
+		&AlertmanagerSilence{},
+		&AlertmanagerSilenceList{},
 		&PrometheusAgent{},
 		&PrometheusAgentList{},
 		&ScrapeConfig{},
 		&ScrapeConfigList{},
+		&OTLPTenant{},
+		&OTLPTenantList{},
+		&RemoteWrite{},
+		&RemoteWriteList{},
+		&ThanosQuerier{},
+		&ThanosQuerierList{},
+		&ThanosStore{},
+		&ThanosStoreList{},
+		&ThanosCompactor{},
+		&ThanosCompactorList{},
 	)
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
 	return nil
@@ -0,0 +1,77 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	v1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+)
+
+const (
+	RemoteWritesKind   = "RemoteWrite"
+	RemoteWriteName    = "remotewrites"
+	RemoteWriteKindKey = "remotewrite"
+)
+
+// +genclient
+// +k8s:openapi-gen=true
+// +kubebuilder:resource:categories="prometheus-operator",shortName="rw"
+// +kubebuilder:printcolumn:name="URL",type="string",JSONPath=".spec.url"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// RemoteWrite defines a remote write endpoint to which Prometheus forwards
+// the series of the namespace where the resource is created.
+//
+// The operator appends the RemoteWrite resources selected by a Prometheus or
+// PrometheusAgent resource (see `spec.remoteWriteSelector` and
+// `spec.remoteWriteNamespaceSelector`) to the `remote_write` section of the
+// configuration. A relabeling rule keeping only the series whose namespace
+// label matches the namespace of the RemoteWrite resource is always
+// evaluated before the write relabeling rules of the resource.
+//
+// Because RemoteWrite resources are meant to be managed by tenants, the
+// following fields are rejected: `bearerToken`, `bearerTokenFile`,
+// `authorization.credentialsFile`, `tlsConfig.caFile`, `tlsConfig.certFile`,
+// `tlsConfig.keyFile`, `azureAd.managedIdentity`, `azureAd.sdk` and `sigv4`
+// without static credentials.
+type RemoteWrite struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec v1.RemoteWriteSpec `json:"spec"`
+}
+
+// DeepCopyObject implements the runtime.Object interface.
+func (l *RemoteWrite) DeepCopyObject() runtime.Object {
+	return l.DeepCopy()
+}
+
+// RemoteWriteList is a list of RemoteWrites.
+// +k8s:openapi-gen=true
+type RemoteWriteList struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard list metadata
+	// More info: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#metadata
+	metav1.ListMeta `json:"metadata,omitempty"`
+	// List of RemoteWrites
+	Items []RemoteWrite `json:"items"`
+}
+
+// DeepCopyObject implements the runtime.Object interface.
+func (l *RemoteWriteList) DeepCopyObject() runtime.Object {
+	return l.DeepCopy()
+}
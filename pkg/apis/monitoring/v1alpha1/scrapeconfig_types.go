@@ -110,6 +110,7 @@ type K8SSelectorConfig struct {
 // +genclient
 // +k8s:openapi-gen=true
 // +kubebuilder:resource:categories="prometheus-operator",shortName="scfg"
+// +kubebuilder:subresource:status
 // +kubebuilder:storageversion
 
 // ScrapeConfig defines a namespaced Prometheus scrape_config to be aggregated across
@@ -119,6 +120,11 @@ type ScrapeConfig struct {
 	metav1.ObjectMeta `json:"metadata,omitempty"`
 
 	Spec ScrapeConfigSpec `json:"spec"`
+	// The status of the ScrapeConfig resource.
+	// It is only populated when the `StatusForConfigurationResources`
+	// feature gate is enabled.
+	// +optional
+	Status v1.ConfigResourceStatus `json:"status,omitempty"`
 }
 
 // DeepCopyObject implements the runtime.Object interface.
@@ -223,6 +229,21 @@ type ScrapeConfigSpec struct {
 	// IonosSDConfigs defines a list of IONOS service discovery configurations.
 	// +optional
 	IonosSDConfigs []IonosSDConfig `json:"ionosSDConfigs,omitempty"`
+	// VultrSDConfigs defines a list of Vultr service discovery configurations.
+	// +optional
+	VultrSDConfigs []VultrSDConfig `json:"vultrSDConfigs,omitempty"`
+	// MarathonSDConfigs defines a list of Marathon service discovery configurations.
+	// +optional
+	MarathonSDConfigs []MarathonSDConfig `json:"marathonSDConfigs,omitempty"`
+	// UyuniSDConfigs defines a list of Uyuni service discovery configurations.
+	// +optional
+	UyuniSDConfigs []UyuniSDConfig `json:"uyuniSDConfigs,omitempty"`
+	// ServersetSDConfigs defines a list of Zookeeper Serverset service discovery configurations.
+	// +optional
+	ServersetSDConfigs []ServersetSDConfig `json:"serversetSDConfigs,omitempty"`
+	// NerveSDConfigs defines a list of Nerve service discovery configurations.
+	// +optional
+	NerveSDConfigs []NerveSDConfig `json:"nerveSDConfigs,omitempty"`
 	// RelabelConfigs defines how to rewrite the target's labels before scraping.
 	// Prometheus Operator automatically adds relabelings for a few standard Kubernetes fields.
 	// The original scrape job's name is available via the `__tmp_prometheus_job_name` label.
@@ -1464,3 +1485,167 @@ type IonosSDConfig struct {
 	// +optional
 	OAuth2 *v1.OAuth2 `json:"oauth2,omitempty"`
 }
+
+// VultrSDConfig configurations allow retrieving scrape targets from Vultr instances.
+// See https://prometheus.io/docs/prometheus/latest/configuration/configuration/#vultr_sd_config
+type VultrSDConfig struct {
+	// Authorization header configuration, required when using Vultr.
+	// Cannot be set at the same time as `oauth2`.
+	// +optional
+	Authorization *v1.SafeAuthorization `json:"authorization,omitempty"`
+	// Optional OAuth 2.0 configuration.
+	// Cannot be set at the same time as `authorization`.
+	// +optional
+	OAuth2 *v1.OAuth2 `json:"oauth2,omitempty"`
+	// The port to scrape metrics from.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=65535
+	// +optional
+	Port *int32 `json:"port,omitempty"`
+	// Refresh interval to re-read the list of instances.
+	// +optional
+	RefreshInterval *v1.Duration `json:"refreshInterval,omitempty"`
+	// +optional
+	v1.ProxyConfig `json:",inline"`
+	// TLS configuration to use when connecting to the Vultr API.
+	// +optional
+	TLSConfig *v1.SafeTLSConfig `json:"tlsConfig,omitempty"`
+	// Configure whether HTTP requests follow HTTP 3xx redirects.
+	// +optional
+	FollowRedirects *bool `json:"followRedirects,omitempty"`
+	// Whether to enable HTTP2.
+	// +optional
+	EnableHTTP2 *bool `json:"enableHTTP2,omitempty"`
+}
+
+// MarathonSDConfig configurations allow retrieving scrape targets from Marathon applications.
+// See https://prometheus.io/docs/prometheus/latest/configuration/configuration/#marathon_sd_config
+type MarathonSDConfig struct {
+	// List of URLs to be used to contact Marathon servers.
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:items:Pattern:="^http(s)?://.+$"
+	// +listType=set
+	// +required
+	Servers []string `json:"servers"`
+	// Refresh interval to re-read the list of applications.
+	// +optional
+	RefreshInterval *v1.Duration `json:"refreshInterval,omitempty"`
+	// The secret's key that contains the authentication token sent to
+	// Marathon as the `Authorization: token <token>` header.
+	// Cannot be set at the same time as `basicAuth`, `authorization` or `oauth2`.
+	// +optional
+	AuthToken *corev1.SecretKeySelector `json:"authToken,omitempty"`
+	// Optional HTTP basic authentication information.
+	// Cannot be set at the same time as `authToken`, `authorization` or `oauth2`.
+	// +optional
+	BasicAuth *v1.BasicAuth `json:"basicAuth,omitempty"`
+	// Optional Authorization header configuration.
+	// Cannot be set at the same time as `authToken`, `basicAuth` or `oauth2`.
+	// +optional
+	Authorization *v1.SafeAuthorization `json:"authorization,omitempty"`
+	// Optional OAuth 2.0 configuration.
+	// Cannot be set at the same time as `authToken`, `basicAuth` or `authorization`.
+	// +optional
+	OAuth2 *v1.OAuth2 `json:"oauth2,omitempty"`
+	// +optional
+	v1.ProxyConfig `json:",inline"`
+	// TLS configuration to use when connecting to the Marathon servers.
+	// +optional
+	TLSConfig *v1.SafeTLSConfig `json:"tlsConfig,omitempty"`
+	// Configure whether HTTP requests follow HTTP 3xx redirects.
+	// +optional
+	FollowRedirects *bool `json:"followRedirects,omitempty"`
+	// Whether to enable HTTP2.
+	// +optional
+	EnableHTTP2 *bool `json:"enableHTTP2,omitempty"`
+}
+
+// UyuniSDConfig configurations allow retrieving scrape targets from Uyuni (and SUSE Manager) servers.
+// See https://prometheus.io/docs/prometheus/latest/configuration/configuration/#uyuni_sd_config
+type UyuniSDConfig struct {
+	// The URL of the Uyuni server.
+	// +kubebuilder:validation:MinLength:=1
+	// +kubebuilder:validation:Pattern:="^http(s)?://.+$"
+	// +required
+	Server string `json:"server"`
+	// The username used to authenticate against the Uyuni API.
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	Username string `json:"username"`
+	// The secret's key that contains the password used to authenticate
+	// against the Uyuni API.
+	// +required
+	Password corev1.SecretKeySelector `json:"password"`
+	// The entitlement string to filter eligible systems.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	Entitlement *string `json:"entitlement,omitempty"`
+	// The string by which Uyuni group names are joined into the groups label.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	Separator *string `json:"separator,omitempty"`
+	// Refresh interval to re-read the list of systems.
+	// +optional
+	RefreshInterval *v1.Duration `json:"refreshInterval,omitempty"`
+	// Optional Authorization header configuration.
+	// Cannot be set at the same time as `oauth2`.
+	// +optional
+	Authorization *v1.SafeAuthorization `json:"authorization,omitempty"`
+	// Optional OAuth 2.0 configuration.
+	// Cannot be set at the same time as `authorization`.
+	// +optional
+	OAuth2 *v1.OAuth2 `json:"oauth2,omitempty"`
+	// +optional
+	v1.ProxyConfig `json:",inline"`
+	// TLS configuration to use when connecting to the Uyuni server.
+	// +optional
+	TLSConfig *v1.SafeTLSConfig `json:"tlsConfig,omitempty"`
+	// Configure whether HTTP requests follow HTTP 3xx redirects.
+	// +optional
+	FollowRedirects *bool `json:"followRedirects,omitempty"`
+	// Whether to enable HTTP2.
+	// +optional
+	EnableHTTP2 *bool `json:"enableHTTP2,omitempty"`
+}
+
+// ServersetSDConfig configurations allow retrieving scrape targets from
+// Serversets stored in Zookeeper.
+// See https://prometheus.io/docs/prometheus/latest/configuration/configuration/#serverset_sd_config
+type ServersetSDConfig struct {
+	// The Zookeeper servers.
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:items:MinLength=1
+	// +listType=set
+	// +required
+	Servers []string `json:"servers"`
+	// Paths can point to a single serverset, or the root of a tree of serversets.
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:items:Pattern:="^/.*$"
+	// +listType=set
+	// +required
+	Paths []string `json:"paths"`
+	// The Zookeeper session timeout.
+	// +optional
+	Timeout *v1.Duration `json:"timeout,omitempty"`
+}
+
+// NerveSDConfig configurations allow retrieving scrape targets from AirBnB's
+// Nerve which are stored in Zookeeper.
+// See https://prometheus.io/docs/prometheus/latest/configuration/configuration/#nerve_sd_config
+type NerveSDConfig struct {
+	// The Zookeeper servers.
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:items:MinLength=1
+	// +listType=set
+	// +required
+	Servers []string `json:"servers"`
+	// Paths can point to a single service, or the root of a tree of services.
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:items:Pattern:="^/.*$"
+	// +listType=set
+	// +required
+	Paths []string `json:"paths"`
+	// The Zookeeper session timeout.
+	// +optional
+	Timeout *v1.Duration `json:"timeout,omitempty"`
+}
@@ -0,0 +1,153 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+
+	v1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+)
+
+// ThanosComponentSpec defines the fields shared by the Thanos components
+// (querier, store, ...) managed by the operator.
+// +k8s:openapi-gen=true
+type ThanosComponentSpec struct {
+	// Version of Thanos to be deployed.
+	// +optional
+	Version *string `json:"version,omitempty"`
+
+	// Thanos container image URL.
+	// +optional
+	Image *string `json:"image,omitempty"`
+
+	// Image pull policy for the 'thanos' container.
+	// See https://kubernetes.io/docs/concepts/containers/images/#image-pull-policy for more details.
+	// +kubebuilder:validation:Enum="";Always;Never;IfNotPresent
+	// +optional
+	ImagePullPolicy corev1.PullPolicy `json:"imagePullPolicy,omitempty"`
+
+	// An optional list of references to secrets in the same namespace
+	// to use for pulling thanos images from registries
+	// see http://kubernetes.io/docs/user-guide/images#specifying-imagepullsecrets-on-a-pod
+	// +optional
+	ImagePullSecrets []corev1.LocalObjectReference `json:"imagePullSecrets,omitempty"`
+
+	// When a Thanos component is paused, no actions except for deletion
+	// will be performed on the underlying objects.
+	// +optional
+	Paused bool `json:"paused,omitempty"`
+
+	// PodMetadata configures labels and annotations which are propagated to
+	// the pods.
+	//
+	// The "app.kubernetes.io/name", "app.kubernetes.io/managed-by" and
+	// "app.kubernetes.io/instance" labels are reserved and cannot be
+	// overridden.
+	// +optional
+	PodMetadata *v1.EmbeddedObjectMetadata `json:"podMetadata,omitempty"`
+
+	// Define which Nodes the Pods are scheduled on.
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+
+	// Resources defines the resource requirements for the Thanos container.
+	// +optional
+	Resources corev1.ResourceRequirements `json:"resources,omitempty"`
+
+	// If specified, the pod's scheduling constraints.
+	// +optional
+	Affinity *corev1.Affinity `json:"affinity,omitempty"`
+
+	// If specified, the pod's tolerations.
+	// +optional
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+
+	// If specified, the pod's topology spread constraints.
+	// +optional
+	TopologySpreadConstraints []corev1.TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
+
+	// SecurityContext holds pod-level security attributes and common container settings.
+	// +optional
+	SecurityContext *corev1.PodSecurityContext `json:"securityContext,omitempty"`
+
+	// Priority class assigned to the Pods.
+	// +optional
+	PriorityClassName string `json:"priorityClassName,omitempty"`
+
+	// ServiceAccountName is the name of the ServiceAccount to use to run the
+	// Pods.
+	// +optional
+	ServiceAccountName string `json:"serviceAccountName,omitempty"`
+
+	// Volumes allows configuration of additional volumes on the output
+	// StatefulSet definition.
+	// +optional
+	Volumes []corev1.Volume `json:"volumes,omitempty"`
+
+	// VolumeMounts allows configuration of additional VolumeMounts on the
+	// Thanos container.
+	// +optional
+	VolumeMounts []corev1.VolumeMount `json:"volumeMounts,omitempty"`
+
+	// Containers allows injecting additional containers or modifying
+	// operator generated containers. Containers described here modify an
+	// operator generated container if they share the same name and
+	// modifications are done via a strategic merge patch.
+	// +optional
+	Containers []corev1.Container `json:"containers,omitempty"`
+
+	// Log level for the Thanos container.
+	// +kubebuilder:validation:Enum="";debug;info;warn;error
+	// +optional
+	LogLevel string `json:"logLevel,omitempty"`
+
+	// Log format for the Thanos container.
+	// +kubebuilder:validation:Enum="";logfmt;json
+	// +optional
+	LogFormat string `json:"logFormat,omitempty"`
+
+	// AdditionalArgs allows setting additional arguments for the Thanos
+	// container. The arguments are passed as-is to the container which may
+	// cause issues if they are invalid or not supported by the given Thanos
+	// version.
+	// In case of an argument conflict (e.g. an argument which is already set
+	// by the operator itself) the reconciliation will fail.
+	// +optional
+	AdditionalArgs []v1.Argument `json:"additionalArgs,omitempty"`
+}
+
+// ThanosComponentStatus is the most recent observed status of a Thanos
+// component managed by the operator.
+// +k8s:openapi-gen=true
+type ThanosComponentStatus struct {
+	// Represents whether any actions on the underlying managed objects are
+	// being performed. Only delete actions will be performed.
+	Paused bool `json:"paused"`
+	// Total number of non-terminated pods targeted by this resource (their
+	// labels match the selector).
+	Replicas int32 `json:"replicas"`
+	// Total number of non-terminated pods targeted by this resource that have
+	// the desired version spec.
+	UpdatedReplicas int32 `json:"updatedReplicas"`
+	// Total number of available pods targeted by this resource.
+	AvailableReplicas int32 `json:"availableReplicas"`
+	// Total number of unavailable pods targeted by this resource.
+	UnavailableReplicas int32 `json:"unavailableReplicas"`
+	// The current state of the resource.
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []v1.Condition `json:"conditions,omitempty"`
+}
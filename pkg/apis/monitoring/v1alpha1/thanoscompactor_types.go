@@ -0,0 +1,152 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	v1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+)
+
+const (
+	ThanosCompactorKind    = "ThanosCompactor"
+	ThanosCompactorName    = "thanoscompactors"
+	ThanosCompactorKindKey = "thanoscompactor"
+)
+
+// +genclient
+// +k8s:openapi-gen=true
+// +kubebuilder:resource:categories="prometheus-operator",shortName="thc"
+// +kubebuilder:printcolumn:name="Version",type="string",JSONPath=".spec.version",description="The version of Thanos"
+// +kubebuilder:printcolumn:name="Ready",type="integer",JSONPath=".status.availableReplicas",description="The number of ready replicas"
+// +kubebuilder:printcolumn:name="Reconciled",type="string",JSONPath=".status.conditions[?(@.type == 'Reconciled')].status"
+// +kubebuilder:printcolumn:name="Available",type="string",JSONPath=".status.conditions[?(@.type == 'Available')].status"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="Paused",type="boolean",JSONPath=".status.paused",description="Whether the resource reconciliation is paused or not",priority=1
+// +kubebuilder:subresource:status
+
+// The `ThanosCompactor` custom resource definition (CRD) defines a desired
+// [Thanos Compactor](https://thanos.io/tip/components/compact.md/) setup to
+// run in a Kubernetes cluster.
+//
+// The compactor runs as a singleton: only one instance should operate on a
+// given object storage bucket at a time.
+type ThanosCompactor struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// Specification of the desired behavior of the ThanosCompactor. More info:
+	// https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#spec-and-status
+	Spec ThanosCompactorSpec `json:"spec"`
+	// Most recent observed status of the ThanosCompactor. Read-only.
+	// More info:
+	// https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#spec-and-status
+	// +optional
+	Status ThanosComponentStatus `json:"status,omitempty"`
+}
+
+// DeepCopyObject implements the runtime.Object interface.
+func (l *ThanosCompactor) DeepCopyObject() runtime.Object {
+	return l.DeepCopy()
+}
+
+// ExpectedReplicas always returns 1 since the compactor is a singleton.
+func (l *ThanosCompactor) ExpectedReplicas() int { return 1 }
+
+func (l *ThanosCompactor) SetReplicas(i int)            { l.Status.Replicas = int32(i) }
+func (l *ThanosCompactor) SetUpdatedReplicas(i int)     { l.Status.UpdatedReplicas = int32(i) }
+func (l *ThanosCompactor) SetAvailableReplicas(i int)   { l.Status.AvailableReplicas = int32(i) }
+func (l *ThanosCompactor) SetUnavailableReplicas(i int) { l.Status.UnavailableReplicas = int32(i) }
+
+// ThanosCompactorList is a list of ThanosCompactors.
+// +k8s:openapi-gen=true
+type ThanosCompactorList struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard list metadata
+	// More info: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#metadata
+	metav1.ListMeta `json:"metadata,omitempty"`
+	// List of ThanosCompactors
+	Items []ThanosCompactor `json:"items"`
+}
+
+// DeepCopyObject implements the runtime.Object interface.
+func (l *ThanosCompactorList) DeepCopyObject() runtime.Object {
+	return l.DeepCopy()
+}
+
+// ThanosCompactorSpec is a specification of the desired behavior of the
+// Thanos Compactor.
+// +k8s:openapi-gen=true
+type ThanosCompactorSpec struct {
+	ThanosComponentSpec `json:",inline"`
+
+	// Secret key containing the object storage configuration.
+	//
+	// It is the same secret reference as the one used by the Thanos sidecar
+	// (`spec.thanos.objectStorageConfig` of the Prometheus resource).
+	//
+	// The configuration format is defined at https://thanos.io/tip/thanos/storage.md/#configuring-access-to-object-storage
+	// +required
+	ObjectStorageConfig corev1.SecretKeySelector `json:"objectStorageConfig"`
+
+	// Storage defines the volume used by the compactor to store the
+	// temporary data.
+	// A persistent volume is recommended to avoid downloading the blocks
+	// again after a restart.
+	// When not defined, an `emptyDir` volume is used.
+	// +optional
+	Storage *v1.StorageSpec `json:"storage,omitempty"`
+
+	// Retention defines how long the blocks are kept in the bucket for each
+	// resolution.
+	// +optional
+	Retention *ThanosCompactorRetention `json:"retention,omitempty"`
+
+	// When true, the compactor doesn't downsample the blocks.
+	//
+	// Disabling the downsampling is only recommended when the retention of
+	// the 5m and 1h resolutions is set to the raw retention.
+	// +optional
+	DisableDownsampling *bool `json:"disableDownsampling,omitempty"`
+
+	// Labels used to deduplicate the blocks produced by replicated sources
+	// (offline deduplication).
+	//
+	// When not defined, the operator uses the replica label names
+	// (`spec.replicaExternalLabelName`) of the Prometheus resources in the
+	// same namespace which upload blocks with the same object storage
+	// configuration (`spec.thanos.objectStorageConfig`).
+	// +listType=set
+	// +optional
+	ReplicaLabels []string `json:"replicaLabels,omitempty"`
+}
+
+// ThanosCompactorRetention defines the retention of the blocks for each
+// resolution.
+//
+// A value of `0` means that the blocks are kept forever.
+// +k8s:openapi-gen=true
+type ThanosCompactorRetention struct {
+	// Retention of the raw samples.
+	// +optional
+	Raw *v1.Duration `json:"raw,omitempty"`
+	// Retention of the samples downsampled to 5 minutes.
+	// +optional
+	FiveMinutes *v1.Duration `json:"fiveMinutes,omitempty"`
+	// Retention of the samples downsampled to 1 hour.
+	// +optional
+	OneHour *v1.Duration `json:"oneHour,omitempty"`
+}
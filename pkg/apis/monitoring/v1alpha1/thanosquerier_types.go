@@ -0,0 +1,157 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	ThanosQuerierKind    = "ThanosQuerier"
+	ThanosQuerierName    = "thanosqueriers"
+	ThanosQuerierKindKey = "thanosquerier"
+)
+
+// +genclient
+// +k8s:openapi-gen=true
+// +kubebuilder:resource:categories="prometheus-operator",shortName="thq"
+// +kubebuilder:printcolumn:name="Version",type="string",JSONPath=".spec.version",description="The version of Thanos"
+// +kubebuilder:printcolumn:name="Replicas",type="integer",JSONPath=".spec.replicas",description="The number of desired replicas"
+// +kubebuilder:printcolumn:name="Ready",type="integer",JSONPath=".status.availableReplicas",description="The number of ready replicas"
+// +kubebuilder:printcolumn:name="Reconciled",type="string",JSONPath=".status.conditions[?(@.type == 'Reconciled')].status"
+// +kubebuilder:printcolumn:name="Available",type="string",JSONPath=".status.conditions[?(@.type == 'Available')].status"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="Paused",type="boolean",JSONPath=".status.paused",description="Whether the resource reconciliation is paused or not",priority=1
+// +kubebuilder:subresource:status
+
+// The `ThanosQuerier` custom resource definition (CRD) defines a desired
+// [Thanos Querier](https://thanos.io/tip/components/query.md/) setup to run
+// in a Kubernetes cluster.
+//
+// The store API endpoints of the querier are discovered from the Prometheus
+// resources running the Thanos sidecar, the ThanosRuler and the ThanosStore
+// resources selected via label and namespace selectors.
+type ThanosQuerier struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// Specification of the desired behavior of the ThanosQuerier. More info:
+	// https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#spec-and-status
+	Spec ThanosQuerierSpec `json:"spec"`
+	// Most recent observed status of the ThanosQuerier. Read-only.
+	// More info:
+	// https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#spec-and-status
+	// +optional
+	Status ThanosComponentStatus `json:"status,omitempty"`
+}
+
+// DeepCopyObject implements the runtime.Object interface.
+func (l *ThanosQuerier) DeepCopyObject() runtime.Object {
+	return l.DeepCopy()
+}
+
+func (l *ThanosQuerier) ExpectedReplicas() int {
+	if l.Spec.Replicas == nil {
+		return 1
+	}
+	return int(*l.Spec.Replicas)
+}
+
+func (l *ThanosQuerier) SetReplicas(i int)            { l.Status.Replicas = int32(i) }
+func (l *ThanosQuerier) SetUpdatedReplicas(i int)     { l.Status.UpdatedReplicas = int32(i) }
+func (l *ThanosQuerier) SetAvailableReplicas(i int)   { l.Status.AvailableReplicas = int32(i) }
+func (l *ThanosQuerier) SetUnavailableReplicas(i int) { l.Status.UnavailableReplicas = int32(i) }
+
+// ThanosQuerierList is a list of ThanosQueriers.
+// +k8s:openapi-gen=true
+type ThanosQuerierList struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard list metadata
+	// More info: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#metadata
+	metav1.ListMeta `json:"metadata,omitempty"`
+	// List of ThanosQueriers
+	Items []ThanosQuerier `json:"items"`
+}
+
+// DeepCopyObject implements the runtime.Object interface.
+func (l *ThanosQuerierList) DeepCopyObject() runtime.Object {
+	return l.DeepCopy()
+}
+
+// ThanosQuerierSpec is a specification of the desired behavior of the
+// Thanos Querier.
+// +k8s:openapi-gen=true
+type ThanosQuerierSpec struct {
+	ThanosComponentSpec `json:",inline"`
+
+	// Number of Thanos Querier instances to deploy.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	Replicas *int32 `json:"replicas,omitempty"`
+
+	// Labels used to deduplicate the series coming from replicated
+	// sources.
+	//
+	// When not defined, the operator uses `prometheus_replica` and
+	// `thanos_ruler_replica` which are the replica labels set by the operator
+	// for Prometheus and ThanosRuler.
+	// +listType=set
+	// +optional
+	ReplicaLabels []string `json:"replicaLabels,omitempty"`
+
+	// Selector to select the Prometheus resources whose Thanos sidecar
+	// should be added as store API endpoints.
+	//
+	// Prometheus resources without the Thanos sidecar (`spec.thanos`) are
+	// ignored. When nil, no Prometheus resource is selected.
+	// +optional
+	PrometheusSelector *metav1.LabelSelector `json:"prometheusSelector,omitempty"`
+
+	// Namespaces to match for Prometheus discovery. When nil, only the
+	// namespace of the ThanosQuerier object is used.
+	// +optional
+	PrometheusNamespaceSelector *metav1.LabelSelector `json:"prometheusNamespaceSelector,omitempty"`
+
+	// Selector to select the ThanosRuler resources which should be added as
+	// store API endpoints.
+	//
+	// When nil, no ThanosRuler resource is selected.
+	// +optional
+	ThanosRulerSelector *metav1.LabelSelector `json:"thanosRulerSelector,omitempty"`
+
+	// Namespaces to match for ThanosRuler discovery. When nil, only the
+	// namespace of the ThanosQuerier object is used.
+	// +optional
+	ThanosRulerNamespaceSelector *metav1.LabelSelector `json:"thanosRulerNamespaceSelector,omitempty"`
+
+	// Selector to select the ThanosStore resources which should be added as
+	// store API endpoints.
+	//
+	// When nil, no ThanosStore resource is selected.
+	// +optional
+	ThanosStoreSelector *metav1.LabelSelector `json:"thanosStoreSelector,omitempty"`
+
+	// Namespaces to match for ThanosStore discovery. When nil, only the
+	// namespace of the ThanosQuerier object is used.
+	// +optional
+	ThanosStoreNamespaceSelector *metav1.LabelSelector `json:"thanosStoreNamespaceSelector,omitempty"`
+
+	// Additional store API endpoints which aren't managed by the operator.
+	//
+	// The values are passed as-is to the `--endpoint` flag.
+	// +listType=set
+	// +optional
+	Endpoints []string `json:"endpoints,omitempty"`
+}
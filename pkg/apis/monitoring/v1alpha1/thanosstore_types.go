@@ -0,0 +1,131 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	v1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+)
+
+const (
+	ThanosStoreKind    = "ThanosStore"
+	ThanosStoreName    = "thanosstores"
+	ThanosStoreKindKey = "thanosstore"
+)
+
+// +genclient
+// +k8s:openapi-gen=true
+// +kubebuilder:resource:categories="prometheus-operator",shortName="ths"
+// +kubebuilder:printcolumn:name="Version",type="string",JSONPath=".spec.version",description="The version of Thanos"
+// +kubebuilder:printcolumn:name="Replicas",type="integer",JSONPath=".spec.replicas",description="The number of desired replicas"
+// +kubebuilder:printcolumn:name="Ready",type="integer",JSONPath=".status.availableReplicas",description="The number of ready replicas"
+// +kubebuilder:printcolumn:name="Reconciled",type="string",JSONPath=".status.conditions[?(@.type == 'Reconciled')].status"
+// +kubebuilder:printcolumn:name="Available",type="string",JSONPath=".status.conditions[?(@.type == 'Available')].status"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="Paused",type="boolean",JSONPath=".status.paused",description="Whether the resource reconciliation is paused or not",priority=1
+// +kubebuilder:subresource:status
+
+// The `ThanosStore` custom resource definition (CRD) defines a desired
+// [Thanos Store Gateway](https://thanos.io/tip/components/store.md/) setup
+// to run in a Kubernetes cluster.
+//
+// The store gateway exposes the metrics stored in an object storage bucket
+// via the Thanos store API.
+type ThanosStore struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// Specification of the desired behavior of the ThanosStore. More info:
+	// https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#spec-and-status
+	Spec ThanosStoreSpec `json:"spec"`
+	// Most recent observed status of the ThanosStore. Read-only.
+	// More info:
+	// https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#spec-and-status
+	// +optional
+	Status ThanosComponentStatus `json:"status,omitempty"`
+}
+
+// DeepCopyObject implements the runtime.Object interface.
+func (l *ThanosStore) DeepCopyObject() runtime.Object {
+	return l.DeepCopy()
+}
+
+func (l *ThanosStore) ExpectedReplicas() int {
+	if l.Spec.Replicas == nil {
+		return 1
+	}
+	return int(*l.Spec.Replicas)
+}
+
+func (l *ThanosStore) SetReplicas(i int)            { l.Status.Replicas = int32(i) }
+func (l *ThanosStore) SetUpdatedReplicas(i int)     { l.Status.UpdatedReplicas = int32(i) }
+func (l *ThanosStore) SetAvailableReplicas(i int)   { l.Status.AvailableReplicas = int32(i) }
+func (l *ThanosStore) SetUnavailableReplicas(i int) { l.Status.UnavailableReplicas = int32(i) }
+
+// ThanosStoreList is a list of ThanosStores.
+// +k8s:openapi-gen=true
+type ThanosStoreList struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard list metadata
+	// More info: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#metadata
+	metav1.ListMeta `json:"metadata,omitempty"`
+	// List of ThanosStores
+	Items []ThanosStore `json:"items"`
+}
+
+// DeepCopyObject implements the runtime.Object interface.
+func (l *ThanosStoreList) DeepCopyObject() runtime.Object {
+	return l.DeepCopy()
+}
+
+// ThanosStoreSpec is a specification of the desired behavior of the Thanos
+// Store Gateway.
+// +k8s:openapi-gen=true
+type ThanosStoreSpec struct {
+	ThanosComponentSpec `json:",inline"`
+
+	// Number of Thanos Store instances to deploy.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	Replicas *int32 `json:"replicas,omitempty"`
+
+	// Secret key containing the object storage configuration.
+	//
+	// The configuration format is defined at https://thanos.io/tip/thanos/storage.md/#configuring-access-to-object-storage
+	// +required
+	ObjectStorageConfig corev1.SecretKeySelector `json:"objectStorageConfig"`
+
+	// Storage defines the volume used to cache the index headers and the
+	// blocks metadata.
+	// When not defined, an `emptyDir` volume is used.
+	// +optional
+	Storage *v1.StorageSpec `json:"storage,omitempty"`
+
+	// Start of the time range served by the store gateway.
+	// It can be either a RFC3339 timestamp or a duration relative to the
+	// current time (e.g. `-2w`).
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	MinTime *string `json:"minTime,omitempty"`
+
+	// End of the time range served by the store gateway.
+	// It can be either a RFC3339 timestamp or a duration relative to the
+	// current time (e.g. `-2w`).
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	MaxTime *string `json:"maxTime,omitempty"`
+}
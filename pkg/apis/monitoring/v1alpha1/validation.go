@@ -20,6 +20,7 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
 )
 
 func (hc *HTTPConfig) Validate() error {
@@ -101,6 +102,34 @@ func (mti MuteTimeInterval) Validate() error {
 			}
 		}
 	}
+
+	if err := mti.Calendar.Validate(); err != nil {
+		return fmt.Errorf("calendar is invalid: %w", err)
+	}
+
+	return nil
+}
+
+// Validate the CalendarSource.
+func (cs *CalendarSource) Validate() error {
+	if cs == nil {
+		return nil
+	}
+
+	if cs.ConfigMap.Name == "" || cs.ConfigMap.Key == "" {
+		return errors.New("configMap name and key are required")
+	}
+
+	if cs.Days != nil && (*cs.Days < 1 || *cs.Days > 366) {
+		return fmt.Errorf("days must be between 1 and 366, got %d", *cs.Days)
+	}
+
+	if cs.TimeZone != nil {
+		if _, err := time.LoadLocation(*cs.TimeZone); err != nil {
+			return fmt.Errorf("invalid time zone %q: %w", *cs.TimeZone, err)
+		}
+	}
+
 	return nil
 }
 
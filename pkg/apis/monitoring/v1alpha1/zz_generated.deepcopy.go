@@ -19,9 +19,10 @@
 package v1alpha1
 
 import (
-	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	corev1 "k8s.io/api/core/v1"
-	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
@@ -30,6 +31,7 @@ func (in *AlertmanagerConfig) DeepCopyInto(out *AlertmanagerConfig) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertmanagerConfig.
@@ -42,6 +44,28 @@ func (in *AlertmanagerConfig) DeepCopy() *AlertmanagerConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertmanagerConfigBinding) DeepCopyInto(out *AlertmanagerConfigBinding) {
+	*out = *in
+	if in.Receivers != nil {
+		in, out := &in.Receivers, &out.Receivers
+		*out = make([]ReceiverStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertmanagerConfigBinding.
+func (in *AlertmanagerConfigBinding) DeepCopy() *AlertmanagerConfigBinding {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertmanagerConfigBinding)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AlertmanagerConfigList) DeepCopyInto(out *AlertmanagerConfigList) {
 	*out = *in
@@ -95,6 +119,11 @@ func (in *AlertmanagerConfigSpec) DeepCopyInto(out *AlertmanagerConfigSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Templates != nil {
+		in, out := &in.Templates, &out.Templates
+		*out = make([]NotificationTemplate, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertmanagerConfigSpec.
@@ -107,6 +136,144 @@ func (in *AlertmanagerConfigSpec) DeepCopy() *AlertmanagerConfigSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertmanagerConfigStatus) DeepCopyInto(out *AlertmanagerConfigStatus) {
+	*out = *in
+	if in.Alertmanagers != nil {
+		in, out := &in.Alertmanagers, &out.Alertmanagers
+		*out = make([]AlertmanagerConfigBinding, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertmanagerConfigStatus.
+func (in *AlertmanagerConfigStatus) DeepCopy() *AlertmanagerConfigStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertmanagerConfigStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertmanagerSilence) DeepCopyInto(out *AlertmanagerSilence) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertmanagerSilence.
+func (in *AlertmanagerSilence) DeepCopy() *AlertmanagerSilence {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertmanagerSilence)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertmanagerSilenceBinding) DeepCopyInto(out *AlertmanagerSilenceBinding) {
+	*out = *in
+	if in.EndsAt != nil {
+		in, out := &in.EndsAt, &out.EndsAt
+		*out = (*in).DeepCopy()
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.ConfigResourceCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertmanagerSilenceBinding.
+func (in *AlertmanagerSilenceBinding) DeepCopy() *AlertmanagerSilenceBinding {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertmanagerSilenceBinding)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertmanagerSilenceList) DeepCopyInto(out *AlertmanagerSilenceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AlertmanagerSilence, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertmanagerSilenceList.
+func (in *AlertmanagerSilenceList) DeepCopy() *AlertmanagerSilenceList {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertmanagerSilenceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertmanagerSilenceSpec) DeepCopyInto(out *AlertmanagerSilenceSpec) {
+	*out = *in
+	if in.Matchers != nil {
+		in, out := &in.Matchers, &out.Matchers
+		*out = make([]Matcher, len(*in))
+		copy(*out, *in)
+	}
+	if in.StartsAt != nil {
+		in, out := &in.StartsAt, &out.StartsAt
+		*out = (*in).DeepCopy()
+	}
+	in.EndsAt.DeepCopyInto(&out.EndsAt)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertmanagerSilenceSpec.
+func (in *AlertmanagerSilenceSpec) DeepCopy() *AlertmanagerSilenceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertmanagerSilenceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertmanagerSilenceStatus) DeepCopyInto(out *AlertmanagerSilenceStatus) {
+	*out = *in
+	if in.Alertmanagers != nil {
+		in, out := &in.Alertmanagers, &out.Alertmanagers
+		*out = make([]AlertmanagerSilenceBinding, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertmanagerSilenceStatus.
+func (in *AlertmanagerSilenceStatus) DeepCopy() *AlertmanagerSilenceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertmanagerSilenceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AttachMetadata) DeepCopyInto(out *AttachMetadata) {
 	*out = *in
@@ -162,7 +329,7 @@ func (in *AzureSDConfig) DeepCopyInto(out *AzureSDConfig) {
 	}
 	if in.RefreshInterval != nil {
 		in, out := &in.RefreshInterval, &out.RefreshInterval
-		*out = new(monitoringv1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 	if in.Port != nil {
@@ -172,17 +339,17 @@ func (in *AzureSDConfig) DeepCopyInto(out *AzureSDConfig) {
 	}
 	if in.BasicAuth != nil {
 		in, out := &in.BasicAuth, &out.BasicAuth
-		*out = new(monitoringv1.BasicAuth)
+		*out = new(v1.BasicAuth)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.Authorization != nil {
 		in, out := &in.Authorization, &out.Authorization
-		*out = new(monitoringv1.SafeAuthorization)
+		*out = new(v1.SafeAuthorization)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.OAuth2 != nil {
 		in, out := &in.OAuth2, &out.OAuth2
-		*out = new(monitoringv1.OAuth2)
+		*out = new(v1.OAuth2)
 		(*in).DeepCopyInto(*out)
 	}
 	in.ProxyConfig.DeepCopyInto(&out.ProxyConfig)
@@ -198,7 +365,7 @@ func (in *AzureSDConfig) DeepCopyInto(out *AzureSDConfig) {
 	}
 	if in.TLSConfig != nil {
 		in, out := &in.TLSConfig, &out.TLSConfig
-		*out = new(monitoringv1.SafeTLSConfig)
+		*out = new(v1.SafeTLSConfig)
 		(*in).DeepCopyInto(*out)
 	}
 }
@@ -213,6 +380,74 @@ func (in *AzureSDConfig) DeepCopy() *AzureSDConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CalendarSource) DeepCopyInto(out *CalendarSource) {
+	*out = *in
+	in.ConfigMap.DeepCopyInto(&out.ConfigMap)
+	if in.Days != nil {
+		in, out := &in.Days, &out.Days
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TimeZone != nil {
+		in, out := &in.TimeZone, &out.TimeZone
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CalendarSource.
+func (in *CalendarSource) DeepCopy() *CalendarSource {
+	if in == nil {
+		return nil
+	}
+	out := new(CalendarSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterAlertmanagerConfig) DeepCopyInto(out *ClusterAlertmanagerConfig) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterAlertmanagerConfig.
+func (in *ClusterAlertmanagerConfig) DeepCopy() *ClusterAlertmanagerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterAlertmanagerConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterAlertmanagerConfigList) DeepCopyInto(out *ClusterAlertmanagerConfigList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterAlertmanagerConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterAlertmanagerConfigList.
+func (in *ClusterAlertmanagerConfigList) DeepCopy() *ClusterAlertmanagerConfigList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterAlertmanagerConfigList)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ConsulSDConfig) DeepCopyInto(out *ConsulSDConfig) {
 	*out = *in
@@ -280,22 +515,22 @@ func (in *ConsulSDConfig) DeepCopyInto(out *ConsulSDConfig) {
 	}
 	if in.RefreshInterval != nil {
 		in, out := &in.RefreshInterval, &out.RefreshInterval
-		*out = new(monitoringv1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 	if in.BasicAuth != nil {
 		in, out := &in.BasicAuth, &out.BasicAuth
-		*out = new(monitoringv1.BasicAuth)
+		*out = new(v1.BasicAuth)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.Authorization != nil {
 		in, out := &in.Authorization, &out.Authorization
-		*out = new(monitoringv1.SafeAuthorization)
+		*out = new(v1.SafeAuthorization)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.OAuth2 != nil {
 		in, out := &in.OAuth2, &out.OAuth2
-		*out = new(monitoringv1.OAuth2)
+		*out = new(v1.OAuth2)
 		(*in).DeepCopyInto(*out)
 	}
 	in.ProxyConfig.DeepCopyInto(&out.ProxyConfig)
@@ -311,7 +546,7 @@ func (in *ConsulSDConfig) DeepCopyInto(out *ConsulSDConfig) {
 	}
 	if in.TLSConfig != nil {
 		in, out := &in.TLSConfig, &out.TLSConfig
-		*out = new(monitoringv1.SafeTLSConfig)
+		*out = new(v1.SafeTLSConfig)
 		(*in).DeepCopyInto(*out)
 	}
 }
@@ -336,7 +571,7 @@ func (in *DNSSDConfig) DeepCopyInto(out *DNSSDConfig) {
 	}
 	if in.RefreshInterval != nil {
 		in, out := &in.RefreshInterval, &out.RefreshInterval
-		*out = new(monitoringv1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 	if in.Type != nil {
@@ -381,12 +616,12 @@ func (in *DigitalOceanSDConfig) DeepCopyInto(out *DigitalOceanSDConfig) {
 	*out = *in
 	if in.Authorization != nil {
 		in, out := &in.Authorization, &out.Authorization
-		*out = new(monitoringv1.SafeAuthorization)
+		*out = new(v1.SafeAuthorization)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.OAuth2 != nil {
 		in, out := &in.OAuth2, &out.OAuth2
-		*out = new(monitoringv1.OAuth2)
+		*out = new(v1.OAuth2)
 		(*in).DeepCopyInto(*out)
 	}
 	in.ProxyConfig.DeepCopyInto(&out.ProxyConfig)
@@ -402,7 +637,7 @@ func (in *DigitalOceanSDConfig) DeepCopyInto(out *DigitalOceanSDConfig) {
 	}
 	if in.TLSConfig != nil {
 		in, out := &in.TLSConfig, &out.TLSConfig
-		*out = new(monitoringv1.SafeTLSConfig)
+		*out = new(v1.SafeTLSConfig)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.Port != nil {
@@ -412,7 +647,7 @@ func (in *DigitalOceanSDConfig) DeepCopyInto(out *DigitalOceanSDConfig) {
 	}
 	if in.RefreshInterval != nil {
 		in, out := &in.RefreshInterval, &out.RefreshInterval
-		*out = new(monitoringv1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 }
@@ -484,7 +719,7 @@ func (in *DockerSDConfig) DeepCopyInto(out *DockerSDConfig) {
 	in.ProxyConfig.DeepCopyInto(&out.ProxyConfig)
 	if in.TLSConfig != nil {
 		in, out := &in.TLSConfig, &out.TLSConfig
-		*out = new(monitoringv1.SafeTLSConfig)
+		*out = new(v1.SafeTLSConfig)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.Port != nil {
@@ -511,22 +746,22 @@ func (in *DockerSDConfig) DeepCopyInto(out *DockerSDConfig) {
 	}
 	if in.RefreshInterval != nil {
 		in, out := &in.RefreshInterval, &out.RefreshInterval
-		*out = new(monitoringv1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 	if in.BasicAuth != nil {
 		in, out := &in.BasicAuth, &out.BasicAuth
-		*out = new(monitoringv1.BasicAuth)
+		*out = new(v1.BasicAuth)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.Authorization != nil {
 		in, out := &in.Authorization, &out.Authorization
-		*out = new(monitoringv1.SafeAuthorization)
+		*out = new(v1.SafeAuthorization)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.OAuth2 != nil {
 		in, out := &in.OAuth2, &out.OAuth2
-		*out = new(monitoringv1.OAuth2)
+		*out = new(v1.OAuth2)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.FollowRedirects != nil {
@@ -568,28 +803,28 @@ func (in *DockerSwarmSDConfig) DeepCopyInto(out *DockerSwarmSDConfig) {
 	}
 	if in.RefreshInterval != nil {
 		in, out := &in.RefreshInterval, &out.RefreshInterval
-		*out = new(monitoringv1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 	if in.BasicAuth != nil {
 		in, out := &in.BasicAuth, &out.BasicAuth
-		*out = new(monitoringv1.BasicAuth)
+		*out = new(v1.BasicAuth)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.Authorization != nil {
 		in, out := &in.Authorization, &out.Authorization
-		*out = new(monitoringv1.SafeAuthorization)
+		*out = new(v1.SafeAuthorization)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.OAuth2 != nil {
 		in, out := &in.OAuth2, &out.OAuth2
-		*out = new(monitoringv1.OAuth2)
+		*out = new(v1.OAuth2)
 		(*in).DeepCopyInto(*out)
 	}
 	in.ProxyConfig.DeepCopyInto(&out.ProxyConfig)
 	if in.TLSConfig != nil {
 		in, out := &in.TLSConfig, &out.TLSConfig
-		*out = new(monitoringv1.SafeTLSConfig)
+		*out = new(v1.SafeTLSConfig)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.FollowRedirects != nil {
@@ -644,7 +879,7 @@ func (in *EC2SDConfig) DeepCopyInto(out *EC2SDConfig) {
 	}
 	if in.RefreshInterval != nil {
 		in, out := &in.RefreshInterval, &out.RefreshInterval
-		*out = new(monitoringv1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 	if in.Filters != nil {
@@ -657,7 +892,7 @@ func (in *EC2SDConfig) DeepCopyInto(out *EC2SDConfig) {
 	in.ProxyConfig.DeepCopyInto(&out.ProxyConfig)
 	if in.TLSConfig != nil {
 		in, out := &in.TLSConfig, &out.TLSConfig
-		*out = new(monitoringv1.SafeTLSConfig)
+		*out = new(v1.SafeTLSConfig)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.FollowRedirects != nil {
@@ -722,7 +957,7 @@ func (in *EmailConfig) DeepCopyInto(out *EmailConfig) {
 	}
 	if in.TLSConfig != nil {
 		in, out := &in.TLSConfig, &out.TLSConfig
-		*out = new(monitoringv1.SafeTLSConfig)
+		*out = new(v1.SafeTLSConfig)
 		(*in).DeepCopyInto(*out)
 	}
 }
@@ -742,22 +977,22 @@ func (in *EurekaSDConfig) DeepCopyInto(out *EurekaSDConfig) {
 	*out = *in
 	if in.BasicAuth != nil {
 		in, out := &in.BasicAuth, &out.BasicAuth
-		*out = new(monitoringv1.BasicAuth)
+		*out = new(v1.BasicAuth)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.Authorization != nil {
 		in, out := &in.Authorization, &out.Authorization
-		*out = new(monitoringv1.SafeAuthorization)
+		*out = new(v1.SafeAuthorization)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.OAuth2 != nil {
 		in, out := &in.OAuth2, &out.OAuth2
-		*out = new(monitoringv1.OAuth2)
+		*out = new(v1.OAuth2)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.TLSConfig != nil {
 		in, out := &in.TLSConfig, &out.TLSConfig
-		*out = new(monitoringv1.SafeTLSConfig)
+		*out = new(v1.SafeTLSConfig)
 		(*in).DeepCopyInto(*out)
 	}
 	in.ProxyConfig.DeepCopyInto(&out.ProxyConfig)
@@ -773,7 +1008,7 @@ func (in *EurekaSDConfig) DeepCopyInto(out *EurekaSDConfig) {
 	}
 	if in.RefreshInterval != nil {
 		in, out := &in.RefreshInterval, &out.RefreshInterval
-		*out = new(monitoringv1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 }
@@ -798,7 +1033,7 @@ func (in *FileSDConfig) DeepCopyInto(out *FileSDConfig) {
 	}
 	if in.RefreshInterval != nil {
 		in, out := &in.RefreshInterval, &out.RefreshInterval
-		*out = new(monitoringv1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 }
@@ -864,7 +1099,7 @@ func (in *GCESDConfig) DeepCopyInto(out *GCESDConfig) {
 	}
 	if in.RefreshInterval != nil {
 		in, out := &in.RefreshInterval, &out.RefreshInterval
-		*out = new(monitoringv1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 	if in.Port != nil {
@@ -894,17 +1129,17 @@ func (in *HTTPConfig) DeepCopyInto(out *HTTPConfig) {
 	*out = *in
 	if in.Authorization != nil {
 		in, out := &in.Authorization, &out.Authorization
-		*out = new(monitoringv1.SafeAuthorization)
+		*out = new(v1.SafeAuthorization)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.BasicAuth != nil {
 		in, out := &in.BasicAuth, &out.BasicAuth
-		*out = new(monitoringv1.BasicAuth)
+		*out = new(v1.BasicAuth)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.OAuth2 != nil {
 		in, out := &in.OAuth2, &out.OAuth2
-		*out = new(monitoringv1.OAuth2)
+		*out = new(v1.OAuth2)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.BearerTokenSecret != nil {
@@ -914,7 +1149,7 @@ func (in *HTTPConfig) DeepCopyInto(out *HTTPConfig) {
 	}
 	if in.TLSConfig != nil {
 		in, out := &in.TLSConfig, &out.TLSConfig
-		*out = new(monitoringv1.SafeTLSConfig)
+		*out = new(v1.SafeTLSConfig)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.ProxyURLOriginal != nil {
@@ -945,28 +1180,28 @@ func (in *HTTPSDConfig) DeepCopyInto(out *HTTPSDConfig) {
 	*out = *in
 	if in.RefreshInterval != nil {
 		in, out := &in.RefreshInterval, &out.RefreshInterval
-		*out = new(monitoringv1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 	if in.BasicAuth != nil {
 		in, out := &in.BasicAuth, &out.BasicAuth
-		*out = new(monitoringv1.BasicAuth)
+		*out = new(v1.BasicAuth)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.Authorization != nil {
 		in, out := &in.Authorization, &out.Authorization
-		*out = new(monitoringv1.SafeAuthorization)
+		*out = new(v1.SafeAuthorization)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.OAuth2 != nil {
 		in, out := &in.OAuth2, &out.OAuth2
-		*out = new(monitoringv1.OAuth2)
+		*out = new(v1.OAuth2)
 		(*in).DeepCopyInto(*out)
 	}
 	in.ProxyConfig.DeepCopyInto(&out.ProxyConfig)
 	if in.TLSConfig != nil {
 		in, out := &in.TLSConfig, &out.TLSConfig
-		*out = new(monitoringv1.SafeTLSConfig)
+		*out = new(v1.SafeTLSConfig)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.FollowRedirects != nil {
@@ -996,17 +1231,17 @@ func (in *HetznerSDConfig) DeepCopyInto(out *HetznerSDConfig) {
 	*out = *in
 	if in.BasicAuth != nil {
 		in, out := &in.BasicAuth, &out.BasicAuth
-		*out = new(monitoringv1.BasicAuth)
+		*out = new(v1.BasicAuth)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.Authorization != nil {
 		in, out := &in.Authorization, &out.Authorization
-		*out = new(monitoringv1.SafeAuthorization)
+		*out = new(v1.SafeAuthorization)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.OAuth2 != nil {
 		in, out := &in.OAuth2, &out.OAuth2
-		*out = new(monitoringv1.OAuth2)
+		*out = new(v1.OAuth2)
 		(*in).DeepCopyInto(*out)
 	}
 	in.ProxyConfig.DeepCopyInto(&out.ProxyConfig)
@@ -1022,7 +1257,7 @@ func (in *HetznerSDConfig) DeepCopyInto(out *HetznerSDConfig) {
 	}
 	if in.TLSConfig != nil {
 		in, out := &in.TLSConfig, &out.TLSConfig
-		*out = new(monitoringv1.SafeTLSConfig)
+		*out = new(v1.SafeTLSConfig)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.Port != nil {
@@ -1032,7 +1267,7 @@ func (in *HetznerSDConfig) DeepCopyInto(out *HetznerSDConfig) {
 	}
 	if in.RefreshInterval != nil {
 		in, out := &in.RefreshInterval, &out.RefreshInterval
-		*out = new(monitoringv1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 }
@@ -1087,14 +1322,14 @@ func (in *IonosSDConfig) DeepCopyInto(out *IonosSDConfig) {
 	}
 	if in.RefreshInterval != nil {
 		in, out := &in.RefreshInterval, &out.RefreshInterval
-		*out = new(monitoringv1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 	in.Authorization.DeepCopyInto(&out.Authorization)
 	in.ProxyConfig.DeepCopyInto(&out.ProxyConfig)
 	if in.TLSConfig != nil {
 		in, out := &in.TLSConfig, &out.TLSConfig
-		*out = new(monitoringv1.SafeTLSConfig)
+		*out = new(v1.SafeTLSConfig)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.FollowRedirects != nil {
@@ -1109,7 +1344,7 @@ func (in *IonosSDConfig) DeepCopyInto(out *IonosSDConfig) {
 	}
 	if in.OAuth2 != nil {
 		in, out := &in.OAuth2, &out.OAuth2
-		*out = new(monitoringv1.OAuth2)
+		*out = new(v1.OAuth2)
 		(*in).DeepCopyInto(*out)
 	}
 }
@@ -1124,6 +1359,99 @@ func (in *IonosSDConfig) DeepCopy() *IonosSDConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JiraConfig) DeepCopyInto(out *JiraConfig) {
+	*out = *in
+	if in.SendResolved != nil {
+		in, out := &in.SendResolved, &out.SendResolved
+		*out = new(bool)
+		**out = **in
+	}
+	if in.APIURL != nil {
+		in, out := &in.APIURL, &out.APIURL
+		*out = new(URL)
+		**out = **in
+	}
+	if in.Summary != nil {
+		in, out := &in.Summary, &out.Summary
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Priority != nil {
+		in, out := &in.Priority, &out.Priority
+		*out = new(string)
+		**out = **in
+	}
+	if in.ReopenTransition != nil {
+		in, out := &in.ReopenTransition, &out.ReopenTransition
+		*out = new(string)
+		**out = **in
+	}
+	if in.ResolveTransition != nil {
+		in, out := &in.ResolveTransition, &out.ResolveTransition
+		*out = new(string)
+		**out = **in
+	}
+	if in.WontFixResolution != nil {
+		in, out := &in.WontFixResolution, &out.WontFixResolution
+		*out = new(string)
+		**out = **in
+	}
+	if in.ReopenDuration != nil {
+		in, out := &in.ReopenDuration, &out.ReopenDuration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Fields != nil {
+		in, out := &in.Fields, &out.Fields
+		*out = make([]JiraField, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.HTTPConfig != nil {
+		in, out := &in.HTTPConfig, &out.HTTPConfig
+		*out = new(HTTPConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JiraConfig.
+func (in *JiraConfig) DeepCopy() *JiraConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(JiraConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JiraField) DeepCopyInto(out *JiraField) {
+	*out = *in
+	in.Value.DeepCopyInto(&out.Value)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JiraField.
+func (in *JiraField) DeepCopy() *JiraField {
+	if in == nil {
+		return nil
+	}
+	out := new(JiraField)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *K8SSelectorConfig) DeepCopyInto(out *K8SSelectorConfig) {
 	*out = *in
@@ -1191,17 +1519,17 @@ func (in *KubernetesSDConfig) DeepCopyInto(out *KubernetesSDConfig) {
 	}
 	if in.BasicAuth != nil {
 		in, out := &in.BasicAuth, &out.BasicAuth
-		*out = new(monitoringv1.BasicAuth)
+		*out = new(v1.BasicAuth)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.Authorization != nil {
 		in, out := &in.Authorization, &out.Authorization
-		*out = new(monitoringv1.SafeAuthorization)
+		*out = new(v1.SafeAuthorization)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.OAuth2 != nil {
 		in, out := &in.OAuth2, &out.OAuth2
-		*out = new(monitoringv1.OAuth2)
+		*out = new(v1.OAuth2)
 		(*in).DeepCopyInto(*out)
 	}
 	in.ProxyConfig.DeepCopyInto(&out.ProxyConfig)
@@ -1217,7 +1545,7 @@ func (in *KubernetesSDConfig) DeepCopyInto(out *KubernetesSDConfig) {
 	}
 	if in.TLSConfig != nil {
 		in, out := &in.TLSConfig, &out.TLSConfig
-		*out = new(monitoringv1.SafeTLSConfig)
+		*out = new(v1.SafeTLSConfig)
 		(*in).DeepCopyInto(*out)
 	}
 }
@@ -1242,33 +1570,33 @@ func (in *KumaSDConfig) DeepCopyInto(out *KumaSDConfig) {
 	}
 	if in.RefreshInterval != nil {
 		in, out := &in.RefreshInterval, &out.RefreshInterval
-		*out = new(monitoringv1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 	if in.FetchTimeout != nil {
 		in, out := &in.FetchTimeout, &out.FetchTimeout
-		*out = new(monitoringv1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 	in.ProxyConfig.DeepCopyInto(&out.ProxyConfig)
 	if in.TLSConfig != nil {
 		in, out := &in.TLSConfig, &out.TLSConfig
-		*out = new(monitoringv1.SafeTLSConfig)
+		*out = new(v1.SafeTLSConfig)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.BasicAuth != nil {
 		in, out := &in.BasicAuth, &out.BasicAuth
-		*out = new(monitoringv1.BasicAuth)
+		*out = new(v1.BasicAuth)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.Authorization != nil {
 		in, out := &in.Authorization, &out.Authorization
-		*out = new(monitoringv1.SafeAuthorization)
+		*out = new(v1.SafeAuthorization)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.OAuth2 != nil {
 		in, out := &in.OAuth2, &out.OAuth2
-		*out = new(monitoringv1.OAuth2)
+		*out = new(v1.OAuth2)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.FollowRedirects != nil {
@@ -1323,7 +1651,7 @@ func (in *LightSailSDConfig) DeepCopyInto(out *LightSailSDConfig) {
 	}
 	if in.RefreshInterval != nil {
 		in, out := &in.RefreshInterval, &out.RefreshInterval
-		*out = new(monitoringv1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 	if in.Port != nil {
@@ -1333,23 +1661,23 @@ func (in *LightSailSDConfig) DeepCopyInto(out *LightSailSDConfig) {
 	}
 	if in.BasicAuth != nil {
 		in, out := &in.BasicAuth, &out.BasicAuth
-		*out = new(monitoringv1.BasicAuth)
+		*out = new(v1.BasicAuth)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.Authorization != nil {
 		in, out := &in.Authorization, &out.Authorization
-		*out = new(monitoringv1.SafeAuthorization)
+		*out = new(v1.SafeAuthorization)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.OAuth2 != nil {
 		in, out := &in.OAuth2, &out.OAuth2
-		*out = new(monitoringv1.OAuth2)
+		*out = new(v1.OAuth2)
 		(*in).DeepCopyInto(*out)
 	}
 	in.ProxyConfig.DeepCopyInto(&out.ProxyConfig)
 	if in.TLSConfig != nil {
 		in, out := &in.TLSConfig, &out.TLSConfig
-		*out = new(monitoringv1.SafeTLSConfig)
+		*out = new(v1.SafeTLSConfig)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.FollowRedirects != nil {
@@ -1394,17 +1722,17 @@ func (in *LinodeSDConfig) DeepCopyInto(out *LinodeSDConfig) {
 	}
 	if in.RefreshInterval != nil {
 		in, out := &in.RefreshInterval, &out.RefreshInterval
-		*out = new(monitoringv1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 	if in.Authorization != nil {
 		in, out := &in.Authorization, &out.Authorization
-		*out = new(monitoringv1.SafeAuthorization)
+		*out = new(v1.SafeAuthorization)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.OAuth2 != nil {
 		in, out := &in.OAuth2, &out.OAuth2
-		*out = new(monitoringv1.OAuth2)
+		*out = new(v1.OAuth2)
 		(*in).DeepCopyInto(*out)
 	}
 	in.ProxyConfig.DeepCopyInto(&out.ProxyConfig)
@@ -1415,7 +1743,7 @@ func (in *LinodeSDConfig) DeepCopyInto(out *LinodeSDConfig) {
 	}
 	if in.TLSConfig != nil {
 		in, out := &in.TLSConfig, &out.TLSConfig
-		*out = new(monitoringv1.SafeTLSConfig)
+		*out = new(v1.SafeTLSConfig)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.EnableHTTP2 != nil {
@@ -1517,30 +1845,96 @@ func (in *MSTeamsV2Config) DeepCopy() *MSTeamsV2Config {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Matcher) DeepCopyInto(out *Matcher) {
+func (in *MarathonSDConfig) DeepCopyInto(out *MarathonSDConfig) {
 	*out = *in
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Matcher.
-func (in *Matcher) DeepCopy() *Matcher {
-	if in == nil {
-		return nil
+	if in.Servers != nil {
+		in, out := &in.Servers, &out.Servers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
-	out := new(Matcher)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MuteTimeInterval) DeepCopyInto(out *MuteTimeInterval) {
-	*out = *in
-	if in.TimeIntervals != nil {
-		in, out := &in.TimeIntervals, &out.TimeIntervals
+	if in.RefreshInterval != nil {
+		in, out := &in.RefreshInterval, &out.RefreshInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.AuthToken != nil {
+		in, out := &in.AuthToken, &out.AuthToken
+		*out = new(corev1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BasicAuth != nil {
+		in, out := &in.BasicAuth, &out.BasicAuth
+		*out = new(v1.BasicAuth)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Authorization != nil {
+		in, out := &in.Authorization, &out.Authorization
+		*out = new(v1.SafeAuthorization)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OAuth2 != nil {
+		in, out := &in.OAuth2, &out.OAuth2
+		*out = new(v1.OAuth2)
+		(*in).DeepCopyInto(*out)
+	}
+	in.ProxyConfig.DeepCopyInto(&out.ProxyConfig)
+	if in.TLSConfig != nil {
+		in, out := &in.TLSConfig, &out.TLSConfig
+		*out = new(v1.SafeTLSConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FollowRedirects != nil {
+		in, out := &in.FollowRedirects, &out.FollowRedirects
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EnableHTTP2 != nil {
+		in, out := &in.EnableHTTP2, &out.EnableHTTP2
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MarathonSDConfig.
+func (in *MarathonSDConfig) DeepCopy() *MarathonSDConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(MarathonSDConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Matcher) DeepCopyInto(out *Matcher) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Matcher.
+func (in *Matcher) DeepCopy() *Matcher {
+	if in == nil {
+		return nil
+	}
+	out := new(Matcher)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MuteTimeInterval) DeepCopyInto(out *MuteTimeInterval) {
+	*out = *in
+	if in.TimeIntervals != nil {
+		in, out := &in.TimeIntervals, &out.TimeIntervals
 		*out = make([]TimeInterval, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Calendar != nil {
+		in, out := &in.Calendar, &out.Calendar
+		*out = new(CalendarSource)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MuteTimeInterval.
@@ -1578,6 +1972,36 @@ func (in *NamespaceDiscovery) DeepCopy() *NamespaceDiscovery {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NerveSDConfig) DeepCopyInto(out *NerveSDConfig) {
+	*out = *in
+	if in.Servers != nil {
+		in, out := &in.Servers, &out.Servers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Paths != nil {
+		in, out := &in.Paths, &out.Paths
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NerveSDConfig.
+func (in *NerveSDConfig) DeepCopy() *NerveSDConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(NerveSDConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NomadSDConfig) DeepCopyInto(out *NomadSDConfig) {
 	*out = *in
@@ -1593,7 +2017,7 @@ func (in *NomadSDConfig) DeepCopyInto(out *NomadSDConfig) {
 	}
 	if in.RefreshInterval != nil {
 		in, out := &in.RefreshInterval, &out.RefreshInterval
-		*out = new(monitoringv1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 	if in.Region != nil {
@@ -1608,22 +2032,22 @@ func (in *NomadSDConfig) DeepCopyInto(out *NomadSDConfig) {
 	}
 	if in.BasicAuth != nil {
 		in, out := &in.BasicAuth, &out.BasicAuth
-		*out = new(monitoringv1.BasicAuth)
+		*out = new(v1.BasicAuth)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.Authorization != nil {
 		in, out := &in.Authorization, &out.Authorization
-		*out = new(monitoringv1.SafeAuthorization)
+		*out = new(v1.SafeAuthorization)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.OAuth2 != nil {
 		in, out := &in.OAuth2, &out.OAuth2
-		*out = new(monitoringv1.OAuth2)
+		*out = new(v1.OAuth2)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.TLSConfig != nil {
 		in, out := &in.TLSConfig, &out.TLSConfig
-		*out = new(monitoringv1.SafeTLSConfig)
+		*out = new(v1.SafeTLSConfig)
 		(*in).DeepCopyInto(*out)
 	}
 	in.ProxyConfig.DeepCopyInto(&out.ProxyConfig)
@@ -1649,6 +2073,88 @@ func (in *NomadSDConfig) DeepCopy() *NomadSDConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationTemplate) DeepCopyInto(out *NotificationTemplate) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationTemplate.
+func (in *NotificationTemplate) DeepCopy() *NotificationTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OTLPTenant) DeepCopyInto(out *OTLPTenant) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OTLPTenant.
+func (in *OTLPTenant) DeepCopy() *OTLPTenant {
+	if in == nil {
+		return nil
+	}
+	out := new(OTLPTenant)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OTLPTenantList) DeepCopyInto(out *OTLPTenantList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OTLPTenant, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OTLPTenantList.
+func (in *OTLPTenantList) DeepCopy() *OTLPTenantList {
+	if in == nil {
+		return nil
+	}
+	out := new(OTLPTenantList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OTLPTenantSpec) DeepCopyInto(out *OTLPTenantSpec) {
+	*out = *in
+	if in.PromoteResourceAttributes != nil {
+		in, out := &in.PromoteResourceAttributes, &out.PromoteResourceAttributes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TranslationStrategy != nil {
+		in, out := &in.TranslationStrategy, &out.TranslationStrategy
+		*out = new(v1.TranslationStrategyOption)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OTLPTenantSpec.
+func (in *OTLPTenantSpec) DeepCopy() *OTLPTenantSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OTLPTenantSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OVHCloudSDConfig) DeepCopyInto(out *OVHCloudSDConfig) {
 	*out = *in
@@ -1661,7 +2167,7 @@ func (in *OVHCloudSDConfig) DeepCopyInto(out *OVHCloudSDConfig) {
 	}
 	if in.RefreshInterval != nil {
 		in, out := &in.RefreshInterval, &out.RefreshInterval
-		*out = new(monitoringv1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 }
@@ -1741,7 +2247,7 @@ func (in *OpenStackSDConfig) DeepCopyInto(out *OpenStackSDConfig) {
 	}
 	if in.RefreshInterval != nil {
 		in, out := &in.RefreshInterval, &out.RefreshInterval
-		*out = new(monitoringv1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 	if in.Port != nil {
@@ -1756,7 +2262,7 @@ func (in *OpenStackSDConfig) DeepCopyInto(out *OpenStackSDConfig) {
 	}
 	if in.TLSConfig != nil {
 		in, out := &in.TLSConfig, &out.TLSConfig
-		*out = new(monitoringv1.SafeTLSConfig)
+		*out = new(v1.SafeTLSConfig)
 		(*in).DeepCopyInto(*out)
 	}
 }
@@ -1990,7 +2496,7 @@ func (in *PuppetDBSDConfig) DeepCopyInto(out *PuppetDBSDConfig) {
 	}
 	if in.RefreshInterval != nil {
 		in, out := &in.RefreshInterval, &out.RefreshInterval
-		*out = new(monitoringv1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 	if in.Port != nil {
@@ -2000,23 +2506,23 @@ func (in *PuppetDBSDConfig) DeepCopyInto(out *PuppetDBSDConfig) {
 	}
 	if in.BasicAuth != nil {
 		in, out := &in.BasicAuth, &out.BasicAuth
-		*out = new(monitoringv1.BasicAuth)
+		*out = new(v1.BasicAuth)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.Authorization != nil {
 		in, out := &in.Authorization, &out.Authorization
-		*out = new(monitoringv1.SafeAuthorization)
+		*out = new(v1.SafeAuthorization)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.OAuth2 != nil {
 		in, out := &in.OAuth2, &out.OAuth2
-		*out = new(monitoringv1.OAuth2)
+		*out = new(v1.OAuth2)
 		(*in).DeepCopyInto(*out)
 	}
 	in.ProxyConfig.DeepCopyInto(&out.ProxyConfig)
 	if in.TLSConfig != nil {
 		in, out := &in.TLSConfig, &out.TLSConfig
-		*out = new(monitoringv1.SafeTLSConfig)
+		*out = new(v1.SafeTLSConfig)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.FollowRedirects != nil {
@@ -2071,7 +2577,7 @@ func (in *PushoverConfig) DeepCopyInto(out *PushoverConfig) {
 	}
 	if in.TTL != nil {
 		in, out := &in.TTL, &out.TTL
-		*out = new(monitoringv1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 	if in.Device != nil {
@@ -2197,6 +2703,20 @@ func (in *Receiver) DeepCopyInto(out *Receiver) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.JiraConfigs != nil {
+		in, out := &in.JiraConfigs, &out.JiraConfigs
+		*out = make([]JiraConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.RocketChatConfigs != nil {
+		in, out := &in.RocketChatConfigs, &out.RocketChatConfigs
+		*out = make([]RocketChatConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Receiver.
@@ -2210,100 +2730,325 @@ func (in *Receiver) DeepCopy() *Receiver {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Route) DeepCopyInto(out *Route) {
+func (in *ReceiverStatus) DeepCopyInto(out *ReceiverStatus) {
 	*out = *in
-	if in.GroupBy != nil {
-		in, out := &in.GroupBy, &out.GroupBy
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.Matchers != nil {
-		in, out := &in.Matchers, &out.Matchers
-		*out = make([]Matcher, len(*in))
-		copy(*out, *in)
-	}
-	if in.Routes != nil {
-		in, out := &in.Routes, &out.Routes
-		*out = make([]v1.JSON, len(*in))
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.MuteTimeIntervals != nil {
-		in, out := &in.MuteTimeIntervals, &out.MuteTimeIntervals
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReceiverStatus.
+func (in *ReceiverStatus) DeepCopy() *ReceiverStatus {
+	if in == nil {
+		return nil
 	}
-	if in.ActiveTimeIntervals != nil {
-		in, out := &in.ActiveTimeIntervals, &out.ActiveTimeIntervals
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	out := new(ReceiverStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RemoteWrite) DeepCopyInto(out *RemoteWrite) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RemoteWrite.
+func (in *RemoteWrite) DeepCopy() *RemoteWrite {
+	if in == nil {
+		return nil
 	}
+	out := new(RemoteWrite)
+	in.DeepCopyInto(out)
+	return out
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Route.
-func (in *Route) DeepCopy() *Route {
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RemoteWriteList) DeepCopyInto(out *RemoteWriteList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]RemoteWrite, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RemoteWriteList.
+func (in *RemoteWriteList) DeepCopy() *RemoteWriteList {
 	if in == nil {
 		return nil
 	}
-	out := new(Route)
+	out := new(RemoteWriteList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SNSConfig) DeepCopyInto(out *SNSConfig) {
+func (in *RocketChatActionConfig) DeepCopyInto(out *RocketChatActionConfig) {
 	*out = *in
-	if in.SendResolved != nil {
-		in, out := &in.SendResolved, &out.SendResolved
-		*out = new(bool)
+	if in.Text != nil {
+		in, out := &in.Text, &out.Text
+		*out = new(string)
 		**out = **in
 	}
-	if in.Sigv4 != nil {
-		in, out := &in.Sigv4, &out.Sigv4
-		*out = new(monitoringv1.Sigv4)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Attributes != nil {
-		in, out := &in.Attributes, &out.Attributes
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
+	if in.URL != nil {
+		in, out := &in.URL, &out.URL
+		*out = new(URL)
+		**out = **in
 	}
-	if in.HTTPConfig != nil {
-		in, out := &in.HTTPConfig, &out.HTTPConfig
-		*out = new(HTTPConfig)
-		(*in).DeepCopyInto(*out)
+	if in.Msg != nil {
+		in, out := &in.Msg, &out.Msg
+		*out = new(string)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SNSConfig.
-func (in *SNSConfig) DeepCopy() *SNSConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RocketChatActionConfig.
+func (in *RocketChatActionConfig) DeepCopy() *RocketChatActionConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(SNSConfig)
+	out := new(RocketChatActionConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ScalewaySDConfig) DeepCopyInto(out *ScalewaySDConfig) {
+func (in *RocketChatConfig) DeepCopyInto(out *RocketChatConfig) {
 	*out = *in
-	in.SecretKey.DeepCopyInto(&out.SecretKey)
-	if in.Port != nil {
-		in, out := &in.Port, &out.Port
-		*out = new(int32)
+	if in.SendResolved != nil {
+		in, out := &in.SendResolved, &out.SendResolved
+		*out = new(bool)
 		**out = **in
 	}
-	if in.ApiURL != nil {
-		in, out := &in.ApiURL, &out.ApiURL
+	if in.APIURL != nil {
+		in, out := &in.APIURL, &out.APIURL
+		*out = new(URL)
+		**out = **in
+	}
+	in.TokenID.DeepCopyInto(&out.TokenID)
+	in.Token.DeepCopyInto(&out.Token)
+	if in.Channel != nil {
+		in, out := &in.Channel, &out.Channel
 		*out = new(string)
 		**out = **in
 	}
-	if in.Zone != nil {
-		in, out := &in.Zone, &out.Zone
+	if in.Color != nil {
+		in, out := &in.Color, &out.Color
+		*out = new(string)
+		**out = **in
+	}
+	if in.Title != nil {
+		in, out := &in.Title, &out.Title
+		*out = new(string)
+		**out = **in
+	}
+	if in.TitleLink != nil {
+		in, out := &in.TitleLink, &out.TitleLink
+		*out = new(string)
+		**out = **in
+	}
+	if in.Text != nil {
+		in, out := &in.Text, &out.Text
+		*out = new(string)
+		**out = **in
+	}
+	if in.Fields != nil {
+		in, out := &in.Fields, &out.Fields
+		*out = make([]RocketChatFieldConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ShortFields != nil {
+		in, out := &in.ShortFields, &out.ShortFields
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Emoji != nil {
+		in, out := &in.Emoji, &out.Emoji
+		*out = new(string)
+		**out = **in
+	}
+	if in.IconURL != nil {
+		in, out := &in.IconURL, &out.IconURL
+		*out = new(URL)
+		**out = **in
+	}
+	if in.ImageURL != nil {
+		in, out := &in.ImageURL, &out.ImageURL
+		*out = new(URL)
+		**out = **in
+	}
+	if in.ThumbURL != nil {
+		in, out := &in.ThumbURL, &out.ThumbURL
+		*out = new(URL)
+		**out = **in
+	}
+	if in.LinkNames != nil {
+		in, out := &in.LinkNames, &out.LinkNames
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Actions != nil {
+		in, out := &in.Actions, &out.Actions
+		*out = make([]RocketChatActionConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.HTTPConfig != nil {
+		in, out := &in.HTTPConfig, &out.HTTPConfig
+		*out = new(HTTPConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RocketChatConfig.
+func (in *RocketChatConfig) DeepCopy() *RocketChatConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(RocketChatConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RocketChatFieldConfig) DeepCopyInto(out *RocketChatFieldConfig) {
+	*out = *in
+	if in.Title != nil {
+		in, out := &in.Title, &out.Title
+		*out = new(string)
+		**out = **in
+	}
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(string)
+		**out = **in
+	}
+	if in.Short != nil {
+		in, out := &in.Short, &out.Short
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RocketChatFieldConfig.
+func (in *RocketChatFieldConfig) DeepCopy() *RocketChatFieldConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(RocketChatFieldConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Route) DeepCopyInto(out *Route) {
+	*out = *in
+	if in.GroupBy != nil {
+		in, out := &in.GroupBy, &out.GroupBy
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Matchers != nil {
+		in, out := &in.Matchers, &out.Matchers
+		*out = make([]Matcher, len(*in))
+		copy(*out, *in)
+	}
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = make([]apiextensionsv1.JSON, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.MuteTimeIntervals != nil {
+		in, out := &in.MuteTimeIntervals, &out.MuteTimeIntervals
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ActiveTimeIntervals != nil {
+		in, out := &in.ActiveTimeIntervals, &out.ActiveTimeIntervals
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Route.
+func (in *Route) DeepCopy() *Route {
+	if in == nil {
+		return nil
+	}
+	out := new(Route)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SNSConfig) DeepCopyInto(out *SNSConfig) {
+	*out = *in
+	if in.SendResolved != nil {
+		in, out := &in.SendResolved, &out.SendResolved
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Sigv4 != nil {
+		in, out := &in.Sigv4, &out.Sigv4
+		*out = new(v1.Sigv4)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Attributes != nil {
+		in, out := &in.Attributes, &out.Attributes
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.HTTPConfig != nil {
+		in, out := &in.HTTPConfig, &out.HTTPConfig
+		*out = new(HTTPConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SNSConfig.
+func (in *SNSConfig) DeepCopy() *SNSConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(SNSConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScalewaySDConfig) DeepCopyInto(out *ScalewaySDConfig) {
+	*out = *in
+	in.SecretKey.DeepCopyInto(&out.SecretKey)
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ApiURL != nil {
+		in, out := &in.ApiURL, &out.ApiURL
+		*out = new(string)
+		**out = **in
+	}
+	if in.Zone != nil {
+		in, out := &in.Zone, &out.Zone
 		*out = new(string)
 		**out = **in
 	}
@@ -2319,7 +3064,7 @@ func (in *ScalewaySDConfig) DeepCopyInto(out *ScalewaySDConfig) {
 	}
 	if in.RefreshInterval != nil {
 		in, out := &in.RefreshInterval, &out.RefreshInterval
-		*out = new(monitoringv1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 	in.ProxyConfig.DeepCopyInto(&out.ProxyConfig)
@@ -2335,7 +3080,7 @@ func (in *ScalewaySDConfig) DeepCopyInto(out *ScalewaySDConfig) {
 	}
 	if in.TLSConfig != nil {
 		in, out := &in.TLSConfig, &out.TLSConfig
-		*out = new(monitoringv1.SafeTLSConfig)
+		*out = new(v1.SafeTLSConfig)
 		(*in).DeepCopyInto(*out)
 	}
 }
@@ -2356,6 +3101,7 @@ func (in *ScrapeConfig) DeepCopyInto(out *ScrapeConfig) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScrapeConfig.
@@ -2561,9 +3307,44 @@ func (in *ScrapeConfigSpec) DeepCopyInto(out *ScrapeConfigSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.VultrSDConfigs != nil {
+		in, out := &in.VultrSDConfigs, &out.VultrSDConfigs
+		*out = make([]VultrSDConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.MarathonSDConfigs != nil {
+		in, out := &in.MarathonSDConfigs, &out.MarathonSDConfigs
+		*out = make([]MarathonSDConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.UyuniSDConfigs != nil {
+		in, out := &in.UyuniSDConfigs, &out.UyuniSDConfigs
+		*out = make([]UyuniSDConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ServersetSDConfigs != nil {
+		in, out := &in.ServersetSDConfigs, &out.ServersetSDConfigs
+		*out = make([]ServersetSDConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.NerveSDConfigs != nil {
+		in, out := &in.NerveSDConfigs, &out.NerveSDConfigs
+		*out = make([]NerveSDConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.RelabelConfigs != nil {
 		in, out := &in.RelabelConfigs, &out.RelabelConfigs
-		*out = make([]monitoringv1.RelabelConfig, len(*in))
+		*out = make([]v1.RelabelConfig, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -2575,22 +3356,22 @@ func (in *ScrapeConfigSpec) DeepCopyInto(out *ScrapeConfigSpec) {
 	}
 	if in.ScrapeInterval != nil {
 		in, out := &in.ScrapeInterval, &out.ScrapeInterval
-		*out = new(monitoringv1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 	if in.ScrapeTimeout != nil {
 		in, out := &in.ScrapeTimeout, &out.ScrapeTimeout
-		*out = new(monitoringv1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 	if in.ScrapeProtocols != nil {
 		in, out := &in.ScrapeProtocols, &out.ScrapeProtocols
-		*out = make([]monitoringv1.ScrapeProtocol, len(*in))
+		*out = make([]v1.ScrapeProtocol, len(*in))
 		copy(*out, *in)
 	}
 	if in.FallbackScrapeProtocol != nil {
 		in, out := &in.FallbackScrapeProtocol, &out.FallbackScrapeProtocol
-		*out = new(monitoringv1.ScrapeProtocol)
+		*out = new(v1.ScrapeProtocol)
 		**out = **in
 	}
 	if in.HonorTimestamps != nil {
@@ -2641,22 +3422,22 @@ func (in *ScrapeConfigSpec) DeepCopyInto(out *ScrapeConfigSpec) {
 	}
 	if in.BasicAuth != nil {
 		in, out := &in.BasicAuth, &out.BasicAuth
-		*out = new(monitoringv1.BasicAuth)
+		*out = new(v1.BasicAuth)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.Authorization != nil {
 		in, out := &in.Authorization, &out.Authorization
-		*out = new(monitoringv1.SafeAuthorization)
+		*out = new(v1.SafeAuthorization)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.OAuth2 != nil {
 		in, out := &in.OAuth2, &out.OAuth2
-		*out = new(monitoringv1.OAuth2)
+		*out = new(v1.OAuth2)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.TLSConfig != nil {
 		in, out := &in.TLSConfig, &out.TLSConfig
-		*out = new(monitoringv1.SafeTLSConfig)
+		*out = new(v1.SafeTLSConfig)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.SampleLimit != nil {
@@ -2692,7 +3473,7 @@ func (in *ScrapeConfigSpec) DeepCopyInto(out *ScrapeConfigSpec) {
 	}
 	if in.MetricRelabelConfigs != nil {
 		in, out := &in.MetricRelabelConfigs, &out.MetricRelabelConfigs
-		*out = make([]monitoringv1.RelabelConfig, len(*in))
+		*out = make([]v1.RelabelConfig, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -2700,12 +3481,12 @@ func (in *ScrapeConfigSpec) DeepCopyInto(out *ScrapeConfigSpec) {
 	in.ProxyConfig.DeepCopyInto(&out.ProxyConfig)
 	if in.NameValidationScheme != nil {
 		in, out := &in.NameValidationScheme, &out.NameValidationScheme
-		*out = new(monitoringv1.NameValidationSchemeOptions)
+		*out = new(v1.NameValidationSchemeOptions)
 		**out = **in
 	}
 	if in.NameEscapingScheme != nil {
 		in, out := &in.NameEscapingScheme, &out.NameEscapingScheme
-		*out = new(monitoringv1.NameEscapingSchemeOptions)
+		*out = new(v1.NameEscapingSchemeOptions)
 		**out = **in
 	}
 	if in.ScrapeClassName != nil {
@@ -2725,6 +3506,36 @@ func (in *ScrapeConfigSpec) DeepCopy() *ScrapeConfigSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServersetSDConfig) DeepCopyInto(out *ServersetSDConfig) {
+	*out = *in
+	if in.Servers != nil {
+		in, out := &in.Servers, &out.Servers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Paths != nil {
+		in, out := &in.Paths, &out.Paths
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServersetSDConfig.
+func (in *ServersetSDConfig) DeepCopy() *ServersetSDConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ServersetSDConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SlackAction) DeepCopyInto(out *SlackAction) {
 	*out = *in
@@ -2902,51 +3713,460 @@ func (in *TelegramConfig) DeepCopy() *TelegramConfig {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *TimeInterval) DeepCopyInto(out *TimeInterval) {
+func (in *ThanosCompactor) DeepCopyInto(out *ThanosCompactor) {
 	*out = *in
-	if in.Times != nil {
-		in, out := &in.Times, &out.Times
-		*out = make([]TimeRange, len(*in))
-		copy(*out, *in)
-	}
-	if in.Weekdays != nil {
-		in, out := &in.Weekdays, &out.Weekdays
-		*out = make([]WeekdayRange, len(*in))
-		copy(*out, *in)
-	}
-	if in.DaysOfMonth != nil {
-		in, out := &in.DaysOfMonth, &out.DaysOfMonth
-		*out = make([]DayOfMonthRange, len(*in))
-		copy(*out, *in)
-	}
-	if in.Months != nil {
-		in, out := &in.Months, &out.Months
-		*out = make([]MonthRange, len(*in))
-		copy(*out, *in)
-	}
-	if in.Years != nil {
-		in, out := &in.Years, &out.Years
-		*out = make([]YearRange, len(*in))
-		copy(*out, *in)
-	}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TimeInterval.
-func (in *TimeInterval) DeepCopy() *TimeInterval {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ThanosCompactor.
+func (in *ThanosCompactor) DeepCopy() *ThanosCompactor {
 	if in == nil {
 		return nil
 	}
-	out := new(TimeInterval)
+	out := new(ThanosCompactor)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *TimeRange) DeepCopyInto(out *TimeRange) {
+func (in *ThanosCompactorList) DeepCopyInto(out *ThanosCompactorList) {
 	*out = *in
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TimeRange.
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ThanosCompactor, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ThanosCompactorList.
+func (in *ThanosCompactorList) DeepCopy() *ThanosCompactorList {
+	if in == nil {
+		return nil
+	}
+	out := new(ThanosCompactorList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ThanosCompactorRetention) DeepCopyInto(out *ThanosCompactorRetention) {
+	*out = *in
+	if in.Raw != nil {
+		in, out := &in.Raw, &out.Raw
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.FiveMinutes != nil {
+		in, out := &in.FiveMinutes, &out.FiveMinutes
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.OneHour != nil {
+		in, out := &in.OneHour, &out.OneHour
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ThanosCompactorRetention.
+func (in *ThanosCompactorRetention) DeepCopy() *ThanosCompactorRetention {
+	if in == nil {
+		return nil
+	}
+	out := new(ThanosCompactorRetention)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ThanosCompactorSpec) DeepCopyInto(out *ThanosCompactorSpec) {
+	*out = *in
+	in.ThanosComponentSpec.DeepCopyInto(&out.ThanosComponentSpec)
+	in.ObjectStorageConfig.DeepCopyInto(&out.ObjectStorageConfig)
+	if in.Storage != nil {
+		in, out := &in.Storage, &out.Storage
+		*out = new(v1.StorageSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Retention != nil {
+		in, out := &in.Retention, &out.Retention
+		*out = new(ThanosCompactorRetention)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DisableDownsampling != nil {
+		in, out := &in.DisableDownsampling, &out.DisableDownsampling
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ReplicaLabels != nil {
+		in, out := &in.ReplicaLabels, &out.ReplicaLabels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ThanosCompactorSpec.
+func (in *ThanosCompactorSpec) DeepCopy() *ThanosCompactorSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ThanosCompactorSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ThanosComponentSpec) DeepCopyInto(out *ThanosComponentSpec) {
+	*out = *in
+	if in.Version != nil {
+		in, out := &in.Version, &out.Version
+		*out = new(string)
+		**out = **in
+	}
+	if in.Image != nil {
+		in, out := &in.Image, &out.Image
+		*out = new(string)
+		**out = **in
+	}
+	if in.ImagePullSecrets != nil {
+		in, out := &in.ImagePullSecrets, &out.ImagePullSecrets
+		*out = make([]corev1.LocalObjectReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.PodMetadata != nil {
+		in, out := &in.PodMetadata, &out.PodMetadata
+		*out = new(v1.EmbeddedObjectMetadata)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	in.Resources.DeepCopyInto(&out.Resources)
+	if in.Affinity != nil {
+		in, out := &in.Affinity, &out.Affinity
+		*out = new(corev1.Affinity)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]corev1.Toleration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TopologySpreadConstraints != nil {
+		in, out := &in.TopologySpreadConstraints, &out.TopologySpreadConstraints
+		*out = make([]corev1.TopologySpreadConstraint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.SecurityContext != nil {
+		in, out := &in.SecurityContext, &out.SecurityContext
+		*out = new(corev1.PodSecurityContext)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Volumes != nil {
+		in, out := &in.Volumes, &out.Volumes
+		*out = make([]corev1.Volume, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.VolumeMounts != nil {
+		in, out := &in.VolumeMounts, &out.VolumeMounts
+		*out = make([]corev1.VolumeMount, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Containers != nil {
+		in, out := &in.Containers, &out.Containers
+		*out = make([]corev1.Container, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AdditionalArgs != nil {
+		in, out := &in.AdditionalArgs, &out.AdditionalArgs
+		*out = make([]v1.Argument, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ThanosComponentSpec.
+func (in *ThanosComponentSpec) DeepCopy() *ThanosComponentSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ThanosComponentSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ThanosComponentStatus) DeepCopyInto(out *ThanosComponentStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ThanosComponentStatus.
+func (in *ThanosComponentStatus) DeepCopy() *ThanosComponentStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ThanosComponentStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ThanosQuerier) DeepCopyInto(out *ThanosQuerier) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ThanosQuerier.
+func (in *ThanosQuerier) DeepCopy() *ThanosQuerier {
+	if in == nil {
+		return nil
+	}
+	out := new(ThanosQuerier)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ThanosQuerierList) DeepCopyInto(out *ThanosQuerierList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ThanosQuerier, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ThanosQuerierList.
+func (in *ThanosQuerierList) DeepCopy() *ThanosQuerierList {
+	if in == nil {
+		return nil
+	}
+	out := new(ThanosQuerierList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ThanosQuerierSpec) DeepCopyInto(out *ThanosQuerierSpec) {
+	*out = *in
+	in.ThanosComponentSpec.DeepCopyInto(&out.ThanosComponentSpec)
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ReplicaLabels != nil {
+		in, out := &in.ReplicaLabels, &out.ReplicaLabels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PrometheusSelector != nil {
+		in, out := &in.PrometheusSelector, &out.PrometheusSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PrometheusNamespaceSelector != nil {
+		in, out := &in.PrometheusNamespaceSelector, &out.PrometheusNamespaceSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ThanosRulerSelector != nil {
+		in, out := &in.ThanosRulerSelector, &out.ThanosRulerSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ThanosRulerNamespaceSelector != nil {
+		in, out := &in.ThanosRulerNamespaceSelector, &out.ThanosRulerNamespaceSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ThanosStoreSelector != nil {
+		in, out := &in.ThanosStoreSelector, &out.ThanosStoreSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ThanosStoreNamespaceSelector != nil {
+		in, out := &in.ThanosStoreNamespaceSelector, &out.ThanosStoreNamespaceSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Endpoints != nil {
+		in, out := &in.Endpoints, &out.Endpoints
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ThanosQuerierSpec.
+func (in *ThanosQuerierSpec) DeepCopy() *ThanosQuerierSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ThanosQuerierSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ThanosStore) DeepCopyInto(out *ThanosStore) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ThanosStore.
+func (in *ThanosStore) DeepCopy() *ThanosStore {
+	if in == nil {
+		return nil
+	}
+	out := new(ThanosStore)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ThanosStoreList) DeepCopyInto(out *ThanosStoreList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ThanosStore, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ThanosStoreList.
+func (in *ThanosStoreList) DeepCopy() *ThanosStoreList {
+	if in == nil {
+		return nil
+	}
+	out := new(ThanosStoreList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ThanosStoreSpec) DeepCopyInto(out *ThanosStoreSpec) {
+	*out = *in
+	in.ThanosComponentSpec.DeepCopyInto(&out.ThanosComponentSpec)
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
+	in.ObjectStorageConfig.DeepCopyInto(&out.ObjectStorageConfig)
+	if in.Storage != nil {
+		in, out := &in.Storage, &out.Storage
+		*out = new(v1.StorageSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MinTime != nil {
+		in, out := &in.MinTime, &out.MinTime
+		*out = new(string)
+		**out = **in
+	}
+	if in.MaxTime != nil {
+		in, out := &in.MaxTime, &out.MaxTime
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ThanosStoreSpec.
+func (in *ThanosStoreSpec) DeepCopy() *ThanosStoreSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ThanosStoreSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TimeInterval) DeepCopyInto(out *TimeInterval) {
+	*out = *in
+	if in.Times != nil {
+		in, out := &in.Times, &out.Times
+		*out = make([]TimeRange, len(*in))
+		copy(*out, *in)
+	}
+	if in.Weekdays != nil {
+		in, out := &in.Weekdays, &out.Weekdays
+		*out = make([]WeekdayRange, len(*in))
+		copy(*out, *in)
+	}
+	if in.DaysOfMonth != nil {
+		in, out := &in.DaysOfMonth, &out.DaysOfMonth
+		*out = make([]DayOfMonthRange, len(*in))
+		copy(*out, *in)
+	}
+	if in.Months != nil {
+		in, out := &in.Months, &out.Months
+		*out = make([]MonthRange, len(*in))
+		copy(*out, *in)
+	}
+	if in.Years != nil {
+		in, out := &in.Years, &out.Years
+		*out = make([]YearRange, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TimeInterval.
+func (in *TimeInterval) DeepCopy() *TimeInterval {
+	if in == nil {
+		return nil
+	}
+	out := new(TimeInterval)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TimeRange) DeepCopyInto(out *TimeRange) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TimeRange.
 func (in *TimeRange) DeepCopy() *TimeRange {
 	if in == nil {
 		return nil
@@ -2956,6 +4176,63 @@ func (in *TimeRange) DeepCopy() *TimeRange {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UyuniSDConfig) DeepCopyInto(out *UyuniSDConfig) {
+	*out = *in
+	in.Password.DeepCopyInto(&out.Password)
+	if in.Entitlement != nil {
+		in, out := &in.Entitlement, &out.Entitlement
+		*out = new(string)
+		**out = **in
+	}
+	if in.Separator != nil {
+		in, out := &in.Separator, &out.Separator
+		*out = new(string)
+		**out = **in
+	}
+	if in.RefreshInterval != nil {
+		in, out := &in.RefreshInterval, &out.RefreshInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Authorization != nil {
+		in, out := &in.Authorization, &out.Authorization
+		*out = new(v1.SafeAuthorization)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OAuth2 != nil {
+		in, out := &in.OAuth2, &out.OAuth2
+		*out = new(v1.OAuth2)
+		(*in).DeepCopyInto(*out)
+	}
+	in.ProxyConfig.DeepCopyInto(&out.ProxyConfig)
+	if in.TLSConfig != nil {
+		in, out := &in.TLSConfig, &out.TLSConfig
+		*out = new(v1.SafeTLSConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FollowRedirects != nil {
+		in, out := &in.FollowRedirects, &out.FollowRedirects
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EnableHTTP2 != nil {
+		in, out := &in.EnableHTTP2, &out.EnableHTTP2
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UyuniSDConfig.
+func (in *UyuniSDConfig) DeepCopy() *UyuniSDConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(UyuniSDConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VictorOpsConfig) DeepCopyInto(out *VictorOpsConfig) {
 	*out = *in
@@ -2991,6 +4268,57 @@ func (in *VictorOpsConfig) DeepCopy() *VictorOpsConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VultrSDConfig) DeepCopyInto(out *VultrSDConfig) {
+	*out = *in
+	if in.Authorization != nil {
+		in, out := &in.Authorization, &out.Authorization
+		*out = new(v1.SafeAuthorization)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OAuth2 != nil {
+		in, out := &in.OAuth2, &out.OAuth2
+		*out = new(v1.OAuth2)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int32)
+		**out = **in
+	}
+	if in.RefreshInterval != nil {
+		in, out := &in.RefreshInterval, &out.RefreshInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	in.ProxyConfig.DeepCopyInto(&out.ProxyConfig)
+	if in.TLSConfig != nil {
+		in, out := &in.TLSConfig, &out.TLSConfig
+		*out = new(v1.SafeTLSConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FollowRedirects != nil {
+		in, out := &in.FollowRedirects, &out.FollowRedirects
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EnableHTTP2 != nil {
+		in, out := &in.EnableHTTP2, &out.EnableHTTP2
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VultrSDConfig.
+func (in *VultrSDConfig) DeepCopy() *VultrSDConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(VultrSDConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WeChatConfig) DeepCopyInto(out *WeChatConfig) {
 	*out = *in
@@ -3081,7 +4409,7 @@ func (in *WebhookConfig) DeepCopyInto(out *WebhookConfig) {
 	}
 	if in.Timeout != nil {
 		in, out := &in.Timeout, &out.Timeout
-		*out = new(monitoringv1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 }
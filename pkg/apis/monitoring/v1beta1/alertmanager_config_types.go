@@ -42,6 +42,7 @@ const (
 // +genclient
 // +k8s:openapi-gen=true
 // +kubebuilder:resource:categories="prometheus-operator",shortName="amcfg"
+// +kubebuilder:subresource:status
 
 // The `AlertmanagerConfig` custom resource definition (CRD) defines how `Alertmanager` objects process Prometheus alerts. It allows to specify alert grouping and routing, notification receivers and inhibition rules.
 //
@@ -51,6 +52,54 @@ type AlertmanagerConfig struct {
 	metav1.ObjectMeta `json:"metadata,omitempty"`
 
 	Spec AlertmanagerConfigSpec `json:"spec"`
+	// The status of the AlertmanagerConfig resource.
+	// It is only populated when the `StatusForConfigurationResources`
+	// feature gate is enabled.
+	// +optional
+	Status AlertmanagerConfigStatus `json:"status,omitempty"`
+}
+
+// AlertmanagerConfigStatus is the most recent observed status of the
+// AlertmanagerConfig resource.
+type AlertmanagerConfigStatus struct {
+	// The list of Alertmanager resources which load the configuration.
+	// +listType=map
+	// +listMapKey=name
+	// +listMapKey=namespace
+	// +optional
+	Alertmanagers []AlertmanagerConfigBinding `json:"alertmanagers,omitempty"`
+}
+
+// AlertmanagerConfigBinding is the status of the configuration for an
+// Alertmanager resource.
+type AlertmanagerConfigBinding struct {
+	// The name of the Alertmanager object.
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	Name string `json:"name"`
+	// The namespace of the Alertmanager object.
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	Namespace string `json:"namespace"`
+	// The notification delivery status of the receivers.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	Receivers []ReceiverStatus `json:"receivers,omitempty"`
+}
+
+// ReceiverStatus is the notification delivery status of a receiver.
+type ReceiverStatus struct {
+	// The name of the receiver.
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	Name string `json:"name"`
+	// The current state of the receiver.
+	// Currently, only the "Delivering" condition is supported.
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []monitoringv1.Condition `json:"conditions,omitempty"`
 }
 
 // AlertmanagerConfigList is a list of AlertmanagerConfig.
@@ -83,6 +132,33 @@ type AlertmanagerConfigSpec struct {
 	// List of TimeInterval specifying when the routes should be muted or active.
 	// +optional
 	TimeIntervals []TimeInterval `json:"timeIntervals,omitempty"`
+	// List of Go templates which can be used by the receivers of this
+	// resource to customize the notifications.
+	//
+	// The operator prefixes the names of the templates defined by
+	// `{{ define "<name>" }}` blocks with `<namespace>/<resource name>/` to
+	// avoid collisions with the templates of other resources. The references
+	// to these templates from the receivers of the same resource (e.g.
+	// `{{ template "<name>" . }}`) are rewritten accordingly.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	Templates []NotificationTemplate `json:"templates,omitempty"`
+}
+
+// NotificationTemplate defines a file of Go templates for notifications.
+// See https://prometheus.io/docs/alerting/latest/notifications/ for details.
+type NotificationTemplate struct {
+	// Name of the template file. It must be unique in the resource.
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z0-9][a-zA-Z0-9.-]*$`
+	// +kubebuilder:validation:MaxLength=64
+	// +required
+	Name string `json:"name"`
+	// Content of the template file, usually a list of `{{ define "<name>" }}`
+	// blocks.
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	Content string `json:"content"`
 }
 
 // Route defines a node in the routing tree.
@@ -189,6 +265,14 @@ type Receiver struct {
 	// List of MSTeamsV2 configurations.
 	// It requires Alertmanager >= 0.28.0.
 	MSTeamsV2Configs []MSTeamsV2Config `json:"msteamsv2Configs,omitempty"`
+	// List of Jira configurations.
+	// It requires Alertmanager >= 0.28.0.
+	// +optional
+	JiraConfigs []JiraConfig `json:"jiraConfigs,omitempty"`
+	// List of RocketChat configurations.
+	// It requires Alertmanager >= 0.28.0.
+	// +optional
+	RocketChatConfigs []RocketChatConfig `json:"rocketchatConfigs,omitempty"`
 }
 
 // PagerDutyConfig configures notifications via PagerDuty.
@@ -538,6 +622,10 @@ type OpsGenieConfig struct {
 	// Priority level of alert. Possible values are P1, P2, P3, P4, and P5.
 	// +optional
 	Priority string `json:"priority,omitempty"`
+	// Whether to update message and description of the alert in OpsGenie if it already exists
+	// By default, the alert is never updated in OpsGenie, the new message only appears in activity log.
+	// +optional
+	UpdateAlerts *bool `json:"updateAlerts,omitempty"`
 	// A set of arbitrary key/value pairs that provide further detail about the incident.
 	// +optional
 	Details []KeyValue `json:"details,omitempty"`
@@ -993,6 +1081,175 @@ type MSTeamsV2Config struct {
 	HTTPConfig *HTTPConfig `json:"httpConfig,omitempty"`
 }
 
+// JiraConfig configures notifications via Jira.
+// See https://prometheus.io/docs/alerting/latest/configuration/#jira_config
+// It requires Alertmanager >= 0.28.0.
+type JiraConfig struct {
+	// Whether to notify about resolved alerts.
+	// +optional
+	SendResolved *bool `json:"sendResolved,omitempty"`
+	// The URL of the Jira API.
+	// If not specified, the value of the `jira_api_url` global setting is used.
+	// +optional
+	APIURL *URL `json:"apiURL,omitempty"`
+	// The project key where issues are created.
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	Project string `json:"project"`
+	// The type of the issue (e.g. Bug).
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	IssueType string `json:"issueType"`
+	// Issue summary template.
+	// +optional
+	Summary *string `json:"summary,omitempty"`
+	// Issue description template.
+	// +optional
+	Description *string `json:"description,omitempty"`
+	// Labels to be added to the issue.
+	// +listType=set
+	// +optional
+	Labels []string `json:"labels,omitempty"`
+	// Priority of the issue.
+	// +optional
+	Priority *string `json:"priority,omitempty"`
+	// Name of the workflow transition to reopen an issue.
+	// The target status should not have the category "done".
+	// +optional
+	ReopenTransition *string `json:"reopenTransition,omitempty"`
+	// Name of the workflow transition to resolve an issue.
+	// The target status must have the category "done".
+	// +optional
+	ResolveTransition *string `json:"resolveTransition,omitempty"`
+	// If `reopenTransition` is defined, issues with this resolution are
+	// ignored and a new issue is created instead.
+	// +optional
+	WontFixResolution *string `json:"wontFixResolution,omitempty"`
+	// If `reopenTransition` is defined, reopen the issue when it is not older
+	// than this value (rounded down to the nearest minute).
+	// Otherwise, a new issue is created.
+	// +optional
+	ReopenDuration *monitoringv1.Duration `json:"reopenDuration,omitempty"`
+	// Other issue and custom fields.
+	// +listType=map
+	// +listMapKey=key
+	// +optional
+	Fields []JiraField `json:"fields,omitempty"`
+	// The HTTP client's configuration.
+	// You must supply the Jira credentials via the `httpConfig.basicAuth` or
+	// `httpConfig.authorization` fields.
+	// +optional
+	HTTPConfig *HTTPConfig `json:"httpConfig,omitempty"`
+}
+
+// JiraField defines an issue field for Jira.
+type JiraField struct {
+	// The name of the field (e.g. "customfield_10000").
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	Key string `json:"key"`
+	// The value of the field.
+	// +required
+	Value apiextensionsv1.JSON `json:"value"`
+}
+
+// RocketChatConfig configures notifications via RocketChat.
+// See https://prometheus.io/docs/alerting/latest/configuration/#rocketchat_config
+// It requires Alertmanager >= 0.28.0.
+type RocketChatConfig struct {
+	// Whether to notify about resolved alerts.
+	// +optional
+	SendResolved *bool `json:"sendResolved,omitempty"`
+	// The URL of the RocketChat API.
+	// If not specified, the value of the `rocketchat_api_url` global setting is used.
+	// +optional
+	APIURL *URL `json:"apiURL,omitempty"`
+	// The secret's key that contains the RocketChat token ID.
+	// The secret needs to be in the same namespace as the AlertmanagerConfig
+	// object and accessible by the Prometheus Operator.
+	// +required
+	TokenID SecretKeySelector `json:"tokenID"`
+	// The secret's key that contains the RocketChat token.
+	// The secret needs to be in the same namespace as the AlertmanagerConfig
+	// object and accessible by the Prometheus Operator.
+	// +required
+	Token SecretKeySelector `json:"token"`
+	// The channel or user to send notifications to (e.g. "#other-channel" or
+	// "@username").
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	Channel *string `json:"channel,omitempty"`
+	// The message color.
+	// +optional
+	Color *string `json:"color,omitempty"`
+	// The message title template.
+	// +optional
+	Title *string `json:"title,omitempty"`
+	// The message title link.
+	// +optional
+	TitleLink *string `json:"titleLink,omitempty"`
+	// The message body template.
+	// +optional
+	Text *string `json:"text,omitempty"`
+	// Additional fields of the message attachment.
+	// +optional
+	Fields []RocketChatFieldConfig `json:"fields,omitempty"`
+	// Whether the fields are displayed side by side.
+	// +optional
+	ShortFields *bool `json:"shortFields,omitempty"`
+	// The emoji used as the message avatar.
+	// +optional
+	Emoji *string `json:"emoji,omitempty"`
+	// The URL of the icon used as the message avatar.
+	// +optional
+	IconURL *URL `json:"iconURL,omitempty"`
+	// The URL of the image displayed in the message attachment.
+	// +optional
+	ImageURL *URL `json:"imageURL,omitempty"`
+	// The URL of the thumbnail displayed in the message attachment.
+	// +optional
+	ThumbURL *URL `json:"thumbURL,omitempty"`
+	// Whether to notify the users and channels mentioned in the message.
+	// +optional
+	LinkNames *bool `json:"linkNames,omitempty"`
+	// Actions displayed as buttons in the message attachment.
+	// +optional
+	Actions []RocketChatActionConfig `json:"actions,omitempty"`
+	// HTTP client configuration.
+	// +optional
+	HTTPConfig *HTTPConfig `json:"httpConfig,omitempty"`
+}
+
+// RocketChatFieldConfig defines an additional field for RocketChat messages.
+type RocketChatFieldConfig struct {
+	// The title of the field.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	Title *string `json:"title,omitempty"`
+	// The value of the field, displayed as a table.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	Value *string `json:"value,omitempty"`
+	// Whether the field is displayed side by side with other fields.
+	// +optional
+	Short *bool `json:"short,omitempty"`
+}
+
+// RocketChatActionConfig defines a button action for RocketChat messages.
+type RocketChatActionConfig struct {
+	// The button text.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	Text *string `json:"text,omitempty"`
+	// The URL opened when the button is clicked.
+	// +optional
+	URL *URL `json:"url,omitempty"`
+	// The message sent when the button is clicked.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	Msg *string `json:"msg,omitempty"`
+}
+
 // InhibitRule defines an inhibition rule that allows to mute alerts when other
 // alerts are already firing.
 // See https://prometheus.io/docs/alerting/latest/configuration/#inhibit_rule
@@ -1124,6 +1381,37 @@ type TimeInterval struct {
 	Name string `json:"name,omitempty"`
 	// TimeIntervals is a list of TimePeriod.
 	TimeIntervals []TimePeriod `json:"timeIntervals,omitempty"`
+	// Calendar references an iCalendar feed from which the operator generates
+	// additional time intervals, one for each event occurring in the
+	// upcoming days. The time intervals are regenerated periodically.
+	//
+	// Only the `VEVENT` components are considered. Recurring events support
+	// the `FREQ`, `INTERVAL`, `COUNT`, `UNTIL` and `BYDAY` (weekly rules only)
+	// parts of `RRULE` as well as `EXDATE` and `RECURRENCE-ID`.
+	// +optional
+	Calendar *CalendarSource `json:"calendar,omitempty"`
+}
+
+// CalendarSource references an iCalendar feed (RFC 5545) from which the time
+// intervals are generated.
+type CalendarSource struct {
+	// The ConfigMap key containing the iCalendar data. The ConfigMap must be
+	// in the same namespace as the AlertmanagerConfig object.
+	// +required
+	ConfigMap v1.ConfigMapKeySelector `json:"configMap"`
+	// Number of days, starting from the current day, for which the calendar
+	// events are expanded into time intervals.
+	// Defaults to 30.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=366
+	// +optional
+	Days *int32 `json:"days,omitempty"`
+	// Time zone (IANA name) of the events without explicit time zone (e.g.
+	// all-day events). Events with a `TZID` parameter use their own time zone.
+	// Defaults to UTC.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	TimeZone *string `json:"timeZone,omitempty"`
 }
 
 // TimePeriod describes periods of time.
@@ -0,0 +1,189 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+
+	"github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+)
+
+// checkLosslessConversion returns an error if the v1beta1 object converted
+// from the v1alpha1 object doesn't hold all the information of the original
+// object. It converts the v1beta1 object back to v1alpha1 and compares the
+// result with the original object.
+//
+// Deprecated v1alpha1 fields which have an equivalent representation in
+// v1beta1 (e.g. the regex field of matchers) aren't considered as lost.
+func checkLosslessConversion(src *v1alpha1.AlertmanagerConfig, dst *AlertmanagerConfig) error {
+	rt := &v1alpha1.AlertmanagerConfig{}
+	if err := dst.ConvertTo(rt); err != nil {
+		return err
+	}
+
+	want, err := normalizeSpec(src.Spec)
+	if err != nil {
+		return err
+	}
+
+	got, err := normalizeSpec(rt.Spec)
+	if err != nil {
+		return err
+	}
+
+	fields := diffFields("spec", want, got, nil)
+	if len(fields) > 0 {
+		return fmt.Errorf("conversion to %s would lose data, the following fields aren't supported: %s", SchemeGroupVersion, strings.Join(fields, ", "))
+	}
+
+	return nil
+}
+
+// normalizeSpec returns the generic JSON representation of the v1alpha1
+// spec after normalizing the deprecated fields.
+func normalizeSpec(in v1alpha1.AlertmanagerConfigSpec) (any, error) {
+	spec := in.DeepCopy()
+
+	if spec.Route != nil {
+		if err := normalizeRoute(spec.Route); err != nil {
+			return nil, err
+		}
+	}
+
+	for i := range spec.InhibitRules {
+		spec.InhibitRules[i].SourceMatch = normalizeMatchers(spec.InhibitRules[i].SourceMatch)
+		spec.InhibitRules[i].TargetMatch = normalizeMatchers(spec.InhibitRules[i].TargetMatch)
+	}
+
+	b, err := json.Marshal(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	var out any
+	if err := json.Unmarshal(b, &out); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+func normalizeRoute(r *v1alpha1.Route) error {
+	r.Matchers = normalizeMatchers(r.Matchers)
+
+	crs, err := r.ChildRoutes()
+	if err != nil {
+		return err
+	}
+
+	for i := range crs {
+		if err := normalizeRoute(&crs[i]); err != nil {
+			return fmt.Errorf("route[%d]: %w", i, err)
+		}
+
+		b, err := json.Marshal(crs[i])
+		if err != nil {
+			return fmt.Errorf("route[%d]: %w", i, err)
+		}
+
+		r.Routes[i] = apiextensionsv1.JSON{Raw: b}
+	}
+
+	return nil
+}
+
+// normalizeMatchers replaces the deprecated regex field by the equivalent
+// match type.
+func normalizeMatchers(in []v1alpha1.Matcher) []v1alpha1.Matcher {
+	for i, m := range in {
+		if m.MatchType == "" {
+			in[i].MatchType = v1alpha1.MatchEqual
+			if m.Regex {
+				in[i].MatchType = v1alpha1.MatchRegexp
+			}
+		}
+		in[i].Regex = false
+	}
+
+	return in
+}
+
+// diffFields returns the paths of the fields which differ between the
+// generic JSON values a and b.
+func diffFields(path string, a, b any, fields []string) []string {
+	// Null and empty values are equivalent.
+	if isEmptyValue(a) && isEmptyValue(b) {
+		return fields
+	}
+
+	ma, okA := a.(map[string]any)
+	mb, okB := b.(map[string]any)
+	if okA && okB {
+		keys := map[string]struct{}{}
+		for k := range ma {
+			keys[k] = struct{}{}
+		}
+		for k := range mb {
+			keys[k] = struct{}{}
+		}
+
+		sorted := make([]string, 0, len(keys))
+		for k := range keys {
+			sorted = append(sorted, k)
+		}
+		sort.Strings(sorted)
+
+		for _, k := range sorted {
+			fields = diffFields(path+"."+k, ma[k], mb[k], fields)
+		}
+
+		return fields
+	}
+
+	sa, okA := a.([]any)
+	sb, okB := b.([]any)
+	if okA && okB && len(sa) == len(sb) {
+		for i := range sa {
+			fields = diffFields(fmt.Sprintf("%s[%d]", path, i), sa[i], sb[i], fields)
+		}
+
+		return fields
+	}
+
+	if !reflect.DeepEqual(a, b) {
+		fields = append(fields, path)
+	}
+
+	return fields
+}
+
+func isEmptyValue(v any) bool {
+	switch v := v.(type) {
+	case nil:
+		return true
+	case map[string]any:
+		return len(v) == 0
+	case []any:
+		return len(v) == 0
+	}
+
+	return false
+}
@@ -90,6 +90,18 @@ func convertMatchersFrom(in []v1alpha1.Matcher) []Matcher {
 	return out
 }
 
+func convertCalendarSourceFrom(in *v1alpha1.CalendarSource) *CalendarSource {
+	if in == nil {
+		return nil
+	}
+
+	return &CalendarSource{
+		ConfigMap: in.ConfigMap,
+		Days:      in.Days,
+		TimeZone:  in.TimeZone,
+	}
+}
+
 func convertTimeIntervalsFrom(in []v1alpha1.TimeInterval) []TimePeriod {
 	out := make([]TimePeriod, 0, len(in))
 
@@ -204,6 +216,7 @@ func convertOpsGenieConfigFrom(in v1alpha1.OpsGenieConfig) OpsGenieConfig {
 		Tags:         in.Tags,
 		Note:         in.Note,
 		Priority:     in.Priority,
+		UpdateAlerts: in.UpdateAlerts,
 		Details:      convertKeyValuesFrom(in.Details),
 		Responders:   convertOpsGenieConfigRespondersFrom(in.Responders),
 		HTTPConfig:   convertHTTPConfigFrom(in.HTTPConfig),
@@ -421,6 +434,7 @@ func convertPushoverConfigFrom(in v1alpha1.PushoverConfig) PushoverConfig {
 		Message:      in.Message,
 		URL:          in.URL,
 		URLTitle:     in.URLTitle,
+		TTL:          in.TTL,
 		Device:       in.Device,
 		Sound:        in.Sound,
 		Priority:     in.Priority,
@@ -482,11 +496,132 @@ func convertMSTeamsV2ConfigFrom(in v1alpha1.MSTeamsV2Config) MSTeamsV2Config {
 	}
 }
 
+func convertJiraFieldsFrom(in []v1alpha1.JiraField) []JiraField {
+	if in == nil {
+		return nil
+	}
+
+	out := make([]JiraField, len(in))
+	for i := range in {
+		out[i] = JiraField{
+			Key:   in[i].Key,
+			Value: in[i].Value,
+		}
+	}
+
+	return out
+}
+
+func convertJiraConfigFrom(in v1alpha1.JiraConfig) JiraConfig {
+	return JiraConfig{
+		SendResolved:      in.SendResolved,
+		APIURL:            (*URL)(in.APIURL),
+		Project:           in.Project,
+		IssueType:         in.IssueType,
+		Summary:           in.Summary,
+		Description:       in.Description,
+		Labels:            in.Labels,
+		Priority:          in.Priority,
+		ReopenTransition:  in.ReopenTransition,
+		ResolveTransition: in.ResolveTransition,
+		WontFixResolution: in.WontFixResolution,
+		ReopenDuration:    in.ReopenDuration,
+		Fields:            convertJiraFieldsFrom(in.Fields),
+		HTTPConfig:        convertHTTPConfigFrom(in.HTTPConfig),
+	}
+}
+
+func convertRocketChatFieldsFrom(in []v1alpha1.RocketChatFieldConfig) []RocketChatFieldConfig {
+	if in == nil {
+		return nil
+	}
+
+	out := make([]RocketChatFieldConfig, len(in))
+	for i := range in {
+		out[i] = RocketChatFieldConfig{
+			Title: in[i].Title,
+			Value: in[i].Value,
+			Short: in[i].Short,
+		}
+	}
+
+	return out
+}
+
+func convertRocketChatActionsFrom(in []v1alpha1.RocketChatActionConfig) []RocketChatActionConfig {
+	if in == nil {
+		return nil
+	}
+
+	out := make([]RocketChatActionConfig, len(in))
+	for i := range in {
+		out[i] = RocketChatActionConfig{
+			Text: in[i].Text,
+			URL:  (*URL)(in[i].URL),
+			Msg:  in[i].Msg,
+		}
+	}
+
+	return out
+}
+
+func convertRocketChatConfigFrom(in v1alpha1.RocketChatConfig) RocketChatConfig {
+	return RocketChatConfig{
+		SendResolved: in.SendResolved,
+		APIURL:       (*URL)(in.APIURL),
+		TokenID:      *convertSecretKeySelectorFrom(&in.TokenID),
+		Token:        *convertSecretKeySelectorFrom(&in.Token),
+		Channel:      in.Channel,
+		Color:        in.Color,
+		Title:        in.Title,
+		TitleLink:    in.TitleLink,
+		Text:         in.Text,
+		Fields:       convertRocketChatFieldsFrom(in.Fields),
+		ShortFields:  in.ShortFields,
+		Emoji:        in.Emoji,
+		IconURL:      (*URL)(in.IconURL),
+		ImageURL:     (*URL)(in.ImageURL),
+		ThumbURL:     (*URL)(in.ThumbURL),
+		LinkNames:    in.LinkNames,
+		Actions:      convertRocketChatActionsFrom(in.Actions),
+		HTTPConfig:   convertHTTPConfigFrom(in.HTTPConfig),
+	}
+}
+
 // ConvertFrom converts from the Hub version (v1alpha1) to this version (v1beta1).
+func convertAlertmanagerConfigStatusFrom(in v1alpha1.AlertmanagerConfigStatus) AlertmanagerConfigStatus {
+	if in.Alertmanagers == nil {
+		return AlertmanagerConfigStatus{}
+	}
+
+	out := make([]AlertmanagerConfigBinding, len(in.Alertmanagers))
+	for i, b := range in.Alertmanagers {
+		out[i] = AlertmanagerConfigBinding{
+			Name:      b.Name,
+			Namespace: b.Namespace,
+		}
+
+		if b.Receivers == nil {
+			continue
+		}
+
+		out[i].Receivers = make([]ReceiverStatus, len(b.Receivers))
+		for j, r := range b.Receivers {
+			out[i].Receivers[j] = ReceiverStatus{
+				Name:       r.Name,
+				Conditions: r.Conditions,
+			}
+		}
+	}
+
+	return AlertmanagerConfigStatus{Alertmanagers: out}
+}
+
 func (dst *AlertmanagerConfig) ConvertFrom(srcRaw conversion.Hub) error {
 	src := srcRaw.(*v1alpha1.AlertmanagerConfig)
 
 	dst.ObjectMeta = src.ObjectMeta
+	dst.Status = convertAlertmanagerConfigStatusFrom(src.Status)
 
 	for _, in := range src.Spec.Receivers {
 		out := Receiver{
@@ -591,6 +726,20 @@ func (dst *AlertmanagerConfig) ConvertFrom(srcRaw conversion.Hub) error {
 			)
 		}
 
+		for _, in := range in.JiraConfigs {
+			out.JiraConfigs = append(
+				out.JiraConfigs,
+				convertJiraConfigFrom(in),
+			)
+		}
+
+		for _, in := range in.RocketChatConfigs {
+			out.RocketChatConfigs = append(
+				out.RocketChatConfigs,
+				convertRocketChatConfigFrom(in),
+			)
+		}
+
 		dst.Spec.Receivers = append(dst.Spec.Receivers, out)
 	}
 
@@ -611,6 +760,17 @@ func (dst *AlertmanagerConfig) ConvertFrom(srcRaw conversion.Hub) error {
 			TimeInterval{
 				Name:          in.Name,
 				TimeIntervals: convertTimeIntervalsFrom(in.TimeIntervals),
+				Calendar:      convertCalendarSourceFrom(in.Calendar),
+			},
+		)
+	}
+
+	for _, in := range src.Spec.Templates {
+		dst.Spec.Templates = append(
+			dst.Spec.Templates,
+			NotificationTemplate{
+				Name:    in.Name,
+				Content: in.Content,
 			},
 		)
 	}
@@ -621,5 +781,6 @@ func (dst *AlertmanagerConfig) ConvertFrom(srcRaw conversion.Hub) error {
 	}
 	dst.Spec.Route = r
 
-	return nil
+	// Reject the conversion rather than silently dropping data.
+	return checkLosslessConversion(src, dst)
 }
@@ -0,0 +1,285 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	"encoding/json"
+	"math/rand"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/apitesting/fuzzer"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metafuzzer "k8s.io/apimachinery/pkg/apis/meta/fuzzer"
+	"k8s.io/apimachinery/pkg/runtime"
+	runtimeserializer "k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/randfill"
+
+	"github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+)
+
+const (
+	fuzzIterations = 1000
+
+	// defaultFuzzSeed is the seed of the conversion fuzzer. It can be
+	// overridden with the FUZZ_SEED environment variable.
+	defaultFuzzSeed int64 = 20250601
+)
+
+var (
+	matchTypes         = []MatchType{MatchEqual, MatchNotEqual, MatchRegexp, MatchNotRegexp}
+	v1alpha1MatchTypes = []v1alpha1.MatchType{"", v1alpha1.MatchEqual, v1alpha1.MatchNotEqual, v1alpha1.MatchRegexp, v1alpha1.MatchNotRegexp}
+)
+
+// conversionFuzzerFuncs returns the fuzzer functions generating objects
+// which are valid from the conversion standpoint.
+func conversionFuzzerFuncs(_ runtimeserializer.CodecFactory) []any {
+	return []any{
+		func(m *Matcher, c randfill.Continue) {
+			c.FillNoCustom(m)
+			m.MatchType = matchTypes[c.Intn(len(matchTypes))]
+		},
+		func(m *v1alpha1.Matcher, c randfill.Continue) {
+			c.FillNoCustom(m)
+			m.MatchType = v1alpha1MatchTypes[c.Intn(len(v1alpha1MatchTypes))]
+		},
+		func(r *Route, c randfill.Continue) {
+			c.FillNoCustom(r)
+			for i := range r.Routes {
+				// Child routes are limited to one level of nesting.
+				child := Route{}
+				c.FillNoCustom(&child)
+				child.Routes = nil
+				r.Routes[i] = mustMarshalRoute(child)
+			}
+		},
+		func(r *v1alpha1.Route, c randfill.Continue) {
+			c.FillNoCustom(r)
+			for i := range r.Routes {
+				child := v1alpha1.Route{}
+				c.FillNoCustom(&child)
+				child.Routes = nil
+				r.Routes[i] = mustMarshalRoute(child)
+			}
+		},
+		func(j *apiextensionsv1.JSON, c randfill.Continue) {
+			b, err := json.Marshal(c.String(0))
+			if err != nil {
+				panic(err)
+			}
+			j.Raw = b
+		},
+		func(s *v1.SecretKeySelector, c randfill.Continue) {
+			c.FillNoCustom(s)
+			// The optional field can't be represented in v1beta1.
+			s.Optional = nil
+		},
+	}
+}
+
+func mustMarshalRoute(r any) apiextensionsv1.JSON {
+	b, err := json.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+
+	return apiextensionsv1.JSON{Raw: b}
+}
+
+func newConversionFuzzer(t *testing.T) *randfill.Filler {
+	seed := defaultFuzzSeed
+	if s := os.Getenv("FUZZ_SEED"); s != "" {
+		var err error
+		seed, err = strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			t.Fatalf("invalid FUZZ_SEED value: %v", err)
+		}
+	}
+	t.Logf("fuzzer seed: %d (override with FUZZ_SEED)", seed)
+
+	return fuzzer.FuzzerFor(
+		fuzzer.MergeFuzzerFuncs(metafuzzer.Funcs, conversionFuzzerFuncs),
+		rand.NewSource(seed),
+		runtimeserializer.NewCodecFactory(runtime.NewScheme()),
+	)
+}
+
+func TestConversionRoundTripFromV1beta1(t *testing.T) {
+	f := newConversionFuzzer(t)
+
+	for i := 0; i < fuzzIterations; i++ {
+		in := &AlertmanagerConfig{}
+		f.Fill(in)
+		// TypeMeta isn't handled by the conversion functions.
+		in.TypeMeta = AlertmanagerConfig{}.TypeMeta
+
+		hub := &v1alpha1.AlertmanagerConfig{}
+		if err := in.DeepCopy().ConvertTo(hub); err != nil {
+			t.Fatalf("ConvertTo: unexpected error: %v", err)
+		}
+
+		out := &AlertmanagerConfig{}
+		if err := out.ConvertFrom(hub); err != nil {
+			t.Fatalf("ConvertFrom: unexpected error: %v", err)
+		}
+
+		if !equality.Semantic.DeepEqual(in, out) {
+			t.Fatalf("round-trip conversion mismatch: %v", diffObjects(t, in, out))
+		}
+	}
+}
+
+func TestConversionRoundTripFromV1alpha1(t *testing.T) {
+	f := newConversionFuzzer(t)
+
+	for i := 0; i < fuzzIterations; i++ {
+		in := &v1alpha1.AlertmanagerConfig{}
+		f.Fill(in)
+		in.TypeMeta = v1alpha1.AlertmanagerConfig{}.TypeMeta
+
+		spoke := &AlertmanagerConfig{}
+		if err := spoke.ConvertFrom(in.DeepCopy()); err != nil {
+			t.Fatalf("ConvertFrom: unexpected error: %v", err)
+		}
+
+		out := &v1alpha1.AlertmanagerConfig{}
+		if err := spoke.ConvertTo(out); err != nil {
+			t.Fatalf("ConvertTo: unexpected error: %v", err)
+		}
+
+		// The deprecated regex field of matchers is converted to the
+		// equivalent match type.
+		want, got := normalizeAlertmanagerConfig(t, in), normalizeAlertmanagerConfig(t, out)
+		if !equality.Semantic.DeepEqual(want, got) {
+			t.Fatalf("round-trip conversion mismatch: %v", diffObjects(t, want, got))
+		}
+	}
+}
+
+func TestConversionFromV1alpha1IsLossless(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		spec   v1alpha1.AlertmanagerConfigSpec
+		fields []string
+	}{
+		{
+			name: "deprecated regex matchers",
+			spec: v1alpha1.AlertmanagerConfigSpec{
+				Route: &v1alpha1.Route{
+					Receiver: "default",
+					Matchers: []v1alpha1.Matcher{{Name: "job", Value: "db.*", Regex: true}},
+					Routes: []apiextensionsv1.JSON{
+						{Raw: []byte(`{"receiver":"db","matchers":[{"name":"severity","value":"critical","regex":false}]}`)},
+					},
+				},
+				InhibitRules: []v1alpha1.InhibitRule{
+					{
+						SourceMatch: []v1alpha1.Matcher{{Name: "severity", Value: "critical"}},
+						TargetMatch: []v1alpha1.Matcher{{Name: "severity", Value: "warning|info", Regex: true}},
+					},
+				},
+			},
+		},
+		{
+			name: "optional secret key selector",
+			spec: v1alpha1.AlertmanagerConfigSpec{
+				Receivers: []v1alpha1.Receiver{
+					{
+						Name: "default",
+						OpsGenieConfigs: []v1alpha1.OpsGenieConfig{
+							{
+								APIKey: &v1.SecretKeySelector{
+									LocalObjectReference: v1.LocalObjectReference{Name: "opsgenie"},
+									Key:                  "apiKey",
+									Optional:             ptr.To(true),
+								},
+								UpdateAlerts: ptr.To(true),
+							},
+						},
+					},
+				},
+			},
+			fields: []string{"spec.receivers[0].opsgenieConfigs[0].apiKey.optional"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := (&AlertmanagerConfig{}).ConvertFrom(&v1alpha1.AlertmanagerConfig{Spec: tc.spec})
+
+			if len(tc.fields) == 0 {
+				if err != nil {
+					t.Fatalf("expected no error, got %v", err)
+				}
+				return
+			}
+
+			if err == nil {
+				t.Fatal("expected error, got none")
+			}
+
+			for _, f := range tc.fields {
+				if !strings.Contains(err.Error(), f) {
+					t.Fatalf("expected error to contain %q, got %v", f, err)
+				}
+			}
+		})
+	}
+}
+
+func normalizeAlertmanagerConfig(t *testing.T, in *v1alpha1.AlertmanagerConfig) *v1alpha1.AlertmanagerConfig {
+	t.Helper()
+
+	out := in.DeepCopy()
+	if out.Spec.Route != nil {
+		if err := normalizeRoute(out.Spec.Route); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for i := range out.Spec.InhibitRules {
+		out.Spec.InhibitRules[i].SourceMatch = normalizeMatchers(out.Spec.InhibitRules[i].SourceMatch)
+		out.Spec.InhibitRules[i].TargetMatch = normalizeMatchers(out.Spec.InhibitRules[i].TargetMatch)
+	}
+
+	return out
+}
+
+// diffObjects returns the paths of the fields which differ between the JSON
+// representations of a and b.
+func diffObjects(t *testing.T, a, b any) []string {
+	t.Helper()
+
+	return diffFields("", toGeneric(t, a), toGeneric(t, b), nil)
+}
+
+func toGeneric(t *testing.T, o any) any {
+	t.Helper()
+
+	b, err := json.Marshal(o)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var out any
+	if err := json.Unmarshal(b, &out); err != nil {
+		t.Fatal(err)
+	}
+
+	return out
+}
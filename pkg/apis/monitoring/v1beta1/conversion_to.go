@@ -83,6 +83,18 @@ func convertMatchersTo(in []Matcher) []v1alpha1.Matcher {
 	return out
 }
 
+func convertCalendarSourceTo(in *CalendarSource) *v1alpha1.CalendarSource {
+	if in == nil {
+		return nil
+	}
+
+	return &v1alpha1.CalendarSource{
+		ConfigMap: in.ConfigMap,
+		Days:      in.Days,
+		TimeZone:  in.TimeZone,
+	}
+}
+
 func convertTimeIntervalsTo(in []TimePeriod) []v1alpha1.TimeInterval {
 	out := make([]v1alpha1.TimeInterval, 0, len(in))
 
@@ -200,6 +212,7 @@ func convertOpsGenieConfigTo(in OpsGenieConfig) v1alpha1.OpsGenieConfig {
 		Tags:         in.Tags,
 		Note:         in.Note,
 		Priority:     in.Priority,
+		UpdateAlerts: in.UpdateAlerts,
 		Details:      convertKeyValuesTo(in.Details),
 		Responders:   convertOpsGenieConfigRespondersTo(in.Responders),
 		HTTPConfig:   convertHTTPConfigTo(in.HTTPConfig),
@@ -417,6 +430,7 @@ func convertPushoverConfigTo(in PushoverConfig) v1alpha1.PushoverConfig {
 		Message:      in.Message,
 		URL:          in.URL,
 		URLTitle:     in.URLTitle,
+		TTL:          in.TTL,
 		Device:       in.Device,
 		Sound:        in.Sound,
 		Priority:     in.Priority,
@@ -478,11 +492,132 @@ func convertMSTeamsV2ConfigTo(in MSTeamsV2Config) v1alpha1.MSTeamsV2Config {
 	}
 }
 
+func convertJiraFieldsTo(in []JiraField) []v1alpha1.JiraField {
+	if in == nil {
+		return nil
+	}
+
+	out := make([]v1alpha1.JiraField, len(in))
+	for i := range in {
+		out[i] = v1alpha1.JiraField{
+			Key:   in[i].Key,
+			Value: in[i].Value,
+		}
+	}
+
+	return out
+}
+
+func convertJiraConfigTo(in JiraConfig) v1alpha1.JiraConfig {
+	return v1alpha1.JiraConfig{
+		SendResolved:      in.SendResolved,
+		APIURL:            (*v1alpha1.URL)(in.APIURL),
+		Project:           in.Project,
+		IssueType:         in.IssueType,
+		Summary:           in.Summary,
+		Description:       in.Description,
+		Labels:            in.Labels,
+		Priority:          in.Priority,
+		ReopenTransition:  in.ReopenTransition,
+		ResolveTransition: in.ResolveTransition,
+		WontFixResolution: in.WontFixResolution,
+		ReopenDuration:    in.ReopenDuration,
+		Fields:            convertJiraFieldsTo(in.Fields),
+		HTTPConfig:        convertHTTPConfigTo(in.HTTPConfig),
+	}
+}
+
+func convertRocketChatFieldsTo(in []RocketChatFieldConfig) []v1alpha1.RocketChatFieldConfig {
+	if in == nil {
+		return nil
+	}
+
+	out := make([]v1alpha1.RocketChatFieldConfig, len(in))
+	for i := range in {
+		out[i] = v1alpha1.RocketChatFieldConfig{
+			Title: in[i].Title,
+			Value: in[i].Value,
+			Short: in[i].Short,
+		}
+	}
+
+	return out
+}
+
+func convertRocketChatActionsTo(in []RocketChatActionConfig) []v1alpha1.RocketChatActionConfig {
+	if in == nil {
+		return nil
+	}
+
+	out := make([]v1alpha1.RocketChatActionConfig, len(in))
+	for i := range in {
+		out[i] = v1alpha1.RocketChatActionConfig{
+			Text: in[i].Text,
+			URL:  (*v1alpha1.URL)(in[i].URL),
+			Msg:  in[i].Msg,
+		}
+	}
+
+	return out
+}
+
+func convertRocketChatConfigTo(in RocketChatConfig) v1alpha1.RocketChatConfig {
+	return v1alpha1.RocketChatConfig{
+		SendResolved: in.SendResolved,
+		APIURL:       (*v1alpha1.URL)(in.APIURL),
+		TokenID:      *convertSecretKeySelectorTo(&in.TokenID),
+		Token:        *convertSecretKeySelectorTo(&in.Token),
+		Channel:      in.Channel,
+		Color:        in.Color,
+		Title:        in.Title,
+		TitleLink:    in.TitleLink,
+		Text:         in.Text,
+		Fields:       convertRocketChatFieldsTo(in.Fields),
+		ShortFields:  in.ShortFields,
+		Emoji:        in.Emoji,
+		IconURL:      (*v1alpha1.URL)(in.IconURL),
+		ImageURL:     (*v1alpha1.URL)(in.ImageURL),
+		ThumbURL:     (*v1alpha1.URL)(in.ThumbURL),
+		LinkNames:    in.LinkNames,
+		Actions:      convertRocketChatActionsTo(in.Actions),
+		HTTPConfig:   convertHTTPConfigTo(in.HTTPConfig),
+	}
+}
+
 // ConvertTo converts from this version (v1beta1) to the Hub version (v1alpha1).
+func convertAlertmanagerConfigStatusTo(in AlertmanagerConfigStatus) v1alpha1.AlertmanagerConfigStatus {
+	if in.Alertmanagers == nil {
+		return v1alpha1.AlertmanagerConfigStatus{}
+	}
+
+	out := make([]v1alpha1.AlertmanagerConfigBinding, len(in.Alertmanagers))
+	for i, b := range in.Alertmanagers {
+		out[i] = v1alpha1.AlertmanagerConfigBinding{
+			Name:      b.Name,
+			Namespace: b.Namespace,
+		}
+
+		if b.Receivers == nil {
+			continue
+		}
+
+		out[i].Receivers = make([]v1alpha1.ReceiverStatus, len(b.Receivers))
+		for j, r := range b.Receivers {
+			out[i].Receivers[j] = v1alpha1.ReceiverStatus{
+				Name:       r.Name,
+				Conditions: r.Conditions,
+			}
+		}
+	}
+
+	return v1alpha1.AlertmanagerConfigStatus{Alertmanagers: out}
+}
+
 func (src *AlertmanagerConfig) ConvertTo(dstRaw conversion.Hub) error {
 	dst := dstRaw.(*v1alpha1.AlertmanagerConfig)
 
 	dst.ObjectMeta = src.ObjectMeta
+	dst.Status = convertAlertmanagerConfigStatusTo(src.Status)
 
 	for _, in := range src.Spec.Receivers {
 		out := v1alpha1.Receiver{
@@ -587,6 +722,20 @@ func (src *AlertmanagerConfig) ConvertTo(dstRaw conversion.Hub) error {
 			)
 		}
 
+		for _, in := range in.JiraConfigs {
+			out.JiraConfigs = append(
+				out.JiraConfigs,
+				convertJiraConfigTo(in),
+			)
+		}
+
+		for _, in := range in.RocketChatConfigs {
+			out.RocketChatConfigs = append(
+				out.RocketChatConfigs,
+				convertRocketChatConfigTo(in),
+			)
+		}
+
 		dst.Spec.Receivers = append(dst.Spec.Receivers, out)
 	}
 
@@ -608,6 +757,17 @@ func (src *AlertmanagerConfig) ConvertTo(dstRaw conversion.Hub) error {
 			v1alpha1.MuteTimeInterval{
 				Name:          in.Name,
 				TimeIntervals: convertTimeIntervalsTo(in.TimeIntervals),
+				Calendar:      convertCalendarSourceTo(in.Calendar),
+			},
+		)
+	}
+
+	for _, in := range src.Spec.Templates {
+		dst.Spec.Templates = append(
+			dst.Spec.Templates,
+			v1alpha1.NotificationTemplate{
+				Name:    in.Name,
+				Content: in.Content,
 			},
 		)
 	}
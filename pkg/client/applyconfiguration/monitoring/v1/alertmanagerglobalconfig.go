@@ -24,13 +24,19 @@ import (
 // AlertmanagerGlobalConfigApplyConfiguration represents a declarative configuration of the AlertmanagerGlobalConfig type for use
 // with apply.
 type AlertmanagerGlobalConfigApplyConfiguration struct {
-	SMTPConfig     *GlobalSMTPConfigApplyConfiguration `json:"smtp,omitempty"`
-	ResolveTimeout *monitoringv1.Duration              `json:"resolveTimeout,omitempty"`
-	HTTPConfig     *HTTPConfigApplyConfiguration       `json:"httpConfig,omitempty"`
-	SlackAPIURL    *corev1.SecretKeySelector           `json:"slackApiUrl,omitempty"`
-	OpsGenieAPIURL *corev1.SecretKeySelector           `json:"opsGenieApiUrl,omitempty"`
-	OpsGenieAPIKey *corev1.SecretKeySelector           `json:"opsGenieApiKey,omitempty"`
-	PagerdutyURL   *string                             `json:"pagerdutyUrl,omitempty"`
+	SMTPConfig       *GlobalSMTPConfigApplyConfiguration       `json:"smtp,omitempty"`
+	ResolveTimeout   *monitoringv1.Duration                    `json:"resolveTimeout,omitempty"`
+	HTTPConfig       *HTTPConfigApplyConfiguration             `json:"httpConfig,omitempty"`
+	SlackAPIURL      *corev1.SecretKeySelector                 `json:"slackApiUrl,omitempty"`
+	OpsGenieAPIURL   *corev1.SecretKeySelector                 `json:"opsGenieApiUrl,omitempty"`
+	OpsGenieAPIKey   *corev1.SecretKeySelector                 `json:"opsGenieApiKey,omitempty"`
+	PagerdutyURL     *string                                   `json:"pagerdutyUrl,omitempty"`
+	TelegramConfig   *GlobalTelegramConfigApplyConfiguration   `json:"telegram,omitempty"`
+	WebexConfig      *GlobalWebexConfigApplyConfiguration      `json:"webex,omitempty"`
+	VictorOpsConfig  *GlobalVictorOpsConfigApplyConfiguration  `json:"victorops,omitempty"`
+	WeChatConfig     *GlobalWeChatConfigApplyConfiguration     `json:"wechat,omitempty"`
+	JiraConfig       *GlobalJiraConfigApplyConfiguration       `json:"jira,omitempty"`
+	RocketChatConfig *GlobalRocketChatConfigApplyConfiguration `json:"rocketChat,omitempty"`
 }
 
 // AlertmanagerGlobalConfigApplyConfiguration constructs a declarative configuration of the AlertmanagerGlobalConfig type for use with
@@ -94,3 +100,51 @@ func (b *AlertmanagerGlobalConfigApplyConfiguration) WithPagerdutyURL(value stri
 	b.PagerdutyURL = &value
 	return b
 }
+
+// WithTelegramConfig sets the TelegramConfig field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TelegramConfig field is set to the value of the last call.
+func (b *AlertmanagerGlobalConfigApplyConfiguration) WithTelegramConfig(value *GlobalTelegramConfigApplyConfiguration) *AlertmanagerGlobalConfigApplyConfiguration {
+	b.TelegramConfig = value
+	return b
+}
+
+// WithWebexConfig sets the WebexConfig field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the WebexConfig field is set to the value of the last call.
+func (b *AlertmanagerGlobalConfigApplyConfiguration) WithWebexConfig(value *GlobalWebexConfigApplyConfiguration) *AlertmanagerGlobalConfigApplyConfiguration {
+	b.WebexConfig = value
+	return b
+}
+
+// WithVictorOpsConfig sets the VictorOpsConfig field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the VictorOpsConfig field is set to the value of the last call.
+func (b *AlertmanagerGlobalConfigApplyConfiguration) WithVictorOpsConfig(value *GlobalVictorOpsConfigApplyConfiguration) *AlertmanagerGlobalConfigApplyConfiguration {
+	b.VictorOpsConfig = value
+	return b
+}
+
+// WithWeChatConfig sets the WeChatConfig field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the WeChatConfig field is set to the value of the last call.
+func (b *AlertmanagerGlobalConfigApplyConfiguration) WithWeChatConfig(value *GlobalWeChatConfigApplyConfiguration) *AlertmanagerGlobalConfigApplyConfiguration {
+	b.WeChatConfig = value
+	return b
+}
+
+// WithJiraConfig sets the JiraConfig field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the JiraConfig field is set to the value of the last call.
+func (b *AlertmanagerGlobalConfigApplyConfiguration) WithJiraConfig(value *GlobalJiraConfigApplyConfiguration) *AlertmanagerGlobalConfigApplyConfiguration {
+	b.JiraConfig = value
+	return b
+}
+
+// WithRocketChatConfig sets the RocketChatConfig field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RocketChatConfig field is set to the value of the last call.
+func (b *AlertmanagerGlobalConfigApplyConfiguration) WithRocketChatConfig(value *GlobalRocketChatConfigApplyConfiguration) *AlertmanagerGlobalConfigApplyConfiguration {
+	b.RocketChatConfig = value
+	return b
+}
@@ -0,0 +1,70 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	metav1 "k8s.io/client-go/applyconfigurations/meta/v1"
+)
+
+// AlertmanagerPeerDiscoveryApplyConfiguration represents a declarative configuration of the AlertmanagerPeerDiscovery type for use
+// with apply.
+type AlertmanagerPeerDiscoveryApplyConfiguration struct {
+	ServiceSelector          *metav1.LabelSelectorApplyConfiguration `json:"serviceSelector,omitempty"`
+	ServiceNamespaceSelector *metav1.LabelSelectorApplyConfiguration `json:"serviceNamespaceSelector,omitempty"`
+	ServicePort              *string                                 `json:"servicePort,omitempty"`
+	DNSSRVRecords            []string                                `json:"dnsSRVRecords,omitempty"`
+}
+
+// AlertmanagerPeerDiscoveryApplyConfiguration constructs a declarative configuration of the AlertmanagerPeerDiscovery type for use with
+// apply.
+func AlertmanagerPeerDiscovery() *AlertmanagerPeerDiscoveryApplyConfiguration {
+	return &AlertmanagerPeerDiscoveryApplyConfiguration{}
+}
+
+// WithServiceSelector sets the ServiceSelector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ServiceSelector field is set to the value of the last call.
+func (b *AlertmanagerPeerDiscoveryApplyConfiguration) WithServiceSelector(value *metav1.LabelSelectorApplyConfiguration) *AlertmanagerPeerDiscoveryApplyConfiguration {
+	b.ServiceSelector = value
+	return b
+}
+
+// WithServiceNamespaceSelector sets the ServiceNamespaceSelector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ServiceNamespaceSelector field is set to the value of the last call.
+func (b *AlertmanagerPeerDiscoveryApplyConfiguration) WithServiceNamespaceSelector(value *metav1.LabelSelectorApplyConfiguration) *AlertmanagerPeerDiscoveryApplyConfiguration {
+	b.ServiceNamespaceSelector = value
+	return b
+}
+
+// WithServicePort sets the ServicePort field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ServicePort field is set to the value of the last call.
+func (b *AlertmanagerPeerDiscoveryApplyConfiguration) WithServicePort(value string) *AlertmanagerPeerDiscoveryApplyConfiguration {
+	b.ServicePort = &value
+	return b
+}
+
+// WithDNSSRVRecords adds the given value to the DNSSRVRecords field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the DNSSRVRecords field.
+func (b *AlertmanagerPeerDiscoveryApplyConfiguration) WithDNSSRVRecords(values ...string) *AlertmanagerPeerDiscoveryApplyConfiguration {
+	for i := range values {
+		b.DNSSRVRecords = append(b.DNSSRVRecords, values[i])
+	}
+	return b
+}
@@ -64,6 +64,7 @@ type AlertmanagerSpecApplyConfiguration struct {
 	InitContainers                       []corev1.Container                                      `json:"initContainers,omitempty"`
 	PriorityClassName                    *string                                                 `json:"priorityClassName,omitempty"`
 	AdditionalPeers                      []string                                                `json:"additionalPeers,omitempty"`
+	PeerDiscovery                        *AlertmanagerPeerDiscoveryApplyConfiguration            `json:"peerDiscovery,omitempty"`
 	ClusterAdvertiseAddress              *string                                                 `json:"clusterAdvertiseAddress,omitempty"`
 	ClusterGossipInterval                *monitoringv1.GoDuration                                `json:"clusterGossipInterval,omitempty"`
 	ClusterLabel                         *string                                                 `json:"clusterLabel,omitempty"`
@@ -74,6 +75,9 @@ type AlertmanagerSpecApplyConfiguration struct {
 	AlertmanagerConfigSelector           *metav1.LabelSelectorApplyConfiguration                 `json:"alertmanagerConfigSelector,omitempty"`
 	AlertmanagerConfigNamespaceSelector  *metav1.LabelSelectorApplyConfiguration                 `json:"alertmanagerConfigNamespaceSelector,omitempty"`
 	AlertmanagerConfigMatcherStrategy    *AlertmanagerConfigMatcherStrategyApplyConfiguration    `json:"alertmanagerConfigMatcherStrategy,omitempty"`
+	ClusterAlertmanagerConfigSelector    *metav1.LabelSelectorApplyConfiguration                 `json:"clusterAlertmanagerConfigSelector,omitempty"`
+	SilenceSelector                      *metav1.LabelSelectorApplyConfiguration                 `json:"silenceSelector,omitempty"`
+	SilenceNamespaceSelector             *metav1.LabelSelectorApplyConfiguration                 `json:"silenceNamespaceSelector,omitempty"`
 	MinReadySeconds                      *uint32                                                 `json:"minReadySeconds,omitempty"`
 	HostAliases                          []HostAliasApplyConfiguration                           `json:"hostAliases,omitempty"`
 	Web                                  *AlertmanagerWebSpecApplyConfiguration                  `json:"web,omitempty"`
@@ -422,6 +426,14 @@ func (b *AlertmanagerSpecApplyConfiguration) WithAdditionalPeers(values ...strin
 	return b
 }
 
+// WithPeerDiscovery sets the PeerDiscovery field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PeerDiscovery field is set to the value of the last call.
+func (b *AlertmanagerSpecApplyConfiguration) WithPeerDiscovery(value *AlertmanagerPeerDiscoveryApplyConfiguration) *AlertmanagerSpecApplyConfiguration {
+	b.PeerDiscovery = value
+	return b
+}
+
 // WithClusterAdvertiseAddress sets the ClusterAdvertiseAddress field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the ClusterAdvertiseAddress field is set to the value of the last call.
@@ -502,6 +514,30 @@ func (b *AlertmanagerSpecApplyConfiguration) WithAlertmanagerConfigMatcherStrate
 	return b
 }
 
+// WithClusterAlertmanagerConfigSelector sets the ClusterAlertmanagerConfigSelector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ClusterAlertmanagerConfigSelector field is set to the value of the last call.
+func (b *AlertmanagerSpecApplyConfiguration) WithClusterAlertmanagerConfigSelector(value *metav1.LabelSelectorApplyConfiguration) *AlertmanagerSpecApplyConfiguration {
+	b.ClusterAlertmanagerConfigSelector = value
+	return b
+}
+
+// WithSilenceSelector sets the SilenceSelector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SilenceSelector field is set to the value of the last call.
+func (b *AlertmanagerSpecApplyConfiguration) WithSilenceSelector(value *metav1.LabelSelectorApplyConfiguration) *AlertmanagerSpecApplyConfiguration {
+	b.SilenceSelector = value
+	return b
+}
+
+// WithSilenceNamespaceSelector sets the SilenceNamespaceSelector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SilenceNamespaceSelector field is set to the value of the last call.
+func (b *AlertmanagerSpecApplyConfiguration) WithSilenceNamespaceSelector(value *metav1.LabelSelectorApplyConfiguration) *AlertmanagerSpecApplyConfiguration {
+	b.SilenceNamespaceSelector = value
+	return b
+}
+
 // WithMinReadySeconds sets the MinReadySeconds field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the MinReadySeconds field is set to the value of the last call.
@@ -26,6 +26,7 @@ type AlertmanagerStatusApplyConfiguration struct {
 	UnavailableReplicas *int32                        `json:"unavailableReplicas,omitempty"`
 	Selector            *string                       `json:"selector,omitempty"`
 	Conditions          []ConditionApplyConfiguration `json:"conditions,omitempty"`
+	ClusterSize         *int32                        `json:"clusterSize,omitempty"`
 }
 
 // AlertmanagerStatusApplyConfiguration constructs a declarative configuration of the AlertmanagerStatus type for use with
@@ -94,3 +95,11 @@ func (b *AlertmanagerStatusApplyConfiguration) WithConditions(values ...*Conditi
 	}
 	return b
 }
+
+// WithClusterSize sets the ClusterSize field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ClusterSize field is set to the value of the last call.
+func (b *AlertmanagerStatusApplyConfiguration) WithClusterSize(value int32) *AlertmanagerStatusApplyConfiguration {
+	b.ClusterSize = &value
+	return b
+}
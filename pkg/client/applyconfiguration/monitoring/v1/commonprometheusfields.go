@@ -42,6 +42,7 @@ type CommonPrometheusFieldsApplyConfiguration struct {
 	ImagePullSecrets                     []corev1.LocalObjectReference                           `json:"imagePullSecrets,omitempty"`
 	Replicas                             *int32                                                  `json:"replicas,omitempty"`
 	Shards                               *int32                                                  `json:"shards,omitempty"`
+	ShardingStrategy                     *monitoringv1.ShardingStrategy                          `json:"shardingStrategy,omitempty"`
 	ReplicaExternalLabelName             *string                                                 `json:"replicaExternalLabelName,omitempty"`
 	PrometheusExternalLabelName          *string                                                 `json:"prometheusExternalLabelName,omitempty"`
 	LogLevel                             *string                                                 `json:"logLevel,omitempty"`
@@ -71,6 +72,8 @@ type CommonPrometheusFieldsApplyConfiguration struct {
 	Tolerations                          []corev1.Toleration                                     `json:"tolerations,omitempty"`
 	TopologySpreadConstraints            []TopologySpreadConstraintApplyConfiguration            `json:"topologySpreadConstraints,omitempty"`
 	RemoteWrite                          []RemoteWriteSpecApplyConfiguration                     `json:"remoteWrite,omitempty"`
+	RemoteWriteSelector                  *metav1.LabelSelectorApplyConfiguration                 `json:"remoteWriteSelector,omitempty"`
+	RemoteWriteNamespaceSelector         *metav1.LabelSelectorApplyConfiguration                 `json:"remoteWriteNamespaceSelector,omitempty"`
 	OTLP                                 *OTLPConfigApplyConfiguration                           `json:"otlp,omitempty"`
 	SecurityContext                      *corev1.PodSecurityContext                              `json:"securityContext,omitempty"`
 	DNSPolicy                            *monitoringv1.DNSPolicy                                 `json:"dnsPolicy,omitempty"`
@@ -260,6 +263,14 @@ func (b *CommonPrometheusFieldsApplyConfiguration) WithShards(value int32) *Comm
 	return b
 }
 
+// WithShardingStrategy sets the ShardingStrategy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ShardingStrategy field is set to the value of the last call.
+func (b *CommonPrometheusFieldsApplyConfiguration) WithShardingStrategy(value monitoringv1.ShardingStrategy) *CommonPrometheusFieldsApplyConfiguration {
+	b.ShardingStrategy = &value
+	return b
+}
+
 // WithReplicaExternalLabelName sets the ReplicaExternalLabelName field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the ReplicaExternalLabelName field is set to the value of the last call.
@@ -530,6 +541,22 @@ func (b *CommonPrometheusFieldsApplyConfiguration) WithRemoteWrite(values ...*Re
 	return b
 }
 
+// WithRemoteWriteSelector sets the RemoteWriteSelector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RemoteWriteSelector field is set to the value of the last call.
+func (b *CommonPrometheusFieldsApplyConfiguration) WithRemoteWriteSelector(value *metav1.LabelSelectorApplyConfiguration) *CommonPrometheusFieldsApplyConfiguration {
+	b.RemoteWriteSelector = value
+	return b
+}
+
+// WithRemoteWriteNamespaceSelector sets the RemoteWriteNamespaceSelector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RemoteWriteNamespaceSelector field is set to the value of the last call.
+func (b *CommonPrometheusFieldsApplyConfiguration) WithRemoteWriteNamespaceSelector(value *metav1.LabelSelectorApplyConfiguration) *CommonPrometheusFieldsApplyConfiguration {
+	b.RemoteWriteNamespaceSelector = value
+	return b
+}
+
 // WithOTLP sets the OTLP field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the OTLP field is set to the value of the last call.
@@ -0,0 +1,60 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// GlobalWeChatConfigApplyConfiguration represents a declarative configuration of the GlobalWeChatConfig type for use
+// with apply.
+type GlobalWeChatConfigApplyConfiguration struct {
+	APIURL    *monitoringv1.URL         `json:"apiURL,omitempty"`
+	APISecret *corev1.SecretKeySelector `json:"apiSecret,omitempty"`
+	APICorpID *string                   `json:"apiCorpID,omitempty"`
+}
+
+// GlobalWeChatConfigApplyConfiguration constructs a declarative configuration of the GlobalWeChatConfig type for use with
+// apply.
+func GlobalWeChatConfig() *GlobalWeChatConfigApplyConfiguration {
+	return &GlobalWeChatConfigApplyConfiguration{}
+}
+
+// WithAPIURL sets the APIURL field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the APIURL field is set to the value of the last call.
+func (b *GlobalWeChatConfigApplyConfiguration) WithAPIURL(value monitoringv1.URL) *GlobalWeChatConfigApplyConfiguration {
+	b.APIURL = &value
+	return b
+}
+
+// WithAPISecret sets the APISecret field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the APISecret field is set to the value of the last call.
+func (b *GlobalWeChatConfigApplyConfiguration) WithAPISecret(value corev1.SecretKeySelector) *GlobalWeChatConfigApplyConfiguration {
+	b.APISecret = &value
+	return b
+}
+
+// WithAPICorpID sets the APICorpID field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the APICorpID field is set to the value of the last call.
+func (b *GlobalWeChatConfigApplyConfiguration) WithAPICorpID(value string) *GlobalWeChatConfigApplyConfiguration {
+	b.APICorpID = &value
+	return b
+}
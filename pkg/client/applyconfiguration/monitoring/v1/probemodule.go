@@ -0,0 +1,69 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+)
+
+// ProbeModuleApplyConfiguration represents a declarative configuration of the ProbeModule type for use
+// with apply.
+type ProbeModuleApplyConfiguration struct {
+	Name    *string                `json:"name,omitempty"`
+	Prober  *string                `json:"prober,omitempty"`
+	Timeout *monitoringv1.Duration `json:"timeout,omitempty"`
+	Config  *apiextensionsv1.JSON  `json:"config,omitempty"`
+}
+
+// ProbeModuleApplyConfiguration constructs a declarative configuration of the ProbeModule type for use with
+// apply.
+func ProbeModule() *ProbeModuleApplyConfiguration {
+	return &ProbeModuleApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *ProbeModuleApplyConfiguration) WithName(value string) *ProbeModuleApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithProber sets the Prober field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Prober field is set to the value of the last call.
+func (b *ProbeModuleApplyConfiguration) WithProber(value string) *ProbeModuleApplyConfiguration {
+	b.Prober = &value
+	return b
+}
+
+// WithTimeout sets the Timeout field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Timeout field is set to the value of the last call.
+func (b *ProbeModuleApplyConfiguration) WithTimeout(value monitoringv1.Duration) *ProbeModuleApplyConfiguration {
+	b.Timeout = &value
+	return b
+}
+
+// WithConfig sets the Config field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Config field is set to the value of the last call.
+func (b *ProbeModuleApplyConfiguration) WithConfig(value apiextensionsv1.JSON) *ProbeModuleApplyConfiguration {
+	b.Config = &value
+	return b
+}
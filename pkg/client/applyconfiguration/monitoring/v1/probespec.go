@@ -28,6 +28,7 @@ type ProbeSpecApplyConfiguration struct {
 	JobName                                 *string                              `json:"jobName,omitempty"`
 	ProberSpec                              *ProberSpecApplyConfiguration        `json:"prober,omitempty"`
 	Module                                  *string                              `json:"module,omitempty"`
+	Modules                                 []ProbeModuleApplyConfiguration      `json:"modules,omitempty"`
 	Targets                                 *ProbeTargetsApplyConfiguration      `json:"targets,omitempty"`
 	Interval                                *monitoringv1.Duration               `json:"interval,omitempty"`
 	ScrapeTimeout                           *monitoringv1.Duration               `json:"scrapeTimeout,omitempty"`
@@ -79,6 +80,19 @@ func (b *ProbeSpecApplyConfiguration) WithModule(value string) *ProbeSpecApplyCo
 	return b
 }
 
+// WithModules adds the given value to the Modules field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Modules field.
+func (b *ProbeSpecApplyConfiguration) WithModules(values ...*ProbeModuleApplyConfiguration) *ProbeSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithModules")
+		}
+		b.Modules = append(b.Modules, *values[i])
+	}
+	return b
+}
+
 // WithTargets sets the Targets field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Targets field is set to the value of the last call.
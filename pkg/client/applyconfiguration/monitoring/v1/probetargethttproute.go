@@ -0,0 +1,73 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	metav1 "k8s.io/client-go/applyconfigurations/meta/v1"
+)
+
+// ProbeTargetHTTPRouteApplyConfiguration represents a declarative configuration of the ProbeTargetHTTPRoute type for use
+// with apply.
+type ProbeTargetHTTPRouteApplyConfiguration struct {
+	Selector          *metav1.LabelSelectorApplyConfiguration `json:"selector,omitempty"`
+	NamespaceSelector *NamespaceSelectorApplyConfiguration    `json:"namespaceSelector,omitempty"`
+	Scheme            *string                                 `json:"scheme,omitempty"`
+	RelabelConfigs    []RelabelConfigApplyConfiguration       `json:"relabelingConfigs,omitempty"`
+}
+
+// ProbeTargetHTTPRouteApplyConfiguration constructs a declarative configuration of the ProbeTargetHTTPRoute type for use with
+// apply.
+func ProbeTargetHTTPRoute() *ProbeTargetHTTPRouteApplyConfiguration {
+	return &ProbeTargetHTTPRouteApplyConfiguration{}
+}
+
+// WithSelector sets the Selector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Selector field is set to the value of the last call.
+func (b *ProbeTargetHTTPRouteApplyConfiguration) WithSelector(value *metav1.LabelSelectorApplyConfiguration) *ProbeTargetHTTPRouteApplyConfiguration {
+	b.Selector = value
+	return b
+}
+
+// WithNamespaceSelector sets the NamespaceSelector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the NamespaceSelector field is set to the value of the last call.
+func (b *ProbeTargetHTTPRouteApplyConfiguration) WithNamespaceSelector(value *NamespaceSelectorApplyConfiguration) *ProbeTargetHTTPRouteApplyConfiguration {
+	b.NamespaceSelector = value
+	return b
+}
+
+// WithScheme sets the Scheme field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Scheme field is set to the value of the last call.
+func (b *ProbeTargetHTTPRouteApplyConfiguration) WithScheme(value string) *ProbeTargetHTTPRouteApplyConfiguration {
+	b.Scheme = &value
+	return b
+}
+
+// WithRelabelConfigs adds the given value to the RelabelConfigs field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the RelabelConfigs field.
+func (b *ProbeTargetHTTPRouteApplyConfiguration) WithRelabelConfigs(values ...*RelabelConfigApplyConfiguration) *ProbeTargetHTTPRouteApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithRelabelConfigs")
+		}
+		b.RelabelConfigs = append(b.RelabelConfigs, *values[i])
+	}
+	return b
+}
@@ -21,6 +21,8 @@ package v1
 type ProbeTargetsApplyConfiguration struct {
 	StaticConfig *ProbeTargetStaticConfigApplyConfiguration `json:"staticConfig,omitempty"`
 	Ingress      *ProbeTargetIngressApplyConfiguration      `json:"ingress,omitempty"`
+	Service      *ProbeTargetServiceApplyConfiguration      `json:"service,omitempty"`
+	HTTPRoute    *ProbeTargetHTTPRouteApplyConfiguration    `json:"httpRoute,omitempty"`
 }
 
 // ProbeTargetsApplyConfiguration constructs a declarative configuration of the ProbeTargets type for use with
@@ -44,3 +46,19 @@ func (b *ProbeTargetsApplyConfiguration) WithIngress(value *ProbeTargetIngressAp
 	b.Ingress = value
 	return b
 }
+
+// WithService sets the Service field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Service field is set to the value of the last call.
+func (b *ProbeTargetsApplyConfiguration) WithService(value *ProbeTargetServiceApplyConfiguration) *ProbeTargetsApplyConfiguration {
+	b.Service = value
+	return b
+}
+
+// WithHTTPRoute sets the HTTPRoute field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the HTTPRoute field is set to the value of the last call.
+func (b *ProbeTargetsApplyConfiguration) WithHTTPRoute(value *ProbeTargetHTTPRouteApplyConfiguration) *ProbeTargetsApplyConfiguration {
+	b.HTTPRoute = value
+	return b
+}
@@ -27,7 +27,8 @@ import (
 type PrometheusRuleApplyConfiguration struct {
 	metav1.TypeMetaApplyConfiguration    `json:",inline"`
 	*metav1.ObjectMetaApplyConfiguration `json:"metadata,omitempty"`
-	Spec                                 *PrometheusRuleSpecApplyConfiguration `json:"spec,omitempty"`
+	Spec                                 *PrometheusRuleSpecApplyConfiguration   `json:"spec,omitempty"`
+	Status                               *PrometheusRuleStatusApplyConfiguration `json:"status,omitempty"`
 }
 
 // PrometheusRule constructs a declarative configuration of the PrometheusRule type for use with
@@ -207,6 +208,14 @@ func (b *PrometheusRuleApplyConfiguration) WithSpec(value *PrometheusRuleSpecApp
 	return b
 }
 
+// WithStatus sets the Status field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Status field is set to the value of the last call.
+func (b *PrometheusRuleApplyConfiguration) WithStatus(value *PrometheusRuleStatusApplyConfiguration) *PrometheusRuleApplyConfiguration {
+	b.Status = value
+	return b
+}
+
 // GetName retrieves the value of the Name field in the declarative configuration.
 func (b *PrometheusRuleApplyConfiguration) GetName() *string {
 	b.ensureObjectMetaApplyConfigurationExists()
@@ -33,17 +33,21 @@ type PrometheusSpecApplyConfiguration struct {
 	Retention                                *monitoringv1.Duration                          `json:"retention,omitempty"`
 	RetentionSize                            *monitoringv1.ByteSize                          `json:"retentionSize,omitempty"`
 	ShardRetentionPolicy                     *ShardRetentionPolicyApplyConfiguration         `json:"shardRetentionPolicy,omitempty"`
+	ShardAutoscaling                         *ShardAutoscalingSpecApplyConfiguration         `json:"shardAutoscaling,omitempty"`
 	DisableCompaction                        *bool                                           `json:"disableCompaction,omitempty"`
 	Rules                                    *RulesApplyConfiguration                        `json:"rules,omitempty"`
 	PrometheusRulesExcludedFromEnforce       []PrometheusRuleExcludeConfigApplyConfiguration `json:"prometheusRulesExcludedFromEnforce,omitempty"`
 	RuleSelector                             *metav1.LabelSelectorApplyConfiguration         `json:"ruleSelector,omitempty"`
 	RuleNamespaceSelector                    *metav1.LabelSelectorApplyConfiguration         `json:"ruleNamespaceSelector,omitempty"`
+	OTLPTenantSelector                       *metav1.LabelSelectorApplyConfiguration         `json:"otlpTenantSelector,omitempty"`
+	OTLPTenantNamespaceSelector              *metav1.LabelSelectorApplyConfiguration         `json:"otlpTenantNamespaceSelector,omitempty"`
 	Query                                    *QuerySpecApplyConfiguration                    `json:"query,omitempty"`
 	Alerting                                 *AlertingSpecApplyConfiguration                 `json:"alerting,omitempty"`
 	AdditionalAlertRelabelConfigs            *corev1.SecretKeySelector                       `json:"additionalAlertRelabelConfigs,omitempty"`
 	AdditionalAlertManagerConfigs            *corev1.SecretKeySelector                       `json:"additionalAlertManagerConfigs,omitempty"`
 	RemoteRead                               []RemoteReadSpecApplyConfiguration              `json:"remoteRead,omitempty"`
 	Thanos                                   *ThanosSpecApplyConfiguration                   `json:"thanos,omitempty"`
+	ManagedProber                            *ManagedProberSpecApplyConfiguration            `json:"managedProber,omitempty"`
 	QueryLogFile                             *string                                         `json:"queryLogFile,omitempty"`
 	AllowOverlappingBlocks                   *bool                                           `json:"allowOverlappingBlocks,omitempty"`
 	Exemplars                                *ExemplarsApplyConfiguration                    `json:"exemplars,omitempty"`
@@ -188,6 +192,14 @@ func (b *PrometheusSpecApplyConfiguration) WithShards(value int32) *PrometheusSp
 	return b
 }
 
+// WithShardingStrategy sets the ShardingStrategy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ShardingStrategy field is set to the value of the last call.
+func (b *PrometheusSpecApplyConfiguration) WithShardingStrategy(value monitoringv1.ShardingStrategy) *PrometheusSpecApplyConfiguration {
+	b.CommonPrometheusFieldsApplyConfiguration.ShardingStrategy = &value
+	return b
+}
+
 // WithReplicaExternalLabelName sets the ReplicaExternalLabelName field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the ReplicaExternalLabelName field is set to the value of the last call.
@@ -458,6 +470,22 @@ func (b *PrometheusSpecApplyConfiguration) WithRemoteWrite(values ...*RemoteWrit
 	return b
 }
 
+// WithRemoteWriteSelector sets the RemoteWriteSelector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RemoteWriteSelector field is set to the value of the last call.
+func (b *PrometheusSpecApplyConfiguration) WithRemoteWriteSelector(value *metav1.LabelSelectorApplyConfiguration) *PrometheusSpecApplyConfiguration {
+	b.CommonPrometheusFieldsApplyConfiguration.RemoteWriteSelector = value
+	return b
+}
+
+// WithRemoteWriteNamespaceSelector sets the RemoteWriteNamespaceSelector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RemoteWriteNamespaceSelector field is set to the value of the last call.
+func (b *PrometheusSpecApplyConfiguration) WithRemoteWriteNamespaceSelector(value *metav1.LabelSelectorApplyConfiguration) *PrometheusSpecApplyConfiguration {
+	b.CommonPrometheusFieldsApplyConfiguration.RemoteWriteNamespaceSelector = value
+	return b
+}
+
 // WithOTLP sets the OTLP field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the OTLP field is set to the value of the last call.
@@ -940,6 +968,14 @@ func (b *PrometheusSpecApplyConfiguration) WithShardRetentionPolicy(value *Shard
 	return b
 }
 
+// WithShardAutoscaling sets the ShardAutoscaling field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ShardAutoscaling field is set to the value of the last call.
+func (b *PrometheusSpecApplyConfiguration) WithShardAutoscaling(value *ShardAutoscalingSpecApplyConfiguration) *PrometheusSpecApplyConfiguration {
+	b.ShardAutoscaling = value
+	return b
+}
+
 // WithDisableCompaction sets the DisableCompaction field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the DisableCompaction field is set to the value of the last call.
@@ -985,6 +1021,22 @@ func (b *PrometheusSpecApplyConfiguration) WithRuleNamespaceSelector(value *meta
 	return b
 }
 
+// WithOTLPTenantSelector sets the OTLPTenantSelector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the OTLPTenantSelector field is set to the value of the last call.
+func (b *PrometheusSpecApplyConfiguration) WithOTLPTenantSelector(value *metav1.LabelSelectorApplyConfiguration) *PrometheusSpecApplyConfiguration {
+	b.OTLPTenantSelector = value
+	return b
+}
+
+// WithOTLPTenantNamespaceSelector sets the OTLPTenantNamespaceSelector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the OTLPTenantNamespaceSelector field is set to the value of the last call.
+func (b *PrometheusSpecApplyConfiguration) WithOTLPTenantNamespaceSelector(value *metav1.LabelSelectorApplyConfiguration) *PrometheusSpecApplyConfiguration {
+	b.OTLPTenantNamespaceSelector = value
+	return b
+}
+
 // WithQuery sets the Query field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Query field is set to the value of the last call.
@@ -1038,6 +1090,14 @@ func (b *PrometheusSpecApplyConfiguration) WithThanos(value *ThanosSpecApplyConf
 	return b
 }
 
+// WithManagedProber sets the ManagedProber field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ManagedProber field is set to the value of the last call.
+func (b *PrometheusSpecApplyConfiguration) WithManagedProber(value *ManagedProberSpecApplyConfiguration) *PrometheusSpecApplyConfiguration {
+	b.ManagedProber = value
+	return b
+}
+
 // WithQueryLogFile sets the QueryLogFile field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the QueryLogFile field is set to the value of the last call.
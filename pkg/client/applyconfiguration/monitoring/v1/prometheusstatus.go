@@ -27,6 +27,7 @@ type PrometheusStatusApplyConfiguration struct {
 	Conditions          []ConditionApplyConfiguration   `json:"conditions,omitempty"`
 	ShardStatuses       []ShardStatusApplyConfiguration `json:"shardStatuses,omitempty"`
 	Shards              *int32                          `json:"shards,omitempty"`
+	RecommendedShards   *int32                          `json:"recommendedShards,omitempty"`
 	Selector            *string                         `json:"selector,omitempty"`
 }
 
@@ -110,6 +111,14 @@ func (b *PrometheusStatusApplyConfiguration) WithShards(value int32) *Prometheus
 	return b
 }
 
+// WithRecommendedShards sets the RecommendedShards field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RecommendedShards field is set to the value of the last call.
+func (b *PrometheusStatusApplyConfiguration) WithRecommendedShards(value int32) *PrometheusStatusApplyConfiguration {
+	b.RecommendedShards = &value
+	return b
+}
+
 // WithSelector sets the Selector field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Selector field is set to the value of the last call.
@@ -0,0 +1,88 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// RuleWorkloadBindingApplyConfiguration represents a declarative configuration of the RuleWorkloadBinding type for use
+// with apply.
+type RuleWorkloadBindingApplyConfiguration struct {
+	WorkloadBindingApplyConfiguration `json:",inline"`
+	Groups                            []RuleGroupStatusApplyConfiguration `json:"groups,omitempty"`
+}
+
+// RuleWorkloadBindingApplyConfiguration constructs a declarative configuration of the RuleWorkloadBinding type for use with
+// apply.
+func RuleWorkloadBinding() *RuleWorkloadBindingApplyConfiguration {
+	return &RuleWorkloadBindingApplyConfiguration{}
+}
+
+// WithGroup sets the Group field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Group field is set to the value of the last call.
+func (b *RuleWorkloadBindingApplyConfiguration) WithGroup(value string) *RuleWorkloadBindingApplyConfiguration {
+	b.WorkloadBindingApplyConfiguration.Group = &value
+	return b
+}
+
+// WithResource sets the Resource field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Resource field is set to the value of the last call.
+func (b *RuleWorkloadBindingApplyConfiguration) WithResource(value string) *RuleWorkloadBindingApplyConfiguration {
+	b.WorkloadBindingApplyConfiguration.Resource = &value
+	return b
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *RuleWorkloadBindingApplyConfiguration) WithName(value string) *RuleWorkloadBindingApplyConfiguration {
+	b.WorkloadBindingApplyConfiguration.Name = &value
+	return b
+}
+
+// WithNamespace sets the Namespace field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Namespace field is set to the value of the last call.
+func (b *RuleWorkloadBindingApplyConfiguration) WithNamespace(value string) *RuleWorkloadBindingApplyConfiguration {
+	b.WorkloadBindingApplyConfiguration.Namespace = &value
+	return b
+}
+
+// WithConditions adds the given value to the Conditions field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Conditions field.
+func (b *RuleWorkloadBindingApplyConfiguration) WithConditions(values ...*ConfigResourceConditionApplyConfiguration) *RuleWorkloadBindingApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithConditions")
+		}
+		b.WorkloadBindingApplyConfiguration.Conditions = append(b.WorkloadBindingApplyConfiguration.Conditions, *values[i])
+	}
+	return b
+}
+
+// WithGroups adds the given value to the Groups field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Groups field.
+func (b *RuleWorkloadBindingApplyConfiguration) WithGroups(values ...*RuleGroupStatusApplyConfiguration) *RuleWorkloadBindingApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithGroups")
+		}
+		b.Groups = append(b.Groups, *values[i])
+	}
+	return b
+}
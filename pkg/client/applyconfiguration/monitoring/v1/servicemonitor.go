@@ -27,7 +27,8 @@ import (
 type ServiceMonitorApplyConfiguration struct {
 	metav1.TypeMetaApplyConfiguration    `json:",inline"`
 	*metav1.ObjectMetaApplyConfiguration `json:"metadata,omitempty"`
-	Spec                                 *ServiceMonitorSpecApplyConfiguration `json:"spec,omitempty"`
+	Spec                                 *ServiceMonitorSpecApplyConfiguration   `json:"spec,omitempty"`
+	Status                               *ConfigResourceStatusApplyConfiguration `json:"status,omitempty"`
 }
 
 // ServiceMonitor constructs a declarative configuration of the ServiceMonitor type for use with
@@ -207,6 +208,14 @@ func (b *ServiceMonitorApplyConfiguration) WithSpec(value *ServiceMonitorSpecApp
 	return b
 }
 
+// WithStatus sets the Status field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Status field is set to the value of the last call.
+func (b *ServiceMonitorApplyConfiguration) WithStatus(value *ConfigResourceStatusApplyConfiguration) *ServiceMonitorApplyConfiguration {
+	b.Status = value
+	return b
+}
+
 // GetName retrieves the value of the Name field in the declarative configuration.
 func (b *ServiceMonitorApplyConfiguration) GetName() *string {
 	b.ensureObjectMetaApplyConfigurationExists()
@@ -0,0 +1,86 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+)
+
+// ShardAutoscalingSpecApplyConfiguration represents a declarative configuration of the ShardAutoscalingSpec type for use
+// with apply.
+type ShardAutoscalingSpecApplyConfiguration struct {
+	Mode                 *monitoringv1.ShardAutoscalingMode `json:"mode,omitempty"`
+	TargetSeriesPerShard *int64                             `json:"targetSeriesPerShard,omitempty"`
+	MinShards            *int32                             `json:"minShards,omitempty"`
+	MaxShards            *int32                             `json:"maxShards,omitempty"`
+	TolerancePercent     *int32                             `json:"tolerancePercent,omitempty"`
+	ScaleDownDelay       *monitoringv1.Duration             `json:"scaleDownDelay,omitempty"`
+}
+
+// ShardAutoscalingSpecApplyConfiguration constructs a declarative configuration of the ShardAutoscalingSpec type for use with
+// apply.
+func ShardAutoscalingSpec() *ShardAutoscalingSpecApplyConfiguration {
+	return &ShardAutoscalingSpecApplyConfiguration{}
+}
+
+// WithMode sets the Mode field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Mode field is set to the value of the last call.
+func (b *ShardAutoscalingSpecApplyConfiguration) WithMode(value monitoringv1.ShardAutoscalingMode) *ShardAutoscalingSpecApplyConfiguration {
+	b.Mode = &value
+	return b
+}
+
+// WithTargetSeriesPerShard sets the TargetSeriesPerShard field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TargetSeriesPerShard field is set to the value of the last call.
+func (b *ShardAutoscalingSpecApplyConfiguration) WithTargetSeriesPerShard(value int64) *ShardAutoscalingSpecApplyConfiguration {
+	b.TargetSeriesPerShard = &value
+	return b
+}
+
+// WithMinShards sets the MinShards field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MinShards field is set to the value of the last call.
+func (b *ShardAutoscalingSpecApplyConfiguration) WithMinShards(value int32) *ShardAutoscalingSpecApplyConfiguration {
+	b.MinShards = &value
+	return b
+}
+
+// WithMaxShards sets the MaxShards field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxShards field is set to the value of the last call.
+func (b *ShardAutoscalingSpecApplyConfiguration) WithMaxShards(value int32) *ShardAutoscalingSpecApplyConfiguration {
+	b.MaxShards = &value
+	return b
+}
+
+// WithTolerancePercent sets the TolerancePercent field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TolerancePercent field is set to the value of the last call.
+func (b *ShardAutoscalingSpecApplyConfiguration) WithTolerancePercent(value int32) *ShardAutoscalingSpecApplyConfiguration {
+	b.TolerancePercent = &value
+	return b
+}
+
+// WithScaleDownDelay sets the ScaleDownDelay field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ScaleDownDelay field is set to the value of the last call.
+func (b *ShardAutoscalingSpecApplyConfiguration) WithScaleDownDelay(value monitoringv1.Duration) *ShardAutoscalingSpecApplyConfiguration {
+	b.ScaleDownDelay = &value
+	return b
+}
@@ -19,10 +19,11 @@ package v1alpha1
 // AlertmanagerConfigSpecApplyConfiguration represents a declarative configuration of the AlertmanagerConfigSpec type for use
 // with apply.
 type AlertmanagerConfigSpecApplyConfiguration struct {
-	Route             *RouteApplyConfiguration             `json:"route,omitempty"`
-	Receivers         []ReceiverApplyConfiguration         `json:"receivers,omitempty"`
-	InhibitRules      []InhibitRuleApplyConfiguration      `json:"inhibitRules,omitempty"`
-	MuteTimeIntervals []MuteTimeIntervalApplyConfiguration `json:"muteTimeIntervals,omitempty"`
+	Route             *RouteApplyConfiguration                 `json:"route,omitempty"`
+	Receivers         []ReceiverApplyConfiguration             `json:"receivers,omitempty"`
+	InhibitRules      []InhibitRuleApplyConfiguration          `json:"inhibitRules,omitempty"`
+	MuteTimeIntervals []MuteTimeIntervalApplyConfiguration     `json:"muteTimeIntervals,omitempty"`
+	Templates         []NotificationTemplateApplyConfiguration `json:"templates,omitempty"`
 }
 
 // AlertmanagerConfigSpecApplyConfiguration constructs a declarative configuration of the AlertmanagerConfigSpec type for use with
@@ -77,3 +78,16 @@ func (b *AlertmanagerConfigSpecApplyConfiguration) WithMuteTimeIntervals(values
 	}
 	return b
 }
+
+// WithTemplates adds the given value to the Templates field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Templates field.
+func (b *AlertmanagerConfigSpecApplyConfiguration) WithTemplates(values ...*NotificationTemplateApplyConfiguration) *AlertmanagerConfigSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithTemplates")
+		}
+		b.Templates = append(b.Templates, *values[i])
+	}
+	return b
+}
@@ -0,0 +1,92 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/client/applyconfiguration/monitoring/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// AlertmanagerSilenceBindingApplyConfiguration represents a declarative configuration of the AlertmanagerSilenceBinding type for use
+// with apply.
+type AlertmanagerSilenceBindingApplyConfiguration struct {
+	Name       *string                                                  `json:"name,omitempty"`
+	Namespace  *string                                                  `json:"namespace,omitempty"`
+	SilenceID  *string                                                  `json:"silenceID,omitempty"`
+	State      *string                                                  `json:"state,omitempty"`
+	EndsAt     *v1.Time                                                 `json:"endsAt,omitempty"`
+	Conditions []monitoringv1.ConfigResourceConditionApplyConfiguration `json:"conditions,omitempty"`
+}
+
+// AlertmanagerSilenceBindingApplyConfiguration constructs a declarative configuration of the AlertmanagerSilenceBinding type for use with
+// apply.
+func AlertmanagerSilenceBinding() *AlertmanagerSilenceBindingApplyConfiguration {
+	return &AlertmanagerSilenceBindingApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *AlertmanagerSilenceBindingApplyConfiguration) WithName(value string) *AlertmanagerSilenceBindingApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithNamespace sets the Namespace field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Namespace field is set to the value of the last call.
+func (b *AlertmanagerSilenceBindingApplyConfiguration) WithNamespace(value string) *AlertmanagerSilenceBindingApplyConfiguration {
+	b.Namespace = &value
+	return b
+}
+
+// WithSilenceID sets the SilenceID field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SilenceID field is set to the value of the last call.
+func (b *AlertmanagerSilenceBindingApplyConfiguration) WithSilenceID(value string) *AlertmanagerSilenceBindingApplyConfiguration {
+	b.SilenceID = &value
+	return b
+}
+
+// WithState sets the State field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the State field is set to the value of the last call.
+func (b *AlertmanagerSilenceBindingApplyConfiguration) WithState(value string) *AlertmanagerSilenceBindingApplyConfiguration {
+	b.State = &value
+	return b
+}
+
+// WithEndsAt sets the EndsAt field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the EndsAt field is set to the value of the last call.
+func (b *AlertmanagerSilenceBindingApplyConfiguration) WithEndsAt(value v1.Time) *AlertmanagerSilenceBindingApplyConfiguration {
+	b.EndsAt = &value
+	return b
+}
+
+// WithConditions adds the given value to the Conditions field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Conditions field.
+func (b *AlertmanagerSilenceBindingApplyConfiguration) WithConditions(values ...*monitoringv1.ConfigResourceConditionApplyConfiguration) *AlertmanagerSilenceBindingApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithConditions")
+		}
+		b.Conditions = append(b.Conditions, *values[i])
+	}
+	return b
+}
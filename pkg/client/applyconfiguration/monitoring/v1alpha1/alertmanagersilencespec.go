@@ -0,0 +1,73 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// AlertmanagerSilenceSpecApplyConfiguration represents a declarative configuration of the AlertmanagerSilenceSpec type for use
+// with apply.
+type AlertmanagerSilenceSpecApplyConfiguration struct {
+	Matchers []MatcherApplyConfiguration `json:"matchers,omitempty"`
+	StartsAt *v1.Time                    `json:"startsAt,omitempty"`
+	EndsAt   *v1.Time                    `json:"endsAt,omitempty"`
+	Comment  *string                     `json:"comment,omitempty"`
+}
+
+// AlertmanagerSilenceSpecApplyConfiguration constructs a declarative configuration of the AlertmanagerSilenceSpec type for use with
+// apply.
+func AlertmanagerSilenceSpec() *AlertmanagerSilenceSpecApplyConfiguration {
+	return &AlertmanagerSilenceSpecApplyConfiguration{}
+}
+
+// WithMatchers adds the given value to the Matchers field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Matchers field.
+func (b *AlertmanagerSilenceSpecApplyConfiguration) WithMatchers(values ...*MatcherApplyConfiguration) *AlertmanagerSilenceSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithMatchers")
+		}
+		b.Matchers = append(b.Matchers, *values[i])
+	}
+	return b
+}
+
+// WithStartsAt sets the StartsAt field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the StartsAt field is set to the value of the last call.
+func (b *AlertmanagerSilenceSpecApplyConfiguration) WithStartsAt(value v1.Time) *AlertmanagerSilenceSpecApplyConfiguration {
+	b.StartsAt = &value
+	return b
+}
+
+// WithEndsAt sets the EndsAt field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the EndsAt field is set to the value of the last call.
+func (b *AlertmanagerSilenceSpecApplyConfiguration) WithEndsAt(value v1.Time) *AlertmanagerSilenceSpecApplyConfiguration {
+	b.EndsAt = &value
+	return b
+}
+
+// WithComment sets the Comment field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Comment field is set to the value of the last call.
+func (b *AlertmanagerSilenceSpecApplyConfiguration) WithComment(value string) *AlertmanagerSilenceSpecApplyConfiguration {
+	b.Comment = &value
+	return b
+}
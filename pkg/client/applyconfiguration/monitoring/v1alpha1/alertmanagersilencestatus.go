@@ -0,0 +1,42 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// AlertmanagerSilenceStatusApplyConfiguration represents a declarative configuration of the AlertmanagerSilenceStatus type for use
+// with apply.
+type AlertmanagerSilenceStatusApplyConfiguration struct {
+	Alertmanagers []AlertmanagerSilenceBindingApplyConfiguration `json:"alertmanagers,omitempty"`
+}
+
+// AlertmanagerSilenceStatusApplyConfiguration constructs a declarative configuration of the AlertmanagerSilenceStatus type for use with
+// apply.
+func AlertmanagerSilenceStatus() *AlertmanagerSilenceStatusApplyConfiguration {
+	return &AlertmanagerSilenceStatusApplyConfiguration{}
+}
+
+// WithAlertmanagers adds the given value to the Alertmanagers field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Alertmanagers field.
+func (b *AlertmanagerSilenceStatusApplyConfiguration) WithAlertmanagers(values ...*AlertmanagerSilenceBindingApplyConfiguration) *AlertmanagerSilenceStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithAlertmanagers")
+		}
+		b.Alertmanagers = append(b.Alertmanagers, *values[i])
+	}
+	return b
+}
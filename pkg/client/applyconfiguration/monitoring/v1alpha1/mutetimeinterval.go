@@ -19,8 +19,9 @@ package v1alpha1
 // MuteTimeIntervalApplyConfiguration represents a declarative configuration of the MuteTimeInterval type for use
 // with apply.
 type MuteTimeIntervalApplyConfiguration struct {
-	Name          *string                          `json:"name,omitempty"`
-	TimeIntervals []TimeIntervalApplyConfiguration `json:"timeIntervals,omitempty"`
+	Name          *string                           `json:"name,omitempty"`
+	TimeIntervals []TimeIntervalApplyConfiguration  `json:"timeIntervals,omitempty"`
+	Calendar      *CalendarSourceApplyConfiguration `json:"calendar,omitempty"`
 }
 
 // MuteTimeIntervalApplyConfiguration constructs a declarative configuration of the MuteTimeInterval type for use with
@@ -49,3 +50,11 @@ func (b *MuteTimeIntervalApplyConfiguration) WithTimeIntervals(values ...*TimeIn
 	}
 	return b
 }
+
+// WithCalendar sets the Calendar field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Calendar field is set to the value of the last call.
+func (b *MuteTimeIntervalApplyConfiguration) WithCalendar(value *CalendarSourceApplyConfiguration) *MuteTimeIntervalApplyConfiguration {
+	b.Calendar = value
+	return b
+}
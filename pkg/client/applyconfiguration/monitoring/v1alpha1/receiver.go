@@ -19,21 +19,23 @@ package v1alpha1
 // ReceiverApplyConfiguration represents a declarative configuration of the Receiver type for use
 // with apply.
 type ReceiverApplyConfiguration struct {
-	Name             *string                             `json:"name,omitempty"`
-	OpsGenieConfigs  []OpsGenieConfigApplyConfiguration  `json:"opsgenieConfigs,omitempty"`
-	PagerDutyConfigs []PagerDutyConfigApplyConfiguration `json:"pagerdutyConfigs,omitempty"`
-	DiscordConfigs   []DiscordConfigApplyConfiguration   `json:"discordConfigs,omitempty"`
-	SlackConfigs     []SlackConfigApplyConfiguration     `json:"slackConfigs,omitempty"`
-	WebhookConfigs   []WebhookConfigApplyConfiguration   `json:"webhookConfigs,omitempty"`
-	WeChatConfigs    []WeChatConfigApplyConfiguration    `json:"wechatConfigs,omitempty"`
-	EmailConfigs     []EmailConfigApplyConfiguration     `json:"emailConfigs,omitempty"`
-	VictorOpsConfigs []VictorOpsConfigApplyConfiguration `json:"victoropsConfigs,omitempty"`
-	PushoverConfigs  []PushoverConfigApplyConfiguration  `json:"pushoverConfigs,omitempty"`
-	SNSConfigs       []SNSConfigApplyConfiguration       `json:"snsConfigs,omitempty"`
-	TelegramConfigs  []TelegramConfigApplyConfiguration  `json:"telegramConfigs,omitempty"`
-	WebexConfigs     []WebexConfigApplyConfiguration     `json:"webexConfigs,omitempty"`
-	MSTeamsConfigs   []MSTeamsConfigApplyConfiguration   `json:"msteamsConfigs,omitempty"`
-	MSTeamsV2Configs []MSTeamsV2ConfigApplyConfiguration `json:"msteamsv2Configs,omitempty"`
+	Name              *string                              `json:"name,omitempty"`
+	OpsGenieConfigs   []OpsGenieConfigApplyConfiguration   `json:"opsgenieConfigs,omitempty"`
+	PagerDutyConfigs  []PagerDutyConfigApplyConfiguration  `json:"pagerdutyConfigs,omitempty"`
+	DiscordConfigs    []DiscordConfigApplyConfiguration    `json:"discordConfigs,omitempty"`
+	SlackConfigs      []SlackConfigApplyConfiguration      `json:"slackConfigs,omitempty"`
+	WebhookConfigs    []WebhookConfigApplyConfiguration    `json:"webhookConfigs,omitempty"`
+	WeChatConfigs     []WeChatConfigApplyConfiguration     `json:"wechatConfigs,omitempty"`
+	EmailConfigs      []EmailConfigApplyConfiguration      `json:"emailConfigs,omitempty"`
+	VictorOpsConfigs  []VictorOpsConfigApplyConfiguration  `json:"victoropsConfigs,omitempty"`
+	PushoverConfigs   []PushoverConfigApplyConfiguration   `json:"pushoverConfigs,omitempty"`
+	SNSConfigs        []SNSConfigApplyConfiguration        `json:"snsConfigs,omitempty"`
+	TelegramConfigs   []TelegramConfigApplyConfiguration   `json:"telegramConfigs,omitempty"`
+	WebexConfigs      []WebexConfigApplyConfiguration      `json:"webexConfigs,omitempty"`
+	MSTeamsConfigs    []MSTeamsConfigApplyConfiguration    `json:"msteamsConfigs,omitempty"`
+	MSTeamsV2Configs  []MSTeamsV2ConfigApplyConfiguration  `json:"msteamsv2Configs,omitempty"`
+	JiraConfigs       []JiraConfigApplyConfiguration       `json:"jiraConfigs,omitempty"`
+	RocketChatConfigs []RocketChatConfigApplyConfiguration `json:"rocketchatConfigs,omitempty"`
 }
 
 // ReceiverApplyConfiguration constructs a declarative configuration of the Receiver type for use with
@@ -231,3 +233,29 @@ func (b *ReceiverApplyConfiguration) WithMSTeamsV2Configs(values ...*MSTeamsV2Co
 	}
 	return b
 }
+
+// WithJiraConfigs adds the given value to the JiraConfigs field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the JiraConfigs field.
+func (b *ReceiverApplyConfiguration) WithJiraConfigs(values ...*JiraConfigApplyConfiguration) *ReceiverApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithJiraConfigs")
+		}
+		b.JiraConfigs = append(b.JiraConfigs, *values[i])
+	}
+	return b
+}
+
+// WithRocketChatConfigs adds the given value to the RocketChatConfigs field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the RocketChatConfigs field.
+func (b *ReceiverApplyConfiguration) WithRocketChatConfigs(values ...*RocketChatConfigApplyConfiguration) *ReceiverApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithRocketChatConfigs")
+		}
+		b.RocketChatConfigs = append(b.RocketChatConfigs, *values[i])
+	}
+	return b
+}
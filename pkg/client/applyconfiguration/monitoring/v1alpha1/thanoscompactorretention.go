@@ -0,0 +1,59 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+)
+
+// ThanosCompactorRetentionApplyConfiguration represents a declarative configuration of the ThanosCompactorRetention type for use
+// with apply.
+type ThanosCompactorRetentionApplyConfiguration struct {
+	Raw         *v1.Duration `json:"raw,omitempty"`
+	FiveMinutes *v1.Duration `json:"fiveMinutes,omitempty"`
+	OneHour     *v1.Duration `json:"oneHour,omitempty"`
+}
+
+// ThanosCompactorRetentionApplyConfiguration constructs a declarative configuration of the ThanosCompactorRetention type for use with
+// apply.
+func ThanosCompactorRetention() *ThanosCompactorRetentionApplyConfiguration {
+	return &ThanosCompactorRetentionApplyConfiguration{}
+}
+
+// WithRaw sets the Raw field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Raw field is set to the value of the last call.
+func (b *ThanosCompactorRetentionApplyConfiguration) WithRaw(value v1.Duration) *ThanosCompactorRetentionApplyConfiguration {
+	b.Raw = &value
+	return b
+}
+
+// WithFiveMinutes sets the FiveMinutes field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the FiveMinutes field is set to the value of the last call.
+func (b *ThanosCompactorRetentionApplyConfiguration) WithFiveMinutes(value v1.Duration) *ThanosCompactorRetentionApplyConfiguration {
+	b.FiveMinutes = &value
+	return b
+}
+
+// WithOneHour sets the OneHour field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the OneHour field is set to the value of the last call.
+func (b *ThanosCompactorRetentionApplyConfiguration) WithOneHour(value v1.Duration) *ThanosCompactorRetentionApplyConfiguration {
+	b.OneHour = &value
+	return b
+}
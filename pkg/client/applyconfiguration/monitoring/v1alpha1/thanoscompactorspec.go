@@ -0,0 +1,264 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/client/applyconfiguration/monitoring/v1"
+	v1 "k8s.io/api/core/v1"
+)
+
+// ThanosCompactorSpecApplyConfiguration represents a declarative configuration of the ThanosCompactorSpec type for use
+// with apply.
+type ThanosCompactorSpecApplyConfiguration struct {
+	ThanosComponentSpecApplyConfiguration `json:",inline"`
+	ObjectStorageConfig                   *v1.SecretKeySelector                       `json:"objectStorageConfig,omitempty"`
+	Storage                               *monitoringv1.StorageSpecApplyConfiguration `json:"storage,omitempty"`
+	Retention                             *ThanosCompactorRetentionApplyConfiguration `json:"retention,omitempty"`
+	DisableDownsampling                   *bool                                       `json:"disableDownsampling,omitempty"`
+	ReplicaLabels                         []string                                    `json:"replicaLabels,omitempty"`
+}
+
+// ThanosCompactorSpecApplyConfiguration constructs a declarative configuration of the ThanosCompactorSpec type for use with
+// apply.
+func ThanosCompactorSpec() *ThanosCompactorSpecApplyConfiguration {
+	return &ThanosCompactorSpecApplyConfiguration{}
+}
+
+// WithVersion sets the Version field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Version field is set to the value of the last call.
+func (b *ThanosCompactorSpecApplyConfiguration) WithVersion(value string) *ThanosCompactorSpecApplyConfiguration {
+	b.ThanosComponentSpecApplyConfiguration.Version = &value
+	return b
+}
+
+// WithImage sets the Image field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Image field is set to the value of the last call.
+func (b *ThanosCompactorSpecApplyConfiguration) WithImage(value string) *ThanosCompactorSpecApplyConfiguration {
+	b.ThanosComponentSpecApplyConfiguration.Image = &value
+	return b
+}
+
+// WithImagePullPolicy sets the ImagePullPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ImagePullPolicy field is set to the value of the last call.
+func (b *ThanosCompactorSpecApplyConfiguration) WithImagePullPolicy(value v1.PullPolicy) *ThanosCompactorSpecApplyConfiguration {
+	b.ThanosComponentSpecApplyConfiguration.ImagePullPolicy = &value
+	return b
+}
+
+// WithImagePullSecrets adds the given value to the ImagePullSecrets field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the ImagePullSecrets field.
+func (b *ThanosCompactorSpecApplyConfiguration) WithImagePullSecrets(values ...v1.LocalObjectReference) *ThanosCompactorSpecApplyConfiguration {
+	for i := range values {
+		b.ThanosComponentSpecApplyConfiguration.ImagePullSecrets = append(b.ThanosComponentSpecApplyConfiguration.ImagePullSecrets, values[i])
+	}
+	return b
+}
+
+// WithPaused sets the Paused field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Paused field is set to the value of the last call.
+func (b *ThanosCompactorSpecApplyConfiguration) WithPaused(value bool) *ThanosCompactorSpecApplyConfiguration {
+	b.ThanosComponentSpecApplyConfiguration.Paused = &value
+	return b
+}
+
+// WithPodMetadata sets the PodMetadata field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PodMetadata field is set to the value of the last call.
+func (b *ThanosCompactorSpecApplyConfiguration) WithPodMetadata(value *monitoringv1.EmbeddedObjectMetadataApplyConfiguration) *ThanosCompactorSpecApplyConfiguration {
+	b.ThanosComponentSpecApplyConfiguration.PodMetadata = value
+	return b
+}
+
+// WithNodeSelector puts the entries into the NodeSelector field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the NodeSelector field,
+// overwriting an existing map entries in NodeSelector field with the same key.
+func (b *ThanosCompactorSpecApplyConfiguration) WithNodeSelector(entries map[string]string) *ThanosCompactorSpecApplyConfiguration {
+	if b.ThanosComponentSpecApplyConfiguration.NodeSelector == nil && len(entries) > 0 {
+		b.ThanosComponentSpecApplyConfiguration.NodeSelector = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.ThanosComponentSpecApplyConfiguration.NodeSelector[k] = v
+	}
+	return b
+}
+
+// WithResources sets the Resources field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Resources field is set to the value of the last call.
+func (b *ThanosCompactorSpecApplyConfiguration) WithResources(value v1.ResourceRequirements) *ThanosCompactorSpecApplyConfiguration {
+	b.ThanosComponentSpecApplyConfiguration.Resources = &value
+	return b
+}
+
+// WithAffinity sets the Affinity field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Affinity field is set to the value of the last call.
+func (b *ThanosCompactorSpecApplyConfiguration) WithAffinity(value v1.Affinity) *ThanosCompactorSpecApplyConfiguration {
+	b.ThanosComponentSpecApplyConfiguration.Affinity = &value
+	return b
+}
+
+// WithTolerations adds the given value to the Tolerations field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Tolerations field.
+func (b *ThanosCompactorSpecApplyConfiguration) WithTolerations(values ...v1.Toleration) *ThanosCompactorSpecApplyConfiguration {
+	for i := range values {
+		b.ThanosComponentSpecApplyConfiguration.Tolerations = append(b.ThanosComponentSpecApplyConfiguration.Tolerations, values[i])
+	}
+	return b
+}
+
+// WithTopologySpreadConstraints adds the given value to the TopologySpreadConstraints field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the TopologySpreadConstraints field.
+func (b *ThanosCompactorSpecApplyConfiguration) WithTopologySpreadConstraints(values ...v1.TopologySpreadConstraint) *ThanosCompactorSpecApplyConfiguration {
+	for i := range values {
+		b.ThanosComponentSpecApplyConfiguration.TopologySpreadConstraints = append(b.ThanosComponentSpecApplyConfiguration.TopologySpreadConstraints, values[i])
+	}
+	return b
+}
+
+// WithSecurityContext sets the SecurityContext field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SecurityContext field is set to the value of the last call.
+func (b *ThanosCompactorSpecApplyConfiguration) WithSecurityContext(value v1.PodSecurityContext) *ThanosCompactorSpecApplyConfiguration {
+	b.ThanosComponentSpecApplyConfiguration.SecurityContext = &value
+	return b
+}
+
+// WithPriorityClassName sets the PriorityClassName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PriorityClassName field is set to the value of the last call.
+func (b *ThanosCompactorSpecApplyConfiguration) WithPriorityClassName(value string) *ThanosCompactorSpecApplyConfiguration {
+	b.ThanosComponentSpecApplyConfiguration.PriorityClassName = &value
+	return b
+}
+
+// WithServiceAccountName sets the ServiceAccountName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ServiceAccountName field is set to the value of the last call.
+func (b *ThanosCompactorSpecApplyConfiguration) WithServiceAccountName(value string) *ThanosCompactorSpecApplyConfiguration {
+	b.ThanosComponentSpecApplyConfiguration.ServiceAccountName = &value
+	return b
+}
+
+// WithVolumes adds the given value to the Volumes field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Volumes field.
+func (b *ThanosCompactorSpecApplyConfiguration) WithVolumes(values ...v1.Volume) *ThanosCompactorSpecApplyConfiguration {
+	for i := range values {
+		b.ThanosComponentSpecApplyConfiguration.Volumes = append(b.ThanosComponentSpecApplyConfiguration.Volumes, values[i])
+	}
+	return b
+}
+
+// WithVolumeMounts adds the given value to the VolumeMounts field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the VolumeMounts field.
+func (b *ThanosCompactorSpecApplyConfiguration) WithVolumeMounts(values ...v1.VolumeMount) *ThanosCompactorSpecApplyConfiguration {
+	for i := range values {
+		b.ThanosComponentSpecApplyConfiguration.VolumeMounts = append(b.ThanosComponentSpecApplyConfiguration.VolumeMounts, values[i])
+	}
+	return b
+}
+
+// WithContainers adds the given value to the Containers field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Containers field.
+func (b *ThanosCompactorSpecApplyConfiguration) WithContainers(values ...v1.Container) *ThanosCompactorSpecApplyConfiguration {
+	for i := range values {
+		b.ThanosComponentSpecApplyConfiguration.Containers = append(b.ThanosComponentSpecApplyConfiguration.Containers, values[i])
+	}
+	return b
+}
+
+// WithLogLevel sets the LogLevel field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LogLevel field is set to the value of the last call.
+func (b *ThanosCompactorSpecApplyConfiguration) WithLogLevel(value string) *ThanosCompactorSpecApplyConfiguration {
+	b.ThanosComponentSpecApplyConfiguration.LogLevel = &value
+	return b
+}
+
+// WithLogFormat sets the LogFormat field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LogFormat field is set to the value of the last call.
+func (b *ThanosCompactorSpecApplyConfiguration) WithLogFormat(value string) *ThanosCompactorSpecApplyConfiguration {
+	b.ThanosComponentSpecApplyConfiguration.LogFormat = &value
+	return b
+}
+
+// WithAdditionalArgs adds the given value to the AdditionalArgs field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the AdditionalArgs field.
+func (b *ThanosCompactorSpecApplyConfiguration) WithAdditionalArgs(values ...*monitoringv1.ArgumentApplyConfiguration) *ThanosCompactorSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithAdditionalArgs")
+		}
+		b.ThanosComponentSpecApplyConfiguration.AdditionalArgs = append(b.ThanosComponentSpecApplyConfiguration.AdditionalArgs, *values[i])
+	}
+	return b
+}
+
+// WithObjectStorageConfig sets the ObjectStorageConfig field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ObjectStorageConfig field is set to the value of the last call.
+func (b *ThanosCompactorSpecApplyConfiguration) WithObjectStorageConfig(value v1.SecretKeySelector) *ThanosCompactorSpecApplyConfiguration {
+	b.ObjectStorageConfig = &value
+	return b
+}
+
+// WithStorage sets the Storage field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Storage field is set to the value of the last call.
+func (b *ThanosCompactorSpecApplyConfiguration) WithStorage(value *monitoringv1.StorageSpecApplyConfiguration) *ThanosCompactorSpecApplyConfiguration {
+	b.Storage = value
+	return b
+}
+
+// WithRetention sets the Retention field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Retention field is set to the value of the last call.
+func (b *ThanosCompactorSpecApplyConfiguration) WithRetention(value *ThanosCompactorRetentionApplyConfiguration) *ThanosCompactorSpecApplyConfiguration {
+	b.Retention = value
+	return b
+}
+
+// WithDisableDownsampling sets the DisableDownsampling field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DisableDownsampling field is set to the value of the last call.
+func (b *ThanosCompactorSpecApplyConfiguration) WithDisableDownsampling(value bool) *ThanosCompactorSpecApplyConfiguration {
+	b.DisableDownsampling = &value
+	return b
+}
+
+// WithReplicaLabels adds the given value to the ReplicaLabels field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the ReplicaLabels field.
+func (b *ThanosCompactorSpecApplyConfiguration) WithReplicaLabels(values ...string) *ThanosCompactorSpecApplyConfiguration {
+	for i := range values {
+		b.ReplicaLabels = append(b.ReplicaLabels, values[i])
+	}
+	return b
+}
@@ -0,0 +1,236 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/client/applyconfiguration/monitoring/v1"
+	v1 "k8s.io/api/core/v1"
+)
+
+// ThanosComponentSpecApplyConfiguration represents a declarative configuration of the ThanosComponentSpec type for use
+// with apply.
+type ThanosComponentSpecApplyConfiguration struct {
+	Version                   *string                                                `json:"version,omitempty"`
+	Image                     *string                                                `json:"image,omitempty"`
+	ImagePullPolicy           *v1.PullPolicy                                         `json:"imagePullPolicy,omitempty"`
+	ImagePullSecrets          []v1.LocalObjectReference                              `json:"imagePullSecrets,omitempty"`
+	Paused                    *bool                                                  `json:"paused,omitempty"`
+	PodMetadata               *monitoringv1.EmbeddedObjectMetadataApplyConfiguration `json:"podMetadata,omitempty"`
+	NodeSelector              map[string]string                                      `json:"nodeSelector,omitempty"`
+	Resources                 *v1.ResourceRequirements                               `json:"resources,omitempty"`
+	Affinity                  *v1.Affinity                                           `json:"affinity,omitempty"`
+	Tolerations               []v1.Toleration                                        `json:"tolerations,omitempty"`
+	TopologySpreadConstraints []v1.TopologySpreadConstraint                          `json:"topologySpreadConstraints,omitempty"`
+	SecurityContext           *v1.PodSecurityContext                                 `json:"securityContext,omitempty"`
+	PriorityClassName         *string                                                `json:"priorityClassName,omitempty"`
+	ServiceAccountName        *string                                                `json:"serviceAccountName,omitempty"`
+	Volumes                   []v1.Volume                                            `json:"volumes,omitempty"`
+	VolumeMounts              []v1.VolumeMount                                       `json:"volumeMounts,omitempty"`
+	Containers                []v1.Container                                         `json:"containers,omitempty"`
+	LogLevel                  *string                                                `json:"logLevel,omitempty"`
+	LogFormat                 *string                                                `json:"logFormat,omitempty"`
+	AdditionalArgs            []monitoringv1.ArgumentApplyConfiguration              `json:"additionalArgs,omitempty"`
+}
+
+// ThanosComponentSpecApplyConfiguration constructs a declarative configuration of the ThanosComponentSpec type for use with
+// apply.
+func ThanosComponentSpec() *ThanosComponentSpecApplyConfiguration {
+	return &ThanosComponentSpecApplyConfiguration{}
+}
+
+// WithVersion sets the Version field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Version field is set to the value of the last call.
+func (b *ThanosComponentSpecApplyConfiguration) WithVersion(value string) *ThanosComponentSpecApplyConfiguration {
+	b.Version = &value
+	return b
+}
+
+// WithImage sets the Image field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Image field is set to the value of the last call.
+func (b *ThanosComponentSpecApplyConfiguration) WithImage(value string) *ThanosComponentSpecApplyConfiguration {
+	b.Image = &value
+	return b
+}
+
+// WithImagePullPolicy sets the ImagePullPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ImagePullPolicy field is set to the value of the last call.
+func (b *ThanosComponentSpecApplyConfiguration) WithImagePullPolicy(value v1.PullPolicy) *ThanosComponentSpecApplyConfiguration {
+	b.ImagePullPolicy = &value
+	return b
+}
+
+// WithImagePullSecrets adds the given value to the ImagePullSecrets field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the ImagePullSecrets field.
+func (b *ThanosComponentSpecApplyConfiguration) WithImagePullSecrets(values ...v1.LocalObjectReference) *ThanosComponentSpecApplyConfiguration {
+	for i := range values {
+		b.ImagePullSecrets = append(b.ImagePullSecrets, values[i])
+	}
+	return b
+}
+
+// WithPaused sets the Paused field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Paused field is set to the value of the last call.
+func (b *ThanosComponentSpecApplyConfiguration) WithPaused(value bool) *ThanosComponentSpecApplyConfiguration {
+	b.Paused = &value
+	return b
+}
+
+// WithPodMetadata sets the PodMetadata field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PodMetadata field is set to the value of the last call.
+func (b *ThanosComponentSpecApplyConfiguration) WithPodMetadata(value *monitoringv1.EmbeddedObjectMetadataApplyConfiguration) *ThanosComponentSpecApplyConfiguration {
+	b.PodMetadata = value
+	return b
+}
+
+// WithNodeSelector puts the entries into the NodeSelector field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the NodeSelector field,
+// overwriting an existing map entries in NodeSelector field with the same key.
+func (b *ThanosComponentSpecApplyConfiguration) WithNodeSelector(entries map[string]string) *ThanosComponentSpecApplyConfiguration {
+	if b.NodeSelector == nil && len(entries) > 0 {
+		b.NodeSelector = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.NodeSelector[k] = v
+	}
+	return b
+}
+
+// WithResources sets the Resources field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Resources field is set to the value of the last call.
+func (b *ThanosComponentSpecApplyConfiguration) WithResources(value v1.ResourceRequirements) *ThanosComponentSpecApplyConfiguration {
+	b.Resources = &value
+	return b
+}
+
+// WithAffinity sets the Affinity field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Affinity field is set to the value of the last call.
+func (b *ThanosComponentSpecApplyConfiguration) WithAffinity(value v1.Affinity) *ThanosComponentSpecApplyConfiguration {
+	b.Affinity = &value
+	return b
+}
+
+// WithTolerations adds the given value to the Tolerations field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Tolerations field.
+func (b *ThanosComponentSpecApplyConfiguration) WithTolerations(values ...v1.Toleration) *ThanosComponentSpecApplyConfiguration {
+	for i := range values {
+		b.Tolerations = append(b.Tolerations, values[i])
+	}
+	return b
+}
+
+// WithTopologySpreadConstraints adds the given value to the TopologySpreadConstraints field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the TopologySpreadConstraints field.
+func (b *ThanosComponentSpecApplyConfiguration) WithTopologySpreadConstraints(values ...v1.TopologySpreadConstraint) *ThanosComponentSpecApplyConfiguration {
+	for i := range values {
+		b.TopologySpreadConstraints = append(b.TopologySpreadConstraints, values[i])
+	}
+	return b
+}
+
+// WithSecurityContext sets the SecurityContext field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SecurityContext field is set to the value of the last call.
+func (b *ThanosComponentSpecApplyConfiguration) WithSecurityContext(value v1.PodSecurityContext) *ThanosComponentSpecApplyConfiguration {
+	b.SecurityContext = &value
+	return b
+}
+
+// WithPriorityClassName sets the PriorityClassName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PriorityClassName field is set to the value of the last call.
+func (b *ThanosComponentSpecApplyConfiguration) WithPriorityClassName(value string) *ThanosComponentSpecApplyConfiguration {
+	b.PriorityClassName = &value
+	return b
+}
+
+// WithServiceAccountName sets the ServiceAccountName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ServiceAccountName field is set to the value of the last call.
+func (b *ThanosComponentSpecApplyConfiguration) WithServiceAccountName(value string) *ThanosComponentSpecApplyConfiguration {
+	b.ServiceAccountName = &value
+	return b
+}
+
+// WithVolumes adds the given value to the Volumes field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Volumes field.
+func (b *ThanosComponentSpecApplyConfiguration) WithVolumes(values ...v1.Volume) *ThanosComponentSpecApplyConfiguration {
+	for i := range values {
+		b.Volumes = append(b.Volumes, values[i])
+	}
+	return b
+}
+
+// WithVolumeMounts adds the given value to the VolumeMounts field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the VolumeMounts field.
+func (b *ThanosComponentSpecApplyConfiguration) WithVolumeMounts(values ...v1.VolumeMount) *ThanosComponentSpecApplyConfiguration {
+	for i := range values {
+		b.VolumeMounts = append(b.VolumeMounts, values[i])
+	}
+	return b
+}
+
+// WithContainers adds the given value to the Containers field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Containers field.
+func (b *ThanosComponentSpecApplyConfiguration) WithContainers(values ...v1.Container) *ThanosComponentSpecApplyConfiguration {
+	for i := range values {
+		b.Containers = append(b.Containers, values[i])
+	}
+	return b
+}
+
+// WithLogLevel sets the LogLevel field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LogLevel field is set to the value of the last call.
+func (b *ThanosComponentSpecApplyConfiguration) WithLogLevel(value string) *ThanosComponentSpecApplyConfiguration {
+	b.LogLevel = &value
+	return b
+}
+
+// WithLogFormat sets the LogFormat field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LogFormat field is set to the value of the last call.
+func (b *ThanosComponentSpecApplyConfiguration) WithLogFormat(value string) *ThanosComponentSpecApplyConfiguration {
+	b.LogFormat = &value
+	return b
+}
+
+// WithAdditionalArgs adds the given value to the AdditionalArgs field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the AdditionalArgs field.
+func (b *ThanosComponentSpecApplyConfiguration) WithAdditionalArgs(values ...*monitoringv1.ArgumentApplyConfiguration) *ThanosComponentSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithAdditionalArgs")
+		}
+		b.AdditionalArgs = append(b.AdditionalArgs, *values[i])
+	}
+	return b
+}
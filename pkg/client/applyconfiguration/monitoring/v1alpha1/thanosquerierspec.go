@@ -0,0 +1,303 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/client/applyconfiguration/monitoring/v1"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/client-go/applyconfigurations/meta/v1"
+)
+
+// ThanosQuerierSpecApplyConfiguration represents a declarative configuration of the ThanosQuerierSpec type for use
+// with apply.
+type ThanosQuerierSpecApplyConfiguration struct {
+	ThanosComponentSpecApplyConfiguration `json:",inline"`
+	Replicas                              *int32                              `json:"replicas,omitempty"`
+	ReplicaLabels                         []string                            `json:"replicaLabels,omitempty"`
+	PrometheusSelector                    *v1.LabelSelectorApplyConfiguration `json:"prometheusSelector,omitempty"`
+	PrometheusNamespaceSelector           *v1.LabelSelectorApplyConfiguration `json:"prometheusNamespaceSelector,omitempty"`
+	ThanosRulerSelector                   *v1.LabelSelectorApplyConfiguration `json:"thanosRulerSelector,omitempty"`
+	ThanosRulerNamespaceSelector          *v1.LabelSelectorApplyConfiguration `json:"thanosRulerNamespaceSelector,omitempty"`
+	ThanosStoreSelector                   *v1.LabelSelectorApplyConfiguration `json:"thanosStoreSelector,omitempty"`
+	ThanosStoreNamespaceSelector          *v1.LabelSelectorApplyConfiguration `json:"thanosStoreNamespaceSelector,omitempty"`
+	Endpoints                             []string                            `json:"endpoints,omitempty"`
+}
+
+// ThanosQuerierSpecApplyConfiguration constructs a declarative configuration of the ThanosQuerierSpec type for use with
+// apply.
+func ThanosQuerierSpec() *ThanosQuerierSpecApplyConfiguration {
+	return &ThanosQuerierSpecApplyConfiguration{}
+}
+
+// WithVersion sets the Version field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Version field is set to the value of the last call.
+func (b *ThanosQuerierSpecApplyConfiguration) WithVersion(value string) *ThanosQuerierSpecApplyConfiguration {
+	b.ThanosComponentSpecApplyConfiguration.Version = &value
+	return b
+}
+
+// WithImage sets the Image field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Image field is set to the value of the last call.
+func (b *ThanosQuerierSpecApplyConfiguration) WithImage(value string) *ThanosQuerierSpecApplyConfiguration {
+	b.ThanosComponentSpecApplyConfiguration.Image = &value
+	return b
+}
+
+// WithImagePullPolicy sets the ImagePullPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ImagePullPolicy field is set to the value of the last call.
+func (b *ThanosQuerierSpecApplyConfiguration) WithImagePullPolicy(value corev1.PullPolicy) *ThanosQuerierSpecApplyConfiguration {
+	b.ThanosComponentSpecApplyConfiguration.ImagePullPolicy = &value
+	return b
+}
+
+// WithImagePullSecrets adds the given value to the ImagePullSecrets field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the ImagePullSecrets field.
+func (b *ThanosQuerierSpecApplyConfiguration) WithImagePullSecrets(values ...corev1.LocalObjectReference) *ThanosQuerierSpecApplyConfiguration {
+	for i := range values {
+		b.ThanosComponentSpecApplyConfiguration.ImagePullSecrets = append(b.ThanosComponentSpecApplyConfiguration.ImagePullSecrets, values[i])
+	}
+	return b
+}
+
+// WithPaused sets the Paused field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Paused field is set to the value of the last call.
+func (b *ThanosQuerierSpecApplyConfiguration) WithPaused(value bool) *ThanosQuerierSpecApplyConfiguration {
+	b.ThanosComponentSpecApplyConfiguration.Paused = &value
+	return b
+}
+
+// WithPodMetadata sets the PodMetadata field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PodMetadata field is set to the value of the last call.
+func (b *ThanosQuerierSpecApplyConfiguration) WithPodMetadata(value *monitoringv1.EmbeddedObjectMetadataApplyConfiguration) *ThanosQuerierSpecApplyConfiguration {
+	b.ThanosComponentSpecApplyConfiguration.PodMetadata = value
+	return b
+}
+
+// WithNodeSelector puts the entries into the NodeSelector field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the NodeSelector field,
+// overwriting an existing map entries in NodeSelector field with the same key.
+func (b *ThanosQuerierSpecApplyConfiguration) WithNodeSelector(entries map[string]string) *ThanosQuerierSpecApplyConfiguration {
+	if b.ThanosComponentSpecApplyConfiguration.NodeSelector == nil && len(entries) > 0 {
+		b.ThanosComponentSpecApplyConfiguration.NodeSelector = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.ThanosComponentSpecApplyConfiguration.NodeSelector[k] = v
+	}
+	return b
+}
+
+// WithResources sets the Resources field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Resources field is set to the value of the last call.
+func (b *ThanosQuerierSpecApplyConfiguration) WithResources(value corev1.ResourceRequirements) *ThanosQuerierSpecApplyConfiguration {
+	b.ThanosComponentSpecApplyConfiguration.Resources = &value
+	return b
+}
+
+// WithAffinity sets the Affinity field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Affinity field is set to the value of the last call.
+func (b *ThanosQuerierSpecApplyConfiguration) WithAffinity(value corev1.Affinity) *ThanosQuerierSpecApplyConfiguration {
+	b.ThanosComponentSpecApplyConfiguration.Affinity = &value
+	return b
+}
+
+// WithTolerations adds the given value to the Tolerations field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Tolerations field.
+func (b *ThanosQuerierSpecApplyConfiguration) WithTolerations(values ...corev1.Toleration) *ThanosQuerierSpecApplyConfiguration {
+	for i := range values {
+		b.ThanosComponentSpecApplyConfiguration.Tolerations = append(b.ThanosComponentSpecApplyConfiguration.Tolerations, values[i])
+	}
+	return b
+}
+
+// WithTopologySpreadConstraints adds the given value to the TopologySpreadConstraints field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the TopologySpreadConstraints field.
+func (b *ThanosQuerierSpecApplyConfiguration) WithTopologySpreadConstraints(values ...corev1.TopologySpreadConstraint) *ThanosQuerierSpecApplyConfiguration {
+	for i := range values {
+		b.ThanosComponentSpecApplyConfiguration.TopologySpreadConstraints = append(b.ThanosComponentSpecApplyConfiguration.TopologySpreadConstraints, values[i])
+	}
+	return b
+}
+
+// WithSecurityContext sets the SecurityContext field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SecurityContext field is set to the value of the last call.
+func (b *ThanosQuerierSpecApplyConfiguration) WithSecurityContext(value corev1.PodSecurityContext) *ThanosQuerierSpecApplyConfiguration {
+	b.ThanosComponentSpecApplyConfiguration.SecurityContext = &value
+	return b
+}
+
+// WithPriorityClassName sets the PriorityClassName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PriorityClassName field is set to the value of the last call.
+func (b *ThanosQuerierSpecApplyConfiguration) WithPriorityClassName(value string) *ThanosQuerierSpecApplyConfiguration {
+	b.ThanosComponentSpecApplyConfiguration.PriorityClassName = &value
+	return b
+}
+
+// WithServiceAccountName sets the ServiceAccountName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ServiceAccountName field is set to the value of the last call.
+func (b *ThanosQuerierSpecApplyConfiguration) WithServiceAccountName(value string) *ThanosQuerierSpecApplyConfiguration {
+	b.ThanosComponentSpecApplyConfiguration.ServiceAccountName = &value
+	return b
+}
+
+// WithVolumes adds the given value to the Volumes field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Volumes field.
+func (b *ThanosQuerierSpecApplyConfiguration) WithVolumes(values ...corev1.Volume) *ThanosQuerierSpecApplyConfiguration {
+	for i := range values {
+		b.ThanosComponentSpecApplyConfiguration.Volumes = append(b.ThanosComponentSpecApplyConfiguration.Volumes, values[i])
+	}
+	return b
+}
+
+// WithVolumeMounts adds the given value to the VolumeMounts field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the VolumeMounts field.
+func (b *ThanosQuerierSpecApplyConfiguration) WithVolumeMounts(values ...corev1.VolumeMount) *ThanosQuerierSpecApplyConfiguration {
+	for i := range values {
+		b.ThanosComponentSpecApplyConfiguration.VolumeMounts = append(b.ThanosComponentSpecApplyConfiguration.VolumeMounts, values[i])
+	}
+	return b
+}
+
+// WithContainers adds the given value to the Containers field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Containers field.
+func (b *ThanosQuerierSpecApplyConfiguration) WithContainers(values ...corev1.Container) *ThanosQuerierSpecApplyConfiguration {
+	for i := range values {
+		b.ThanosComponentSpecApplyConfiguration.Containers = append(b.ThanosComponentSpecApplyConfiguration.Containers, values[i])
+	}
+	return b
+}
+
+// WithLogLevel sets the LogLevel field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LogLevel field is set to the value of the last call.
+func (b *ThanosQuerierSpecApplyConfiguration) WithLogLevel(value string) *ThanosQuerierSpecApplyConfiguration {
+	b.ThanosComponentSpecApplyConfiguration.LogLevel = &value
+	return b
+}
+
+// WithLogFormat sets the LogFormat field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LogFormat field is set to the value of the last call.
+func (b *ThanosQuerierSpecApplyConfiguration) WithLogFormat(value string) *ThanosQuerierSpecApplyConfiguration {
+	b.ThanosComponentSpecApplyConfiguration.LogFormat = &value
+	return b
+}
+
+// WithAdditionalArgs adds the given value to the AdditionalArgs field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the AdditionalArgs field.
+func (b *ThanosQuerierSpecApplyConfiguration) WithAdditionalArgs(values ...*monitoringv1.ArgumentApplyConfiguration) *ThanosQuerierSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithAdditionalArgs")
+		}
+		b.ThanosComponentSpecApplyConfiguration.AdditionalArgs = append(b.ThanosComponentSpecApplyConfiguration.AdditionalArgs, *values[i])
+	}
+	return b
+}
+
+// WithReplicas sets the Replicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Replicas field is set to the value of the last call.
+func (b *ThanosQuerierSpecApplyConfiguration) WithReplicas(value int32) *ThanosQuerierSpecApplyConfiguration {
+	b.Replicas = &value
+	return b
+}
+
+// WithReplicaLabels adds the given value to the ReplicaLabels field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the ReplicaLabels field.
+func (b *ThanosQuerierSpecApplyConfiguration) WithReplicaLabels(values ...string) *ThanosQuerierSpecApplyConfiguration {
+	for i := range values {
+		b.ReplicaLabels = append(b.ReplicaLabels, values[i])
+	}
+	return b
+}
+
+// WithPrometheusSelector sets the PrometheusSelector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PrometheusSelector field is set to the value of the last call.
+func (b *ThanosQuerierSpecApplyConfiguration) WithPrometheusSelector(value *v1.LabelSelectorApplyConfiguration) *ThanosQuerierSpecApplyConfiguration {
+	b.PrometheusSelector = value
+	return b
+}
+
+// WithPrometheusNamespaceSelector sets the PrometheusNamespaceSelector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PrometheusNamespaceSelector field is set to the value of the last call.
+func (b *ThanosQuerierSpecApplyConfiguration) WithPrometheusNamespaceSelector(value *v1.LabelSelectorApplyConfiguration) *ThanosQuerierSpecApplyConfiguration {
+	b.PrometheusNamespaceSelector = value
+	return b
+}
+
+// WithThanosRulerSelector sets the ThanosRulerSelector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ThanosRulerSelector field is set to the value of the last call.
+func (b *ThanosQuerierSpecApplyConfiguration) WithThanosRulerSelector(value *v1.LabelSelectorApplyConfiguration) *ThanosQuerierSpecApplyConfiguration {
+	b.ThanosRulerSelector = value
+	return b
+}
+
+// WithThanosRulerNamespaceSelector sets the ThanosRulerNamespaceSelector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ThanosRulerNamespaceSelector field is set to the value of the last call.
+func (b *ThanosQuerierSpecApplyConfiguration) WithThanosRulerNamespaceSelector(value *v1.LabelSelectorApplyConfiguration) *ThanosQuerierSpecApplyConfiguration {
+	b.ThanosRulerNamespaceSelector = value
+	return b
+}
+
+// WithThanosStoreSelector sets the ThanosStoreSelector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ThanosStoreSelector field is set to the value of the last call.
+func (b *ThanosQuerierSpecApplyConfiguration) WithThanosStoreSelector(value *v1.LabelSelectorApplyConfiguration) *ThanosQuerierSpecApplyConfiguration {
+	b.ThanosStoreSelector = value
+	return b
+}
+
+// WithThanosStoreNamespaceSelector sets the ThanosStoreNamespaceSelector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ThanosStoreNamespaceSelector field is set to the value of the last call.
+func (b *ThanosQuerierSpecApplyConfiguration) WithThanosStoreNamespaceSelector(value *v1.LabelSelectorApplyConfiguration) *ThanosQuerierSpecApplyConfiguration {
+	b.ThanosStoreNamespaceSelector = value
+	return b
+}
+
+// WithEndpoints adds the given value to the Endpoints field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Endpoints field.
+func (b *ThanosQuerierSpecApplyConfiguration) WithEndpoints(values ...string) *ThanosQuerierSpecApplyConfiguration {
+	for i := range values {
+		b.Endpoints = append(b.Endpoints, values[i])
+	}
+	return b
+}
@@ -0,0 +1,262 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/client/applyconfiguration/monitoring/v1"
+	v1 "k8s.io/api/core/v1"
+)
+
+// ThanosStoreSpecApplyConfiguration represents a declarative configuration of the ThanosStoreSpec type for use
+// with apply.
+type ThanosStoreSpecApplyConfiguration struct {
+	ThanosComponentSpecApplyConfiguration `json:",inline"`
+	Replicas                              *int32                                      `json:"replicas,omitempty"`
+	ObjectStorageConfig                   *v1.SecretKeySelector                       `json:"objectStorageConfig,omitempty"`
+	Storage                               *monitoringv1.StorageSpecApplyConfiguration `json:"storage,omitempty"`
+	MinTime                               *string                                     `json:"minTime,omitempty"`
+	MaxTime                               *string                                     `json:"maxTime,omitempty"`
+}
+
+// ThanosStoreSpecApplyConfiguration constructs a declarative configuration of the ThanosStoreSpec type for use with
+// apply.
+func ThanosStoreSpec() *ThanosStoreSpecApplyConfiguration {
+	return &ThanosStoreSpecApplyConfiguration{}
+}
+
+// WithVersion sets the Version field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Version field is set to the value of the last call.
+func (b *ThanosStoreSpecApplyConfiguration) WithVersion(value string) *ThanosStoreSpecApplyConfiguration {
+	b.ThanosComponentSpecApplyConfiguration.Version = &value
+	return b
+}
+
+// WithImage sets the Image field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Image field is set to the value of the last call.
+func (b *ThanosStoreSpecApplyConfiguration) WithImage(value string) *ThanosStoreSpecApplyConfiguration {
+	b.ThanosComponentSpecApplyConfiguration.Image = &value
+	return b
+}
+
+// WithImagePullPolicy sets the ImagePullPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ImagePullPolicy field is set to the value of the last call.
+func (b *ThanosStoreSpecApplyConfiguration) WithImagePullPolicy(value v1.PullPolicy) *ThanosStoreSpecApplyConfiguration {
+	b.ThanosComponentSpecApplyConfiguration.ImagePullPolicy = &value
+	return b
+}
+
+// WithImagePullSecrets adds the given value to the ImagePullSecrets field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the ImagePullSecrets field.
+func (b *ThanosStoreSpecApplyConfiguration) WithImagePullSecrets(values ...v1.LocalObjectReference) *ThanosStoreSpecApplyConfiguration {
+	for i := range values {
+		b.ThanosComponentSpecApplyConfiguration.ImagePullSecrets = append(b.ThanosComponentSpecApplyConfiguration.ImagePullSecrets, values[i])
+	}
+	return b
+}
+
+// WithPaused sets the Paused field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Paused field is set to the value of the last call.
+func (b *ThanosStoreSpecApplyConfiguration) WithPaused(value bool) *ThanosStoreSpecApplyConfiguration {
+	b.ThanosComponentSpecApplyConfiguration.Paused = &value
+	return b
+}
+
+// WithPodMetadata sets the PodMetadata field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PodMetadata field is set to the value of the last call.
+func (b *ThanosStoreSpecApplyConfiguration) WithPodMetadata(value *monitoringv1.EmbeddedObjectMetadataApplyConfiguration) *ThanosStoreSpecApplyConfiguration {
+	b.ThanosComponentSpecApplyConfiguration.PodMetadata = value
+	return b
+}
+
+// WithNodeSelector puts the entries into the NodeSelector field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the NodeSelector field,
+// overwriting an existing map entries in NodeSelector field with the same key.
+func (b *ThanosStoreSpecApplyConfiguration) WithNodeSelector(entries map[string]string) *ThanosStoreSpecApplyConfiguration {
+	if b.ThanosComponentSpecApplyConfiguration.NodeSelector == nil && len(entries) > 0 {
+		b.ThanosComponentSpecApplyConfiguration.NodeSelector = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.ThanosComponentSpecApplyConfiguration.NodeSelector[k] = v
+	}
+	return b
+}
+
+// WithResources sets the Resources field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Resources field is set to the value of the last call.
+func (b *ThanosStoreSpecApplyConfiguration) WithResources(value v1.ResourceRequirements) *ThanosStoreSpecApplyConfiguration {
+	b.ThanosComponentSpecApplyConfiguration.Resources = &value
+	return b
+}
+
+// WithAffinity sets the Affinity field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Affinity field is set to the value of the last call.
+func (b *ThanosStoreSpecApplyConfiguration) WithAffinity(value v1.Affinity) *ThanosStoreSpecApplyConfiguration {
+	b.ThanosComponentSpecApplyConfiguration.Affinity = &value
+	return b
+}
+
+// WithTolerations adds the given value to the Tolerations field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Tolerations field.
+func (b *ThanosStoreSpecApplyConfiguration) WithTolerations(values ...v1.Toleration) *ThanosStoreSpecApplyConfiguration {
+	for i := range values {
+		b.ThanosComponentSpecApplyConfiguration.Tolerations = append(b.ThanosComponentSpecApplyConfiguration.Tolerations, values[i])
+	}
+	return b
+}
+
+// WithTopologySpreadConstraints adds the given value to the TopologySpreadConstraints field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the TopologySpreadConstraints field.
+func (b *ThanosStoreSpecApplyConfiguration) WithTopologySpreadConstraints(values ...v1.TopologySpreadConstraint) *ThanosStoreSpecApplyConfiguration {
+	for i := range values {
+		b.ThanosComponentSpecApplyConfiguration.TopologySpreadConstraints = append(b.ThanosComponentSpecApplyConfiguration.TopologySpreadConstraints, values[i])
+	}
+	return b
+}
+
+// WithSecurityContext sets the SecurityContext field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SecurityContext field is set to the value of the last call.
+func (b *ThanosStoreSpecApplyConfiguration) WithSecurityContext(value v1.PodSecurityContext) *ThanosStoreSpecApplyConfiguration {
+	b.ThanosComponentSpecApplyConfiguration.SecurityContext = &value
+	return b
+}
+
+// WithPriorityClassName sets the PriorityClassName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PriorityClassName field is set to the value of the last call.
+func (b *ThanosStoreSpecApplyConfiguration) WithPriorityClassName(value string) *ThanosStoreSpecApplyConfiguration {
+	b.ThanosComponentSpecApplyConfiguration.PriorityClassName = &value
+	return b
+}
+
+// WithServiceAccountName sets the ServiceAccountName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ServiceAccountName field is set to the value of the last call.
+func (b *ThanosStoreSpecApplyConfiguration) WithServiceAccountName(value string) *ThanosStoreSpecApplyConfiguration {
+	b.ThanosComponentSpecApplyConfiguration.ServiceAccountName = &value
+	return b
+}
+
+// WithVolumes adds the given value to the Volumes field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Volumes field.
+func (b *ThanosStoreSpecApplyConfiguration) WithVolumes(values ...v1.Volume) *ThanosStoreSpecApplyConfiguration {
+	for i := range values {
+		b.ThanosComponentSpecApplyConfiguration.Volumes = append(b.ThanosComponentSpecApplyConfiguration.Volumes, values[i])
+	}
+	return b
+}
+
+// WithVolumeMounts adds the given value to the VolumeMounts field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the VolumeMounts field.
+func (b *ThanosStoreSpecApplyConfiguration) WithVolumeMounts(values ...v1.VolumeMount) *ThanosStoreSpecApplyConfiguration {
+	for i := range values {
+		b.ThanosComponentSpecApplyConfiguration.VolumeMounts = append(b.ThanosComponentSpecApplyConfiguration.VolumeMounts, values[i])
+	}
+	return b
+}
+
+// WithContainers adds the given value to the Containers field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Containers field.
+func (b *ThanosStoreSpecApplyConfiguration) WithContainers(values ...v1.Container) *ThanosStoreSpecApplyConfiguration {
+	for i := range values {
+		b.ThanosComponentSpecApplyConfiguration.Containers = append(b.ThanosComponentSpecApplyConfiguration.Containers, values[i])
+	}
+	return b
+}
+
+// WithLogLevel sets the LogLevel field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LogLevel field is set to the value of the last call.
+func (b *ThanosStoreSpecApplyConfiguration) WithLogLevel(value string) *ThanosStoreSpecApplyConfiguration {
+	b.ThanosComponentSpecApplyConfiguration.LogLevel = &value
+	return b
+}
+
+// WithLogFormat sets the LogFormat field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LogFormat field is set to the value of the last call.
+func (b *ThanosStoreSpecApplyConfiguration) WithLogFormat(value string) *ThanosStoreSpecApplyConfiguration {
+	b.ThanosComponentSpecApplyConfiguration.LogFormat = &value
+	return b
+}
+
+// WithAdditionalArgs adds the given value to the AdditionalArgs field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the AdditionalArgs field.
+func (b *ThanosStoreSpecApplyConfiguration) WithAdditionalArgs(values ...*monitoringv1.ArgumentApplyConfiguration) *ThanosStoreSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithAdditionalArgs")
+		}
+		b.ThanosComponentSpecApplyConfiguration.AdditionalArgs = append(b.ThanosComponentSpecApplyConfiguration.AdditionalArgs, *values[i])
+	}
+	return b
+}
+
+// WithReplicas sets the Replicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Replicas field is set to the value of the last call.
+func (b *ThanosStoreSpecApplyConfiguration) WithReplicas(value int32) *ThanosStoreSpecApplyConfiguration {
+	b.Replicas = &value
+	return b
+}
+
+// WithObjectStorageConfig sets the ObjectStorageConfig field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ObjectStorageConfig field is set to the value of the last call.
+func (b *ThanosStoreSpecApplyConfiguration) WithObjectStorageConfig(value v1.SecretKeySelector) *ThanosStoreSpecApplyConfiguration {
+	b.ObjectStorageConfig = &value
+	return b
+}
+
+// WithStorage sets the Storage field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Storage field is set to the value of the last call.
+func (b *ThanosStoreSpecApplyConfiguration) WithStorage(value *monitoringv1.StorageSpecApplyConfiguration) *ThanosStoreSpecApplyConfiguration {
+	b.Storage = value
+	return b
+}
+
+// WithMinTime sets the MinTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MinTime field is set to the value of the last call.
+func (b *ThanosStoreSpecApplyConfiguration) WithMinTime(value string) *ThanosStoreSpecApplyConfiguration {
+	b.MinTime = &value
+	return b
+}
+
+// WithMaxTime sets the MaxTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxTime field is set to the value of the last call.
+func (b *ThanosStoreSpecApplyConfiguration) WithMaxTime(value string) *ThanosStoreSpecApplyConfiguration {
+	b.MaxTime = &value
+	return b
+}
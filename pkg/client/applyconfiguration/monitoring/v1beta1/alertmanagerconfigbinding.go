@@ -0,0 +1,60 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+// AlertmanagerConfigBindingApplyConfiguration represents a declarative configuration of the AlertmanagerConfigBinding type for use
+// with apply.
+type AlertmanagerConfigBindingApplyConfiguration struct {
+	Name      *string                            `json:"name,omitempty"`
+	Namespace *string                            `json:"namespace,omitempty"`
+	Receivers []ReceiverStatusApplyConfiguration `json:"receivers,omitempty"`
+}
+
+// AlertmanagerConfigBindingApplyConfiguration constructs a declarative configuration of the AlertmanagerConfigBinding type for use with
+// apply.
+func AlertmanagerConfigBinding() *AlertmanagerConfigBindingApplyConfiguration {
+	return &AlertmanagerConfigBindingApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *AlertmanagerConfigBindingApplyConfiguration) WithName(value string) *AlertmanagerConfigBindingApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithNamespace sets the Namespace field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Namespace field is set to the value of the last call.
+func (b *AlertmanagerConfigBindingApplyConfiguration) WithNamespace(value string) *AlertmanagerConfigBindingApplyConfiguration {
+	b.Namespace = &value
+	return b
+}
+
+// WithReceivers adds the given value to the Receivers field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Receivers field.
+func (b *AlertmanagerConfigBindingApplyConfiguration) WithReceivers(values ...*ReceiverStatusApplyConfiguration) *AlertmanagerConfigBindingApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithReceivers")
+		}
+		b.Receivers = append(b.Receivers, *values[i])
+	}
+	return b
+}
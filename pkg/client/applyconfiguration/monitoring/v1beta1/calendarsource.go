@@ -0,0 +1,59 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	v1 "k8s.io/api/core/v1"
+)
+
+// CalendarSourceApplyConfiguration represents a declarative configuration of the CalendarSource type for use
+// with apply.
+type CalendarSourceApplyConfiguration struct {
+	ConfigMap *v1.ConfigMapKeySelector `json:"configMap,omitempty"`
+	Days      *int32                   `json:"days,omitempty"`
+	TimeZone  *string                  `json:"timeZone,omitempty"`
+}
+
+// CalendarSourceApplyConfiguration constructs a declarative configuration of the CalendarSource type for use with
+// apply.
+func CalendarSource() *CalendarSourceApplyConfiguration {
+	return &CalendarSourceApplyConfiguration{}
+}
+
+// WithConfigMap sets the ConfigMap field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ConfigMap field is set to the value of the last call.
+func (b *CalendarSourceApplyConfiguration) WithConfigMap(value v1.ConfigMapKeySelector) *CalendarSourceApplyConfiguration {
+	b.ConfigMap = &value
+	return b
+}
+
+// WithDays sets the Days field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Days field is set to the value of the last call.
+func (b *CalendarSourceApplyConfiguration) WithDays(value int32) *CalendarSourceApplyConfiguration {
+	b.Days = &value
+	return b
+}
+
+// WithTimeZone sets the TimeZone field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TimeZone field is set to the value of the last call.
+func (b *CalendarSourceApplyConfiguration) WithTimeZone(value string) *CalendarSourceApplyConfiguration {
+	b.TimeZone = &value
+	return b
+}
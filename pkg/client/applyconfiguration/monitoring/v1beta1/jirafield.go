@@ -0,0 +1,50 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	v1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+)
+
+// JiraFieldApplyConfiguration represents a declarative configuration of the JiraField type for use
+// with apply.
+type JiraFieldApplyConfiguration struct {
+	Key   *string  `json:"key,omitempty"`
+	Value *v1.JSON `json:"value,omitempty"`
+}
+
+// JiraFieldApplyConfiguration constructs a declarative configuration of the JiraField type for use with
+// apply.
+func JiraField() *JiraFieldApplyConfiguration {
+	return &JiraFieldApplyConfiguration{}
+}
+
+// WithKey sets the Key field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Key field is set to the value of the last call.
+func (b *JiraFieldApplyConfiguration) WithKey(value string) *JiraFieldApplyConfiguration {
+	b.Key = &value
+	return b
+}
+
+// WithValue sets the Value field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Value field is set to the value of the last call.
+func (b *JiraFieldApplyConfiguration) WithValue(value v1.JSON) *JiraFieldApplyConfiguration {
+	b.Value = &value
+	return b
+}
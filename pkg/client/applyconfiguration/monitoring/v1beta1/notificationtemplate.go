@@ -0,0 +1,46 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+// NotificationTemplateApplyConfiguration represents a declarative configuration of the NotificationTemplate type for use
+// with apply.
+type NotificationTemplateApplyConfiguration struct {
+	Name    *string `json:"name,omitempty"`
+	Content *string `json:"content,omitempty"`
+}
+
+// NotificationTemplateApplyConfiguration constructs a declarative configuration of the NotificationTemplate type for use with
+// apply.
+func NotificationTemplate() *NotificationTemplateApplyConfiguration {
+	return &NotificationTemplateApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *NotificationTemplateApplyConfiguration) WithName(value string) *NotificationTemplateApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithContent sets the Content field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Content field is set to the value of the last call.
+func (b *NotificationTemplateApplyConfiguration) WithContent(value string) *NotificationTemplateApplyConfiguration {
+	b.Content = &value
+	return b
+}
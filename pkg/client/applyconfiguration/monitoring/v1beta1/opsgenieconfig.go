@@ -28,6 +28,7 @@ type OpsGenieConfigApplyConfiguration struct {
 	Tags         *string                                     `json:"tags,omitempty"`
 	Note         *string                                     `json:"note,omitempty"`
 	Priority     *string                                     `json:"priority,omitempty"`
+	UpdateAlerts *bool                                       `json:"updateAlerts,omitempty"`
 	Details      []KeyValueApplyConfiguration                `json:"details,omitempty"`
 	Responders   []OpsGenieConfigResponderApplyConfiguration `json:"responders,omitempty"`
 	HTTPConfig   *HTTPConfigApplyConfiguration               `json:"httpConfig,omitempty"`
@@ -113,6 +114,14 @@ func (b *OpsGenieConfigApplyConfiguration) WithPriority(value string) *OpsGenieC
 	return b
 }
 
+// WithUpdateAlerts sets the UpdateAlerts field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the UpdateAlerts field is set to the value of the last call.
+func (b *OpsGenieConfigApplyConfiguration) WithUpdateAlerts(value bool) *OpsGenieConfigApplyConfiguration {
+	b.UpdateAlerts = &value
+	return b
+}
+
 // WithDetails adds the given value to the Details field in the declarative configuration
 // and returns the receiver, so that objects can be build by chaining "With" function invocations.
 // If called multiple times, values provided by each call will be appended to the Details field.
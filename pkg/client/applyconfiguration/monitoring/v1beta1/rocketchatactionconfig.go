@@ -0,0 +1,59 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	monitoringv1beta1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1beta1"
+)
+
+// RocketChatActionConfigApplyConfiguration represents a declarative configuration of the RocketChatActionConfig type for use
+// with apply.
+type RocketChatActionConfigApplyConfiguration struct {
+	Text *string                `json:"text,omitempty"`
+	URL  *monitoringv1beta1.URL `json:"url,omitempty"`
+	Msg  *string                `json:"msg,omitempty"`
+}
+
+// RocketChatActionConfigApplyConfiguration constructs a declarative configuration of the RocketChatActionConfig type for use with
+// apply.
+func RocketChatActionConfig() *RocketChatActionConfigApplyConfiguration {
+	return &RocketChatActionConfigApplyConfiguration{}
+}
+
+// WithText sets the Text field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Text field is set to the value of the last call.
+func (b *RocketChatActionConfigApplyConfiguration) WithText(value string) *RocketChatActionConfigApplyConfiguration {
+	b.Text = &value
+	return b
+}
+
+// WithURL sets the URL field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the URL field is set to the value of the last call.
+func (b *RocketChatActionConfigApplyConfiguration) WithURL(value monitoringv1beta1.URL) *RocketChatActionConfigApplyConfiguration {
+	b.URL = &value
+	return b
+}
+
+// WithMsg sets the Msg field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Msg field is set to the value of the last call.
+func (b *RocketChatActionConfigApplyConfiguration) WithMsg(value string) *RocketChatActionConfigApplyConfiguration {
+	b.Msg = &value
+	return b
+}
@@ -19,8 +19,9 @@ package v1beta1
 // TimeIntervalApplyConfiguration represents a declarative configuration of the TimeInterval type for use
 // with apply.
 type TimeIntervalApplyConfiguration struct {
-	Name          *string                        `json:"name,omitempty"`
-	TimeIntervals []TimePeriodApplyConfiguration `json:"timeIntervals,omitempty"`
+	Name          *string                           `json:"name,omitempty"`
+	TimeIntervals []TimePeriodApplyConfiguration    `json:"timeIntervals,omitempty"`
+	Calendar      *CalendarSourceApplyConfiguration `json:"calendar,omitempty"`
 }
 
 // TimeIntervalApplyConfiguration constructs a declarative configuration of the TimeInterval type for use with
@@ -49,3 +50,11 @@ func (b *TimeIntervalApplyConfiguration) WithTimeIntervals(values ...*TimePeriod
 	}
 	return b
 }
+
+// WithCalendar sets the Calendar field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Calendar field is set to the value of the last call.
+func (b *TimeIntervalApplyConfiguration) WithCalendar(value *CalendarSourceApplyConfiguration) *TimeIntervalApplyConfiguration {
+	b.Calendar = value
+	return b
+}
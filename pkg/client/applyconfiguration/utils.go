@@ -48,6 +48,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &monitoringv1.AlertmanagerGlobalConfigApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("AlertmanagerLimitsSpec"):
 		return &monitoringv1.AlertmanagerLimitsSpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("AlertmanagerPeerDiscovery"):
+		return &monitoringv1.AlertmanagerPeerDiscoveryApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("AlertmanagerSpec"):
 		return &monitoringv1.AlertmanagerSpecApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("AlertmanagerStatus"):
@@ -78,6 +80,10 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &monitoringv1.CommonPrometheusFieldsApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("Condition"):
 		return &monitoringv1.ConditionApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("ConfigResourceCondition"):
+		return &monitoringv1.ConfigResourceConditionApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("ConfigResourceStatus"):
+		return &monitoringv1.ConfigResourceStatusApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("CoreV1TopologySpreadConstraint"):
 		return &monitoringv1.CoreV1TopologySpreadConstraintApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("EmbeddedObjectMetadata"):
@@ -88,8 +94,20 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &monitoringv1.EndpointApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("Exemplars"):
 		return &monitoringv1.ExemplarsApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("GlobalJiraConfig"):
+		return &monitoringv1.GlobalJiraConfigApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("GlobalRocketChatConfig"):
+		return &monitoringv1.GlobalRocketChatConfigApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("GlobalSMTPConfig"):
 		return &monitoringv1.GlobalSMTPConfigApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("GlobalTelegramConfig"):
+		return &monitoringv1.GlobalTelegramConfigApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("GlobalVictorOpsConfig"):
+		return &monitoringv1.GlobalVictorOpsConfigApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("GlobalWebexConfig"):
+		return &monitoringv1.GlobalWebexConfigApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("GlobalWeChatConfig"):
+		return &monitoringv1.GlobalWeChatConfigApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("HostAlias"):
 		return &monitoringv1.HostAliasApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("HostPort"):
@@ -98,6 +116,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &monitoringv1.HTTPConfigApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("ManagedIdentity"):
 		return &monitoringv1.ManagedIdentityApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("ManagedProberSpec"):
+		return &monitoringv1.ManagedProberSpecApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("MetadataConfig"):
 		return &monitoringv1.MetadataConfigApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("NamespaceSelector"):
@@ -122,14 +142,20 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &monitoringv1.PodMonitorSpecApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("Probe"):
 		return &monitoringv1.ProbeApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("ProbeModule"):
+		return &monitoringv1.ProbeModuleApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("ProberSpec"):
 		return &monitoringv1.ProberSpecApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("ProbeSpec"):
 		return &monitoringv1.ProbeSpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("ProbeTargetHTTPRoute"):
+		return &monitoringv1.ProbeTargetHTTPRouteApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("ProbeTargetIngress"):
 		return &monitoringv1.ProbeTargetIngressApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("ProbeTargets"):
 		return &monitoringv1.ProbeTargetsApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("ProbeTargetService"):
+		return &monitoringv1.ProbeTargetServiceApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("ProbeTargetStaticConfig"):
 		return &monitoringv1.ProbeTargetStaticConfigApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("Prometheus"):
@@ -140,6 +166,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &monitoringv1.PrometheusRuleExcludeConfigApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("PrometheusRuleSpec"):
 		return &monitoringv1.PrometheusRuleSpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("PrometheusRuleStatus"):
+		return &monitoringv1.PrometheusRuleStatusApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("PrometheusSpec"):
 		return &monitoringv1.PrometheusSpecApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("PrometheusStatus"):
@@ -166,10 +194,14 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &monitoringv1.RuleApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("RuleGroup"):
 		return &monitoringv1.RuleGroupApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("RuleGroupStatus"):
+		return &monitoringv1.RuleGroupStatusApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("Rules"):
 		return &monitoringv1.RulesApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("RulesAlert"):
 		return &monitoringv1.RulesAlertApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("RuleWorkloadBinding"):
+		return &monitoringv1.RuleWorkloadBindingApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("RuntimeConfig"):
 		return &monitoringv1.RuntimeConfigApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("SafeAuthorization"):
@@ -184,6 +216,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &monitoringv1.ServiceMonitorApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("ServiceMonitorSpec"):
 		return &monitoringv1.ServiceMonitorSpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("ShardAutoscalingSpec"):
+		return &monitoringv1.ShardAutoscalingSpecApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("ShardRetentionPolicy"):
 		return &monitoringv1.ShardRetentionPolicyApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("ShardStatus"):
@@ -216,16 +250,34 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &monitoringv1.WebHTTPHeadersApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("WebTLSConfig"):
 		return &monitoringv1.WebTLSConfigApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("WorkloadBinding"):
+		return &monitoringv1.WorkloadBindingApplyConfiguration{}
 
 		// Group=monitoring.coreos.com, Version=v1alpha1
 	case v1alpha1.SchemeGroupVersion.WithKind("AlertmanagerConfig"):
 		return &monitoringv1alpha1.AlertmanagerConfigApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("AlertmanagerConfigBinding"):
+		return &monitoringv1alpha1.AlertmanagerConfigBindingApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("AlertmanagerConfigSpec"):
 		return &monitoringv1alpha1.AlertmanagerConfigSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("AlertmanagerConfigStatus"):
+		return &monitoringv1alpha1.AlertmanagerConfigStatusApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("AlertmanagerSilence"):
+		return &monitoringv1alpha1.AlertmanagerSilenceApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("AlertmanagerSilenceBinding"):
+		return &monitoringv1alpha1.AlertmanagerSilenceBindingApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("AlertmanagerSilenceSpec"):
+		return &monitoringv1alpha1.AlertmanagerSilenceSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("AlertmanagerSilenceStatus"):
+		return &monitoringv1alpha1.AlertmanagerSilenceStatusApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("AttachMetadata"):
 		return &monitoringv1alpha1.AttachMetadataApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("AzureSDConfig"):
 		return &monitoringv1alpha1.AzureSDConfigApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("CalendarSource"):
+		return &monitoringv1alpha1.CalendarSourceApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ClusterAlertmanagerConfig"):
+		return &monitoringv1alpha1.ClusterAlertmanagerConfigApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("ConsulSDConfig"):
 		return &monitoringv1alpha1.ConsulSDConfigApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("DayOfMonthRange"):
@@ -262,6 +314,10 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &monitoringv1alpha1.InhibitRuleApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("IonosSDConfig"):
 		return &monitoringv1alpha1.IonosSDConfigApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("JiraConfig"):
+		return &monitoringv1alpha1.JiraConfigApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("JiraField"):
+		return &monitoringv1alpha1.JiraFieldApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("K8SSelectorConfig"):
 		return &monitoringv1alpha1.K8SSelectorConfigApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("KeyValue"):
@@ -274,6 +330,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &monitoringv1alpha1.LightSailSDConfigApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("LinodeSDConfig"):
 		return &monitoringv1alpha1.LinodeSDConfigApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("MarathonSDConfig"):
+		return &monitoringv1alpha1.MarathonSDConfigApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("Matcher"):
 		return &monitoringv1alpha1.MatcherApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("MSTeamsConfig"):
@@ -284,14 +342,22 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &monitoringv1alpha1.MuteTimeIntervalApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("NamespaceDiscovery"):
 		return &monitoringv1alpha1.NamespaceDiscoveryApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("NerveSDConfig"):
+		return &monitoringv1alpha1.NerveSDConfigApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("NomadSDConfig"):
 		return &monitoringv1alpha1.NomadSDConfigApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("NotificationTemplate"):
+		return &monitoringv1alpha1.NotificationTemplateApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("OpenStackSDConfig"):
 		return &monitoringv1alpha1.OpenStackSDConfigApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("OpsGenieConfig"):
 		return &monitoringv1alpha1.OpsGenieConfigApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("OpsGenieConfigResponder"):
 		return &monitoringv1alpha1.OpsGenieConfigResponderApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("OTLPTenant"):
+		return &monitoringv1alpha1.OTLPTenantApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("OTLPTenantSpec"):
+		return &monitoringv1alpha1.OTLPTenantSpecApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("OVHCloudSDConfig"):
 		return &monitoringv1alpha1.OVHCloudSDConfigApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("PagerDutyConfig"):
@@ -310,6 +376,16 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &monitoringv1alpha1.PushoverConfigApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("Receiver"):
 		return &monitoringv1alpha1.ReceiverApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ReceiverStatus"):
+		return &monitoringv1alpha1.ReceiverStatusApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("RemoteWrite"):
+		return &monitoringv1alpha1.RemoteWriteApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("RocketChatActionConfig"):
+		return &monitoringv1alpha1.RocketChatActionConfigApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("RocketChatConfig"):
+		return &monitoringv1alpha1.RocketChatConfigApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("RocketChatFieldConfig"):
+		return &monitoringv1alpha1.RocketChatFieldConfigApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("Route"):
 		return &monitoringv1alpha1.RouteApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("ScalewaySDConfig"):
@@ -318,6 +394,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &monitoringv1alpha1.ScrapeConfigApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("ScrapeConfigSpec"):
 		return &monitoringv1alpha1.ScrapeConfigSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ServersetSDConfig"):
+		return &monitoringv1alpha1.ServersetSDConfigApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("SlackAction"):
 		return &monitoringv1alpha1.SlackActionApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("SlackConfig"):
@@ -332,12 +410,34 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &monitoringv1alpha1.StaticConfigApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("TelegramConfig"):
 		return &monitoringv1alpha1.TelegramConfigApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ThanosCompactor"):
+		return &monitoringv1alpha1.ThanosCompactorApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ThanosCompactorRetention"):
+		return &monitoringv1alpha1.ThanosCompactorRetentionApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ThanosCompactorSpec"):
+		return &monitoringv1alpha1.ThanosCompactorSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ThanosComponentSpec"):
+		return &monitoringv1alpha1.ThanosComponentSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ThanosComponentStatus"):
+		return &monitoringv1alpha1.ThanosComponentStatusApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ThanosQuerier"):
+		return &monitoringv1alpha1.ThanosQuerierApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ThanosQuerierSpec"):
+		return &monitoringv1alpha1.ThanosQuerierSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ThanosStore"):
+		return &monitoringv1alpha1.ThanosStoreApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ThanosStoreSpec"):
+		return &monitoringv1alpha1.ThanosStoreSpecApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("TimeInterval"):
 		return &monitoringv1alpha1.TimeIntervalApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("TimeRange"):
 		return &monitoringv1alpha1.TimeRangeApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("UyuniSDConfig"):
+		return &monitoringv1alpha1.UyuniSDConfigApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("VictorOpsConfig"):
 		return &monitoringv1alpha1.VictorOpsConfigApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("VultrSDConfig"):
+		return &monitoringv1alpha1.VultrSDConfigApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("WebexConfig"):
 		return &monitoringv1alpha1.WebexConfigApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("WebhookConfig"):
@@ -348,8 +448,14 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		// Group=monitoring.coreos.com, Version=v1beta1
 	case v1beta1.SchemeGroupVersion.WithKind("AlertmanagerConfig"):
 		return &monitoringv1beta1.AlertmanagerConfigApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("AlertmanagerConfigBinding"):
+		return &monitoringv1beta1.AlertmanagerConfigBindingApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("AlertmanagerConfigSpec"):
 		return &monitoringv1beta1.AlertmanagerConfigSpecApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("AlertmanagerConfigStatus"):
+		return &monitoringv1beta1.AlertmanagerConfigStatusApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("CalendarSource"):
+		return &monitoringv1beta1.CalendarSourceApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("DayOfMonthRange"):
 		return &monitoringv1beta1.DayOfMonthRangeApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("DiscordConfig"):
@@ -360,6 +466,10 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &monitoringv1beta1.HTTPConfigApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("InhibitRule"):
 		return &monitoringv1beta1.InhibitRuleApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("JiraConfig"):
+		return &monitoringv1beta1.JiraConfigApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("JiraField"):
+		return &monitoringv1beta1.JiraFieldApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("KeyValue"):
 		return &monitoringv1beta1.KeyValueApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("Matcher"):
@@ -368,6 +478,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &monitoringv1beta1.MSTeamsConfigApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("MSTeamsV2Config"):
 		return &monitoringv1beta1.MSTeamsV2ConfigApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("NotificationTemplate"):
+		return &monitoringv1beta1.NotificationTemplateApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("OpsGenieConfig"):
 		return &monitoringv1beta1.OpsGenieConfigApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("OpsGenieConfigResponder"):
@@ -382,6 +494,14 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &monitoringv1beta1.PushoverConfigApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("Receiver"):
 		return &monitoringv1beta1.ReceiverApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("ReceiverStatus"):
+		return &monitoringv1beta1.ReceiverStatusApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("RocketChatActionConfig"):
+		return &monitoringv1beta1.RocketChatActionConfigApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("RocketChatConfig"):
+		return &monitoringv1beta1.RocketChatConfigApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("RocketChatFieldConfig"):
+		return &monitoringv1beta1.RocketChatFieldConfigApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("Route"):
 		return &monitoringv1beta1.RouteApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("SecretKeySelector"):
@@ -0,0 +1,68 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	listers "k8s.io/client-go/listers"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// AlertmanagerSilenceLister helps list AlertmanagerSilences.
+// All objects returned here must be treated as read-only.
+type AlertmanagerSilenceLister interface {
+	// List lists all AlertmanagerSilences in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*monitoringv1alpha1.AlertmanagerSilence, err error)
+	// AlertmanagerSilences returns an object that can list and get AlertmanagerSilences.
+	AlertmanagerSilences(namespace string) AlertmanagerSilenceNamespaceLister
+	AlertmanagerSilenceListerExpansion
+}
+
+// alertmanagerSilenceLister implements the AlertmanagerSilenceLister interface.
+type alertmanagerSilenceLister struct {
+	listers.ResourceIndexer[*monitoringv1alpha1.AlertmanagerSilence]
+}
+
+// NewAlertmanagerSilenceLister returns a new AlertmanagerSilenceLister.
+func NewAlertmanagerSilenceLister(indexer cache.Indexer) AlertmanagerSilenceLister {
+	return &alertmanagerSilenceLister{listers.New[*monitoringv1alpha1.AlertmanagerSilence](indexer, monitoringv1alpha1.Resource("alertmanagersilence"))}
+}
+
+// AlertmanagerSilences returns an object that can list and get AlertmanagerSilences.
+func (s *alertmanagerSilenceLister) AlertmanagerSilences(namespace string) AlertmanagerSilenceNamespaceLister {
+	return alertmanagerSilenceNamespaceLister{listers.NewNamespaced[*monitoringv1alpha1.AlertmanagerSilence](s.ResourceIndexer, namespace)}
+}
+
+// AlertmanagerSilenceNamespaceLister helps list and get AlertmanagerSilences.
+// All objects returned here must be treated as read-only.
+type AlertmanagerSilenceNamespaceLister interface {
+	// List lists all AlertmanagerSilences in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*monitoringv1alpha1.AlertmanagerSilence, err error)
+	// Get retrieves the AlertmanagerSilence from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*monitoringv1alpha1.AlertmanagerSilence, error)
+	AlertmanagerSilenceNamespaceListerExpansion
+}
+
+// alertmanagerSilenceNamespaceLister implements the AlertmanagerSilenceNamespaceLister
+// interface.
+type alertmanagerSilenceNamespaceLister struct {
+	listers.ResourceIndexer[*monitoringv1alpha1.AlertmanagerSilence]
+}
@@ -0,0 +1,46 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	listers "k8s.io/client-go/listers"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// ClusterAlertmanagerConfigLister helps list ClusterAlertmanagerConfigs.
+// All objects returned here must be treated as read-only.
+type ClusterAlertmanagerConfigLister interface {
+	// List lists all ClusterAlertmanagerConfigs in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*monitoringv1alpha1.ClusterAlertmanagerConfig, err error)
+	// Get retrieves the ClusterAlertmanagerConfig from the index for a given name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*monitoringv1alpha1.ClusterAlertmanagerConfig, error)
+	ClusterAlertmanagerConfigListerExpansion
+}
+
+// clusterAlertmanagerConfigLister implements the ClusterAlertmanagerConfigLister interface.
+type clusterAlertmanagerConfigLister struct {
+	listers.ResourceIndexer[*monitoringv1alpha1.ClusterAlertmanagerConfig]
+}
+
+// NewClusterAlertmanagerConfigLister returns a new ClusterAlertmanagerConfigLister.
+func NewClusterAlertmanagerConfigLister(indexer cache.Indexer) ClusterAlertmanagerConfigLister {
+	return &clusterAlertmanagerConfigLister{listers.New[*monitoringv1alpha1.ClusterAlertmanagerConfig](indexer, monitoringv1alpha1.Resource("clusteralertmanagerconfig"))}
+}
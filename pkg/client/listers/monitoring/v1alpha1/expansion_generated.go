@@ -24,6 +24,26 @@ type AlertmanagerConfigListerExpansion interface{}
 // AlertmanagerConfigNamespaceLister.
 type AlertmanagerConfigNamespaceListerExpansion interface{}
 
+// AlertmanagerSilenceListerExpansion allows custom methods to be added to
+// AlertmanagerSilenceLister.
+type AlertmanagerSilenceListerExpansion interface{}
+
+// AlertmanagerSilenceNamespaceListerExpansion allows custom methods to be added to
+// AlertmanagerSilenceNamespaceLister.
+type AlertmanagerSilenceNamespaceListerExpansion interface{}
+
+// ClusterAlertmanagerConfigListerExpansion allows custom methods to be added to
+// ClusterAlertmanagerConfigLister.
+type ClusterAlertmanagerConfigListerExpansion interface{}
+
+// OTLPTenantListerExpansion allows custom methods to be added to
+// OTLPTenantLister.
+type OTLPTenantListerExpansion interface{}
+
+// OTLPTenantNamespaceListerExpansion allows custom methods to be added to
+// OTLPTenantNamespaceLister.
+type OTLPTenantNamespaceListerExpansion interface{}
+
 // PrometheusAgentListerExpansion allows custom methods to be added to
 // PrometheusAgentLister.
 type PrometheusAgentListerExpansion interface{}
@@ -32,6 +52,14 @@ type PrometheusAgentListerExpansion interface{}
 // PrometheusAgentNamespaceLister.
 type PrometheusAgentNamespaceListerExpansion interface{}
 
+// RemoteWriteListerExpansion allows custom methods to be added to
+// RemoteWriteLister.
+type RemoteWriteListerExpansion interface{}
+
+// RemoteWriteNamespaceListerExpansion allows custom methods to be added to
+// RemoteWriteNamespaceLister.
+type RemoteWriteNamespaceListerExpansion interface{}
+
 // ScrapeConfigListerExpansion allows custom methods to be added to
 // ScrapeConfigLister.
 type ScrapeConfigListerExpansion interface{}
@@ -39,3 +67,27 @@ type ScrapeConfigListerExpansion interface{}
 // ScrapeConfigNamespaceListerExpansion allows custom methods to be added to
 // ScrapeConfigNamespaceLister.
 type ScrapeConfigNamespaceListerExpansion interface{}
+
+// ThanosCompactorListerExpansion allows custom methods to be added to
+// ThanosCompactorLister.
+type ThanosCompactorListerExpansion interface{}
+
+// ThanosCompactorNamespaceListerExpansion allows custom methods to be added to
+// ThanosCompactorNamespaceLister.
+type ThanosCompactorNamespaceListerExpansion interface{}
+
+// ThanosQuerierListerExpansion allows custom methods to be added to
+// ThanosQuerierLister.
+type ThanosQuerierListerExpansion interface{}
+
+// ThanosQuerierNamespaceListerExpansion allows custom methods to be added to
+// ThanosQuerierNamespaceLister.
+type ThanosQuerierNamespaceListerExpansion interface{}
+
+// ThanosStoreListerExpansion allows custom methods to be added to
+// ThanosStoreLister.
+type ThanosStoreListerExpansion interface{}
+
+// ThanosStoreNamespaceListerExpansion allows custom methods to be added to
+// ThanosStoreNamespaceLister.
+type ThanosStoreNamespaceListerExpansion interface{}
@@ -0,0 +1,68 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	listers "k8s.io/client-go/listers"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// OTLPTenantLister helps list OTLPTenants.
+// All objects returned here must be treated as read-only.
+type OTLPTenantLister interface {
+	// List lists all OTLPTenants in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*monitoringv1alpha1.OTLPTenant, err error)
+	// OTLPTenants returns an object that can list and get OTLPTenants.
+	OTLPTenants(namespace string) OTLPTenantNamespaceLister
+	OTLPTenantListerExpansion
+}
+
+// oTLPTenantLister implements the OTLPTenantLister interface.
+type oTLPTenantLister struct {
+	listers.ResourceIndexer[*monitoringv1alpha1.OTLPTenant]
+}
+
+// NewOTLPTenantLister returns a new OTLPTenantLister.
+func NewOTLPTenantLister(indexer cache.Indexer) OTLPTenantLister {
+	return &oTLPTenantLister{listers.New[*monitoringv1alpha1.OTLPTenant](indexer, monitoringv1alpha1.Resource("otlptenant"))}
+}
+
+// OTLPTenants returns an object that can list and get OTLPTenants.
+func (s *oTLPTenantLister) OTLPTenants(namespace string) OTLPTenantNamespaceLister {
+	return oTLPTenantNamespaceLister{listers.NewNamespaced[*monitoringv1alpha1.OTLPTenant](s.ResourceIndexer, namespace)}
+}
+
+// OTLPTenantNamespaceLister helps list and get OTLPTenants.
+// All objects returned here must be treated as read-only.
+type OTLPTenantNamespaceLister interface {
+	// List lists all OTLPTenants in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*monitoringv1alpha1.OTLPTenant, err error)
+	// Get retrieves the OTLPTenant from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*monitoringv1alpha1.OTLPTenant, error)
+	OTLPTenantNamespaceListerExpansion
+}
+
+// oTLPTenantNamespaceLister implements the OTLPTenantNamespaceLister
+// interface.
+type oTLPTenantNamespaceLister struct {
+	listers.ResourceIndexer[*monitoringv1alpha1.OTLPTenant]
+}
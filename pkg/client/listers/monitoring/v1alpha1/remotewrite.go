@@ -0,0 +1,68 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	listers "k8s.io/client-go/listers"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// RemoteWriteLister helps list RemoteWrites.
+// All objects returned here must be treated as read-only.
+type RemoteWriteLister interface {
+	// List lists all RemoteWrites in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*monitoringv1alpha1.RemoteWrite, err error)
+	// RemoteWrites returns an object that can list and get RemoteWrites.
+	RemoteWrites(namespace string) RemoteWriteNamespaceLister
+	RemoteWriteListerExpansion
+}
+
+// remoteWriteLister implements the RemoteWriteLister interface.
+type remoteWriteLister struct {
+	listers.ResourceIndexer[*monitoringv1alpha1.RemoteWrite]
+}
+
+// NewRemoteWriteLister returns a new RemoteWriteLister.
+func NewRemoteWriteLister(indexer cache.Indexer) RemoteWriteLister {
+	return &remoteWriteLister{listers.New[*monitoringv1alpha1.RemoteWrite](indexer, monitoringv1alpha1.Resource("remotewrite"))}
+}
+
+// RemoteWrites returns an object that can list and get RemoteWrites.
+func (s *remoteWriteLister) RemoteWrites(namespace string) RemoteWriteNamespaceLister {
+	return remoteWriteNamespaceLister{listers.NewNamespaced[*monitoringv1alpha1.RemoteWrite](s.ResourceIndexer, namespace)}
+}
+
+// RemoteWriteNamespaceLister helps list and get RemoteWrites.
+// All objects returned here must be treated as read-only.
+type RemoteWriteNamespaceLister interface {
+	// List lists all RemoteWrites in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*monitoringv1alpha1.RemoteWrite, err error)
+	// Get retrieves the RemoteWrite from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*monitoringv1alpha1.RemoteWrite, error)
+	RemoteWriteNamespaceListerExpansion
+}
+
+// remoteWriteNamespaceLister implements the RemoteWriteNamespaceLister
+// interface.
+type remoteWriteNamespaceLister struct {
+	listers.ResourceIndexer[*monitoringv1alpha1.RemoteWrite]
+}
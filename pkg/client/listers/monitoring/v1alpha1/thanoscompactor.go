@@ -0,0 +1,68 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	listers "k8s.io/client-go/listers"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// ThanosCompactorLister helps list ThanosCompactors.
+// All objects returned here must be treated as read-only.
+type ThanosCompactorLister interface {
+	// List lists all ThanosCompactors in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*monitoringv1alpha1.ThanosCompactor, err error)
+	// ThanosCompactors returns an object that can list and get ThanosCompactors.
+	ThanosCompactors(namespace string) ThanosCompactorNamespaceLister
+	ThanosCompactorListerExpansion
+}
+
+// thanosCompactorLister implements the ThanosCompactorLister interface.
+type thanosCompactorLister struct {
+	listers.ResourceIndexer[*monitoringv1alpha1.ThanosCompactor]
+}
+
+// NewThanosCompactorLister returns a new ThanosCompactorLister.
+func NewThanosCompactorLister(indexer cache.Indexer) ThanosCompactorLister {
+	return &thanosCompactorLister{listers.New[*monitoringv1alpha1.ThanosCompactor](indexer, monitoringv1alpha1.Resource("thanoscompactor"))}
+}
+
+// ThanosCompactors returns an object that can list and get ThanosCompactors.
+func (s *thanosCompactorLister) ThanosCompactors(namespace string) ThanosCompactorNamespaceLister {
+	return thanosCompactorNamespaceLister{listers.NewNamespaced[*monitoringv1alpha1.ThanosCompactor](s.ResourceIndexer, namespace)}
+}
+
+// ThanosCompactorNamespaceLister helps list and get ThanosCompactors.
+// All objects returned here must be treated as read-only.
+type ThanosCompactorNamespaceLister interface {
+	// List lists all ThanosCompactors in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*monitoringv1alpha1.ThanosCompactor, err error)
+	// Get retrieves the ThanosCompactor from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*monitoringv1alpha1.ThanosCompactor, error)
+	ThanosCompactorNamespaceListerExpansion
+}
+
+// thanosCompactorNamespaceLister implements the ThanosCompactorNamespaceLister
+// interface.
+type thanosCompactorNamespaceLister struct {
+	listers.ResourceIndexer[*monitoringv1alpha1.ThanosCompactor]
+}
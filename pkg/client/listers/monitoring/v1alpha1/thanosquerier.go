@@ -0,0 +1,68 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	listers "k8s.io/client-go/listers"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// ThanosQuerierLister helps list ThanosQueriers.
+// All objects returned here must be treated as read-only.
+type ThanosQuerierLister interface {
+	// List lists all ThanosQueriers in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*monitoringv1alpha1.ThanosQuerier, err error)
+	// ThanosQueriers returns an object that can list and get ThanosQueriers.
+	ThanosQueriers(namespace string) ThanosQuerierNamespaceLister
+	ThanosQuerierListerExpansion
+}
+
+// thanosQuerierLister implements the ThanosQuerierLister interface.
+type thanosQuerierLister struct {
+	listers.ResourceIndexer[*monitoringv1alpha1.ThanosQuerier]
+}
+
+// NewThanosQuerierLister returns a new ThanosQuerierLister.
+func NewThanosQuerierLister(indexer cache.Indexer) ThanosQuerierLister {
+	return &thanosQuerierLister{listers.New[*monitoringv1alpha1.ThanosQuerier](indexer, monitoringv1alpha1.Resource("thanosquerier"))}
+}
+
+// ThanosQueriers returns an object that can list and get ThanosQueriers.
+func (s *thanosQuerierLister) ThanosQueriers(namespace string) ThanosQuerierNamespaceLister {
+	return thanosQuerierNamespaceLister{listers.NewNamespaced[*monitoringv1alpha1.ThanosQuerier](s.ResourceIndexer, namespace)}
+}
+
+// ThanosQuerierNamespaceLister helps list and get ThanosQueriers.
+// All objects returned here must be treated as read-only.
+type ThanosQuerierNamespaceLister interface {
+	// List lists all ThanosQueriers in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*monitoringv1alpha1.ThanosQuerier, err error)
+	// Get retrieves the ThanosQuerier from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*monitoringv1alpha1.ThanosQuerier, error)
+	ThanosQuerierNamespaceListerExpansion
+}
+
+// thanosQuerierNamespaceLister implements the ThanosQuerierNamespaceLister
+// interface.
+type thanosQuerierNamespaceLister struct {
+	listers.ResourceIndexer[*monitoringv1alpha1.ThanosQuerier]
+}
@@ -0,0 +1,68 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	listers "k8s.io/client-go/listers"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// ThanosStoreLister helps list ThanosStores.
+// All objects returned here must be treated as read-only.
+type ThanosStoreLister interface {
+	// List lists all ThanosStores in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*monitoringv1alpha1.ThanosStore, err error)
+	// ThanosStores returns an object that can list and get ThanosStores.
+	ThanosStores(namespace string) ThanosStoreNamespaceLister
+	ThanosStoreListerExpansion
+}
+
+// thanosStoreLister implements the ThanosStoreLister interface.
+type thanosStoreLister struct {
+	listers.ResourceIndexer[*monitoringv1alpha1.ThanosStore]
+}
+
+// NewThanosStoreLister returns a new ThanosStoreLister.
+func NewThanosStoreLister(indexer cache.Indexer) ThanosStoreLister {
+	return &thanosStoreLister{listers.New[*monitoringv1alpha1.ThanosStore](indexer, monitoringv1alpha1.Resource("thanosstore"))}
+}
+
+// ThanosStores returns an object that can list and get ThanosStores.
+func (s *thanosStoreLister) ThanosStores(namespace string) ThanosStoreNamespaceLister {
+	return thanosStoreNamespaceLister{listers.NewNamespaced[*monitoringv1alpha1.ThanosStore](s.ResourceIndexer, namespace)}
+}
+
+// ThanosStoreNamespaceLister helps list and get ThanosStores.
+// All objects returned here must be treated as read-only.
+type ThanosStoreNamespaceLister interface {
+	// List lists all ThanosStores in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*monitoringv1alpha1.ThanosStore, err error)
+	// Get retrieves the ThanosStore from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*monitoringv1alpha1.ThanosStore, error)
+	ThanosStoreNamespaceListerExpansion
+}
+
+// thanosStoreNamespaceLister implements the ThanosStoreNamespaceLister
+// interface.
+type thanosStoreNamespaceLister struct {
+	listers.ResourceIndexer[*monitoringv1alpha1.ThanosStore]
+}
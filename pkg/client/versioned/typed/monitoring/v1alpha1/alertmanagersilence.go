@@ -0,0 +1,74 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	context "context"
+
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	applyconfigurationmonitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/client/applyconfiguration/monitoring/v1alpha1"
+	scheme "github.com/prometheus-operator/prometheus-operator/pkg/client/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// AlertmanagerSilencesGetter has a method to return a AlertmanagerSilenceInterface.
+// A group's client should implement this interface.
+type AlertmanagerSilencesGetter interface {
+	AlertmanagerSilences(namespace string) AlertmanagerSilenceInterface
+}
+
+// AlertmanagerSilenceInterface has methods to work with AlertmanagerSilence resources.
+type AlertmanagerSilenceInterface interface {
+	Create(ctx context.Context, alertmanagerSilence *monitoringv1alpha1.AlertmanagerSilence, opts v1.CreateOptions) (*monitoringv1alpha1.AlertmanagerSilence, error)
+	Update(ctx context.Context, alertmanagerSilence *monitoringv1alpha1.AlertmanagerSilence, opts v1.UpdateOptions) (*monitoringv1alpha1.AlertmanagerSilence, error)
+	// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+	UpdateStatus(ctx context.Context, alertmanagerSilence *monitoringv1alpha1.AlertmanagerSilence, opts v1.UpdateOptions) (*monitoringv1alpha1.AlertmanagerSilence, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*monitoringv1alpha1.AlertmanagerSilence, error)
+	List(ctx context.Context, opts v1.ListOptions) (*monitoringv1alpha1.AlertmanagerSilenceList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *monitoringv1alpha1.AlertmanagerSilence, err error)
+	Apply(ctx context.Context, alertmanagerSilence *applyconfigurationmonitoringv1alpha1.AlertmanagerSilenceApplyConfiguration, opts v1.ApplyOptions) (result *monitoringv1alpha1.AlertmanagerSilence, err error)
+	// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+	ApplyStatus(ctx context.Context, alertmanagerSilence *applyconfigurationmonitoringv1alpha1.AlertmanagerSilenceApplyConfiguration, opts v1.ApplyOptions) (result *monitoringv1alpha1.AlertmanagerSilence, err error)
+	AlertmanagerSilenceExpansion
+}
+
+// alertmanagerSilences implements AlertmanagerSilenceInterface
+type alertmanagerSilences struct {
+	*gentype.ClientWithListAndApply[*monitoringv1alpha1.AlertmanagerSilence, *monitoringv1alpha1.AlertmanagerSilenceList, *applyconfigurationmonitoringv1alpha1.AlertmanagerSilenceApplyConfiguration]
+}
+
+// newAlertmanagerSilences returns a AlertmanagerSilences
+func newAlertmanagerSilences(c *MonitoringV1alpha1Client, namespace string) *alertmanagerSilences {
+	return &alertmanagerSilences{
+		gentype.NewClientWithListAndApply[*monitoringv1alpha1.AlertmanagerSilence, *monitoringv1alpha1.AlertmanagerSilenceList, *applyconfigurationmonitoringv1alpha1.AlertmanagerSilenceApplyConfiguration](
+			"alertmanagersilences",
+			c.RESTClient(),
+			scheme.ParameterCodec,
+			namespace,
+			func() *monitoringv1alpha1.AlertmanagerSilence { return &monitoringv1alpha1.AlertmanagerSilence{} },
+			func() *monitoringv1alpha1.AlertmanagerSilenceList {
+				return &monitoringv1alpha1.AlertmanagerSilenceList{}
+			},
+		),
+	}
+}
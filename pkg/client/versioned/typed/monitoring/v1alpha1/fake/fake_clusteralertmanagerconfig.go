@@ -0,0 +1,51 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/client/applyconfiguration/monitoring/v1alpha1"
+	typedmonitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/client/versioned/typed/monitoring/v1alpha1"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// fakeClusterAlertmanagerConfigs implements ClusterAlertmanagerConfigInterface
+type fakeClusterAlertmanagerConfigs struct {
+	*gentype.FakeClientWithListAndApply[*v1alpha1.ClusterAlertmanagerConfig, *v1alpha1.ClusterAlertmanagerConfigList, *monitoringv1alpha1.ClusterAlertmanagerConfigApplyConfiguration]
+	Fake *FakeMonitoringV1alpha1
+}
+
+func newFakeClusterAlertmanagerConfigs(fake *FakeMonitoringV1alpha1) typedmonitoringv1alpha1.ClusterAlertmanagerConfigInterface {
+	return &fakeClusterAlertmanagerConfigs{
+		gentype.NewFakeClientWithListAndApply[*v1alpha1.ClusterAlertmanagerConfig, *v1alpha1.ClusterAlertmanagerConfigList, *monitoringv1alpha1.ClusterAlertmanagerConfigApplyConfiguration](
+			fake.Fake,
+			"",
+			v1alpha1.SchemeGroupVersion.WithResource("clusteralertmanagerconfigs"),
+			v1alpha1.SchemeGroupVersion.WithKind("ClusterAlertmanagerConfig"),
+			func() *v1alpha1.ClusterAlertmanagerConfig { return &v1alpha1.ClusterAlertmanagerConfig{} },
+			func() *v1alpha1.ClusterAlertmanagerConfigList { return &v1alpha1.ClusterAlertmanagerConfigList{} },
+			func(dst, src *v1alpha1.ClusterAlertmanagerConfigList) { dst.ListMeta = src.ListMeta },
+			func(list *v1alpha1.ClusterAlertmanagerConfigList) []*v1alpha1.ClusterAlertmanagerConfig {
+				return gentype.ToPointerSlice(list.Items)
+			},
+			func(list *v1alpha1.ClusterAlertmanagerConfigList, items []*v1alpha1.ClusterAlertmanagerConfig) {
+				list.Items = gentype.FromPointerSlice(items)
+			},
+		),
+		fake,
+	}
+}
@@ -30,14 +30,42 @@ func (c *FakeMonitoringV1alpha1) AlertmanagerConfigs(namespace string) v1alpha1.
 	return newFakeAlertmanagerConfigs(c, namespace)
 }
 
+func (c *FakeMonitoringV1alpha1) AlertmanagerSilences(namespace string) v1alpha1.AlertmanagerSilenceInterface {
+	return newFakeAlertmanagerSilences(c, namespace)
+}
+
+func (c *FakeMonitoringV1alpha1) ClusterAlertmanagerConfigs() v1alpha1.ClusterAlertmanagerConfigInterface {
+	return newFakeClusterAlertmanagerConfigs(c)
+}
+
+func (c *FakeMonitoringV1alpha1) OTLPTenants(namespace string) v1alpha1.OTLPTenantInterface {
+	return newFakeOTLPTenants(c, namespace)
+}
+
 func (c *FakeMonitoringV1alpha1) PrometheusAgents(namespace string) v1alpha1.PrometheusAgentInterface {
 	return newFakePrometheusAgents(c, namespace)
 }
 
+func (c *FakeMonitoringV1alpha1) RemoteWrites(namespace string) v1alpha1.RemoteWriteInterface {
+	return newFakeRemoteWrites(c, namespace)
+}
+
 func (c *FakeMonitoringV1alpha1) ScrapeConfigs(namespace string) v1alpha1.ScrapeConfigInterface {
 	return newFakeScrapeConfigs(c, namespace)
 }
 
+func (c *FakeMonitoringV1alpha1) ThanosCompactors(namespace string) v1alpha1.ThanosCompactorInterface {
+	return newFakeThanosCompactors(c, namespace)
+}
+
+func (c *FakeMonitoringV1alpha1) ThanosQueriers(namespace string) v1alpha1.ThanosQuerierInterface {
+	return newFakeThanosQueriers(c, namespace)
+}
+
+func (c *FakeMonitoringV1alpha1) ThanosStores(namespace string) v1alpha1.ThanosStoreInterface {
+	return newFakeThanosStores(c, namespace)
+}
+
 // RESTClient returns a RESTClient that is used to communicate
 // with API server by this client implementation.
 func (c *FakeMonitoringV1alpha1) RESTClient() rest.Interface {
@@ -0,0 +1,51 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/client/applyconfiguration/monitoring/v1alpha1"
+	typedmonitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/client/versioned/typed/monitoring/v1alpha1"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// fakeRemoteWrites implements RemoteWriteInterface
+type fakeRemoteWrites struct {
+	*gentype.FakeClientWithListAndApply[*v1alpha1.RemoteWrite, *v1alpha1.RemoteWriteList, *monitoringv1alpha1.RemoteWriteApplyConfiguration]
+	Fake *FakeMonitoringV1alpha1
+}
+
+func newFakeRemoteWrites(fake *FakeMonitoringV1alpha1, namespace string) typedmonitoringv1alpha1.RemoteWriteInterface {
+	return &fakeRemoteWrites{
+		gentype.NewFakeClientWithListAndApply[*v1alpha1.RemoteWrite, *v1alpha1.RemoteWriteList, *monitoringv1alpha1.RemoteWriteApplyConfiguration](
+			fake.Fake,
+			namespace,
+			v1alpha1.SchemeGroupVersion.WithResource("remotewrites"),
+			v1alpha1.SchemeGroupVersion.WithKind("RemoteWrite"),
+			func() *v1alpha1.RemoteWrite { return &v1alpha1.RemoteWrite{} },
+			func() *v1alpha1.RemoteWriteList { return &v1alpha1.RemoteWriteList{} },
+			func(dst, src *v1alpha1.RemoteWriteList) { dst.ListMeta = src.ListMeta },
+			func(list *v1alpha1.RemoteWriteList) []*v1alpha1.RemoteWrite {
+				return gentype.ToPointerSlice(list.Items)
+			},
+			func(list *v1alpha1.RemoteWriteList, items []*v1alpha1.RemoteWrite) {
+				list.Items = gentype.FromPointerSlice(items)
+			},
+		),
+		fake,
+	}
+}
@@ -18,6 +18,20 @@ package v1alpha1
 
 type AlertmanagerConfigExpansion interface{}
 
+type AlertmanagerSilenceExpansion interface{}
+
+type ClusterAlertmanagerConfigExpansion interface{}
+
+type OTLPTenantExpansion interface{}
+
 type PrometheusAgentExpansion interface{}
 
+type RemoteWriteExpansion interface{}
+
 type ScrapeConfigExpansion interface{}
+
+type ThanosCompactorExpansion interface{}
+
+type ThanosQuerierExpansion interface{}
+
+type ThanosStoreExpansion interface{}
@@ -27,8 +27,15 @@ import (
 type MonitoringV1alpha1Interface interface {
 	RESTClient() rest.Interface
 	AlertmanagerConfigsGetter
+	AlertmanagerSilencesGetter
+	ClusterAlertmanagerConfigsGetter
+	OTLPTenantsGetter
 	PrometheusAgentsGetter
+	RemoteWritesGetter
 	ScrapeConfigsGetter
+	ThanosCompactorsGetter
+	ThanosQueriersGetter
+	ThanosStoresGetter
 }
 
 // MonitoringV1alpha1Client is used to interact with features provided by the monitoring.coreos.com group.
@@ -40,14 +47,42 @@ func (c *MonitoringV1alpha1Client) AlertmanagerConfigs(namespace string) Alertma
 	return newAlertmanagerConfigs(c, namespace)
 }
 
+func (c *MonitoringV1alpha1Client) AlertmanagerSilences(namespace string) AlertmanagerSilenceInterface {
+	return newAlertmanagerSilences(c, namespace)
+}
+
+func (c *MonitoringV1alpha1Client) ClusterAlertmanagerConfigs() ClusterAlertmanagerConfigInterface {
+	return newClusterAlertmanagerConfigs(c)
+}
+
+func (c *MonitoringV1alpha1Client) OTLPTenants(namespace string) OTLPTenantInterface {
+	return newOTLPTenants(c, namespace)
+}
+
 func (c *MonitoringV1alpha1Client) PrometheusAgents(namespace string) PrometheusAgentInterface {
 	return newPrometheusAgents(c, namespace)
 }
 
+func (c *MonitoringV1alpha1Client) RemoteWrites(namespace string) RemoteWriteInterface {
+	return newRemoteWrites(c, namespace)
+}
+
 func (c *MonitoringV1alpha1Client) ScrapeConfigs(namespace string) ScrapeConfigInterface {
 	return newScrapeConfigs(c, namespace)
 }
 
+func (c *MonitoringV1alpha1Client) ThanosCompactors(namespace string) ThanosCompactorInterface {
+	return newThanosCompactors(c, namespace)
+}
+
+func (c *MonitoringV1alpha1Client) ThanosQueriers(namespace string) ThanosQuerierInterface {
+	return newThanosQueriers(c, namespace)
+}
+
+func (c *MonitoringV1alpha1Client) ThanosStores(namespace string) ThanosStoreInterface {
+	return newThanosStores(c, namespace)
+}
+
 // NewForConfig creates a new MonitoringV1alpha1Client for the given config.
 // NewForConfig is equivalent to NewForConfigAndClient(c, httpClient),
 // where httpClient was generated with rest.HTTPClientFor(c).
@@ -0,0 +1,68 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	context "context"
+
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	applyconfigurationmonitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/client/applyconfiguration/monitoring/v1alpha1"
+	scheme "github.com/prometheus-operator/prometheus-operator/pkg/client/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// OTLPTenantsGetter has a method to return a OTLPTenantInterface.
+// A group's client should implement this interface.
+type OTLPTenantsGetter interface {
+	OTLPTenants(namespace string) OTLPTenantInterface
+}
+
+// OTLPTenantInterface has methods to work with OTLPTenant resources.
+type OTLPTenantInterface interface {
+	Create(ctx context.Context, oTLPTenant *monitoringv1alpha1.OTLPTenant, opts v1.CreateOptions) (*monitoringv1alpha1.OTLPTenant, error)
+	Update(ctx context.Context, oTLPTenant *monitoringv1alpha1.OTLPTenant, opts v1.UpdateOptions) (*monitoringv1alpha1.OTLPTenant, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*monitoringv1alpha1.OTLPTenant, error)
+	List(ctx context.Context, opts v1.ListOptions) (*monitoringv1alpha1.OTLPTenantList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *monitoringv1alpha1.OTLPTenant, err error)
+	Apply(ctx context.Context, oTLPTenant *applyconfigurationmonitoringv1alpha1.OTLPTenantApplyConfiguration, opts v1.ApplyOptions) (result *monitoringv1alpha1.OTLPTenant, err error)
+	OTLPTenantExpansion
+}
+
+// oTLPTenants implements OTLPTenantInterface
+type oTLPTenants struct {
+	*gentype.ClientWithListAndApply[*monitoringv1alpha1.OTLPTenant, *monitoringv1alpha1.OTLPTenantList, *applyconfigurationmonitoringv1alpha1.OTLPTenantApplyConfiguration]
+}
+
+// newOTLPTenants returns a OTLPTenants
+func newOTLPTenants(c *MonitoringV1alpha1Client, namespace string) *oTLPTenants {
+	return &oTLPTenants{
+		gentype.NewClientWithListAndApply[*monitoringv1alpha1.OTLPTenant, *monitoringv1alpha1.OTLPTenantList, *applyconfigurationmonitoringv1alpha1.OTLPTenantApplyConfiguration](
+			"otlptenants",
+			c.RESTClient(),
+			scheme.ParameterCodec,
+			namespace,
+			func() *monitoringv1alpha1.OTLPTenant { return &monitoringv1alpha1.OTLPTenant{} },
+			func() *monitoringv1alpha1.OTLPTenantList { return &monitoringv1alpha1.OTLPTenantList{} },
+		),
+	}
+}
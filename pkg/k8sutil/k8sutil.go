@@ -347,6 +347,30 @@ func UpdateDaemonSet(ctx context.Context, dmsClient clientappsv1.DaemonSetInterf
 	})
 }
 
+// CreateOrUpdateDeployment creates the Deployment if it doesn't exist or
+// merges metadata of existing Deployment with new one and updates it.
+func CreateOrUpdateDeployment(ctx context.Context, deployClient clientappsv1.DeploymentInterface, desired *appsv1.Deployment) error {
+	// As stated in the RetryOnConflict's documentation, the returned error shouldn't be wrapped.
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		existingDeploy, err := deployClient.Get(ctx, desired.Name, metav1.GetOptions{})
+		if err != nil {
+			if !apierrors.IsNotFound(err) {
+				return err
+			}
+
+			_, err = deployClient.Create(ctx, desired, metav1.CreateOptions{})
+			return err
+		}
+
+		mergeMetadata(&desired.ObjectMeta, existingDeploy.ObjectMeta)
+		// Propagate annotations set by kubectl on spec.template.annotations. e.g performing a rolling restart.
+		mergeKubectlAnnotations(&existingDeploy.Spec.Template.ObjectMeta, desired.Spec.Template.ObjectMeta)
+
+		_, err = deployClient.Update(ctx, desired, metav1.UpdateOptions{})
+		return err
+	})
+}
+
 // CreateOrUpdateSecret merges metadata of existing Secret with new one and updates it.
 func CreateOrUpdateSecret(ctx context.Context, secretClient clientv1.SecretInterface, desired *v1.Secret) error {
 	// As stated in the RetryOnConflict's documentation, the returned error shouldn't be wrapped.
@@ -102,6 +102,10 @@ func DefaultConfig(cpu, memory string) Config {
 				description: "Enables shard retention policy for Prometheus",
 				enabled:     false,
 			},
+			PrometheusShardAutoscalingFeature: FeatureGate{
+				description: "Enables the computation of the number of shards for Prometheus from the number of active series",
+				enabled:     false,
+			},
 			StatusForConfigurationResourcesFeature: FeatureGate{
 				description: "Updates the status subresource for configuration resources",
 				enabled:     false,
@@ -0,0 +1,379 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operator
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+
+	"github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring"
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	monitoringclient "github.com/prometheus-operator/prometheus-operator/pkg/client/versioned"
+)
+
+// ConfigResourceSyncer updates the status of the configuration resources
+// (ServiceMonitor, PodMonitor, Probe, ScrapeConfig and PrometheusRule) which
+// are selected by a workload resource (Prometheus, PrometheusAgent or
+// ThanosRuler).
+//
+// Each workload resource owns exactly one item in the `status.bindings` list
+// of the configuration resources.
+type ConfigResourceSyncer struct {
+	mclient   monitoringclient.Interface
+	resource  string
+	namespace string
+	name      string
+}
+
+// NewConfigResourceSyncer returns a ConfigResourceSyncer for the given
+// workload. The resource argument is the plural name of the workload's
+// resource (e.g. "prometheuses").
+func NewConfigResourceSyncer(mclient monitoringclient.Interface, resource string, workload metav1.Object) *ConfigResourceSyncer {
+	return &ConfigResourceSyncer{
+		mclient:   mclient,
+		resource:  resource,
+		namespace: workload.GetNamespace(),
+		name:      workload.GetName(),
+	}
+}
+
+// NewConfigResourceCondition returns the Accepted condition for a
+// configuration resource. A nil error means that the resource has been
+// accepted by the workload.
+func NewConfigResourceCondition(obj metav1.Object, reason string, err error) monitoringv1.ConfigResourceCondition {
+	if err == nil {
+		return monitoringv1.ConfigResourceCondition{
+			Type:               monitoringv1.Accepted,
+			Status:             monitoringv1.ConditionTrue,
+			ObservedGeneration: obj.GetGeneration(),
+		}
+	}
+
+	return monitoringv1.ConfigResourceCondition{
+		Type:               monitoringv1.Accepted,
+		Status:             monitoringv1.ConditionFalse,
+		Reason:             reason,
+		Message:            err.Error(),
+		ObservedGeneration: obj.GetGeneration(),
+	}
+}
+
+// UpdateBinding ensures that the status of the configuration resource
+// contains a binding for the workload with the given conditions.
+// The object isn't updated if the binding is already up-to-date.
+func (s *ConfigResourceSyncer) UpdateBinding(ctx context.Context, obj metav1.Object, conditions []monitoringv1.ConfigResourceCondition) error {
+	// As stated in the RetryOnConflict's documentation, the returned error shouldn't be wrapped.
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		bindings, err := configResourceBindings(obj)
+		if err != nil {
+			return err
+		}
+
+		var (
+			i        = s.findBinding(bindings)
+			existing []monitoringv1.ConfigResourceCondition
+		)
+		if i >= 0 {
+			existing = bindings[i].Conditions
+		}
+
+		binding := s.newBinding(mergeConfigResourceConditions(existing, conditions))
+		if i >= 0 && equality.Semantic.DeepEqual(bindings[i], binding) {
+			return nil
+		}
+
+		newBindings := make([]monitoringv1.WorkloadBinding, 0, len(bindings)+1)
+		newBindings = append(newBindings, bindings...)
+		if i >= 0 {
+			newBindings[i] = binding
+		} else {
+			newBindings = append(newBindings, binding)
+		}
+
+		err = s.updateStatus(ctx, obj, newBindings)
+		if apierrors.IsConflict(err) {
+			// Refresh the object before retrying.
+			if latest, getErr := s.get(ctx, obj); getErr == nil {
+				obj = latest
+			}
+		}
+
+		return err
+	})
+}
+
+// UpdateRuleBinding ensures that the status of the PrometheusRule resource
+// contains a binding for the workload with the given conditions and rule
+// group statuses.
+// The object isn't updated if the binding is already up-to-date.
+func (s *ConfigResourceSyncer) UpdateRuleBinding(ctx context.Context, rule *monitoringv1.PrometheusRule, conditions []monitoringv1.ConfigResourceCondition, groups []monitoringv1.RuleGroupStatus) error {
+	// As stated in the RetryOnConflict's documentation, the returned error shouldn't be wrapped.
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		var (
+			bindings = rule.Status.Bindings
+			i        = s.findRuleBinding(bindings)
+			existing monitoringv1.RuleWorkloadBinding
+		)
+		if i >= 0 {
+			existing = bindings[i]
+		}
+
+		binding := monitoringv1.RuleWorkloadBinding{
+			WorkloadBinding: s.newBinding(mergeConfigResourceConditions(existing.Conditions, conditions)),
+			Groups:          mergeRuleGroupStatuses(existing.Groups, groups),
+		}
+		if i >= 0 && equality.Semantic.DeepEqual(bindings[i], binding) {
+			return nil
+		}
+
+		newBindings := make([]monitoringv1.RuleWorkloadBinding, 0, len(bindings)+1)
+		newBindings = append(newBindings, bindings...)
+		if i >= 0 {
+			newBindings[i] = binding
+		} else {
+			newBindings = append(newBindings, binding)
+		}
+
+		err := s.updateRuleStatus(ctx, rule, newBindings)
+		if apierrors.IsConflict(err) {
+			// Refresh the object before retrying.
+			if latest, getErr := s.mclient.MonitoringV1().PrometheusRules(rule.Namespace).Get(ctx, rule.Name, metav1.GetOptions{}); getErr == nil {
+				rule = latest
+			}
+		}
+
+		return err
+	})
+}
+
+// RemoveBinding removes the workload's binding from the status of the
+// configuration resource if it exists.
+func (s *ConfigResourceSyncer) RemoveBinding(ctx context.Context, obj metav1.Object) error {
+	if rule, ok := obj.(*monitoringv1.PrometheusRule); ok {
+		return s.removeRuleBinding(ctx, rule)
+	}
+
+	// As stated in the RetryOnConflict's documentation, the returned error shouldn't be wrapped.
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		bindings, err := configResourceBindings(obj)
+		if err != nil {
+			return err
+		}
+
+		i := s.findBinding(bindings)
+		if i < 0 {
+			return nil
+		}
+
+		newBindings := make([]monitoringv1.WorkloadBinding, 0, len(bindings)-1)
+		newBindings = append(newBindings, bindings[:i]...)
+		newBindings = append(newBindings, bindings[i+1:]...)
+
+		err = s.updateStatus(ctx, obj, newBindings)
+		switch {
+		case apierrors.IsNotFound(err):
+			return nil
+		case apierrors.IsConflict(err):
+			// Refresh the object before retrying.
+			if latest, getErr := s.get(ctx, obj); getErr == nil {
+				obj = latest
+			}
+		}
+
+		return err
+	})
+}
+
+func (s *ConfigResourceSyncer) removeRuleBinding(ctx context.Context, rule *monitoringv1.PrometheusRule) error {
+	// As stated in the RetryOnConflict's documentation, the returned error shouldn't be wrapped.
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		bindings := rule.Status.Bindings
+
+		i := s.findRuleBinding(bindings)
+		if i < 0 {
+			return nil
+		}
+
+		newBindings := make([]monitoringv1.RuleWorkloadBinding, 0, len(bindings)-1)
+		newBindings = append(newBindings, bindings[:i]...)
+		newBindings = append(newBindings, bindings[i+1:]...)
+
+		err := s.updateRuleStatus(ctx, rule, newBindings)
+		switch {
+		case apierrors.IsNotFound(err):
+			return nil
+		case apierrors.IsConflict(err):
+			// Refresh the object before retrying.
+			if latest, getErr := s.mclient.MonitoringV1().PrometheusRules(rule.Namespace).Get(ctx, rule.Name, metav1.GetOptions{}); getErr == nil {
+				rule = latest
+			}
+		}
+
+		return err
+	})
+}
+
+func (s *ConfigResourceSyncer) newBinding(conditions []monitoringv1.ConfigResourceCondition) monitoringv1.WorkloadBinding {
+	return monitoringv1.WorkloadBinding{
+		Group:      monitoring.GroupName,
+		Resource:   s.resource,
+		Name:       s.name,
+		Namespace:  s.namespace,
+		Conditions: conditions,
+	}
+}
+
+func (s *ConfigResourceSyncer) findBinding(bindings []monitoringv1.WorkloadBinding) int {
+	for i, b := range bindings {
+		if b.Group == monitoring.GroupName && b.Resource == s.resource && b.Namespace == s.namespace && b.Name == s.name {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func (s *ConfigResourceSyncer) findRuleBinding(bindings []monitoringv1.RuleWorkloadBinding) int {
+	for i, b := range bindings {
+		if b.Group == monitoring.GroupName && b.Resource == s.resource && b.Namespace == s.namespace && b.Name == s.name {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// mergeConfigResourceConditions sets the last transition time of the new
+// conditions, retaining the existing value when the status hasn't changed.
+func mergeConfigResourceConditions(conditions []monitoringv1.ConfigResourceCondition, newConditions []monitoringv1.ConfigResourceCondition) []monitoringv1.ConfigResourceCondition {
+	now := metav1.Now()
+	ret := make([]monitoringv1.ConfigResourceCondition, 0, len(newConditions))
+
+	for _, nc := range newConditions {
+		nc.LastTransitionTime = now
+		for _, c := range conditions {
+			if c.Type == nc.Type && c.Status == nc.Status {
+				nc.LastTransitionTime = c.LastTransitionTime
+				break
+			}
+		}
+		ret = append(ret, nc)
+	}
+
+	return ret
+}
+
+// mergeRuleGroupStatuses sets the last transition time of the new rule
+// group conditions, retaining the existing values when the status hasn't
+// changed.
+func mergeRuleGroupStatuses(groups []monitoringv1.RuleGroupStatus, newGroups []monitoringv1.RuleGroupStatus) []monitoringv1.RuleGroupStatus {
+	if len(newGroups) == 0 {
+		return nil
+	}
+
+	ret := make([]monitoringv1.RuleGroupStatus, 0, len(newGroups))
+	for _, ng := range newGroups {
+		var existing []monitoringv1.ConfigResourceCondition
+		for _, g := range groups {
+			if g.Name == ng.Name {
+				existing = g.Conditions
+				break
+			}
+		}
+
+		ret = append(ret, monitoringv1.RuleGroupStatus{
+			Name:       ng.Name,
+			Conditions: mergeConfigResourceConditions(existing, ng.Conditions),
+		})
+	}
+
+	return ret
+}
+
+func configResourceBindings(obj metav1.Object) ([]monitoringv1.WorkloadBinding, error) {
+	switch o := obj.(type) {
+	case *monitoringv1.ServiceMonitor:
+		return o.Status.Bindings, nil
+	case *monitoringv1.PodMonitor:
+		return o.Status.Bindings, nil
+	case *monitoringv1.Probe:
+		return o.Status.Bindings, nil
+	case *monitoringv1alpha1.ScrapeConfig:
+		return o.Status.Bindings, nil
+	}
+
+	return nil, fmt.Errorf("unsupported configuration resource type %T", obj)
+}
+
+func (s *ConfigResourceSyncer) get(ctx context.Context, obj metav1.Object) (metav1.Object, error) {
+	var (
+		ns   = obj.GetNamespace()
+		name = obj.GetName()
+	)
+
+	switch obj.(type) {
+	case *monitoringv1.ServiceMonitor:
+		return s.mclient.MonitoringV1().ServiceMonitors(ns).Get(ctx, name, metav1.GetOptions{})
+	case *monitoringv1.PodMonitor:
+		return s.mclient.MonitoringV1().PodMonitors(ns).Get(ctx, name, metav1.GetOptions{})
+	case *monitoringv1.Probe:
+		return s.mclient.MonitoringV1().Probes(ns).Get(ctx, name, metav1.GetOptions{})
+	case *monitoringv1alpha1.ScrapeConfig:
+		return s.mclient.MonitoringV1alpha1().ScrapeConfigs(ns).Get(ctx, name, metav1.GetOptions{})
+	}
+
+	return nil, fmt.Errorf("unsupported configuration resource type %T", obj)
+}
+
+func (s *ConfigResourceSyncer) updateStatus(ctx context.Context, obj metav1.Object, bindings []monitoringv1.WorkloadBinding) error {
+	var err error
+
+	switch o := obj.(type) {
+	case *monitoringv1.ServiceMonitor:
+		o = o.DeepCopy()
+		o.Status.Bindings = bindings
+		_, err = s.mclient.MonitoringV1().ServiceMonitors(o.Namespace).UpdateStatus(ctx, o, metav1.UpdateOptions{FieldManager: PrometheusOperatorFieldManager})
+	case *monitoringv1.PodMonitor:
+		o = o.DeepCopy()
+		o.Status.Bindings = bindings
+		_, err = s.mclient.MonitoringV1().PodMonitors(o.Namespace).UpdateStatus(ctx, o, metav1.UpdateOptions{FieldManager: PrometheusOperatorFieldManager})
+	case *monitoringv1.Probe:
+		o = o.DeepCopy()
+		o.Status.Bindings = bindings
+		_, err = s.mclient.MonitoringV1().Probes(o.Namespace).UpdateStatus(ctx, o, metav1.UpdateOptions{FieldManager: PrometheusOperatorFieldManager})
+	case *monitoringv1alpha1.ScrapeConfig:
+		o = o.DeepCopy()
+		o.Status.Bindings = bindings
+		_, err = s.mclient.MonitoringV1alpha1().ScrapeConfigs(o.Namespace).UpdateStatus(ctx, o, metav1.UpdateOptions{FieldManager: PrometheusOperatorFieldManager})
+	default:
+		err = fmt.Errorf("unsupported configuration resource type %T", obj)
+	}
+
+	return err
+}
+
+func (s *ConfigResourceSyncer) updateRuleStatus(ctx context.Context, rule *monitoringv1.PrometheusRule, bindings []monitoringv1.RuleWorkloadBinding) error {
+	rule = rule.DeepCopy()
+	rule.Status.Bindings = bindings
+	_, err := s.mclient.MonitoringV1().PrometheusRules(rule.Namespace).UpdateStatus(ctx, rule, metav1.UpdateOptions{FieldManager: PrometheusOperatorFieldManager})
+
+	return err
+}
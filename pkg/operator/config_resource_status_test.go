@@ -0,0 +1,177 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operator
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/client/versioned/fake"
+)
+
+func TestConfigResourceSyncer(t *testing.T) {
+	ctx := context.Background()
+	smon := &monitoringv1.ServiceMonitor{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "smon",
+			Namespace:  "ns",
+			Generation: 2,
+		},
+		Status: monitoringv1.ConfigResourceStatus{
+			Bindings: []monitoringv1.WorkloadBinding{
+				{
+					Group:     "monitoring.coreos.com",
+					Resource:  "prometheusagents",
+					Name:      "agent",
+					Namespace: "ns",
+				},
+			},
+		},
+	}
+	mclient := fake.NewSimpleClientset(smon)
+
+	getBindings := func() []monitoringv1.WorkloadBinding {
+		t.Helper()
+
+		o, err := mclient.MonitoringV1().ServiceMonitors("ns").Get(ctx, "smon", metav1.GetOptions{})
+		require.NoError(t, err)
+
+		return o.Status.Bindings
+	}
+
+	syncer := NewConfigResourceSyncer(mclient, monitoringv1.PrometheusName, &metav1.ObjectMeta{Name: "prom", Namespace: "default"})
+
+	// Add a new binding.
+	err := syncer.UpdateBinding(ctx, smon, []monitoringv1.ConfigResourceCondition{NewConfigResourceCondition(smon, "", nil)})
+	require.NoError(t, err)
+
+	bindings := getBindings()
+	require.Len(t, bindings, 2)
+	require.Equal(t, "prometheusagents", bindings[0].Resource)
+	require.Equal(t, "prometheuses", bindings[1].Resource)
+	require.Equal(t, "prom", bindings[1].Name)
+	require.Equal(t, "default", bindings[1].Namespace)
+	require.Len(t, bindings[1].Conditions, 1)
+	require.Equal(t, monitoringv1.Accepted, bindings[1].Conditions[0].Type)
+	require.Equal(t, monitoringv1.ConditionTrue, bindings[1].Conditions[0].Status)
+	require.Equal(t, int64(2), bindings[1].Conditions[0].ObservedGeneration)
+	require.False(t, bindings[1].Conditions[0].LastTransitionTime.IsZero())
+
+	// Update the existing binding.
+	smon, err = mclient.MonitoringV1().ServiceMonitors("ns").Get(ctx, "smon", metav1.GetOptions{})
+	require.NoError(t, err)
+
+	err = syncer.UpdateBinding(ctx, smon, []monitoringv1.ConfigResourceCondition{NewConfigResourceCondition(smon, InvalidConfigurationEvent, errors.New("invalid"))})
+	require.NoError(t, err)
+
+	bindings = getBindings()
+	require.Len(t, bindings, 2)
+	require.Equal(t, monitoringv1.ConditionFalse, bindings[1].Conditions[0].Status)
+	require.Equal(t, InvalidConfigurationEvent, bindings[1].Conditions[0].Reason)
+	require.Equal(t, "invalid", bindings[1].Conditions[0].Message)
+
+	// Remove the binding.
+	smon, err = mclient.MonitoringV1().ServiceMonitors("ns").Get(ctx, "smon", metav1.GetOptions{})
+	require.NoError(t, err)
+
+	err = syncer.RemoveBinding(ctx, smon)
+	require.NoError(t, err)
+
+	bindings = getBindings()
+	require.Len(t, bindings, 1)
+	require.Equal(t, "prometheusagents", bindings[0].Resource)
+}
+
+func TestMergeConfigResourceConditions(t *testing.T) {
+	ts := metav1.NewTime(metav1.Now().Add(-3600))
+
+	existing := []monitoringv1.ConfigResourceCondition{
+		{
+			Type:               monitoringv1.Accepted,
+			Status:             monitoringv1.ConditionTrue,
+			LastTransitionTime: ts,
+		},
+	}
+
+	// The last transition time is retained when the status is unchanged.
+	merged := mergeConfigResourceConditions(existing, []monitoringv1.ConfigResourceCondition{{Type: monitoringv1.Accepted, Status: monitoringv1.ConditionTrue}})
+	require.Len(t, merged, 1)
+	require.Equal(t, ts, merged[0].LastTransitionTime)
+
+	// The last transition time is updated when the status changes.
+	merged = mergeConfigResourceConditions(existing, []monitoringv1.ConfigResourceCondition{{Type: monitoringv1.Accepted, Status: monitoringv1.ConditionFalse}})
+	require.Len(t, merged, 1)
+	require.NotEqual(t, ts, merged[0].LastTransitionTime)
+}
+
+func TestConfigResourceSyncerWithPrometheusRule(t *testing.T) {
+	ctx := context.Background()
+	rule := &monitoringv1.PrometheusRule{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "rule",
+			Namespace: "ns",
+		},
+	}
+	mclient := fake.NewSimpleClientset(rule)
+
+	getBindings := func() []monitoringv1.RuleWorkloadBinding {
+		t.Helper()
+
+		o, err := mclient.MonitoringV1().PrometheusRules("ns").Get(ctx, "rule", metav1.GetOptions{})
+		require.NoError(t, err)
+
+		return o.Status.Bindings
+	}
+
+	syncer := NewConfigResourceSyncer(mclient, monitoringv1.ThanosRulerName, &metav1.ObjectMeta{Name: "ruler", Namespace: "default"})
+
+	// Add a new binding.
+	err := syncer.UpdateRuleBinding(
+		ctx,
+		rule,
+		[]monitoringv1.ConfigResourceCondition{NewConfigResourceCondition(rule, "", nil)},
+		[]monitoringv1.RuleGroupStatus{
+			{
+				Name:       "group",
+				Conditions: []monitoringv1.ConfigResourceCondition{NewConfigResourceCondition(rule, "", nil)},
+			},
+		},
+	)
+	require.NoError(t, err)
+
+	bindings := getBindings()
+	require.Len(t, bindings, 1)
+	require.Equal(t, "thanosrulers", bindings[0].Resource)
+	require.Equal(t, "ruler", bindings[0].Name)
+	require.Len(t, bindings[0].Conditions, 1)
+	require.Equal(t, monitoringv1.ConditionTrue, bindings[0].Conditions[0].Status)
+	require.Len(t, bindings[0].Groups, 1)
+	require.Equal(t, "group", bindings[0].Groups[0].Name)
+	require.False(t, bindings[0].Groups[0].Conditions[0].LastTransitionTime.IsZero())
+
+	// Remove the binding.
+	rule, err = mclient.MonitoringV1().PrometheusRules("ns").Get(ctx, "rule", metav1.GetOptions{})
+	require.NoError(t, err)
+
+	err = syncer.RemoveBinding(ctx, rule)
+	require.NoError(t, err)
+
+	require.Empty(t, getBindings())
+}
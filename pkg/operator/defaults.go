@@ -35,6 +35,13 @@ const (
 	DefaultThanosBaseImage = "quay.io/thanos/thanos"
 	// DefaultThanosImage is a default image pulling address for the Thanos long-term prometheus storage collector.
 	DefaultThanosImage = DefaultThanosBaseImage + ":" + DefaultThanosVersion
+
+	// DefaultBlackboxExporterVersion is a default image tag for the blackbox exporter.
+	DefaultBlackboxExporterVersion = "v0.26.0"
+	// DefaultBlackboxExporterBaseImage is a base container registry address for the blackbox exporter.
+	DefaultBlackboxExporterBaseImage = "quay.io/prometheus/blackbox-exporter"
+	// DefaultBlackboxExporterImage is a default image pulling address for the blackbox exporter.
+	DefaultBlackboxExporterImage = DefaultBlackboxExporterBaseImage + ":" + DefaultBlackboxExporterVersion
 )
 
 var (
@@ -17,6 +17,7 @@ package operator
 import (
 	"fmt"
 	"log/slog"
+	"reflect"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -29,6 +30,21 @@ type EventHandler struct {
 
 	objName     string
 	enqueueFunc func(string)
+
+	skipStatusUpdates bool
+}
+
+// EventHandlerOption configures an EventHandler.
+type EventHandlerOption func(*EventHandler)
+
+// WithoutStatusUpdates tells the event handler to ignore update events which
+// change neither the generation nor the labels of the object. It avoids
+// triggering reconciliations when the operator updates the status
+// subresource of the watched objects.
+func WithoutStatusUpdates() EventHandlerOption {
+	return func(e *EventHandler) {
+		e.skipStatusUpdates = true
+	}
 }
 
 func NewEventHandler(
@@ -37,14 +53,21 @@ func NewEventHandler(
 	metrics *Metrics,
 	objName string,
 	enqueueFunc func(ns string),
+	opts ...EventHandlerOption,
 ) *EventHandler {
-	return &EventHandler{
+	e := &EventHandler{
 		logger:      logger,
 		accessor:    accessor,
 		metrics:     metrics,
 		objName:     objName,
 		enqueueFunc: enqueueFunc,
 	}
+
+	for _, opt := range opts {
+		opt(e)
+	}
+
+	return e
 }
 
 func (e *EventHandler) OnAdd(obj interface{}, _ bool) {
@@ -61,6 +84,10 @@ func (e *EventHandler) OnUpdate(old, cur interface{}) {
 		return
 	}
 
+	if e.skipStatusUpdates && isStatusUpdate(old.(metav1.Object), cur.(metav1.Object)) {
+		return
+	}
+
 	if o, ok := e.accessor.ObjectMetadata(cur); ok {
 		e.logger.Debug(fmt.Sprintf("%s updated", e.objName))
 		e.metrics.TriggerByCounter(e.objName, UpdateEvent)
@@ -75,3 +102,17 @@ func (e *EventHandler) OnDelete(obj interface{}) {
 		e.enqueueFunc(o.GetNamespace())
 	}
 }
+
+// isStatusUpdate returns true if the update didn't modify the generation nor
+// the labels of the object.
+func isStatusUpdate(old, cur metav1.Object) bool {
+	if cur.GetGeneration() == 0 {
+		// The object's generation isn't set, it isn't possible to detect
+		// status-only updates.
+		return false
+	}
+
+	return old.GetGeneration() == cur.GetGeneration() &&
+		old.GetDeletionTimestamp().Equal(cur.GetDeletionTimestamp()) &&
+		reflect.DeepEqual(old.GetLabels(), cur.GetLabels())
+}
@@ -32,6 +32,9 @@ const (
 	// PrometheusShardRetentionPolicyFeature enables the shard retention policy for Prometheus.
 	PrometheusShardRetentionPolicyFeature FeatureGateName = "PrometheusShardRetentionPolicy"
 
+	// PrometheusShardAutoscalingFeature enables the computation of the number of shards for Prometheus.
+	PrometheusShardAutoscalingFeature FeatureGateName = "PrometheusShardAutoscaling"
+
 	// StatusForConfigurationResourcesFeature enables the status subresource for Prometheus-Operator Config Objects.
 	StatusForConfigurationResourcesFeature FeatureGateName = "StatusForConfigurationResources"
 )
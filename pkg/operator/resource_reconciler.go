@@ -296,7 +296,7 @@ func (rr *ResourceReconciler) OnAdd(obj interface{}, _ bool) {
 		return
 	}
 
-	if !rr.isManagedByController(objMeta) {
+	if !rr.IsManagedByController(objMeta) {
 		return
 	}
 
@@ -332,7 +332,7 @@ func (rr *ResourceReconciler) OnUpdate(old, cur interface{}) {
 		rr.logger.Error("failed to get current object meta", "err", err, "key", key)
 	}
 
-	if !rr.isManagedByController(mCur) {
+	if !rr.IsManagedByController(mCur) {
 		return
 	}
 
@@ -371,7 +371,7 @@ func (rr *ResourceReconciler) OnDelete(obj interface{}) {
 		return
 	}
 
-	if !rr.isManagedByController(objMeta) {
+	if !rr.IsManagedByController(objMeta) {
 		return
 	}
 
@@ -488,16 +488,26 @@ func (rr *ResourceReconciler) onDaemonSetDelete(ds *appsv1.DaemonSet) {
 
 // EnqueueForReconciliation asks for reconciling the object.
 func (rr *ResourceReconciler) EnqueueForReconciliation(obj metav1.Object) {
-	if !rr.isManagedByController(obj) {
+	if !rr.IsManagedByController(obj) {
 		return
 	}
 
 	rr.reconcileQ.Add(obj.GetNamespace() + "/" + obj.GetName())
 }
 
+// EnqueueForReconciliationAfter asks for reconciling the object once the
+// given duration has passed.
+func (rr *ResourceReconciler) EnqueueForReconciliationAfter(obj metav1.Object, d time.Duration) {
+	if !rr.IsManagedByController(obj) {
+		return
+	}
+
+	rr.reconcileQ.AddAfter(obj.GetNamespace()+"/"+obj.GetName(), d)
+}
+
 // EnqueueForStatus asks for updating the status of the object.
 func (rr *ResourceReconciler) EnqueueForStatus(obj metav1.Object) {
-	if !rr.isManagedByController(obj) {
+	if !rr.IsManagedByController(obj) {
 		return
 	}
 
@@ -595,10 +605,10 @@ func ListMatchingNamespaces(selector labels.Selector, nsInf cache.SharedIndexInf
 	return ns, nil
 }
 
-// isManagedByController returns true if the controller is the "owner" of the object.
+// IsManagedByController returns true if the controller is the "owner" of the object.
 // Whether it's owner is determined by the value of 'controllerID'
 // annotation. If the value matches the controllerID then it owns it.
-func (rr *ResourceReconciler) isManagedByController(obj metav1.Object) bool {
+func (rr *ResourceReconciler) IsManagedByController(obj metav1.Object) bool {
 	var controllerID string
 
 	if obj.GetAnnotations() != nil {
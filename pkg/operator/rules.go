@@ -15,6 +15,7 @@
 package operator
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log/slog"
@@ -26,6 +27,7 @@ import (
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/yaml"
@@ -45,6 +47,10 @@ func init() {
 	model.NameValidationScheme = model.LegacyValidation
 }
 
+// RuleGroupNotLoadedReason is the reason of the Accepted condition for the
+// valid rule groups of a rejected PrometheusRule object.
+const RuleGroupNotLoadedReason = "PrometheusRuleRejected"
+
 type RuleConfigurationFormat int
 
 const (
@@ -92,6 +98,24 @@ func NewPrometheusRuleSelector(ruleFormat RuleConfigurationFormat, version strin
 	}, nil
 }
 
+// GenerateRulesConfiguration returns the rule file generated for the
+// PrometheusRule object, as it would be loaded by a Prometheus (or Thanos)
+// instance of the given version: the namespace label is enforced, the fields
+// unsupported by the version are removed and the rules are validated.
+func GenerateRulesConfiguration(promRule *monitoringv1.PrometheusRule, ruleFormat RuleConfigurationFormat, version string, nsLabeler *namespacelabeler.Labeler, logger *slog.Logger) (string, error) {
+	prs, err := NewPrometheusRuleSelector(ruleFormat, version, nil, nsLabeler, nil, nil, logger)
+	if err != nil {
+		return "", err
+	}
+
+	promRule = promRule.DeepCopy()
+	if err := nsLabeler.EnforceNamespaceLabel(promRule); err != nil {
+		return "", err
+	}
+
+	return prs.generateRulesConfiguration(promRule)
+}
+
 func (prs *PrometheusRuleSelector) generateRulesConfiguration(promRule *monitoringv1.PrometheusRule) (string, error) {
 	logger := prs.logger.With("prometheusrule", promRule.Name, "prometheusrule-namespace", promRule.Namespace)
 	promRuleSpec := promRule.Spec
@@ -110,7 +134,7 @@ func (prs *PrometheusRuleSelector) generateRulesConfiguration(promRule *monitori
 		for _, err := range errs {
 			logger.Info(m, "err", err)
 		}
-		return "", errors.New(m)
+		return "", fmt.Errorf("%s: %w", m, errors.Join(errs...))
 	}
 
 	return string(content), nil
@@ -199,9 +223,93 @@ func ValidateRule(promRuleSpec monitoringv1.PrometheusRuleSpec) []error {
 	return errs
 }
 
+// PrometheusRuleSelection holds the PrometheusRule objects selected by a
+// workload, including the rejected ones.
+type PrometheusRuleSelection struct {
+	ruleFiles map[string]string                 // Rule files keyed by their name.
+	selection map[string]SelectedPrometheusRule // PrometheusRules keyed by their namespace/name key.
+	rejected  int
+}
+
+// RuleFiles returns the rule files of the valid PrometheusRules keyed by
+// their filename.
+func (s PrometheusRuleSelection) RuleFiles() map[string]string {
+	return s.ruleFiles
+}
+
+// Selection returns all the selected PrometheusRules (including the
+// rejected ones) keyed by their namespace/name key.
+func (s PrometheusRuleSelection) Selection() map[string]SelectedPrometheusRule {
+	return s.selection
+}
+
+// SelectedLen returns the number of valid PrometheusRules.
+func (s PrometheusRuleSelection) SelectedLen() int {
+	return len(s.ruleFiles)
+}
+
+// RejectedLen returns the number of rejected PrometheusRules.
+func (s PrometheusRuleSelection) RejectedLen() int {
+	return s.rejected
+}
+
+// SelectedPrometheusRule represents a PrometheusRule object selected by a
+// workload.
+type SelectedPrometheusRule struct {
+	rule      *monitoringv1.PrometheusRule
+	err       error
+	reason    string
+	groupErrs map[string]error
+}
+
+// Resource returns the PrometheusRule object.
+func (r SelectedPrometheusRule) Resource() *monitoringv1.PrometheusRule {
+	return r.rule
+}
+
+// Err returns the reason why the PrometheusRule has been rejected (if any).
+func (r SelectedPrometheusRule) Err() error {
+	return r.err
+}
+
+// Conditions returns the conditions of the PrometheusRule object for the
+// selecting workload.
+func (r SelectedPrometheusRule) Conditions() []monitoringv1.ConfigResourceCondition {
+	return []monitoringv1.ConfigResourceCondition{NewConfigResourceCondition(r.rule, r.reason, r.err)}
+}
+
+// GroupStatuses returns the status of each rule group for the selecting
+// workload. When the PrometheusRule object is rejected, none of its groups
+// are loaded.
+func (r SelectedPrometheusRule) GroupStatuses() []monitoringv1.RuleGroupStatus {
+	groups := make([]monitoringv1.RuleGroupStatus, 0, len(r.rule.Spec.Groups))
+
+	for _, g := range r.rule.Spec.Groups {
+		var (
+			reason = r.reason
+			err    = r.err
+		)
+
+		if r.err != nil {
+			if groupErr := r.groupErrs[g.Name]; groupErr != nil {
+				err = groupErr
+			} else {
+				reason = RuleGroupNotLoadedReason
+				err = errors.New("the rule group is valid but the PrometheusRule resource has been rejected")
+			}
+		}
+
+		groups = append(groups, monitoringv1.RuleGroupStatus{
+			Name:       g.Name,
+			Conditions: []monitoringv1.ConfigResourceCondition{NewConfigResourceCondition(r.rule, reason, err)},
+		})
+	}
+
+	return groups
+}
+
 // Select selects PrometheusRules and translates them into native Prometheus/Thanos configurations.
-// The second returned value is the number of rejected PrometheusRule objects.
-func (prs *PrometheusRuleSelector) Select(namespaces []string) (map[string]string, int, error) {
+func (prs *PrometheusRuleSelector) Select(namespaces []string) (PrometheusRuleSelection, error) {
 	promRules := map[string]*monitoringv1.PrometheusRule{}
 
 	for _, ns := range namespaces {
@@ -215,38 +323,55 @@ func (prs *PrometheusRuleSelector) Select(namespaces []string) (map[string]strin
 			promRules[fmt.Sprintf("%v-%v-%v.yaml", promRule.Namespace, promRule.Name, promRule.UID)] = promRule
 		})
 		if err != nil {
-			return nil, 0, fmt.Errorf("failed to list prometheus rules in namespace %s: %w", ns, err)
+			return PrometheusRuleSelection{}, fmt.Errorf("failed to list prometheus rules in namespace %s: %w", ns, err)
 		}
 	}
 
-	var rejected int
-	rules := make(map[string]string, len(promRules))
+	selection := PrometheusRuleSelection{
+		ruleFiles: make(map[string]string, len(promRules)),
+		selection: make(map[string]SelectedPrometheusRule, len(promRules)),
+	}
 
 	for ruleName, promRule := range promRules {
-		var err error
-		var content string
+		var (
+			err     error
+			content string
+			// Keep a copy of the original object since enforcing the
+			// namespace label modifies the spec.
+			selected = SelectedPrometheusRule{rule: promRule.DeepCopy()}
+		)
+
 		if err := prs.nsLabeler.EnforceNamespaceLabel(promRule); err != nil {
+			selected.err = err
+			selected.reason = InvalidConfigurationEvent
+			selection.selection[promRule.Namespace+"/"+promRule.Name] = selected
 			continue
 		}
 
 		content, err = prs.generateRulesConfiguration(promRule)
 		if err != nil {
-			rejected++
+			selection.rejected++
 			prs.logger.Warn(
 				"skipping prometheusrule",
 				"error", err.Error(),
 				"prometheusrule", promRule.Name,
 				"namespace", promRule.Namespace,
 			)
-			prs.eventRecorder.Eventf(promRule, v1.EventTypeWarning, "InvalidConfiguration", "PrometheusRule %s was rejected due to invalid configuration: %v", promRule.Name, err)
+			prs.eventRecorder.Eventf(promRule, v1.EventTypeWarning, InvalidConfigurationEvent, "PrometheusRule %s was rejected due to invalid configuration: %v", promRule.Name, err)
+
+			selected.err = err
+			selected.reason = InvalidConfigurationEvent
+			selected.groupErrs = prs.validateRuleGroups(promRule)
+			selection.selection[promRule.Namespace+"/"+promRule.Name] = selected
 			continue
 		}
 
-		rules[ruleName] = content
+		selection.ruleFiles[ruleName] = content
+		selection.selection[promRule.Namespace+"/"+promRule.Name] = selected
 	}
 
 	ruleNames := []string{}
-	for name := range rules {
+	for name := range selection.ruleFiles {
 		ruleNames = append(ruleNames, name)
 	}
 
@@ -255,5 +380,60 @@ func (prs *PrometheusRuleSelector) Select(namespaces []string) (map[string]strin
 		"rules", strings.Join(ruleNames, ","),
 	)
 
-	return rules, rejected, nil
+	return selection, nil
+}
+
+// validateRuleGroups validates each rule group of the PrometheusRule object
+// independently and returns the errors keyed by group name.
+func (prs *PrometheusRuleSelector) validateRuleGroups(promRule *monitoringv1.PrometheusRule) map[string]error {
+	// The warnings have already been logged when generating the rule file.
+	promRuleSpec := prs.sanitizePrometheusRulesSpec(promRule.Spec, slog.New(slog.DiscardHandler))
+
+	groupErrs := map[string]error{}
+	for _, g := range promRuleSpec.Groups {
+		if errs := ValidateRule(monitoringv1.PrometheusRuleSpec{Groups: []monitoringv1.RuleGroup{g}}); len(errs) > 0 {
+			groupErrs[g.Name] = errors.Join(errs...)
+		}
+	}
+
+	return groupErrs
+}
+
+// UpdatePrometheusRulesStatus records the workload's binding in the status of
+// the selected PrometheusRule objects and removes it from the PrometheusRule
+// objects which aren't selected anymore.
+// An empty selection removes the workload's binding from all the objects.
+func UpdatePrometheusRulesStatus(ctx context.Context, syncer *ConfigResourceSyncer, selection PrometheusRuleSelection, ruleInformer *informers.ForResource) error {
+	var errs []error
+
+	for key, r := range selection.selection {
+		if err := syncer.UpdateRuleBinding(ctx, r.rule, r.Conditions(), r.GroupStatuses()); err != nil {
+			errs = append(errs, fmt.Errorf("failed to update the status of %q: %w", key, err))
+		}
+	}
+
+	err := ruleInformer.ListAll(labels.Everything(), func(obj interface{}) {
+		promRule, ok := obj.(*monitoringv1.PrometheusRule)
+		if !ok {
+			return
+		}
+
+		key, err := cache.MetaNamespaceKeyFunc(promRule)
+		if err != nil {
+			return
+		}
+
+		if _, found := selection.selection[key]; found {
+			return
+		}
+
+		if err := syncer.RemoveBinding(ctx, promRule); err != nil {
+			errs = append(errs, fmt.Errorf("failed to remove the binding from %q: %w", key, err))
+		}
+	})
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	return errors.Join(errs...)
 }
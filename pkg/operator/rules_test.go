@@ -22,9 +22,11 @@ import (
 
 	"github.com/blang/semver/v4"
 	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/namespacelabeler"
 )
 
 func TestMakeRulesConfigMaps(t *testing.T) {
@@ -509,3 +511,119 @@ func shouldAcceptRuleWithGroupLabels(t *testing.T) {
 	_, err := pr.generateRulesConfiguration(rules)
 	require.NoError(t, err)
 }
+
+func TestSelectedPrometheusRuleGroupStatuses(t *testing.T) {
+	rule := &monitoringv1.PrometheusRule{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "rule",
+			Namespace:  "ns",
+			Generation: 3,
+		},
+		Spec: monitoringv1.PrometheusRuleSpec{Groups: []monitoringv1.RuleGroup{
+			{
+				Name: "valid",
+				Rules: []monitoringv1.Rule{
+					{
+						Alert: "alert",
+						Expr:  intstr.FromString("vector(1)"),
+					},
+				},
+			},
+			{
+				Name: "invalid",
+				Rules: []monitoringv1.Rule{
+					{
+						Alert: "alert",
+						Expr:  intstr.FromString("invalidfn(1)"),
+					},
+				},
+			},
+		}},
+	}
+
+	promVersion, _ := semver.ParseTolerant(DefaultPrometheusVersion)
+	pr := newRuleSelectorForConfigGeneration(PrometheusFormat, promVersion)
+
+	_, err := pr.generateRulesConfiguration(rule.DeepCopy())
+	require.ErrorContains(t, err, "invalidfn")
+
+	t.Run("rejected", func(t *testing.T) {
+		selected := SelectedPrometheusRule{
+			rule:      rule,
+			err:       err,
+			reason:    InvalidConfigurationEvent,
+			groupErrs: pr.validateRuleGroups(rule.DeepCopy()),
+		}
+
+		conditions := selected.Conditions()
+		require.Len(t, conditions, 1)
+		require.Equal(t, monitoringv1.ConditionFalse, conditions[0].Status)
+		require.Equal(t, int64(3), conditions[0].ObservedGeneration)
+
+		groups := selected.GroupStatuses()
+		require.Len(t, groups, 2)
+
+		require.Equal(t, "valid", groups[0].Name)
+		require.Equal(t, monitoringv1.ConditionFalse, groups[0].Conditions[0].Status)
+		require.Equal(t, RuleGroupNotLoadedReason, groups[0].Conditions[0].Reason)
+
+		require.Equal(t, "invalid", groups[1].Name)
+		require.Equal(t, monitoringv1.ConditionFalse, groups[1].Conditions[0].Status)
+		require.Equal(t, InvalidConfigurationEvent, groups[1].Conditions[0].Reason)
+		require.Contains(t, groups[1].Conditions[0].Message, "invalidfn")
+	})
+
+	t.Run("accepted", func(t *testing.T) {
+		selected := SelectedPrometheusRule{rule: rule}
+
+		conditions := selected.Conditions()
+		require.Len(t, conditions, 1)
+		require.Equal(t, monitoringv1.ConditionTrue, conditions[0].Status)
+
+		groups := selected.GroupStatuses()
+		require.Len(t, groups, 2)
+		for _, g := range groups {
+			require.Equal(t, monitoringv1.ConditionTrue, g.Conditions[0].Status)
+		}
+	})
+}
+
+func TestGenerateRulesConfiguration(t *testing.T) {
+	rule := &monitoringv1.PrometheusRule{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "rule",
+			Namespace: "ns",
+		},
+		Spec: monitoringv1.PrometheusRuleSpec{Groups: []monitoringv1.RuleGroup{
+			{
+				Name:                    "group",
+				PartialResponseStrategy: "warn",
+				Rules: []monitoringv1.Rule{
+					{
+						Alert: "alert",
+						Expr:  intstr.FromString("up == 0"),
+					},
+				},
+			},
+		}},
+	}
+	logger := slog.New(slog.DiscardHandler)
+
+	content, err := GenerateRulesConfiguration(rule, PrometheusFormat, DefaultPrometheusVersion, namespacelabeler.New("namespace", nil, true), logger)
+	require.NoError(t, err)
+	require.Equal(t, `groups:
+- name: group
+  rules:
+  - alert: alert
+    expr: up{namespace="ns"} == 0
+    labels:
+      namespace: ns
+`, content)
+
+	// The object isn't modified.
+	require.Equal(t, "up == 0", rule.Spec.Groups[0].Rules[0].Expr.String())
+
+	rule.Spec.Groups[0].Rules[0].Expr = intstr.FromString("invalidfn(1)")
+	_, err = GenerateRulesConfiguration(rule, PrometheusFormat, DefaultPrometheusVersion, namespacelabeler.New("", nil, true), logger)
+	require.ErrorContains(t, err, "invalidfn")
+}
@@ -29,6 +29,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/metadata"
 	"k8s.io/client-go/rest"
@@ -74,6 +75,8 @@ type Operator struct {
 	pmonInfs  *informers.ForResource
 	probeInfs *informers.ForResource
 	sconInfs  *informers.ForResource
+	rwInfs    *informers.ForResource
+	routeInfs *informers.ForResource
 	cmapInfs  *informers.ForResource
 	secrInfs  *informers.ForResource
 	ssetInfs  *informers.ForResource
@@ -88,13 +91,16 @@ type Operator struct {
 
 	endpointSliceSupported bool // Whether the Kubernetes API suports the EndpointSlice kind.
 	scrapeConfigSupported  bool
+	remoteWriteSupported   bool
+	httpRouteSupported     bool
 	canReadStorageClass    bool
 
 	eventRecorder record.EventRecorder
 
 	statusReporter prompkg.StatusReporter
 
-	daemonSetFeatureGateEnabled bool
+	daemonSetFeatureGateEnabled  bool
+	configResourcesStatusEnabled bool
 }
 
 type ControllerOption func(*Operator)
@@ -113,6 +119,21 @@ func WithScrapeConfig() ControllerOption {
 	}
 }
 
+// WithRemoteWrite tells that the controller manages RemoteWrite objects.
+func WithRemoteWrite() ControllerOption {
+	return func(o *Operator) {
+		o.remoteWriteSupported = true
+	}
+}
+
+// WithHTTPRoute tells that the controller can watch Gateway API HTTPRoute
+// objects for the Probe HTTPRoute targets.
+func WithHTTPRoute() ControllerOption {
+	return func(o *Operator) {
+		o.httpRouteSupported = true
+	}
+}
+
 // WithStorageClassValidation tells that the controller should verify that the
 // Prometheus spec references a valid StorageClass name.
 func WithStorageClassValidation() ControllerOption {
@@ -160,6 +181,8 @@ func New(ctx context.Context, restConfig *rest.Config, c operator.Config, logger
 		reconciliations: &operator.ReconciliationTracker{},
 		controllerID:    c.ControllerID,
 		eventRecorder:   c.EventRecorderFactory(client, controllerName),
+
+		configResourcesStatusEnabled: c.Gates.Enabled(operator.StatusForConfigurationResourcesFeature),
 	}
 	o.metrics.MustRegister(
 		o.reconciliations,
@@ -258,6 +281,43 @@ func New(ctx context.Context, restConfig *rest.Config, c operator.Config, logger
 		}
 	}
 
+	if o.remoteWriteSupported {
+		o.rwInfs, err = informers.NewInformersForResource(
+			informers.NewMonitoringInformerFactories(
+				c.Namespaces.AllowList,
+				c.Namespaces.DenyList,
+				mclient,
+				resyncPeriod,
+				nil,
+			),
+			monitoringv1alpha1.SchemeGroupVersion.WithResource(monitoringv1alpha1.RemoteWriteName),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("error creating remotewrite informers: %w", err)
+		}
+	}
+
+	if o.httpRouteSupported {
+		dynClient, err := dynamic.NewForConfig(restConfig)
+		if err != nil {
+			return nil, fmt.Errorf("instantiating dynamic client failed: %w", err)
+		}
+
+		o.routeInfs, err = informers.NewInformersForResource(
+			informers.NewDynamicInformerFactories(
+				c.Namespaces.AllowList,
+				c.Namespaces.DenyList,
+				dynClient,
+				resyncPeriod,
+				nil,
+			),
+			prompkg.HTTPRouteGroupVersionResource,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("error creating httproutes informers: %w", err)
+		}
+	}
+
 	o.cmapInfs, err = informers.NewInformersForResourceWithTransform(
 		informers.NewMetadataInformerFactory(
 			c.Namespaces.PrometheusAllowList,
@@ -382,6 +442,12 @@ func (c *Operator) Run(ctx context.Context) error {
 	if c.scrapeConfigSupported {
 		go c.sconInfs.Start(ctx.Done())
 	}
+	if c.remoteWriteSupported {
+		go c.rwInfs.Start(ctx.Done())
+	}
+	if c.httpRouteSupported {
+		go c.routeInfs.Start(ctx.Done())
+	}
 	go c.cmapInfs.Start(ctx.Done())
 	go c.secrInfs.Start(ctx.Done())
 	go c.ssetInfs.Start(ctx.Done())
@@ -437,6 +503,8 @@ func (c *Operator) waitForCacheSync(ctx context.Context) error {
 		{"PodMonitor", c.pmonInfs},
 		{"Probe", c.probeInfs},
 		{"ScrapeConfig", c.sconInfs},
+		{"RemoteWrite", c.rwInfs},
+		{"HTTPRoute", c.routeInfs},
 		{"ConfigMap", c.cmapInfs},
 		{"Secret", c.secrInfs},
 		{"StatefulSet", c.ssetInfs},
@@ -487,6 +555,7 @@ func (c *Operator) addHandlers() {
 		c.metrics,
 		monitoringv1.ServiceMonitorsKind,
 		c.enqueueForMonitorNamespace,
+		operator.WithoutStatusUpdates(),
 	))
 
 	c.pmonInfs.AddEventHandler(operator.NewEventHandler(
@@ -495,6 +564,7 @@ func (c *Operator) addHandlers() {
 		c.metrics,
 		monitoringv1.PodMonitorsKind,
 		c.enqueueForMonitorNamespace,
+		operator.WithoutStatusUpdates(),
 	))
 
 	c.probeInfs.AddEventHandler(operator.NewEventHandler(
@@ -503,6 +573,7 @@ func (c *Operator) addHandlers() {
 		c.metrics,
 		monitoringv1.ProbesKind,
 		c.enqueueForMonitorNamespace,
+		operator.WithoutStatusUpdates(),
 	))
 
 	if c.sconInfs != nil {
@@ -512,6 +583,29 @@ func (c *Operator) addHandlers() {
 			c.metrics,
 			monitoringv1alpha1.ScrapeConfigsKind,
 			c.enqueueForMonitorNamespace,
+			operator.WithoutStatusUpdates(),
+		))
+	}
+
+	if c.rwInfs != nil {
+		c.rwInfs.AddEventHandler(operator.NewEventHandler(
+			c.logger,
+			c.accessor,
+			c.metrics,
+			monitoringv1alpha1.RemoteWritesKind,
+			c.enqueueForMonitorNamespace,
+			operator.WithoutStatusUpdates(),
+		))
+	}
+
+	if c.routeInfs != nil {
+		c.routeInfs.AddEventHandler(operator.NewEventHandler(
+			c.logger,
+			c.accessor,
+			c.metrics,
+			"HTTPRoute",
+			c.enqueueForHTTPRouteNamespace,
+			operator.WithoutStatusUpdates(),
 		))
 	}
 
@@ -555,6 +649,7 @@ func (c *Operator) sync(ctx context.Context, key string) error {
 	if apierrors.IsNotFound(err) {
 		c.reconciliations.ForgetObject(key)
 		// Dependent resources are cleaned up by K8s via OwnerReferences
+		c.removeConfigResourcesBindings(ctx, key)
 		return nil
 	}
 
@@ -603,10 +698,13 @@ func (c *Operator) sync(ctx context.Context, key string) error {
 		return err
 	}
 
-	if err := c.createOrUpdateConfigurationSecret(ctx, p, cg, assetStore); err != nil {
+	resources, err := c.createOrUpdateConfigurationSecret(ctx, p, cg, assetStore)
+	if err != nil {
 		return fmt.Errorf("creating config failed: %w", err)
 	}
 
+	c.updateConfigResourcesStatus(ctx, p, resources)
+
 	tlsAssets, err := operator.ReconcileShardedSecret(ctx, assetStore.TLSAssets(), c.kclient, prompkg.NewTLSAssetSecret(p, c.config))
 	if err != nil {
 		return fmt.Errorf("failed to reconcile the TLS secrets: %w", err)
@@ -834,74 +932,142 @@ func (c *Operator) syncStatefulSet(ctx context.Context, key string, p *monitorin
 	return nil
 }
 
-func (c *Operator) createOrUpdateConfigurationSecret(ctx context.Context, p *monitoringv1alpha1.PrometheusAgent, cg *prompkg.ConfigGenerator, store *assets.StoreBuilder) error {
+func (c *Operator) createOrUpdateConfigurationSecret(ctx context.Context, p *monitoringv1alpha1.PrometheusAgent, cg *prompkg.ConfigGenerator, store *assets.StoreBuilder) (prompkg.ResourcesSelection, error) {
+	var resources prompkg.ResourcesSelection
+
 	resourceSelector, err := prompkg.NewResourceSelector(c.logger, p, store, c.nsMonInf, c.metrics, c.eventRecorder)
 	if err != nil {
-		return err
+		return resources, err
+	}
+
+	resources.ServiceMonitors, err = resourceSelector.SelectServiceMonitors(ctx, c.smonInfs.ListAllByNamespace)
+	if err != nil {
+		return resources, fmt.Errorf("selecting ServiceMonitors failed: %w", err)
 	}
 
-	smons, err := resourceSelector.SelectServiceMonitors(ctx, c.smonInfs.ListAllByNamespace)
+	resources.PodMonitors, err = resourceSelector.SelectPodMonitors(ctx, c.pmonInfs.ListAllByNamespace)
 	if err != nil {
-		return fmt.Errorf("selecting ServiceMonitors failed: %w", err)
+		return resources, fmt.Errorf("selecting PodMonitors failed: %w", err)
 	}
 
-	pmons, err := resourceSelector.SelectPodMonitors(ctx, c.pmonInfs.ListAllByNamespace)
+	resources.Probes, err = resourceSelector.SelectProbes(ctx, c.probeInfs.ListAllByNamespace)
 	if err != nil {
-		return fmt.Errorf("selecting PodMonitors failed: %w", err)
+		return resources, fmt.Errorf("selecting Probes failed: %w", err)
+	}
+
+	var listHTTPRoutes prompkg.ListAllByNamespaceFn
+	if c.routeInfs != nil {
+		listHTTPRoutes = c.routeInfs.ListAllByNamespace
 	}
 
-	bmons, err := resourceSelector.SelectProbes(ctx, c.probeInfs.ListAllByNamespace)
+	probeHTTPRoutes, err := resourceSelector.SelectProbeHTTPRoutes(resources.Probes, listHTTPRoutes)
 	if err != nil {
-		return fmt.Errorf("selecting Probes failed: %w", err)
+		return resources, fmt.Errorf("selecting HTTPRoutes failed: %w", err)
 	}
+	cg = cg.WithProbeHTTPRoutes(probeHTTPRoutes)
 
-	var scrapeConfigs map[string]*monitoringv1alpha1.ScrapeConfig
 	if c.sconInfs != nil {
-		scrapeConfigs, err = resourceSelector.SelectScrapeConfigs(ctx, c.sconInfs.ListAllByNamespace)
+		resources.ScrapeConfigs, err = resourceSelector.SelectScrapeConfigs(ctx, c.sconInfs.ListAllByNamespace)
+		if err != nil {
+			return resources, fmt.Errorf("selecting ScrapeConfigs failed: %w", err)
+		}
+	}
+
+	if c.rwInfs != nil {
+		remoteWrites, err := resourceSelector.SelectRemoteWrites(ctx, c.rwInfs.ListAllByNamespace)
 		if err != nil {
-			return fmt.Errorf("selecting ScrapeConfigs failed: %w", err)
+			return resources, fmt.Errorf("selecting RemoteWrites failed: %w", err)
 		}
+
+		cg = cg.WithRemoteWrites(remoteWrites.ValidResources())
 	}
 
 	if err := prompkg.AddRemoteWritesToStore(ctx, store, p.GetNamespace(), p.Spec.RemoteWrite); err != nil {
-		return err
+		return resources, err
 	}
 
 	if err := prompkg.AddAPIServerConfigToStore(ctx, store, p.GetNamespace(), p.Spec.APIServerConfig); err != nil {
-		return err
+		return resources, err
 	}
 
 	if err := prompkg.AddScrapeClassesToStore(ctx, store, p.GetNamespace(), p.Spec.ScrapeClasses); err != nil {
-		return fmt.Errorf("failed to process scrape classes: %w", err)
+		return resources, fmt.Errorf("failed to process scrape classes: %w", err)
 	}
 
 	sClient := c.kclient.CoreV1().Secrets(p.Namespace)
 	additionalScrapeConfigs, err := k8sutil.LoadSecretRef(ctx, c.logger, sClient, p.Spec.AdditionalScrapeConfigs)
 	if err != nil {
-		return fmt.Errorf("loading additional scrape configs from Secret failed: %w", err)
+		return resources, fmt.Errorf("loading additional scrape configs from Secret failed: %w", err)
 	}
 
 	// Update secret based on the most recent configuration.
 	conf, err := cg.GenerateAgentConfiguration(
-		smons,
-		pmons,
-		bmons,
-		scrapeConfigs,
+		resources.ServiceMonitors.ValidResources(),
+		resources.PodMonitors.ValidResources(),
+		resources.Probes.ValidResources(),
+		resources.ScrapeConfigs.ValidResources(),
 		store,
 		additionalScrapeConfigs,
 	)
 	if err != nil {
-		return fmt.Errorf("generating config failed: %w", err)
+		return resources, fmt.Errorf("generating config failed: %w", err)
 	}
 
 	// Compress config to avoid 1mb secret limit for a while
 	s, err := prompkg.MakeConfigurationSecret(p, c.config, conf)
 	if err != nil {
-		return fmt.Errorf("creating compressed secret failed: %w", err)
+		return resources, fmt.Errorf("creating compressed secret failed: %w", err)
 	}
 
 	c.logger.Debug("updating Prometheus configuration secret")
-	return k8sutil.CreateOrUpdateSecret(ctx, sClient, s)
+	return resources, k8sutil.CreateOrUpdateSecret(ctx, sClient, s)
+}
+
+// updateConfigResourcesStatus updates the status of the configuration
+// resources (ServiceMonitor, PodMonitor, Probe and ScrapeConfig) selected by
+// the PrometheusAgent object.
+func (c *Operator) updateConfigResourcesStatus(ctx context.Context, p *monitoringv1alpha1.PrometheusAgent, resources prompkg.ResourcesSelection) {
+	if !c.configResourcesStatusEnabled {
+		return
+	}
+
+	syncer := operator.NewConfigResourceSyncer(c.mclient, monitoringv1alpha1.PrometheusAgentName, p)
+	if err := prompkg.UpdateConfigResourcesStatus(ctx, syncer, resources, c.configResourceListers()); err != nil {
+		c.logger.Warn("failed to update the status of configuration resources", "err", err, "name", p.Name, "namespace", p.Namespace)
+	}
+}
+
+// removeConfigResourcesBindings removes the bindings of a deleted
+// PrometheusAgent object from the status of the configuration resources.
+func (c *Operator) removeConfigResourcesBindings(ctx context.Context, key string) {
+	if !c.configResourcesStatusEnabled {
+		return
+	}
+
+	ns, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		c.logger.Error("failed to split key", "err", err, "key", key)
+		return
+	}
+
+	syncer := operator.NewConfigResourceSyncer(c.mclient, monitoringv1alpha1.PrometheusAgentName, &metav1.ObjectMeta{Namespace: ns, Name: name})
+	if err := prompkg.UpdateConfigResourcesStatus(ctx, syncer, prompkg.ResourcesSelection{}, c.configResourceListers()); err != nil {
+		c.logger.Warn("failed to remove bindings from configuration resources", "err", err, "key", key)
+	}
+}
+
+func (c *Operator) configResourceListers() prompkg.ConfigResourceListers {
+	listers := prompkg.ConfigResourceListers{
+		ServiceMonitors: c.smonInfs.ListAll,
+		PodMonitors:     c.pmonInfs.ListAll,
+		Probes:          c.probeInfs.ListAll,
+	}
+
+	if c.sconInfs != nil {
+		listers.ScrapeConfigs = c.sconInfs.ListAll
+	}
+
+	return listers
 }
 
 func createSSetInputHash(p monitoringv1alpha1.PrometheusAgent, c prompkg.Config, tlsAssets *operator.ShardedSecret, ssSpec appsv1.StatefulSetSpec) (string, error) {
@@ -1019,6 +1185,21 @@ func (c *Operator) enqueueForMonitorNamespace(nsName string) {
 	c.enqueueForNamespace(c.nsMonInf.GetStore(), nsName)
 }
 
+// enqueueForHTTPRouteNamespace enqueues all the PrometheusAgent objects. Because
+// Probe objects can select HTTPRoute objects from other namespaces, the
+// namespace of the HTTPRoute object isn't used for filtering.
+func (c *Operator) enqueueForHTTPRouteNamespace(_ string) {
+	err := c.promInfs.ListAll(labels.Everything(), func(obj interface{}) {
+		c.rr.EnqueueForReconciliation(obj.(*monitoringv1alpha1.PrometheusAgent))
+	})
+	if err != nil {
+		c.logger.Error(
+			"listing all PrometheusAgent instances from cache failed",
+			"err", err,
+		)
+	}
+}
+
 // enqueueForNamespace enqueues all Prometheus object keys that belong to the
 // given namespace or select objects in the given namespace.
 func (c *Operator) enqueueForNamespace(store cache.Store, nsName string) {
@@ -1103,6 +1284,21 @@ func (c *Operator) enqueueForNamespace(store cache.Store, nsName string) {
 			c.rr.EnqueueForReconciliation(p)
 			return
 		}
+
+		// Check for Prometheus instances selecting RemoteWrites in the NS.
+		remoteWriteNSSelector, err := metav1.LabelSelectorAsSelector(p.Spec.RemoteWriteNamespaceSelector)
+		if err != nil {
+			c.logger.Error(
+				fmt.Sprintf("failed to convert RemoteWriteNamespaceSelector of %q to selector", p.Name),
+				"err", err,
+			)
+			return
+		}
+
+		if remoteWriteNSSelector.Matches(labels.Set(ns.Labels)) {
+			c.rr.EnqueueForReconciliation(p)
+			return
+		}
 	})
 	if err != nil {
 		c.logger.Error("listing all Prometheus instances from cache failed",
@@ -1135,6 +1331,7 @@ func (c *Operator) handleMonitorNamespaceUpdate(oldo, curo interface{}) {
 		for name, selector := range map[string]*metav1.LabelSelector{
 			"PodMonitors":     p.Spec.PodMonitorNamespaceSelector,
 			"Probes":          p.Spec.ProbeNamespaceSelector,
+			"RemoteWrites":    p.Spec.RemoteWriteNamespaceSelector,
 			"ServiceMonitors": p.Spec.ServiceMonitorNamespaceSelector,
 		} {
 
@@ -0,0 +1,139 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheusagent
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/prometheus/client_golang/prometheus"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	kubeinformers "k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/assets"
+	monitoringclient "github.com/prometheus-operator/prometheus-operator/pkg/client/versioned"
+	"github.com/prometheus-operator/prometheus-operator/pkg/informers"
+	"github.com/prometheus-operator/prometheus-operator/pkg/k8sutil"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+	prompkg "github.com/prometheus-operator/prometheus-operator/pkg/prometheus"
+)
+
+// RenderedConfigFilename is the name of the Prometheus configuration file
+// returned by RenderConfiguration.
+const RenderedConfigFilename = "prometheus.yaml"
+
+// RenderConfiguration returns the configuration files that the controller
+// would generate for the given PrometheusAgent object, keyed by file name.
+//
+// The function doesn't require a Kubernetes cluster: the clients are expected
+// to be fake clients populated with the namespaces, the configuration
+// resources, the secrets and the configmaps. The generated objects are
+// written back to the clients.
+func RenderConfiguration(ctx context.Context, logger *slog.Logger, kclient kubernetes.Interface, mclient monitoringclient.Interface, p *monitoringv1alpha1.PrometheusAgent) (map[string][]byte, error) {
+	if logger == nil {
+		logger = slog.New(slog.DiscardHandler)
+	}
+
+	p = p.DeepCopy()
+	if err := k8sutil.AddTypeInformationToObject(p); err != nil {
+		return nil, fmt.Errorf("failed to set PrometheusAgent type information: %w", err)
+	}
+
+	c := &Operator{
+		kclient:               kclient,
+		mclient:               mclient,
+		logger:                logger,
+		accessor:              operator.NewAccessor(logger),
+		metrics:               operator.NewMetrics(prometheus.NewRegistry()),
+		eventRecorder:         &record.FakeRecorder{},
+		scrapeConfigSupported: true,
+	}
+
+	var err error
+	for _, inf := range []struct {
+		infs     **informers.ForResource
+		resource schema.GroupVersionResource
+	}{
+		{&c.smonInfs, monitoringv1.SchemeGroupVersion.WithResource(monitoringv1.ServiceMonitorName)},
+		{&c.pmonInfs, monitoringv1.SchemeGroupVersion.WithResource(monitoringv1.PodMonitorName)},
+		{&c.probeInfs, monitoringv1.SchemeGroupVersion.WithResource(monitoringv1.ProbeName)},
+		{&c.sconInfs, monitoringv1alpha1.SchemeGroupVersion.WithResource(monitoringv1alpha1.ScrapeConfigName)},
+	} {
+		*inf.infs, err = informers.NewInformersForResource(
+			informers.NewMonitoringInformerFactories(
+				map[string]struct{}{v1.NamespaceAll: {}},
+				nil,
+				mclient,
+				0,
+				nil,
+			),
+			inf.resource,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("error creating %s informers: %w", inf.resource.Resource, err)
+		}
+
+		(*inf.infs).Start(ctx.Done())
+		if !cache.WaitForCacheSync(ctx.Done(), (*inf.infs).HasSynced) {
+			return nil, fmt.Errorf("failed to sync cache for %s informers", inf.resource.Resource)
+		}
+	}
+
+	kinfs := kubeinformers.NewSharedInformerFactory(kclient, 0)
+	c.nsMonInf = kinfs.Core().V1().Namespaces().Informer()
+	c.nsPromInf = c.nsMonInf
+	kinfs.Start(ctx.Done())
+	for inf, synced := range kinfs.WaitForCacheSync(ctx.Done()) {
+		if !synced {
+			return nil, fmt.Errorf("failed to sync cache for %s informer", inf)
+		}
+	}
+
+	opts := []prompkg.ConfigGeneratorOption{prompkg.WithEndpointSliceSupport()}
+	if ptr.Deref(p.Spec.Mode, "") == monitoringv1alpha1.DaemonSetPrometheusAgentMode {
+		opts = append(opts, prompkg.WithDaemonSet())
+	}
+
+	cg, err := prompkg.NewConfigGenerator(logger, p, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	store := assets.NewStoreBuilder(kclient.CoreV1(), kclient.CoreV1())
+	if _, err := c.createOrUpdateConfigurationSecret(ctx, p, cg, store); err != nil {
+		return nil, fmt.Errorf("creating config failed: %w", err)
+	}
+
+	s, err := kclient.CoreV1().Secrets(p.Namespace).Get(ctx, prompkg.ConfigSecretName(p), metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the configuration secret: %w", err)
+	}
+
+	conf, err := operator.GunzipConfig(s.Data[prompkg.ConfigFilename])
+	if err != nil {
+		return nil, fmt.Errorf("failed to decompress the configuration: %w", err)
+	}
+
+	return map[string][]byte{RenderedConfigFilename: []byte(conf)}, nil
+}
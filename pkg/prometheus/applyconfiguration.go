@@ -45,6 +45,10 @@ func prometheusStatusApplyConfigurationFromPrometheusStatus(status *monitoringv1
 		psac = psac.WithShards(status.Shards).WithSelector(status.Selector)
 	}
 
+	if status.RecommendedShards != nil {
+		psac = psac.WithRecommendedShards(*status.RecommendedShards)
+	}
+
 	for _, condition := range status.Conditions {
 		psac.WithConditions(
 			monitoringv1ac.Condition().
@@ -0,0 +1,157 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+)
+
+const (
+	// BlackboxExporterPort is the port exposed by the managed blackbox
+	// exporter.
+	BlackboxExporterPort = 9115
+
+	// BlackboxExporterConfigFile is the name of the configuration file of the
+	// managed blackbox exporter.
+	BlackboxExporterConfigFile = "blackbox.yml"
+)
+
+// BlackboxExporterName returns the name of the objects (Deployment, Service
+// and configuration Secret) of the blackbox exporter managed for the
+// Prometheus object.
+func BlackboxExporterName(p monitoringv1.PrometheusInterface) string {
+	return fmt.Sprintf("%s-blackbox-exporter", PrefixedName(p))
+}
+
+// BlackboxExporterAddress returns the address of the blackbox exporter
+// managed for the Prometheus object.
+func BlackboxExporterAddress(p monitoringv1.PrometheusInterface) string {
+	return fmt.Sprintf("%s.%s.svc:%d", BlackboxExporterName(p), p.GetObjectMeta().GetNamespace(), BlackboxExporterPort)
+}
+
+// BlackboxExporterModuleName returns the name of the module in the managed
+// blackbox exporter configuration for a module defined by the probe.
+// Module names are qualified by the probe's namespace and name to avoid
+// collisions between probes.
+func BlackboxExporterModuleName(probe *monitoringv1.Probe, module string) string {
+	return fmt.Sprintf("%s/%s/%s", probe.GetNamespace(), probe.GetName(), module)
+}
+
+// usesManagedProber returns true if the probe's targets are probed by the
+// managed blackbox exporter.
+func usesManagedProber(probe *monitoringv1.Probe) bool {
+	return probe.Spec.ProberSpec.URL == ""
+}
+
+// validateProbeModules checks that the modules of a probe using the managed
+// prober can be loaded by the blackbox exporter and that the probe references
+// one of them.
+func validateProbeModules(probe *monitoringv1.Probe) error {
+	if len(probe.Spec.Modules) == 0 {
+		return fmt.Errorf("at least one module is required when prober.url is empty")
+	}
+
+	for _, m := range probe.Spec.Modules {
+		if _, err := probeModuleConfig(m); err != nil {
+			return fmt.Errorf("module %q: %w", m.Name, err)
+		}
+	}
+
+	if !slices.ContainsFunc(probe.Spec.Modules, func(m monitoringv1.ProbeModule) bool { return m.Name == probe.Spec.Module }) {
+		return fmt.Errorf("module %q isn't defined in modules", probe.Spec.Module)
+	}
+
+	return nil
+}
+
+// probeModuleConfig returns the blackbox exporter configuration of the
+// module.
+func probeModuleConfig(m monitoringv1.ProbeModule) (yaml.MapSlice, error) {
+	cfg := yaml.MapSlice{
+		{Key: "prober", Value: m.Prober},
+	}
+
+	if m.Timeout != nil {
+		cfg = append(cfg, yaml.MapItem{Key: "timeout", Value: *m.Timeout})
+	}
+
+	var raw []byte
+	if m.Config != nil {
+		raw = m.Config.Raw
+	}
+
+	// JSON being a subset of YAML, the YAML decoder preserves the order of
+	// the keys.
+	var settings yaml.MapSlice
+	if err := yaml.Unmarshal(raw, &settings); err != nil {
+		return nil, fmt.Errorf("config must be an object: %w", err)
+	}
+
+	// Some probers have mandatory settings (e.g. the DNS query name) hence
+	// the validation happens even without config.
+	if err := validateBlackboxProberConfig(m.Prober, raw); err != nil {
+		return nil, fmt.Errorf("invalid %s prober config: %w", m.Prober, err)
+	}
+
+	if len(settings) == 0 {
+		return cfg, nil
+	}
+
+	return append(cfg, yaml.MapItem{Key: m.Prober, Value: settings}), nil
+}
+
+// GenerateBlackboxExporterConfig returns the configuration of the managed
+// blackbox exporter which includes the modules of the probes using the
+// managed prober.
+func GenerateBlackboxExporterConfig(probes map[string]*monitoringv1.Probe) ([]byte, error) {
+	var modules yaml.MapSlice
+
+	for _, k := range slices.Sorted(maps.Keys(probes)) {
+		probe := probes[k]
+		if !usesManagedProber(probe) {
+			continue
+		}
+
+		ms := slices.Clone(probe.Spec.Modules)
+		slices.SortFunc(ms, func(a, b monitoringv1.ProbeModule) int {
+			return strings.Compare(a.Name, b.Name)
+		})
+
+		for _, m := range ms {
+			cfg, err := probeModuleConfig(m)
+			if err != nil {
+				return nil, fmt.Errorf("probe %s: module %q: %w", k, m.Name, err)
+			}
+
+			modules = append(modules, yaml.MapItem{
+				Key:   BlackboxExporterModuleName(probe, m.Name),
+				Value: cfg,
+			})
+		}
+	}
+
+	if modules == nil {
+		modules = yaml.MapSlice{}
+	}
+
+	return yaml.Marshal(yaml.MapSlice{{Key: "modules", Value: modules}})
+}
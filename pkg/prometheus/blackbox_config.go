@@ -0,0 +1,286 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+
+	"github.com/alecthomas/units"
+	"github.com/prometheus/common/config"
+	"gopkg.in/yaml.v2"
+)
+
+// The types below mirror the prober settings of the blackbox exporter
+// configuration (https://github.com/prometheus/blackbox_exporter/blob/master/CONFIGURATION.md).
+// The blackbox exporter refuses to load a configuration with unknown fields
+// or invalid values which would break all the modules of the managed prober
+// so the settings of each module are validated against them.
+
+type blackboxHTTPProbe struct {
+	ValidStatusCodes             []int                   `yaml:"valid_status_codes,omitempty"`
+	ValidHTTPVersions            []string                `yaml:"valid_http_versions,omitempty"`
+	IPProtocol                   string                  `yaml:"preferred_ip_protocol,omitempty"`
+	IPProtocolFallback           bool                    `yaml:"ip_protocol_fallback,omitempty"`
+	SkipResolvePhaseWithProxy    bool                    `yaml:"skip_resolve_phase_with_proxy,omitempty"`
+	NoFollowRedirects            *bool                   `yaml:"no_follow_redirects,omitempty"`
+	FailIfSSL                    bool                    `yaml:"fail_if_ssl,omitempty"`
+	FailIfNotSSL                 bool                    `yaml:"fail_if_not_ssl,omitempty"`
+	Method                       string                  `yaml:"method,omitempty"`
+	Headers                      map[string]string       `yaml:"headers,omitempty"`
+	FailIfBodyMatchesRegexp      []string                `yaml:"fail_if_body_matches_regexp,omitempty"`
+	FailIfBodyNotMatchesRegexp   []string                `yaml:"fail_if_body_not_matches_regexp,omitempty"`
+	FailIfBodyJSONMatchesCEL     *string                 `yaml:"fail_if_body_json_matches_cel,omitempty"`
+	FailIfBodyJSONNotMatchesCEL  *string                 `yaml:"fail_if_body_json_not_matches_cel,omitempty"`
+	FailIfHeaderMatchesRegexp    []blackboxHeaderMatch   `yaml:"fail_if_header_matches,omitempty"`
+	FailIfHeaderNotMatchesRegexp []blackboxHeaderMatch   `yaml:"fail_if_header_not_matches,omitempty"`
+	Body                         string                  `yaml:"body,omitempty"`
+	BodyFile                     string                  `yaml:"body_file,omitempty"`
+	Compression                  string                  `yaml:"compression,omitempty"`
+	BodySizeLimit                units.Base2Bytes        `yaml:"body_size_limit,omitempty"`
+	HTTPClientConfig             config.HTTPClientConfig `yaml:",inline"`
+}
+
+type blackboxHeaderMatch struct {
+	Header       string `yaml:"header,omitempty"`
+	Regexp       string `yaml:"regexp,omitempty"`
+	AllowMissing bool   `yaml:"allow_missing,omitempty"`
+}
+
+type blackboxTCPProbe struct {
+	IPProtocol         string                  `yaml:"preferred_ip_protocol,omitempty"`
+	IPProtocolFallback bool                    `yaml:"ip_protocol_fallback,omitempty"`
+	SourceIPAddress    string                  `yaml:"source_ip_address,omitempty"`
+	QueryResponse      []blackboxQueryResponse `yaml:"query_response,omitempty"`
+	TLS                bool                    `yaml:"tls,omitempty"`
+	TLSConfig          config.TLSConfig        `yaml:"tls_config,omitempty"`
+}
+
+type blackboxQueryResponse struct {
+	Expect   string          `yaml:"expect,omitempty"`
+	Labels   []blackboxLabel `yaml:"labels,omitempty"`
+	Send     string          `yaml:"send,omitempty"`
+	StartTLS bool            `yaml:"starttls,omitempty"`
+}
+
+type blackboxLabel struct {
+	Name  string `yaml:"name,omitempty"`
+	Value string `yaml:"value,omitempty"`
+}
+
+type blackboxICMPProbe struct {
+	IPProtocol         string `yaml:"preferred_ip_protocol,omitempty"`
+	IPProtocolFallback bool   `yaml:"ip_protocol_fallback,omitempty"`
+	SourceIPAddress    string `yaml:"source_ip_address,omitempty"`
+	PayloadSize        int    `yaml:"payload_size,omitempty"`
+	DontFragment       bool   `yaml:"dont_fragment,omitempty"`
+	TTL                int    `yaml:"ttl,omitempty"`
+}
+
+type blackboxDNSProbe struct {
+	IPProtocol         string                 `yaml:"preferred_ip_protocol,omitempty"`
+	IPProtocolFallback bool                   `yaml:"ip_protocol_fallback,omitempty"`
+	DNSOverTLS         bool                   `yaml:"dns_over_tls,omitempty"`
+	TLSConfig          config.TLSConfig       `yaml:"tls_config,omitempty"`
+	SourceIPAddress    string                 `yaml:"source_ip_address,omitempty"`
+	TransportProtocol  string                 `yaml:"transport_protocol,omitempty"`
+	QueryClass         string                 `yaml:"query_class,omitempty"`
+	QueryName          string                 `yaml:"query_name,omitempty"`
+	QueryType          string                 `yaml:"query_type,omitempty"`
+	Recursion          *bool                  `yaml:"recursion_desired,omitempty"`
+	ValidRcodes        []string               `yaml:"valid_rcodes,omitempty"`
+	ValidateAnswer     blackboxDNSRRValidator `yaml:"validate_answer_rrs,omitempty"`
+	ValidateAuthority  blackboxDNSRRValidator `yaml:"validate_authority_rrs,omitempty"`
+	ValidateAdditional blackboxDNSRRValidator `yaml:"validate_additional_rrs,omitempty"`
+}
+
+type blackboxDNSRRValidator struct {
+	FailIfMatchesRegexp     []string `yaml:"fail_if_matches_regexp,omitempty"`
+	FailIfAllMatchRegexp    []string `yaml:"fail_if_all_match_regexp,omitempty"`
+	FailIfNotMatchesRegexp  []string `yaml:"fail_if_not_matches_regexp,omitempty"`
+	FailIfNoneMatchesRegexp []string `yaml:"fail_if_none_matches_regexp,omitempty"`
+}
+
+type blackboxGRPCProbe struct {
+	Service            string           `yaml:"service,omitempty"`
+	TLS                bool             `yaml:"tls,omitempty"`
+	TLSConfig          config.TLSConfig `yaml:"tls_config,omitempty"`
+	IPProtocolFallback bool             `yaml:"ip_protocol_fallback,omitempty"`
+	IPProtocol         string           `yaml:"preferred_ip_protocol,omitempty"`
+}
+
+// validateBlackboxProberConfig checks that the settings can be loaded by the
+// blackbox exporter for the given prober.
+func validateBlackboxProberConfig(prober string, raw []byte) error {
+	switch prober {
+	case "http":
+		var p blackboxHTTPProbe
+		if err := yaml.UnmarshalStrict(raw, &p); err != nil {
+			return err
+		}
+		return p.validate()
+
+	case "tcp":
+		var p blackboxTCPProbe
+		if err := yaml.UnmarshalStrict(raw, &p); err != nil {
+			return err
+		}
+		return p.validate()
+
+	case "icmp":
+		var p blackboxICMPProbe
+		if err := yaml.UnmarshalStrict(raw, &p); err != nil {
+			return err
+		}
+		return p.validate()
+
+	case "dns":
+		var p blackboxDNSProbe
+		if err := yaml.UnmarshalStrict(raw, &p); err != nil {
+			return err
+		}
+		return p.validate()
+
+	case "grpc":
+		var p blackboxGRPCProbe
+		if err := yaml.UnmarshalStrict(raw, &p); err != nil {
+			return err
+		}
+		return validateIPProtocol(p.IPProtocol)
+	}
+
+	return fmt.Errorf("unsupported prober %q", prober)
+}
+
+func (p *blackboxHTTPProbe) validate() error {
+	if err := validateIPProtocol(p.IPProtocol); err != nil {
+		return err
+	}
+
+	if p.Body != "" && p.BodyFile != "" {
+		return errors.New("setting body and body_file both are not allowed")
+	}
+
+	if err := validateRegexps("fail_if_body_matches_regexp", p.FailIfBodyMatchesRegexp); err != nil {
+		return err
+	}
+
+	if err := validateRegexps("fail_if_body_not_matches_regexp", p.FailIfBodyNotMatchesRegexp); err != nil {
+		return err
+	}
+
+	for _, hm := range slices.Concat(p.FailIfHeaderMatchesRegexp, p.FailIfHeaderNotMatchesRegexp) {
+		if hm.Header == "" {
+			return errors.New("header name must be set for HTTP header matchers")
+		}
+
+		if hm.Regexp == "" {
+			return errors.New("regexp must be set for HTTP header matchers")
+		}
+
+		if _, err := regexp.Compile(hm.Regexp); err != nil {
+			return fmt.Errorf("header %q: invalid regexp %q: %w", hm.Header, hm.Regexp, err)
+		}
+	}
+
+	return p.HTTPClientConfig.Validate()
+}
+
+func (p *blackboxTCPProbe) validate() error {
+	if err := validateIPProtocol(p.IPProtocol); err != nil {
+		return err
+	}
+
+	for _, qr := range p.QueryResponse {
+		if _, err := regexp.Compile(qr.Expect); err != nil {
+			return fmt.Errorf("query_response: invalid regexp %q: %w", qr.Expect, err)
+		}
+	}
+
+	return nil
+}
+
+func (p *blackboxICMPProbe) validate() error {
+	if err := validateIPProtocol(p.IPProtocol); err != nil {
+		return err
+	}
+
+	if p.IPProtocol == "ip6" && p.DontFragment {
+		return errors.New("dont_fragment is not supported for IPv6")
+	}
+
+	if p.TTL < 0 || p.TTL > 255 {
+		return fmt.Errorf("ttl must be between 0 and 255, got %d", p.TTL)
+	}
+
+	return nil
+}
+
+func (p *blackboxDNSProbe) validate() error {
+	if err := validateIPProtocol(p.IPProtocol); err != nil {
+		return err
+	}
+
+	if p.QueryName == "" {
+		return errors.New("query_name must be set")
+	}
+
+	switch p.TransportProtocol {
+	case "", "udp", "tcp":
+	default:
+		return fmt.Errorf("transport_protocol must be 'udp' or 'tcp', got %q", p.TransportProtocol)
+	}
+
+	for _, v := range []struct {
+		field     string
+		validator blackboxDNSRRValidator
+	}{
+		{field: "validate_answer_rrs", validator: p.ValidateAnswer},
+		{field: "validate_authority_rrs", validator: p.ValidateAuthority},
+		{field: "validate_additional_rrs", validator: p.ValidateAdditional},
+	} {
+		if err := validateRegexps(v.field, slices.Concat(
+			v.validator.FailIfMatchesRegexp,
+			v.validator.FailIfAllMatchRegexp,
+			v.validator.FailIfNotMatchesRegexp,
+			v.validator.FailIfNoneMatchesRegexp,
+		)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validateIPProtocol(p string) error {
+	switch p {
+	case "", "ip4", "ip6":
+		return nil
+	}
+
+	return fmt.Errorf("preferred_ip_protocol must be 'ip4' or 'ip6', got %q", p)
+}
+
+func validateRegexps(field string, res []string) error {
+	for _, re := range res {
+		if _, err := regexp.Compile(re); err != nil {
+			return fmt.Errorf("%s: invalid regexp %q: %w", field, re, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,224 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gotest.tools/v3/golden"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+)
+
+func TestGenerateBlackboxExporterConfig(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		probes map[string]*monitoringv1.Probe
+		golden string
+	}{
+		{
+			name:   "no probes",
+			golden: "BlackboxExporterConfigEmpty.golden",
+		},
+		{
+			name: "probes with modules",
+			probes: map[string]*monitoringv1.Probe{
+				"ns1/probe1": {
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "probe1",
+						Namespace: "ns1",
+					},
+					Spec: monitoringv1.ProbeSpec{
+						Module: "http_2xx",
+						Modules: []monitoringv1.ProbeModule{
+							{
+								Name:    "http_2xx",
+								Prober:  "http",
+								Timeout: ptr.To(monitoringv1.Duration("5s")),
+								Config: &apiextensionsv1.JSON{
+									Raw: []byte(`{"valid_status_codes":[200,204],"method":"GET","fail_if_ssl":false}`),
+								},
+							},
+							{
+								Name:   "icmp",
+								Prober: "icmp",
+							},
+						},
+					},
+				},
+				"ns2/probe2": {
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "probe2",
+						Namespace: "ns2",
+					},
+					Spec: monitoringv1.ProbeSpec{
+						Module: "tcp_connect",
+						Modules: []monitoringv1.ProbeModule{
+							{
+								Name:   "tcp_connect",
+								Prober: "tcp",
+								Config: &apiextensionsv1.JSON{
+									Raw: []byte(`{"tls":true}`),
+								},
+							},
+						},
+					},
+				},
+				"ns2/external": {
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "external",
+						Namespace: "ns2",
+					},
+					Spec: monitoringv1.ProbeSpec{
+						ProberSpec: monitoringv1.ProberSpec{
+							URL: "blackbox-exporter:9115",
+						},
+						Modules: []monitoringv1.ProbeModule{
+							{
+								Name:   "ignored",
+								Prober: "http",
+							},
+						},
+					},
+				},
+			},
+			golden: "BlackboxExporterConfig.golden",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg, err := GenerateBlackboxExporterConfig(tc.probes)
+			require.NoError(t, err)
+
+			golden.Assert(t, string(cfg), tc.golden)
+		})
+	}
+}
+
+func TestValidateProbeModules(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		module monitoringv1.ProbeModule
+		err    bool
+	}{
+		{
+			name:   "http without config",
+			module: monitoringv1.ProbeModule{Prober: "http"},
+		},
+		{
+			name: "valid http config",
+			module: monitoringv1.ProbeModule{
+				Prober: "http",
+				Config: &apiextensionsv1.JSON{Raw: []byte(`{"valid_status_codes":[200],"fail_if_body_not_matches_regexp":["ok"],"body_size_limit":"1MB","follow_redirects":false,"tls_config":{"insecure_skip_verify":true}}`)},
+			},
+		},
+		{
+			name: "unknown http setting",
+			module: monitoringv1.ProbeModule{
+				Prober: "http",
+				Config: &apiextensionsv1.JSON{Raw: []byte(`{"valid_status_code":[200]}`)},
+			},
+			err: true,
+		},
+		{
+			name: "invalid http setting type",
+			module: monitoringv1.ProbeModule{
+				Prober: "http",
+				Config: &apiextensionsv1.JSON{Raw: []byte(`{"valid_status_codes":"200"}`)},
+			},
+			err: true,
+		},
+		{
+			name: "invalid http regexp",
+			module: monitoringv1.ProbeModule{
+				Prober: "http",
+				Config: &apiextensionsv1.JSON{Raw: []byte(`{"fail_if_body_matches_regexp":["("]}`)},
+			},
+			err: true,
+		},
+		{
+			name: "invalid http client config",
+			module: monitoringv1.ProbeModule{
+				Prober: "http",
+				Config: &apiextensionsv1.JSON{Raw: []byte(`{"bearer_token":"foo","bearer_token_file":"/foo"}`)},
+			},
+			err: true,
+		},
+		{
+			name: "tcp with query response",
+			module: monitoringv1.ProbeModule{
+				Prober: "tcp",
+				Config: &apiextensionsv1.JSON{Raw: []byte(`{"query_response":[{"expect":"^SSH-2.0-"},{"send":"QUIT"}]}`)},
+			},
+		},
+		{
+			name: "invalid tcp expect regexp",
+			module: monitoringv1.ProbeModule{
+				Prober: "tcp",
+				Config: &apiextensionsv1.JSON{Raw: []byte(`{"query_response":[{"expect":"["}]}`)},
+			},
+			err: true,
+		},
+		{
+			name: "invalid icmp ttl",
+			module: monitoringv1.ProbeModule{
+				Prober: "icmp",
+				Config: &apiextensionsv1.JSON{Raw: []byte(`{"ttl":256}`)},
+			},
+			err: true,
+		},
+		{
+			name: "dns with query name",
+			module: monitoringv1.ProbeModule{
+				Prober: "dns",
+				Config: &apiextensionsv1.JSON{Raw: []byte(`{"query_name":"example.com","query_type":"A","validate_answer_rrs":{"fail_if_not_matches_regexp":["example.com.\t.*\tIN\tA\t.*"]}}`)},
+			},
+		},
+		{
+			name:   "dns without query name",
+			module: monitoringv1.ProbeModule{Prober: "dns"},
+			err:    true,
+		},
+		{
+			name: "invalid grpc ip protocol",
+			module: monitoringv1.ProbeModule{
+				Prober: "grpc",
+				Config: &apiextensionsv1.JSON{Raw: []byte(`{"preferred_ip_protocol":"ipv4"}`)},
+			},
+			err: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.module.Name = "module"
+			probe := &monitoringv1.Probe{
+				Spec: monitoringv1.ProbeSpec{
+					Module:  "module",
+					Modules: []monitoringv1.ProbeModule{tc.module},
+				},
+			}
+
+			err := validateProbeModules(probe)
+			if tc.err {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
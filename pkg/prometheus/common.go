@@ -105,6 +105,19 @@ func ReplicasNumberPtr(
 	return &replicas
 }
 
+// PrometheusExternalLabelName returns the name of the external label
+// identifying the Prometheus resource. An empty value means that the label
+// isn't set.
+func PrometheusExternalLabelName(p monitoringv1.PrometheusInterface) string {
+	return ptr.Deref(p.GetCommonPrometheusFields().PrometheusExternalLabelName, defaultPrometheusExternalLabelName)
+}
+
+// ReplicaExternalLabelName returns the name of the external label identifying
+// the Prometheus replica. An empty value means that the label isn't set.
+func ReplicaExternalLabelName(p monitoringv1.PrometheusInterface) string {
+	return ptr.Deref(p.GetCommonPrometheusFields().ReplicaExternalLabelName, defaultReplicaExternalLabelName)
+}
+
 func prometheusNameByShard(p monitoringv1.PrometheusInterface, shard int32) string {
 	base := PrefixedName(p)
 	if shard == 0 {
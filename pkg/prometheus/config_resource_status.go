@@ -0,0 +1,102 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+)
+
+// ListAllFn lists all the objects matching the selector.
+type ListAllFn func(selector labels.Selector, appendFn cache.AppendFunc) error
+
+// ResourcesSelection holds all the configuration resources selected by a
+// Prometheus or PrometheusAgent object, including the rejected ones.
+type ResourcesSelection struct {
+	ServiceMonitors TypedResourcesSelection[*monitoringv1.ServiceMonitor]
+	PodMonitors     TypedResourcesSelection[*monitoringv1.PodMonitor]
+	Probes          TypedResourcesSelection[*monitoringv1.Probe]
+	ScrapeConfigs   TypedResourcesSelection[*monitoringv1alpha1.ScrapeConfig]
+}
+
+// ConfigResourceListers gives access to all the configuration resources
+// watched by the controller. A nil function means that the resource isn't
+// supported.
+type ConfigResourceListers struct {
+	ServiceMonitors ListAllFn
+	PodMonitors     ListAllFn
+	Probes          ListAllFn
+	ScrapeConfigs   ListAllFn
+}
+
+// UpdateConfigResourcesStatus records the workload's binding in the status
+// of the selected configuration resources and removes it from the
+// configuration resources which aren't selected anymore.
+// An empty selection removes the workload's binding from all the resources.
+func UpdateConfigResourcesStatus(ctx context.Context, syncer *operator.ConfigResourceSyncer, resources ResourcesSelection, listers ConfigResourceListers) error {
+	return errors.Join(
+		updateBindings(ctx, syncer, resources.ServiceMonitors, listers.ServiceMonitors),
+		updateBindings(ctx, syncer, resources.PodMonitors, listers.PodMonitors),
+		updateBindings(ctx, syncer, resources.Probes, listers.Probes),
+		updateBindings(ctx, syncer, resources.ScrapeConfigs, listers.ScrapeConfigs),
+	)
+}
+
+func updateBindings[T ConfigurationResource](ctx context.Context, syncer *operator.ConfigResourceSyncer, selection TypedResourcesSelection[T], listAll ListAllFn) error {
+	var errs []error
+
+	for key, r := range selection {
+		if err := syncer.UpdateBinding(ctx, r.resource, r.Conditions()); err != nil {
+			errs = append(errs, fmt.Errorf("failed to update the status of %q: %w", key, err))
+		}
+	}
+
+	if listAll == nil {
+		return errors.Join(errs...)
+	}
+
+	err := listAll(labels.Everything(), func(obj interface{}) {
+		o, ok := obj.(T)
+		if !ok {
+			return
+		}
+
+		key, err := cache.MetaNamespaceKeyFunc(o)
+		if err != nil {
+			return
+		}
+
+		if _, found := selection[key]; found {
+			return
+		}
+
+		if err := syncer.RemoveBinding(ctx, o); err != nil {
+			errs = append(errs, fmt.Errorf("failed to remove the binding from %q: %w", key, err))
+		}
+	})
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,210 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+)
+
+// HTTPRouteGroupVersionResource identifies the Gateway API HTTPRoute
+// resource discovered by the Probe HTTPRoute targets.
+var HTTPRouteGroupVersionResource = schema.GroupVersionResource{
+	Group:    "gateway.networking.k8s.io",
+	Version:  "v1",
+	Resource: "httproutes",
+}
+
+// HTTPRouteTargets holds the URLs to probe for an HTTPRoute object.
+type HTTPRouteTargets struct {
+	Namespace string
+	Name      string
+	URLs      []string
+}
+
+// SelectProbeHTTPRoutes discovers the HTTPRoute objects targeted by the valid
+// probes of the selection and returns the probed URLs indexed by probe key
+// ("<namespace>/<name>").
+//
+// If listFn is nil (e.g. the Gateway API isn't installed or the operator
+// isn't allowed to watch HTTPRoute objects), the probes with HTTPRoute
+// targets are rejected.
+func (rs *ResourceSelector) SelectProbeHTTPRoutes(probes TypedResourcesSelection[*monitoringv1.Probe], listFn ListAllByNamespaceFn) (map[string][]HTTPRouteTargets, error) {
+	var (
+		objMeta  = rs.p.GetObjectMeta()
+		res      = map[string][]HTTPRouteTargets{}
+		rejected bool
+	)
+
+	for _, k := range probes.keys() {
+		probe := probes[k].resource
+		target := probe.Spec.Targets.HTTPRoute
+		if target == nil {
+			continue
+		}
+
+		if listFn == nil {
+			err := fmt.Errorf("HTTPRoute targets require the %s resource", HTTPRouteGroupVersionResource.GroupResource())
+			probes[k] = TypedConfigurationResource[*monitoringv1.Probe]{
+				resource: probe,
+				err:      err,
+				reason:   operator.InvalidConfigurationEvent,
+			}
+			rejected = true
+
+			rs.l.Warn("skipping probe",
+				"error", err.Error(),
+				"probe", k,
+				"namespace", objMeta.GetNamespace(),
+				"prometheus", objMeta.GetName(),
+			)
+			rs.eventRecorder.Eventf(probe, v1.EventTypeWarning, operator.InvalidConfigurationEvent, "Probe %s was rejected due to invalid configuration: %v", probe.GetName(), err)
+			continue
+		}
+
+		selector, err := metav1.LabelSelectorAsSelector(&target.Selector)
+		if err != nil {
+			return nil, err
+		}
+
+		var routes []HTTPRouteTargets
+		for _, ns := range rs.httpRouteNamespaces(probe) {
+			err := listFn(ns, selector, func(obj interface{}) {
+				u, ok := obj.(*unstructured.Unstructured)
+				if !ok {
+					return
+				}
+
+				urls := httpRouteURLs(u, ptr.Deref(target.Scheme, "http"))
+				if len(urls) == 0 {
+					return
+				}
+
+				routes = append(routes, HTTPRouteTargets{
+					Namespace: u.GetNamespace(),
+					Name:      u.GetName(),
+					URLs:      urls,
+				})
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to list HTTPRoutes in namespace %s: %w", ns, err)
+			}
+		}
+
+		slices.SortFunc(routes, func(a, b HTTPRouteTargets) int {
+			return strings.Compare(a.Namespace+"/"+a.Name, b.Namespace+"/"+b.Name)
+		})
+		res[k] = routes
+	}
+
+	if rejected {
+		if pKey, ok := rs.accessor.MetaNamespaceKey(rs.p); ok {
+			valid := len(probes.ValidResources())
+			rs.metrics.SetSelectedResources(pKey, monitoringv1.ProbesKind, valid)
+			rs.metrics.SetRejectedResources(pKey, monitoringv1.ProbesKind, len(probes)-valid)
+		}
+	}
+
+	return res, nil
+}
+
+// httpRouteNamespaces returns the namespaces from which the HTTPRoute objects
+// are selected by the probe.
+func (rs *ResourceSelector) httpRouteNamespaces(probe *monitoringv1.Probe) []string {
+	nsel := probe.Spec.Targets.HTTPRoute.NamespaceSelector
+
+	switch {
+	case rs.p.GetCommonPrometheusFields().IgnoreNamespaceSelectors:
+		return []string{probe.GetNamespace()}
+	case nsel.Any:
+		return []string{metav1.NamespaceAll}
+	case len(nsel.MatchNames) == 0:
+		return []string{probe.GetNamespace()}
+	}
+
+	return nsel.MatchNames
+}
+
+// httpRouteURLs returns the sorted list of URLs built from the hostnames and
+// the path matches of the HTTPRoute object.
+// Wildcard hostnames and regular expression path matches are ignored.
+func httpRouteURLs(u *unstructured.Unstructured, scheme string) []string {
+	hostnames, _, _ := unstructured.NestedStringSlice(u.Object, "spec", "hostnames")
+
+	var paths []string
+	rules, _, _ := unstructured.NestedSlice(u.Object, "spec", "rules")
+	for _, r := range rules {
+		rule, ok := r.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		matches, _, _ := unstructured.NestedSlice(rule, "matches")
+		if len(matches) == 0 {
+			paths = append(paths, "/")
+			continue
+		}
+
+		for _, m := range matches {
+			match, ok := m.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			pathType, found, _ := unstructured.NestedString(match, "path", "type")
+			if found && pathType != "PathPrefix" && pathType != "Exact" {
+				continue
+			}
+
+			path, found, _ := unstructured.NestedString(match, "path", "value")
+			if !found || path == "" {
+				path = "/"
+			}
+
+			paths = append(paths, path)
+		}
+	}
+
+	if len(rules) == 0 {
+		paths = append(paths, "/")
+	}
+
+	var urls []string
+	for _, hostname := range hostnames {
+		if hostname == "" || strings.HasPrefix(hostname, "*") {
+			continue
+		}
+
+		for _, path := range paths {
+			url := fmt.Sprintf("%s://%s%s", scheme, hostname, path)
+			if !slices.Contains(urls, url) {
+				urls = append(urls, url)
+			}
+		}
+	}
+	slices.Sort(urls)
+
+	return urls
+}
@@ -0,0 +1,215 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/assets"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+)
+
+func newHTTPRoute(ns, name string, hostnames []interface{}, rules []interface{}) *unstructured.Unstructured {
+	u := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "gateway.networking.k8s.io/v1",
+			"kind":       "HTTPRoute",
+			"spec": map[string]interface{}{
+				"hostnames": hostnames,
+				"rules":     rules,
+			},
+		},
+	}
+	u.SetNamespace(ns)
+	u.SetName(name)
+	u.SetLabels(map[string]string{"probe": "true"})
+
+	return u
+}
+
+func TestHTTPRouteURLs(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		route    *unstructured.Unstructured
+		expected []string
+	}{
+		{
+			name:     "no rules",
+			route:    newHTTPRoute("default", "route", []interface{}{"example.com"}, nil),
+			expected: []string{"http://example.com/"},
+		},
+		{
+			name:  "rule without matches",
+			route: newHTTPRoute("default", "route", []interface{}{"example.com"}, []interface{}{map[string]interface{}{}}),
+			expected: []string{
+				"http://example.com/",
+			},
+		},
+		{
+			name: "path matches",
+			route: newHTTPRoute("default", "route",
+				[]interface{}{"example.com", "www.example.com"},
+				[]interface{}{
+					map[string]interface{}{
+						"matches": []interface{}{
+							map[string]interface{}{"path": map[string]interface{}{"type": "PathPrefix", "value": "/api"}},
+							map[string]interface{}{"path": map[string]interface{}{"type": "Exact", "value": "/health"}},
+							map[string]interface{}{"path": map[string]interface{}{"type": "RegularExpression", "value": "/v[0-9]+"}},
+						},
+					},
+					map[string]interface{}{
+						"matches": []interface{}{
+							map[string]interface{}{"headers": []interface{}{}},
+							map[string]interface{}{"path": map[string]interface{}{"type": "PathPrefix", "value": "/api"}},
+						},
+					},
+				},
+			),
+			expected: []string{
+				"http://example.com/",
+				"http://example.com/api",
+				"http://example.com/health",
+				"http://www.example.com/",
+				"http://www.example.com/api",
+				"http://www.example.com/health",
+			},
+		},
+		{
+			name:     "wildcard hostname",
+			route:    newHTTPRoute("default", "route", []interface{}{"*.example.com", "foo.example.com"}, nil),
+			expected: []string{"http://foo.example.com/"},
+		},
+		{
+			name:  "no hostnames",
+			route: newHTTPRoute("default", "route", nil, nil),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, httpRouteURLs(tc.route, "http"))
+		})
+	}
+}
+
+func TestSelectProbeHTTPRoutes(t *testing.T) {
+	probes := func() TypedResourcesSelection[*monitoringv1.Probe] {
+		return TypedResourcesSelection[*monitoringv1.Probe]{
+			"default/http-route": {
+				resource: &monitoringv1.Probe{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "http-route",
+						Namespace: "default",
+					},
+					Spec: monitoringv1.ProbeSpec{
+						Targets: monitoringv1.ProbeTargets{
+							HTTPRoute: &monitoringv1.ProbeTargetHTTPRoute{
+								Selector: metav1.LabelSelector{
+									MatchLabels: map[string]string{"probe": "true"},
+								},
+								NamespaceSelector: monitoringv1.NamespaceSelector{
+									MatchNames: []string{"default", "web"},
+								},
+							},
+						},
+					},
+				},
+			},
+			"default/static": {
+				resource: &monitoringv1.Probe{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "static",
+						Namespace: "default",
+					},
+					Spec: monitoringv1.ProbeSpec{
+						Targets: monitoringv1.ProbeTargets{
+							StaticConfig: &monitoringv1.ProbeTargetStaticConfig{
+								Targets: []string{"example.com"},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	newSelector := func(t *testing.T) *ResourceSelector {
+		rs, err := NewResourceSelector(
+			newLogger(),
+			&monitoringv1.Prometheus{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test",
+					Namespace: "test",
+				},
+			},
+			assets.NewTestStoreBuilder(),
+			nil,
+			operator.NewMetrics(prometheus.NewPedanticRegistry()),
+			record.NewFakeRecorder(1),
+		)
+		require.NoError(t, err)
+
+		return rs
+	}
+
+	t.Run("HTTPRoute available", func(t *testing.T) {
+		routes := map[string][]*unstructured.Unstructured{
+			"default": {
+				newHTTPRoute("default", "b", []interface{}{"b.example.com"}, nil),
+				newHTTPRoute("default", "a", []interface{}{"a.example.com"}, nil),
+			},
+			"web": {
+				newHTTPRoute("web", "wildcard", []interface{}{"*.example.com"}, nil),
+				newHTTPRoute("web", "c", []interface{}{"c.example.com"}, nil),
+			},
+		}
+
+		sel := probes()
+		res, err := newSelector(t).SelectProbeHTTPRoutes(sel, func(ns string, _ labels.Selector, appendFn cache.AppendFunc) error {
+			for _, r := range routes[ns] {
+				appendFn(r)
+			}
+			return nil
+		})
+		require.NoError(t, err)
+		require.Len(t, sel.ValidResources(), 2)
+		require.Equal(t,
+			map[string][]HTTPRouteTargets{
+				"default/http-route": {
+					{Namespace: "default", Name: "a", URLs: []string{"http://a.example.com/"}},
+					{Namespace: "default", Name: "b", URLs: []string{"http://b.example.com/"}},
+					{Namespace: "web", Name: "c", URLs: []string{"http://c.example.com/"}},
+				},
+			},
+			res,
+		)
+	})
+
+	t.Run("HTTPRoute not available", func(t *testing.T) {
+		sel := probes()
+		res, err := newSelector(t).SelectProbeHTTPRoutes(sel, nil)
+		require.NoError(t, err)
+		require.Empty(t, res)
+		require.Len(t, sel.ValidResources(), 1)
+		require.Contains(t, sel.ValidResources(), "default/static")
+	})
+}
@@ -24,6 +24,7 @@ import (
 	"reflect"
 	"regexp"
 	"slices"
+	"strconv"
 	"strings"
 
 	"github.com/alecthomas/units"
@@ -48,12 +49,19 @@ const (
 	kubernetesSDRoleEndpointSlice = "endpointslice"
 	kubernetesSDRolePod           = "pod"
 	kubernetesSDRoleIngress       = "ingress"
+	kubernetesSDRoleService       = "service"
 
 	defaultPrometheusExternalLabelName = "prometheus"
 	defaultReplicaExternalLabelName    = "prometheus_replica"
 
 	hashLabelNameForSharding          = "__tmp_hash"
 	hashLabelNameForDisablingSharding = "__tmp_disable_sharding"
+	shardLabelNameForSharding         = "__tmp_shard"
+
+	// consistentShardingBuckets is the number of buckets used by the
+	// consistent sharding strategy. Changing the value would reassign all
+	// targets.
+	consistentShardingBuckets = 1024
 )
 
 var invalidLabelCharRE = regexp.MustCompile(`[^a-zA-Z0-9_]`)
@@ -75,6 +83,10 @@ type ConfigGenerator struct {
 	daemonSet                  bool
 	prometheusTopologySharding bool
 	inlineTLSConfig            bool
+	otlpTenants                map[string]*monitoringv1alpha1.OTLPTenant
+	remoteWrites               map[string]*monitoringv1alpha1.RemoteWrite
+	probeHTTPRoutes            map[string][]HTTPRouteTargets
+	managedProberAddress       string
 
 	bypassVersionCheck bool
 }
@@ -236,10 +248,52 @@ func (cg *ConfigGenerator) WithKeyVals(keyvals ...interface{}) *ConfigGenerator
 		daemonSet:                  cg.daemonSet,
 		prometheusTopologySharding: cg.prometheusTopologySharding,
 		inlineTLSConfig:            cg.inlineTLSConfig,
+		otlpTenants:                cg.otlpTenants,
+		remoteWrites:               cg.remoteWrites,
+		probeHTTPRoutes:            cg.probeHTTPRoutes,
+		managedProberAddress:       cg.managedProberAddress,
 		bypassVersionCheck:         cg.bypassVersionCheck,
 	}
 }
 
+// WithOTLPTenants returns a new ConfigGenerator which merges the settings of
+// the given OTLPTenant resources into the OTLP configuration.
+// The tenants are expected to be validated by the caller (see
+// [ResourceSelector.SelectOTLPTenants]).
+func (cg *ConfigGenerator) WithOTLPTenants(tenants map[string]*monitoringv1alpha1.OTLPTenant) *ConfigGenerator {
+	ncg := cg.WithKeyVals()
+	ncg.otlpTenants = tenants
+	return ncg
+}
+
+// WithRemoteWrites returns a new ConfigGenerator which appends the endpoints
+// of the given RemoteWrite resources to the remote write configuration.
+// The resources are expected to be validated by the caller (see
+// [ResourceSelector.SelectRemoteWrites]).
+func (cg *ConfigGenerator) WithRemoteWrites(remoteWrites map[string]*monitoringv1alpha1.RemoteWrite) *ConfigGenerator {
+	ncg := cg.WithKeyVals()
+	ncg.remoteWrites = remoteWrites
+	return ncg
+}
+
+// WithProbeHTTPRoutes returns a new ConfigGenerator which uses the given
+// HTTPRoute targets (indexed by probe key) for the probes with HTTPRoute
+// targets (see [ResourceSelector.SelectProbeHTTPRoutes]).
+func (cg *ConfigGenerator) WithProbeHTTPRoutes(httpRoutes map[string][]HTTPRouteTargets) *ConfigGenerator {
+	ncg := cg.WithKeyVals()
+	ncg.probeHTTPRoutes = httpRoutes
+	return ncg
+}
+
+// WithManagedProber returns a new ConfigGenerator which configures the probes
+// with an empty prober URL to use the managed blackbox exporter listening on
+// the given address.
+func (cg *ConfigGenerator) WithManagedProber(address string) *ConfigGenerator {
+	ncg := cg.WithKeyVals()
+	ncg.managedProberAddress = address
+	return ncg
+}
+
 // WithMinimumVersion returns a new ConfigGenerator that does nothing (except
 // logging a warning message) if the Prometheus version is lesser than the
 // given version.
@@ -261,6 +315,10 @@ func (cg *ConfigGenerator) WithMinimumVersion(version string) *ConfigGenerator {
 			daemonSet:                  cg.daemonSet,
 			prometheusTopologySharding: cg.prometheusTopologySharding,
 			inlineTLSConfig:            cg.inlineTLSConfig,
+			otlpTenants:                cg.otlpTenants,
+			remoteWrites:               cg.remoteWrites,
+			probeHTTPRoutes:            cg.probeHTTPRoutes,
+			managedProberAddress:       cg.managedProberAddress,
 			bypassVersionCheck:         cg.bypassVersionCheck,
 		}
 	}
@@ -289,6 +347,10 @@ func (cg *ConfigGenerator) WithMaximumVersion(version string) *ConfigGenerator {
 			daemonSet:                  cg.daemonSet,
 			prometheusTopologySharding: cg.prometheusTopologySharding,
 			inlineTLSConfig:            cg.inlineTLSConfig,
+			otlpTenants:                cg.otlpTenants,
+			remoteWrites:               cg.remoteWrites,
+			probeHTTPRoutes:            cg.probeHTTPRoutes,
+			managedProberAddress:       cg.managedProberAddress,
 			bypassVersionCheck:         cg.bypassVersionCheck,
 		}
 	}
@@ -703,20 +765,14 @@ func (cg *ConfigGenerator) buildExternalLabels() yaml.MapSlice {
 	cpf := cg.prom.GetCommonPrometheusFields()
 	objMeta := cg.prom.GetObjectMeta()
 
-	prometheusExternalLabelName := defaultPrometheusExternalLabelName
-	if cpf.PrometheusExternalLabelName != nil {
-		prometheusExternalLabelName = *cpf.PrometheusExternalLabelName
-	}
+	prometheusExternalLabelName := PrometheusExternalLabelName(cg.prom)
 
 	// Do not add the external label if the resulting value is empty.
 	if prometheusExternalLabelName != "" {
 		m[prometheusExternalLabelName] = fmt.Sprintf("%s/%s", objMeta.GetNamespace(), objMeta.GetName())
 	}
 
-	replicaExternalLabelName := defaultReplicaExternalLabelName
-	if cpf.ReplicaExternalLabelName != nil {
-		replicaExternalLabelName = *cpf.ReplicaExternalLabelName
-	}
+	replicaExternalLabelName := ReplicaExternalLabelName(cg.prom)
 
 	// Do not add the external label if the resulting value is empty.
 	if replicaExternalLabelName != "" {
@@ -966,8 +1022,8 @@ func (cg *ConfigGenerator) GenerateServerConfiguration(
 	}
 
 	// Remote write config
-	if len(cpf.RemoteWrite) > 0 {
-		cfg = append(cfg, cg.GenerateRemoteWriteConfig(cpf.RemoteWrite, s))
+	if len(cpf.RemoteWrite) > 0 || len(cg.remoteWrites) > 0 {
+		cfg = append(cfg, cg.GenerateRemoteWriteConfig(cpf.RemoteWrite, store, cg.prom.GetObjectMeta().GetNamespace()))
 	}
 
 	// Remote read config
@@ -1044,10 +1100,7 @@ func (cg *ConfigGenerator) appendAlertingConfig(
 
 	var alertRelabelConfigs []yaml.MapSlice
 
-	replicaExternalLabelName := defaultReplicaExternalLabelName
-	if cpf.ReplicaExternalLabelName != nil {
-		replicaExternalLabelName = *cpf.ReplicaExternalLabelName
-	}
+	replicaExternalLabelName := ReplicaExternalLabelName(cg.prom)
 
 	if replicaExternalLabelName != "" {
 		// Drop the replica label to enable proper deduplication on the Alertmanager side.
@@ -1507,7 +1560,7 @@ func (cg *ConfigGenerator) generatePodMonitorConfig(
 
 	// DaemonSet mode doesn't support sharding.
 	if !cg.daemonSet {
-		relabelings = appendShardingRelabelingWithAddress(relabelings, shards)
+		relabelings = cg.appendShardingRelabelingWithAddress(relabelings, shards)
 	}
 
 	cfg = append(cfg, yaml.MapItem{Key: "relabel_configs", Value: relabelings})
@@ -1548,6 +1601,15 @@ func (cg *ConfigGenerator) generateProbeConfig(
 ) yaml.MapSlice {
 	scrapeClass := cg.getScrapeClassOrDefault(m.Spec.ScrapeClassName)
 
+	proberURL, proberPath, module := m.Spec.ProberSpec.URL, m.Spec.ProberSpec.Path, m.Spec.Module
+	if usesManagedProber(m) && cg.managedProberAddress != "" {
+		proberURL = cg.managedProberAddress
+		if proberPath == "" {
+			proberPath = "/probe"
+		}
+		module = BlackboxExporterModuleName(m, m.Spec.Module)
+	}
+
 	jobName := fmt.Sprintf("probe/%s/%s", m.Namespace, m.Name)
 	cfg := yaml.MapSlice{
 		{
@@ -1559,7 +1621,7 @@ func (cg *ConfigGenerator) generateProbeConfig(
 	hTs := true
 	cfg = cg.AddHonorTimestamps(cfg, &hTs)
 
-	cfg = append(cfg, yaml.MapItem{Key: "metrics_path", Value: m.Spec.ProberSpec.Path})
+	cfg = append(cfg, yaml.MapItem{Key: "metrics_path", Value: proberPath})
 
 	if m.Spec.Interval != "" {
 		cfg = append(cfg, yaml.MapItem{Key: "scrape_interval", Value: m.Spec.Interval})
@@ -1574,9 +1636,9 @@ func (cg *ConfigGenerator) generateProbeConfig(
 		cfg = append(cfg, yaml.MapItem{Key: "proxy_url", Value: m.Spec.ProberSpec.ProxyURL})
 	}
 
-	if m.Spec.Module != "" {
+	if module != "" {
 		cfg = append(cfg, yaml.MapItem{Key: "params", Value: yaml.MapSlice{
-			{Key: "module", Value: []string{m.Spec.Module}},
+			{Key: "module", Value: []string{module}},
 		}})
 	}
 
@@ -1648,7 +1710,7 @@ func (cg *ConfigGenerator) generateProbeConfig(
 			},
 			{
 				{Key: "target_label", Value: "__address__"},
-				{Key: "replacement", Value: m.Spec.ProberSpec.URL},
+				{Key: "replacement", Value: proberURL},
 			},
 		}...)
 
@@ -1662,45 +1724,7 @@ func (cg *ConfigGenerator) generateProbeConfig(
 	case m.Spec.Targets.Ingress != nil:
 		// Generate kubernetes_sd_config section for the ingress resources.
 		// Filter targets by ingresses selected by the monitor.
-		// Exact label matches.
-		for _, k := range util.SortedKeys(m.Spec.Targets.Ingress.Selector.MatchLabels) {
-			relabelings = append(relabelings, yaml.MapSlice{
-				{Key: "action", Value: "keep"},
-				{Key: "source_labels", Value: []string{"__meta_kubernetes_ingress_label_" + sanitizeLabelName(k), "__meta_kubernetes_ingress_labelpresent_" + sanitizeLabelName(k)}},
-				{Key: "regex", Value: fmt.Sprintf("(%s);true", m.Spec.Targets.Ingress.Selector.MatchLabels[k])},
-			})
-		}
-
-		// Set based label matching. We have to map the valid relations
-		// `In`, `NotIn`, `Exists`, and `DoesNotExist`, into relabeling rules.
-		for _, exp := range m.Spec.Targets.Ingress.Selector.MatchExpressions {
-			switch exp.Operator {
-			case metav1.LabelSelectorOpIn:
-				relabelings = append(relabelings, yaml.MapSlice{
-					{Key: "action", Value: "keep"},
-					{Key: "source_labels", Value: []string{"__meta_kubernetes_ingress_label_" + sanitizeLabelName(exp.Key), "__meta_kubernetes_ingress_labelpresent_" + sanitizeLabelName(exp.Key)}},
-					{Key: "regex", Value: fmt.Sprintf("(%s);true", strings.Join(exp.Values, "|"))},
-				})
-			case metav1.LabelSelectorOpNotIn:
-				relabelings = append(relabelings, yaml.MapSlice{
-					{Key: "action", Value: "drop"},
-					{Key: "source_labels", Value: []string{"__meta_kubernetes_ingress_label_" + sanitizeLabelName(exp.Key), "__meta_kubernetes_ingress_labelpresent_" + sanitizeLabelName(exp.Key)}},
-					{Key: "regex", Value: fmt.Sprintf("(%s);true", strings.Join(exp.Values, "|"))},
-				})
-			case metav1.LabelSelectorOpExists:
-				relabelings = append(relabelings, yaml.MapSlice{
-					{Key: "action", Value: "keep"},
-					{Key: "source_labels", Value: []string{"__meta_kubernetes_ingress_labelpresent_" + sanitizeLabelName(exp.Key)}},
-					{Key: "regex", Value: "true"},
-				})
-			case metav1.LabelSelectorOpDoesNotExist:
-				relabelings = append(relabelings, yaml.MapSlice{
-					{Key: "action", Value: "drop"},
-					{Key: "source_labels", Value: []string{"__meta_kubernetes_ingress_labelpresent_" + sanitizeLabelName(exp.Key)}},
-					{Key: "regex", Value: "true"},
-				})
-			}
-		}
+		relabelings = append(relabelings, generateProbeSelectorRelabelings(kubernetesSDRoleIngress, m.Spec.Targets.Ingress.Selector)...)
 
 		cfg = append(cfg, cg.generateK8SSDConfig(m.Spec.Targets.Ingress.NamespaceSelector, m.Namespace, apiserverConfig, s, kubernetesSDRoleIngress, nil))
 
@@ -1740,7 +1764,7 @@ func (cg *ConfigGenerator) generateProbeConfig(
 			},
 			{
 				{Key: "target_label", Value: "__address__"},
-				{Key: "replacement", Value: m.Spec.ProberSpec.URL},
+				{Key: "replacement", Value: proberURL},
 			},
 		}...)
 
@@ -1749,9 +1773,107 @@ func (cg *ConfigGenerator) generateProbeConfig(
 
 		// Add configured relabelings.
 		relabelings = append(relabelings, generateRelabelConfig(labeler.GetRelabelingConfigs(m.TypeMeta, m.ObjectMeta, m.Spec.Targets.Ingress.RelabelConfigs))...)
+
+	case m.Spec.Targets.Service != nil:
+		// Generate kubernetes_sd_config section for the service resources.
+		// Filter targets by services selected by the monitor.
+		relabelings = append(relabelings, generateProbeSelectorRelabelings(kubernetesSDRoleService, m.Spec.Targets.Service.Selector)...)
+
+		if m.Spec.Targets.Service.Port != nil {
+			relabelings = append(relabelings, yaml.MapSlice{
+				{Key: "action", Value: "keep"},
+				{Key: "source_labels", Value: []string{"__meta_kubernetes_service_port_name"}},
+				{Key: "regex", Value: regexp.QuoteMeta(*m.Spec.Targets.Service.Port)},
+			})
+		}
+
+		cfg = append(cfg, cg.generateK8SSDConfig(m.Spec.Targets.Service.NamespaceSelector, m.Namespace, apiserverConfig, s, kubernetesSDRoleService, nil))
+
+		// Relabelings for service SD.
+		relabelings = append(relabelings, []yaml.MapSlice{
+			{
+				{Key: "source_labels", Value: []string{"__address__"}},
+				{Key: "target_label", Value: "__tmp_prometheus_service_address"},
+			},
+			{
+				{Key: "source_labels", Value: []string{"__address__"}},
+				{Key: "target_label", Value: "__param_target"},
+			},
+			{
+				{Key: "source_labels", Value: []string{"__meta_kubernetes_namespace"}},
+				{Key: "target_label", Value: "namespace"},
+			},
+			{
+				{Key: "source_labels", Value: []string{"__meta_kubernetes_service_name"}},
+				{Key: "target_label", Value: "service"},
+			},
+		}...)
+
+		// Relabelings for prober.
+		relabelings = append(relabelings, []yaml.MapSlice{
+			{
+				{Key: "source_labels", Value: []string{"__param_target"}},
+				{Key: "target_label", Value: "instance"},
+			},
+			{
+				{Key: "target_label", Value: "__address__"},
+				{Key: "replacement", Value: proberURL},
+			},
+		}...)
+
+		// Add scrape class relabelings if there is any.
+		relabelings = append(relabelings, generateRelabelConfig(scrapeClass.Relabelings)...)
+
+		// Add configured relabelings.
+		relabelings = append(relabelings, generateRelabelConfig(labeler.GetRelabelingConfigs(m.TypeMeta, m.ObjectMeta, m.Spec.Targets.Service.RelabelConfigs))...)
+
+	case m.Spec.Targets.HTTPRoute != nil:
+		// Generate one static_config section per HTTPRoute object
+		// discovered by the operator.
+		staticConfigs := []yaml.MapSlice{}
+		for _, route := range cg.probeHTTPRoutes[fmt.Sprintf("%s/%s", m.Namespace, m.Name)] {
+			staticConfigs = append(staticConfigs, yaml.MapSlice{
+				{Key: "targets", Value: route.URLs},
+				{Key: "labels", Value: yaml.MapSlice{
+					{Key: "httproute", Value: route.Name},
+					{Key: "namespace", Value: route.Namespace},
+				}},
+			})
+		}
+
+		cfg = append(cfg, yaml.MapItem{
+			Key:   "static_configs",
+			Value: staticConfigs,
+		})
+
+		// Relabelings for prober.
+		relabelings = append(relabelings, []yaml.MapSlice{
+			{
+				{Key: "source_labels", Value: []string{"__address__"}},
+				{Key: "target_label", Value: "__tmp_prometheus_httproute_url"},
+			},
+			{
+				{Key: "source_labels", Value: []string{"__address__"}},
+				{Key: "target_label", Value: "__param_target"},
+			},
+			{
+				{Key: "source_labels", Value: []string{"__param_target"}},
+				{Key: "target_label", Value: "instance"},
+			},
+			{
+				{Key: "target_label", Value: "__address__"},
+				{Key: "replacement", Value: proberURL},
+			},
+		}...)
+
+		// Add scrape class relabelings if there is any.
+		relabelings = append(relabelings, generateRelabelConfig(scrapeClass.Relabelings)...)
+
+		// Add configured relabelings.
+		relabelings = append(relabelings, generateRelabelConfig(labeler.GetRelabelingConfigs(m.TypeMeta, m.ObjectMeta, m.Spec.Targets.HTTPRoute.RelabelConfigs))...)
 	}
 
-	relabelings = appendShardingRelabelingForProbes(relabelings, shards)
+	relabelings = cg.appendShardingRelabelingForProbes(relabelings, shards)
 	cfg = append(cfg, yaml.MapItem{Key: "relabel_configs", Value: relabelings})
 
 	cfg = cg.addTLStoYaml(cfg, s, mergeSafeTLSConfigWithScrapeClass(m.Spec.TLSConfig, scrapeClass))
@@ -1781,6 +1903,59 @@ func (cg *ConfigGenerator) generateProbeConfig(
 	return cfg
 }
 
+// generateProbeSelectorRelabelings returns the relabeling rules which keep
+// only the targets of the Kubernetes objects (ingresses or services) matching
+// the label selector.
+func generateProbeSelectorRelabelings(role string, selector metav1.LabelSelector) []yaml.MapSlice {
+	var (
+		relabelings   []yaml.MapSlice
+		labelPrefix   = fmt.Sprintf("__meta_kubernetes_%s_label_", role)
+		presentPrefix = fmt.Sprintf("__meta_kubernetes_%s_labelpresent_", role)
+	)
+
+	// Exact label matches.
+	for _, k := range util.SortedKeys(selector.MatchLabels) {
+		relabelings = append(relabelings, yaml.MapSlice{
+			{Key: "action", Value: "keep"},
+			{Key: "source_labels", Value: []string{labelPrefix + sanitizeLabelName(k), presentPrefix + sanitizeLabelName(k)}},
+			{Key: "regex", Value: fmt.Sprintf("(%s);true", selector.MatchLabels[k])},
+		})
+	}
+
+	// Set based label matching. We have to map the valid relations
+	// `In`, `NotIn`, `Exists`, and `DoesNotExist`, into relabeling rules.
+	for _, exp := range selector.MatchExpressions {
+		switch exp.Operator {
+		case metav1.LabelSelectorOpIn:
+			relabelings = append(relabelings, yaml.MapSlice{
+				{Key: "action", Value: "keep"},
+				{Key: "source_labels", Value: []string{labelPrefix + sanitizeLabelName(exp.Key), presentPrefix + sanitizeLabelName(exp.Key)}},
+				{Key: "regex", Value: fmt.Sprintf("(%s);true", strings.Join(exp.Values, "|"))},
+			})
+		case metav1.LabelSelectorOpNotIn:
+			relabelings = append(relabelings, yaml.MapSlice{
+				{Key: "action", Value: "drop"},
+				{Key: "source_labels", Value: []string{labelPrefix + sanitizeLabelName(exp.Key), presentPrefix + sanitizeLabelName(exp.Key)}},
+				{Key: "regex", Value: fmt.Sprintf("(%s);true", strings.Join(exp.Values, "|"))},
+			})
+		case metav1.LabelSelectorOpExists:
+			relabelings = append(relabelings, yaml.MapSlice{
+				{Key: "action", Value: "keep"},
+				{Key: "source_labels", Value: []string{presentPrefix + sanitizeLabelName(exp.Key)}},
+				{Key: "regex", Value: "true"},
+			})
+		case metav1.LabelSelectorOpDoesNotExist:
+			relabelings = append(relabelings, yaml.MapSlice{
+				{Key: "action", Value: "drop"},
+				{Key: "source_labels", Value: []string{presentPrefix + sanitizeLabelName(exp.Key)}},
+				{Key: "regex", Value: "true"},
+			})
+		}
+	}
+
+	return relabelings
+}
+
 func (cg *ConfigGenerator) generateServiceMonitorConfig(
 	m *monitoringv1.ServiceMonitor,
 	ep monitoringv1.Endpoint,
@@ -2041,7 +2216,7 @@ func (cg *ConfigGenerator) generateServiceMonitorConfig(
 	labeler := namespacelabeler.New(cpf.EnforcedNamespaceLabel, cpf.ExcludedFromEnforcement, false)
 	relabelings = append(relabelings, generateRelabelConfig(labeler.GetRelabelingConfigs(m.TypeMeta, m.ObjectMeta, ep.RelabelConfigs))...)
 
-	relabelings = appendShardingRelabelingWithAddress(relabelings, shards)
+	relabelings = cg.appendShardingRelabelingWithAddress(relabelings, shards)
 	cfg = append(cfg, yaml.MapItem{Key: "relabel_configs", Value: relabelings})
 
 	cfg = cg.AddLimitsToYAML(cfg, sampleLimitKey, m.Spec.SampleLimit, cpf.EnforcedSampleLimit)
@@ -2097,12 +2272,12 @@ func (cg *ConfigGenerator) getLimit(user *uint64, enforced *uint64) *uint64 {
 	return enforced
 }
 
-func appendShardingRelabelingWithAddress(relabelings []yaml.MapSlice, shards int32) []yaml.MapSlice {
-	return appendShardingRelabelingWithLabel(relabelings, shards, "__address__")
+func (cg *ConfigGenerator) appendShardingRelabelingWithAddress(relabelings []yaml.MapSlice, shards int32) []yaml.MapSlice {
+	return cg.appendShardingRelabelingWithLabel(relabelings, shards, "__address__")
 }
 
-func appendShardingRelabelingForProbes(relabelings []yaml.MapSlice, shards int32) []yaml.MapSlice {
-	return appendShardingRelabelingWithLabel(relabelings, shards, "__param_target")
+func (cg *ConfigGenerator) appendShardingRelabelingForProbes(relabelings []yaml.MapSlice, shards int32) []yaml.MapSlice {
+	return cg.appendShardingRelabelingWithLabel(relabelings, shards, "__param_target")
 }
 
 func (cg *ConfigGenerator) appendShardingRelabelingWithAddressIfMissing(relabelings []yaml.MapSlice, shards int32) []yaml.MapSlice {
@@ -2114,10 +2289,15 @@ func (cg *ConfigGenerator) appendShardingRelabelingWithAddressIfMissing(relabeli
 			}
 		}
 	}
-	return appendShardingRelabelingWithAddress(relabelings, shards)
+	return cg.appendShardingRelabelingWithAddress(relabelings, shards)
 }
 
-func appendShardingRelabelingWithLabel(relabelings []yaml.MapSlice, shards int32, shardLabel string) []yaml.MapSlice {
+func (cg *ConfigGenerator) appendShardingRelabelingWithLabel(relabelings []yaml.MapSlice, shards int32, shardLabel string) []yaml.MapSlice {
+	strategy := ptr.Deref(cg.prom.GetCommonPrometheusFields().ShardingStrategy, monitoringv1.HashModShardingStrategy)
+	if strategy == monitoringv1.ConsistentShardingStrategy && shards > 1 {
+		return appendConsistentShardingRelabelingWithLabel(relabelings, shards, shardLabel)
+	}
+
 	return append(relabelings,
 		// Store the "shardLabel" value into the __tmp_hash label unless the
 		// latter is already set.
@@ -2139,6 +2319,176 @@ func appendShardingRelabelingWithLabel(relabelings []yaml.MapSlice, shards int32
 		})
 }
 
+// appendConsistentShardingRelabelingWithLabel hashes the targets into a fixed
+// number of buckets and maps the buckets to the shards (see
+// consistentShardingAssignment).
+func appendConsistentShardingRelabelingWithLabel(relabelings []yaml.MapSlice, shards int32, shardLabel string) []yaml.MapSlice {
+	relabelings = append(relabelings,
+		// Store the "shardLabel" value into the __tmp_hash label unless the
+		// latter is already set.
+		yaml.MapSlice{
+			{Key: "source_labels", Value: []string{shardLabel, hashLabelNameForSharding}},
+			{Key: "target_label", Value: hashLabelNameForSharding},
+			{Key: "regex", Value: "(.+);"},
+			{Key: "replacement", Value: "$1"},
+			{Key: "action", Value: "replace"},
+		}, yaml.MapSlice{
+			{Key: "source_labels", Value: []string{hashLabelNameForSharding}},
+			{Key: "target_label", Value: hashLabelNameForSharding},
+			{Key: "modulus", Value: consistentShardingBuckets},
+			{Key: "action", Value: "hashmod"},
+		})
+
+	for shard, buckets := range consistentShardingAssignment(shards) {
+		if len(buckets) == 0 {
+			continue
+		}
+
+		relabelings = append(relabelings, yaml.MapSlice{
+			{Key: "source_labels", Value: []string{hashLabelNameForSharding}},
+			{Key: "target_label", Value: shardLabelNameForSharding},
+			{Key: "regex", Value: bucketsRegex(buckets)},
+			{Key: "replacement", Value: strconv.Itoa(shard)},
+			{Key: "action", Value: "replace"},
+		})
+	}
+
+	return append(relabelings, yaml.MapSlice{
+		{Key: "source_labels", Value: []string{shardLabelNameForSharding, hashLabelNameForDisablingSharding}},
+		{Key: "regex", Value: fmt.Sprintf("$(%s);|.+;.+", operator.ShardEnvVar)},
+		{Key: "action", Value: "keep"},
+	})
+}
+
+// consistentShardingAssignment returns the sorted list of buckets assigned to
+// each shard.
+//
+// The assignment for N+1 shards is derived from the assignment for N shards:
+// the new shard takes buckets from the shards owning the most buckets until
+// all shards own the same number of buckets (+/- 1). Hence adding or removing
+// the last shard only moves the buckets of this shard.
+func consistentShardingAssignment(shards int32) [][]int {
+	assignment := make([][]int, 1, shards)
+	assignment[0] = make([]int, consistentShardingBuckets)
+	for i := range assignment[0] {
+		assignment[0][i] = i
+	}
+
+	for n := 1; n < int(shards); n++ {
+		quota := consistentShardingBuckets / (n + 1)
+		taken := make([]int, 0, quota)
+		for len(taken) < quota {
+			// Take the last bucket of the first shard owning the most buckets.
+			largest := 0
+			for i := range assignment {
+				if len(assignment[i]) > len(assignment[largest]) {
+					largest = i
+				}
+			}
+
+			last := len(assignment[largest]) - 1
+			taken = append(taken, assignment[largest][last])
+			assignment[largest] = assignment[largest][:last]
+		}
+
+		slices.Sort(taken)
+		assignment = append(assignment, taken)
+	}
+
+	return assignment
+}
+
+// bucketsRegex returns a regular expression matching the sorted list of
+// buckets. Contiguous buckets are expressed as numeric ranges to keep the
+// expression short.
+func bucketsRegex(buckets []int) string {
+	var regex []string
+	for i := 0; i < len(buckets); {
+		j := i
+		for j+1 < len(buckets) && buckets[j+1] == buckets[j]+1 {
+			j++
+		}
+
+		regex = append(regex, numericRangeRegex(buckets[i], buckets[j])...)
+		i = j + 1
+	}
+
+	return strings.Join(regex, "|")
+}
+
+// numericRangeRegex returns the regular expressions matching the decimal
+// representation of the integers between lo and hi (inclusive).
+func numericRangeRegex(lo, hi int) []string {
+	// Split the range at the powers of 10 so that both bounds have the same
+	// number of digits.
+	for p := 10; p <= hi; p *= 10 {
+		if lo < p {
+			return append(numericRangeRegex(lo, p-1), numericRangeRegex(p, hi)...)
+		}
+	}
+
+	return sameLengthRangeRegex(strconv.Itoa(lo), strconv.Itoa(hi))
+}
+
+// sameLengthRangeRegex returns the regular expressions matching the decimal
+// numbers between lo and hi which have the same number of digits.
+func sameLengthRangeRegex(lo, hi string) []string {
+	if lo == hi {
+		return []string{lo}
+	}
+
+	if len(lo) == 1 {
+		return []string{digitRangeRegex(lo[0], hi[0])}
+	}
+
+	if lo[0] == hi[0] {
+		var regex []string
+		for _, r := range sameLengthRangeRegex(lo[1:], hi[1:]) {
+			regex = append(regex, lo[:1]+r)
+		}
+
+		return regex
+	}
+
+	var (
+		regex      []string
+		n          = len(lo) - 1
+		start, end = lo[0], hi[0]
+	)
+
+	// Numbers starting with the first digit of lo.
+	if strings.Trim(lo[1:], "0") != "" {
+		for _, r := range sameLengthRangeRegex(lo[1:], strings.Repeat("9", n)) {
+			regex = append(regex, lo[:1]+r)
+		}
+		start++
+	}
+
+	// Numbers starting with the last digit of hi.
+	var upper []string
+	if strings.Trim(hi[1:], "9") != "" {
+		for _, r := range sameLengthRangeRegex(strings.Repeat("0", n), hi[1:]) {
+			upper = append(upper, hi[:1]+r)
+		}
+		end--
+	}
+
+	// Numbers starting with the digits in between.
+	if start <= end {
+		regex = append(regex, digitRangeRegex(start, end)+strings.Repeat("[0-9]", n))
+	}
+
+	return append(regex, upper...)
+}
+
+func digitRangeRegex(lo, hi byte) string {
+	if lo == hi {
+		return string(lo)
+	}
+
+	return fmt.Sprintf("[%c-%c]", lo, hi)
+}
+
 func generateRelabelConfig(rc []monitoringv1.RelabelConfig) []yaml.MapSlice {
 	var cfg []yaml.MapSlice
 
@@ -2606,218 +2956,267 @@ func toProtobufMessageVersion(mv monitoringv1.RemoteWriteMessageVersion) string
 	return "prometheus.WriteRequest"
 }
 
-func (cg *ConfigGenerator) GenerateRemoteWriteConfig(rws []monitoringv1.RemoteWriteSpec, s assets.StoreGetter) yaml.MapItem {
-	var cfgs []yaml.MapSlice
+// GenerateRemoteWriteConfig returns the remote write configuration for the
+// given endpoints (whose secrets and configmaps are looked up in namespace),
+// followed by the endpoints of the RemoteWrite resources.
+func (cg *ConfigGenerator) GenerateRemoteWriteConfig(rws []monitoringv1.RemoteWriteSpec, store *assets.StoreBuilder, namespace string) yaml.MapItem {
+	var (
+		cfgs []yaml.MapSlice
+		s    = store.ForNamespace(namespace)
+	)
 
 	for i, spec := range rws {
-		cfg := yaml.MapSlice{
-			{Key: "url", Value: spec.URL},
-		}
+		cfgs = append(cfgs, cg.generateRemoteWriteEndpoint(i, spec, s))
+	}
 
-		if spec.RemoteTimeout != nil {
-			cfg = append(cfg, yaml.MapItem{Key: "remote_timeout", Value: *spec.RemoteTimeout})
-		}
+	keys := make([]string, 0, len(cg.remoteWrites))
+	for k := range cg.remoteWrites {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
 
-		if len(spec.Headers) > 0 {
-			cfg = cg.WithMinimumVersion("2.15.0").AppendMapItem(cfg, "headers", stringMapToMapSlice(spec.Headers))
-		}
+	for i, k := range keys {
+		rw := cg.remoteWrites[k]
+		cfgs = append(cfgs, cg.generateRemoteWriteEndpoint(
+			len(rws)+i,
+			cg.enforceRemoteWriteNamespace(rw),
+			store.ForNamespace(rw.GetNamespace()),
+		))
+	}
 
-		if ptr.Deref(spec.Name, "") != "" {
-			cfg = cg.WithMinimumVersion("2.15.0").AppendMapItem(cfg, "name", *spec.Name)
-		}
+	return yaml.MapItem{
+		Key:   "remote_write",
+		Value: cfgs,
+	}
+}
 
-		if spec.MessageVersion != nil {
-			cfg = cg.WithMinimumVersion("2.54.0").AppendMapItem(cfg, "protobuf_message", toProtobufMessageVersion(*spec.MessageVersion))
-		}
+// enforceRemoteWriteNamespace returns the remote write configuration of the
+// RemoteWrite resource with a first relabeling rule which drops the series
+// whose namespace label doesn't match the namespace of the resource.
+// Because the rule is evaluated first, it can't be bypassed by the
+// relabeling rules of the resource.
+func (cg *ConfigGenerator) enforceRemoteWriteNamespace(rw *monitoringv1alpha1.RemoteWrite) monitoringv1.RemoteWriteSpec {
+	namespaceLabel := cg.prom.GetCommonPrometheusFields().EnforcedNamespaceLabel
+	if namespaceLabel == "" {
+		namespaceLabel = "namespace"
+	}
+
+	spec := rw.Spec.DeepCopy()
+	spec.WriteRelabelConfigs = append(
+		[]monitoringv1.RelabelConfig{{
+			SourceLabels: []monitoringv1.LabelName{monitoringv1.LabelName(namespaceLabel)},
+			Regex:        regexp.QuoteMeta(rw.GetNamespace()),
+			Action:       "keep",
+		}},
+		spec.WriteRelabelConfigs...,
+	)
 
-		if spec.SendExemplars != nil {
-			cfg = cg.WithMinimumVersion("2.27.0").AppendMapItem(cfg, "send_exemplars", spec.SendExemplars)
-		}
+	return *spec
+}
 
-		if spec.SendNativeHistograms != nil {
-			cfg = cg.WithMinimumVersion("2.40.0").AppendMapItem(cfg, "send_native_histograms", spec.SendNativeHistograms)
-		}
+func (cg *ConfigGenerator) generateRemoteWriteEndpoint(i int, spec monitoringv1.RemoteWriteSpec, s assets.StoreGetter) yaml.MapSlice {
+	cfg := yaml.MapSlice{
+		{Key: "url", Value: spec.URL},
+	}
 
-		var relabelings []yaml.MapSlice
-		for _, c := range spec.WriteRelabelConfigs {
-			var relabeling yaml.MapSlice
+	if spec.RemoteTimeout != nil {
+		cfg = append(cfg, yaml.MapItem{Key: "remote_timeout", Value: *spec.RemoteTimeout})
+	}
 
-			if len(c.SourceLabels) > 0 {
-				relabeling = append(relabeling, yaml.MapItem{Key: "source_labels", Value: c.SourceLabels})
-			}
+	if len(spec.Headers) > 0 {
+		cfg = cg.WithMinimumVersion("2.15.0").AppendMapItem(cfg, "headers", stringMapToMapSlice(spec.Headers))
+	}
 
-			if c.Separator != nil {
-				relabeling = append(relabeling, yaml.MapItem{Key: "separator", Value: *c.Separator})
-			}
+	if ptr.Deref(spec.Name, "") != "" {
+		cfg = cg.WithMinimumVersion("2.15.0").AppendMapItem(cfg, "name", *spec.Name)
+	}
 
-			if c.TargetLabel != "" {
-				relabeling = append(relabeling, yaml.MapItem{Key: "target_label", Value: c.TargetLabel})
-			}
+	if spec.MessageVersion != nil {
+		cfg = cg.WithMinimumVersion("2.54.0").AppendMapItem(cfg, "protobuf_message", toProtobufMessageVersion(*spec.MessageVersion))
+	}
 
-			if c.Regex != "" {
-				relabeling = append(relabeling, yaml.MapItem{Key: "regex", Value: c.Regex})
-			}
+	if spec.SendExemplars != nil {
+		cfg = cg.WithMinimumVersion("2.27.0").AppendMapItem(cfg, "send_exemplars", spec.SendExemplars)
+	}
 
-			if c.Modulus != uint64(0) {
-				relabeling = append(relabeling, yaml.MapItem{Key: "modulus", Value: c.Modulus})
-			}
+	if spec.SendNativeHistograms != nil {
+		cfg = cg.WithMinimumVersion("2.40.0").AppendMapItem(cfg, "send_native_histograms", spec.SendNativeHistograms)
+	}
 
-			if c.Replacement != nil {
-				relabeling = append(relabeling, yaml.MapItem{Key: "replacement", Value: *c.Replacement})
-			}
+	var relabelings []yaml.MapSlice
+	for _, c := range spec.WriteRelabelConfigs {
+		var relabeling yaml.MapSlice
 
-			if c.Action != "" {
-				relabeling = append(relabeling, yaml.MapItem{Key: "action", Value: strings.ToLower(c.Action)})
-			}
+		if len(c.SourceLabels) > 0 {
+			relabeling = append(relabeling, yaml.MapItem{Key: "source_labels", Value: c.SourceLabels})
+		}
+
+		if c.Separator != nil {
+			relabeling = append(relabeling, yaml.MapItem{Key: "separator", Value: *c.Separator})
+		}
 
-			relabelings = append(relabelings, relabeling)
+		if c.TargetLabel != "" {
+			relabeling = append(relabeling, yaml.MapItem{Key: "target_label", Value: c.TargetLabel})
 		}
 
-		if len(relabelings) > 0 {
-			cfg = append(cfg, yaml.MapItem{Key: "write_relabel_configs", Value: relabelings})
+		if c.Regex != "" {
+			relabeling = append(relabeling, yaml.MapItem{Key: "regex", Value: c.Regex})
 		}
 
-		cfg = cg.addBasicAuthToYaml(cfg, s, spec.BasicAuth)
+		if c.Modulus != uint64(0) {
+			relabeling = append(relabeling, yaml.MapItem{Key: "modulus", Value: c.Modulus})
+		}
 
-		//nolint:staticcheck // Ignore SA1019 this field is marked as deprecated.
-		if spec.BearerToken != "" {
-			cg.logger.Warn("'bearerToken' is deprecated, use 'authorization' instead.")
-			cfg = append(cfg, yaml.MapItem{Key: "bearer_token", Value: spec.BearerToken})
+		if c.Replacement != nil {
+			relabeling = append(relabeling, yaml.MapItem{Key: "replacement", Value: *c.Replacement})
 		}
 
-		//nolint:staticcheck // Ignore SA1019 this field is marked as deprecated.
-		if spec.BearerTokenFile != "" {
-			cg.logger.Debug("'bearerTokenFile' is deprecated, use 'authorization' instead.")
-			cfg = append(cfg, yaml.MapItem{Key: "bearer_token_file", Value: spec.BearerTokenFile})
+		if c.Action != "" {
+			relabeling = append(relabeling, yaml.MapItem{Key: "action", Value: strings.ToLower(c.Action)})
 		}
 
-		cfg = cg.addOAuth2ToYaml(cfg, s, spec.OAuth2)
+		relabelings = append(relabelings, relabeling)
+	}
 
-		cfg = cg.addTLStoYaml(cfg, s, spec.TLSConfig)
+	if len(relabelings) > 0 {
+		cfg = append(cfg, yaml.MapItem{Key: "write_relabel_configs", Value: relabelings})
+	}
 
-		cfg = cg.addAuthorizationToYaml(cfg, s, spec.Authorization)
+	cfg = cg.addBasicAuthToYaml(cfg, s, spec.BasicAuth)
 
-		cfg = cg.addProxyConfigtoYaml(cfg, s, spec.ProxyConfig)
+	//nolint:staticcheck // Ignore SA1019 this field is marked as deprecated.
+	if spec.BearerToken != "" {
+		cg.logger.Warn("'bearerToken' is deprecated, use 'authorization' instead.")
+		cfg = append(cfg, yaml.MapItem{Key: "bearer_token", Value: spec.BearerToken})
+	}
+
+	//nolint:staticcheck // Ignore SA1019 this field is marked as deprecated.
+	if spec.BearerTokenFile != "" {
+		cg.logger.Debug("'bearerTokenFile' is deprecated, use 'authorization' instead.")
+		cfg = append(cfg, yaml.MapItem{Key: "bearer_token_file", Value: spec.BearerTokenFile})
+	}
 
-		cfg = cg.WithMinimumVersion("2.26.0").addSigv4ToYaml(cfg, fmt.Sprintf("remoteWrite/%d", i), s, spec.Sigv4)
+	cfg = cg.addOAuth2ToYaml(cfg, s, spec.OAuth2)
 
-		if spec.AzureAD != nil {
-			azureAd := yaml.MapSlice{}
+	cfg = cg.addTLStoYaml(cfg, s, spec.TLSConfig)
 
-			if spec.AzureAD.ManagedIdentity != nil {
-				azureAd = append(azureAd,
-					yaml.MapItem{Key: "managed_identity", Value: yaml.MapSlice{
-						{Key: "client_id", Value: spec.AzureAD.ManagedIdentity.ClientID},
-					}},
-				)
-			}
+	cfg = cg.addAuthorizationToYaml(cfg, s, spec.Authorization)
 
-			if spec.AzureAD.OAuth != nil {
-				b, err := s.GetSecretKey(spec.AzureAD.OAuth.ClientSecret)
-				if err != nil {
-					cg.logger.Error("invalid Azure OAuth client secret", "err", err)
-				} else {
-					azureAd = cg.WithMinimumVersion("2.48.0").AppendMapItem(azureAd, "oauth", yaml.MapSlice{
-						{Key: "client_id", Value: spec.AzureAD.OAuth.ClientID},
-						{Key: "client_secret", Value: string(b)},
-						{Key: "tenant_id", Value: spec.AzureAD.OAuth.TenantID},
-					})
-				}
-			}
+	cfg = cg.addProxyConfigtoYaml(cfg, s, spec.ProxyConfig)
 
-			if spec.AzureAD.SDK != nil {
-				azureAd = cg.WithMinimumVersion("2.52.0").AppendMapItem(
-					azureAd,
-					"sdk",
-					yaml.MapSlice{
-						{Key: "tenant_id", Value: ptr.Deref(spec.AzureAD.SDK.TenantID, "")},
-					})
-			}
+	cfg = cg.WithMinimumVersion("2.26.0").addSigv4ToYaml(cfg, fmt.Sprintf("remoteWrite/%d", i), s, spec.Sigv4)
 
-			if spec.AzureAD.Cloud != nil {
-				azureAd = append(azureAd, yaml.MapItem{Key: "cloud", Value: spec.AzureAD.Cloud})
-			}
+	if spec.AzureAD != nil {
+		azureAd := yaml.MapSlice{}
 
-			cfg = cg.WithMinimumVersion("2.45.0").AppendMapItem(cfg, "azuread", azureAd)
+		if spec.AzureAD.ManagedIdentity != nil {
+			azureAd = append(azureAd,
+				yaml.MapItem{Key: "managed_identity", Value: yaml.MapSlice{
+					{Key: "client_id", Value: spec.AzureAD.ManagedIdentity.ClientID},
+				}},
+			)
 		}
 
-		if spec.FollowRedirects != nil {
-			cfg = cg.WithMinimumVersion("2.26.0").AppendMapItem(cfg, "follow_redirects", spec.FollowRedirects)
+		if spec.AzureAD.OAuth != nil {
+			b, err := s.GetSecretKey(spec.AzureAD.OAuth.ClientSecret)
+			if err != nil {
+				cg.logger.Error("invalid Azure OAuth client secret", "err", err)
+			} else {
+				azureAd = cg.WithMinimumVersion("2.48.0").AppendMapItem(azureAd, "oauth", yaml.MapSlice{
+					{Key: "client_id", Value: spec.AzureAD.OAuth.ClientID},
+					{Key: "client_secret", Value: string(b)},
+					{Key: "tenant_id", Value: spec.AzureAD.OAuth.TenantID},
+				})
+			}
 		}
 
-		if spec.EnableHttp2 != nil {
-			cfg = cg.WithMinimumVersion("2.35.0").AppendMapItem(cfg, "enable_http2", *spec.EnableHttp2)
+		if spec.AzureAD.SDK != nil {
+			azureAd = cg.WithMinimumVersion("2.52.0").AppendMapItem(
+				azureAd,
+				"sdk",
+				yaml.MapSlice{
+					{Key: "tenant_id", Value: ptr.Deref(spec.AzureAD.SDK.TenantID, "")},
+				})
 		}
 
-		if spec.QueueConfig != nil {
-			queueConfig := yaml.MapSlice{}
+		if spec.AzureAD.Cloud != nil {
+			azureAd = append(azureAd, yaml.MapItem{Key: "cloud", Value: spec.AzureAD.Cloud})
+		}
 
-			if spec.QueueConfig.Capacity != int(0) {
-				queueConfig = append(queueConfig, yaml.MapItem{Key: "capacity", Value: spec.QueueConfig.Capacity})
-			}
+		cfg = cg.WithMinimumVersion("2.45.0").AppendMapItem(cfg, "azuread", azureAd)
+	}
 
-			if spec.QueueConfig.MinShards != int(0) {
-				queueConfig = cg.WithMinimumVersion("2.6.0").AppendMapItem(queueConfig, "min_shards", spec.QueueConfig.MinShards)
-			}
+	if spec.FollowRedirects != nil {
+		cfg = cg.WithMinimumVersion("2.26.0").AppendMapItem(cfg, "follow_redirects", spec.FollowRedirects)
+	}
 
-			if spec.QueueConfig.MaxShards != int(0) {
-				queueConfig = append(queueConfig, yaml.MapItem{Key: "max_shards", Value: spec.QueueConfig.MaxShards})
-			}
+	if spec.EnableHttp2 != nil {
+		cfg = cg.WithMinimumVersion("2.35.0").AppendMapItem(cfg, "enable_http2", *spec.EnableHttp2)
+	}
 
-			if spec.QueueConfig.MaxSamplesPerSend != int(0) {
-				queueConfig = append(queueConfig, yaml.MapItem{Key: "max_samples_per_send", Value: spec.QueueConfig.MaxSamplesPerSend})
-			}
+	if spec.QueueConfig != nil {
+		queueConfig := yaml.MapSlice{}
 
-			if spec.QueueConfig.BatchSendDeadline != nil {
-				queueConfig = append(queueConfig, yaml.MapItem{Key: "batch_send_deadline", Value: string(*spec.QueueConfig.BatchSendDeadline)})
-			}
+		if spec.QueueConfig.Capacity != int(0) {
+			queueConfig = append(queueConfig, yaml.MapItem{Key: "capacity", Value: spec.QueueConfig.Capacity})
+		}
 
-			if spec.QueueConfig.MaxRetries != int(0) {
-				queueConfig = cg.WithMaximumVersion("2.11.0").AppendMapItem(queueConfig, "max_retries", spec.QueueConfig.MaxRetries)
-			}
+		if spec.QueueConfig.MinShards != int(0) {
+			queueConfig = cg.WithMinimumVersion("2.6.0").AppendMapItem(queueConfig, "min_shards", spec.QueueConfig.MinShards)
+		}
 
-			if spec.QueueConfig.MinBackoff != nil {
-				queueConfig = append(queueConfig, yaml.MapItem{Key: "min_backoff", Value: string(*spec.QueueConfig.MinBackoff)})
-			}
+		if spec.QueueConfig.MaxShards != int(0) {
+			queueConfig = append(queueConfig, yaml.MapItem{Key: "max_shards", Value: spec.QueueConfig.MaxShards})
+		}
 
-			if spec.QueueConfig.MaxBackoff != nil {
-				queueConfig = append(queueConfig, yaml.MapItem{Key: "max_backoff", Value: string(*spec.QueueConfig.MaxBackoff)})
-			}
+		if spec.QueueConfig.MaxSamplesPerSend != int(0) {
+			queueConfig = append(queueConfig, yaml.MapItem{Key: "max_samples_per_send", Value: spec.QueueConfig.MaxSamplesPerSend})
+		}
 
-			if spec.QueueConfig.RetryOnRateLimit {
-				queueConfig = cg.WithMinimumVersion("2.26.0").AppendMapItem(queueConfig, "retry_on_http_429", spec.QueueConfig.RetryOnRateLimit)
-			}
+		if spec.QueueConfig.BatchSendDeadline != nil {
+			queueConfig = append(queueConfig, yaml.MapItem{Key: "batch_send_deadline", Value: string(*spec.QueueConfig.BatchSendDeadline)})
+		}
 
-			if spec.QueueConfig.SampleAgeLimit != nil {
-				queueConfig = cg.WithMinimumVersion("2.50.0").AppendMapItem(queueConfig, "sample_age_limit", string(*spec.QueueConfig.SampleAgeLimit))
-			}
+		if spec.QueueConfig.MaxRetries != int(0) {
+			queueConfig = cg.WithMaximumVersion("2.11.0").AppendMapItem(queueConfig, "max_retries", spec.QueueConfig.MaxRetries)
+		}
 
-			cfg = append(cfg, yaml.MapItem{Key: "queue_config", Value: queueConfig})
+		if spec.QueueConfig.MinBackoff != nil {
+			queueConfig = append(queueConfig, yaml.MapItem{Key: "min_backoff", Value: string(*spec.QueueConfig.MinBackoff)})
 		}
 
-		if spec.MetadataConfig != nil {
-			metadataConfig := append(yaml.MapSlice{}, yaml.MapItem{Key: "send", Value: spec.MetadataConfig.Send})
-			if spec.MetadataConfig.SendInterval != "" {
-				metadataConfig = append(metadataConfig, yaml.MapItem{Key: "send_interval", Value: spec.MetadataConfig.SendInterval})
-			}
-			if spec.MetadataConfig.MaxSamplesPerSend != nil {
-				metadataConfig = cg.WithMinimumVersion("2.29.0").AppendMapItem(metadataConfig, "max_samples_per_send", *spec.MetadataConfig.MaxSamplesPerSend)
-			}
+		if spec.QueueConfig.MaxBackoff != nil {
+			queueConfig = append(queueConfig, yaml.MapItem{Key: "max_backoff", Value: string(*spec.QueueConfig.MaxBackoff)})
+		}
 
-			cfg = cg.WithMinimumVersion("2.23.0").AppendMapItem(cfg, "metadata_config", metadataConfig)
+		if spec.QueueConfig.RetryOnRateLimit {
+			queueConfig = cg.WithMinimumVersion("2.26.0").AppendMapItem(queueConfig, "retry_on_http_429", spec.QueueConfig.RetryOnRateLimit)
 		}
 
-		if spec.RoundRobinDNS != nil {
-			cfg = cg.WithMinimumVersion("3.1.0").AppendMapItem(cfg, "round_robin_dns", spec.RoundRobinDNS)
+		if spec.QueueConfig.SampleAgeLimit != nil {
+			queueConfig = cg.WithMinimumVersion("2.50.0").AppendMapItem(queueConfig, "sample_age_limit", string(*spec.QueueConfig.SampleAgeLimit))
 		}
 
-		cfgs = append(cfgs, cfg)
+		cfg = append(cfg, yaml.MapItem{Key: "queue_config", Value: queueConfig})
 	}
 
-	return yaml.MapItem{
-		Key:   "remote_write",
-		Value: cfgs,
+	if spec.MetadataConfig != nil {
+		metadataConfig := append(yaml.MapSlice{}, yaml.MapItem{Key: "send", Value: spec.MetadataConfig.Send})
+		if spec.MetadataConfig.SendInterval != "" {
+			metadataConfig = append(metadataConfig, yaml.MapItem{Key: "send_interval", Value: spec.MetadataConfig.SendInterval})
+		}
+		if spec.MetadataConfig.MaxSamplesPerSend != nil {
+			metadataConfig = cg.WithMinimumVersion("2.29.0").AppendMapItem(metadataConfig, "max_samples_per_send", *spec.MetadataConfig.MaxSamplesPerSend)
+		}
+
+		cfg = cg.WithMinimumVersion("2.23.0").AppendMapItem(cfg, "metadata_config", metadataConfig)
+	}
+
+	if spec.RoundRobinDNS != nil {
+		cfg = cg.WithMinimumVersion("3.1.0").AppendMapItem(cfg, "round_robin_dns", spec.RoundRobinDNS)
 	}
+
+	return cfg
 }
 
 func (cg *ConfigGenerator) appendScrapeIntervals(slice yaml.MapSlice) yaml.MapSlice {
@@ -3082,8 +3481,8 @@ func (cg *ConfigGenerator) GenerateAgentConfiguration(
 
 	// Remote write config
 	s := store.ForNamespace(cg.prom.GetObjectMeta().GetNamespace())
-	if len(cpf.RemoteWrite) > 0 {
-		cfg = append(cfg, cg.GenerateRemoteWriteConfig(cpf.RemoteWrite, s))
+	if len(cpf.RemoteWrite) > 0 || len(cg.remoteWrites) > 0 {
+		cfg = append(cfg, cg.GenerateRemoteWriteConfig(cpf.RemoteWrite, store, cg.prom.GetObjectMeta().GetNamespace()))
 	}
 
 	// OTLP config
@@ -4718,6 +5117,196 @@ func (cg *ConfigGenerator) generateScrapeConfig(
 		})
 	}
 
+	// VultrSDConfig
+	if len(sc.Spec.VultrSDConfigs) > 0 {
+		configs := make([][]yaml.MapItem, len(sc.Spec.VultrSDConfigs))
+		for i, config := range sc.Spec.VultrSDConfigs {
+			configs[i] = cg.addSafeAuthorizationToYaml(configs[i], s, config.Authorization)
+			configs[i] = cg.addOAuth2ToYaml(configs[i], s, config.OAuth2)
+			configs[i] = cg.addProxyConfigtoYaml(configs[i], s, config.ProxyConfig)
+			configs[i] = cg.addSafeTLStoYaml(configs[i], s, config.TLSConfig)
+
+			if config.Port != nil {
+				configs[i] = append(configs[i], yaml.MapItem{
+					Key:   "port",
+					Value: config.Port,
+				})
+			}
+
+			if config.FollowRedirects != nil {
+				configs[i] = append(configs[i], yaml.MapItem{
+					Key:   "follow_redirects",
+					Value: config.FollowRedirects,
+				})
+			}
+
+			if config.EnableHTTP2 != nil {
+				configs[i] = append(configs[i], yaml.MapItem{
+					Key:   "enable_http2",
+					Value: config.EnableHTTP2,
+				})
+			}
+
+			if config.RefreshInterval != nil {
+				configs[i] = append(configs[i], yaml.MapItem{
+					Key:   "refresh_interval",
+					Value: config.RefreshInterval,
+				})
+			}
+		}
+
+		cfg = append(cfg, yaml.MapItem{
+			Key:   "vultr_sd_configs",
+			Value: configs,
+		})
+	}
+
+	// MarathonSDConfig
+	if len(sc.Spec.MarathonSDConfigs) > 0 {
+		configs := make([][]yaml.MapItem, len(sc.Spec.MarathonSDConfigs))
+		for i, config := range sc.Spec.MarathonSDConfigs {
+			configs[i] = append(configs[i], yaml.MapItem{
+				Key:   "servers",
+				Value: config.Servers,
+			})
+
+			if config.AuthToken != nil {
+				value, err := s.GetSecretKey(*config.AuthToken)
+				if err != nil {
+					return cfg, fmt.Errorf("failed to read %s secret %s: %w", config.AuthToken.Name, jobName, err)
+				}
+
+				configs[i] = append(configs[i], yaml.MapItem{
+					Key:   "auth_token",
+					Value: string(value),
+				})
+			}
+
+			configs[i] = cg.addBasicAuthToYaml(configs[i], s, config.BasicAuth)
+			configs[i] = cg.addSafeAuthorizationToYaml(configs[i], s, config.Authorization)
+			configs[i] = cg.addOAuth2ToYaml(configs[i], s, config.OAuth2)
+			configs[i] = cg.addProxyConfigtoYaml(configs[i], s, config.ProxyConfig)
+			configs[i] = cg.addSafeTLStoYaml(configs[i], s, config.TLSConfig)
+
+			if config.FollowRedirects != nil {
+				configs[i] = append(configs[i], yaml.MapItem{
+					Key:   "follow_redirects",
+					Value: config.FollowRedirects,
+				})
+			}
+
+			if config.EnableHTTP2 != nil {
+				configs[i] = append(configs[i], yaml.MapItem{
+					Key:   "enable_http2",
+					Value: config.EnableHTTP2,
+				})
+			}
+
+			if config.RefreshInterval != nil {
+				configs[i] = append(configs[i], yaml.MapItem{
+					Key:   "refresh_interval",
+					Value: config.RefreshInterval,
+				})
+			}
+		}
+
+		cfg = append(cfg, yaml.MapItem{
+			Key:   "marathon_sd_configs",
+			Value: configs,
+		})
+	}
+
+	// UyuniSDConfig
+	if len(sc.Spec.UyuniSDConfigs) > 0 {
+		configs := make([][]yaml.MapItem, len(sc.Spec.UyuniSDConfigs))
+		for i, config := range sc.Spec.UyuniSDConfigs {
+			configs[i] = append(configs[i], yaml.MapItem{
+				Key:   "server",
+				Value: config.Server,
+			})
+
+			configs[i] = append(configs[i], yaml.MapItem{
+				Key:   "username",
+				Value: config.Username,
+			})
+
+			value, err := s.GetSecretKey(config.Password)
+			if err != nil {
+				return cfg, fmt.Errorf("failed to read %s secret %s: %w", config.Password.Name, jobName, err)
+			}
+
+			configs[i] = append(configs[i], yaml.MapItem{
+				Key:   "password",
+				Value: string(value),
+			})
+
+			if config.Entitlement != nil {
+				configs[i] = append(configs[i], yaml.MapItem{
+					Key:   "entitlement",
+					Value: config.Entitlement,
+				})
+			}
+
+			if config.Separator != nil {
+				configs[i] = append(configs[i], yaml.MapItem{
+					Key:   "separator",
+					Value: config.Separator,
+				})
+			}
+
+			configs[i] = cg.addSafeAuthorizationToYaml(configs[i], s, config.Authorization)
+			configs[i] = cg.addOAuth2ToYaml(configs[i], s, config.OAuth2)
+			configs[i] = cg.addProxyConfigtoYaml(configs[i], s, config.ProxyConfig)
+			configs[i] = cg.addSafeTLStoYaml(configs[i], s, config.TLSConfig)
+
+			if config.FollowRedirects != nil {
+				configs[i] = append(configs[i], yaml.MapItem{
+					Key:   "follow_redirects",
+					Value: config.FollowRedirects,
+				})
+			}
+
+			if config.EnableHTTP2 != nil {
+				configs[i] = append(configs[i], yaml.MapItem{
+					Key:   "enable_http2",
+					Value: config.EnableHTTP2,
+				})
+			}
+
+			if config.RefreshInterval != nil {
+				configs[i] = append(configs[i], yaml.MapItem{
+					Key:   "refresh_interval",
+					Value: config.RefreshInterval,
+				})
+			}
+		}
+
+		cfg = append(cfg, yaml.MapItem{
+			Key:   "uyuni_sd_configs",
+			Value: configs,
+		})
+	}
+
+	// ServersetSDConfig
+	if len(sc.Spec.ServersetSDConfigs) > 0 {
+		configs := make([][]yaml.MapItem, len(sc.Spec.ServersetSDConfigs))
+		for i, config := range sc.Spec.ServersetSDConfigs {
+			configs[i] = cg.addZookeeperSDConfigToYaml(configs[i], config.Servers, config.Paths, config.Timeout)
+		}
+
+		cfg = cg.AppendMapItem(cfg, "serverset_sd_configs", configs)
+	}
+
+	// NerveSDConfig
+	if len(sc.Spec.NerveSDConfigs) > 0 {
+		configs := make([][]yaml.MapItem, len(sc.Spec.NerveSDConfigs))
+		for i, config := range sc.Spec.NerveSDConfigs {
+			configs[i] = cg.addZookeeperSDConfigToYaml(configs[i], config.Servers, config.Paths, config.Timeout)
+		}
+
+		cfg = cg.AppendMapItem(cfg, "nerve_sd_configs", configs)
+	}
+
 	if len(sc.Spec.RelabelConfigs) > 0 {
 		relabelings = append(relabelings, generateRelabelConfig(labeler.GetRelabelingConfigs(sc.TypeMeta, sc.ObjectMeta, sc.Spec.RelabelConfigs))...)
 	}
@@ -4745,7 +5334,7 @@ func (cg *ConfigGenerator) generateScrapeConfig(
 }
 
 func (cg *ConfigGenerator) appendOTLPConfig(cfg yaml.MapSlice) (yaml.MapSlice, error) {
-	otlpConfig := cg.prom.GetCommonPrometheusFields().OTLP
+	otlpConfig := cg.mergeOTLPTenants(cg.prom.GetCommonPrometheusFields().OTLP)
 	nameValidationScheme := cg.prom.GetCommonPrometheusFields().NameValidationScheme
 
 	if otlpConfig == nil {
@@ -4797,6 +5386,45 @@ func (cg *ConfigGenerator) appendOTLPConfig(cfg yaml.MapSlice) (yaml.MapSlice, e
 	return cg.AppendMapItem(cfg, "otlp", otlp), nil
 }
 
+// mergeOTLPTenants returns the OTLP configuration resulting from the merge
+// of the given configuration with the selected OTLPTenant resources.
+// Resource attributes are appended in the lexicographical order of the
+// tenant keys and the translation strategy of the first tenant defining one
+// applies if the Prometheus resource doesn't define it.
+func (cg *ConfigGenerator) mergeOTLPTenants(otlpConfig *monitoringv1.OTLPConfig) *monitoringv1.OTLPConfig {
+	if len(cg.otlpTenants) == 0 {
+		return otlpConfig
+	}
+
+	if otlpConfig == nil {
+		otlpConfig = &monitoringv1.OTLPConfig{}
+	} else {
+		otlpConfig = otlpConfig.DeepCopy()
+	}
+
+	keys := make([]string, 0, len(cg.otlpTenants))
+	for k := range cg.otlpTenants {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+
+	for _, k := range keys {
+		tenant := cg.otlpTenants[k]
+
+		for _, attr := range tenant.Spec.PromoteResourceAttributes {
+			if !slices.Contains(otlpConfig.PromoteResourceAttributes, attr) {
+				otlpConfig.PromoteResourceAttributes = append(otlpConfig.PromoteResourceAttributes, attr)
+			}
+		}
+
+		if otlpConfig.TranslationStrategy == nil && tenant.Spec.TranslationStrategy != nil {
+			otlpConfig.TranslationStrategy = ptr.To(*tenant.Spec.TranslationStrategy)
+		}
+	}
+
+	return otlpConfig
+}
+
 func (cg *ConfigGenerator) appendTracingConfig(cfg yaml.MapSlice, s assets.StoreGetter) (yaml.MapSlice, error) {
 	tracingConfig := cg.prom.GetCommonPrometheusFields().TracingConfig
 	if tracingConfig == nil {
@@ -4949,6 +5577,21 @@ func isByteSizeEmpty(v *monitoringv1.ByteSize) bool {
 	return v == nil || *v == ""
 }
 
+// addZookeeperSDConfigToYaml appends the fields shared by the Zookeeper-based
+// service discovery mechanisms (Serverset and Nerve).
+func (cg *ConfigGenerator) addZookeeperSDConfigToYaml(cfg yaml.MapSlice, servers, paths []string, timeout *monitoringv1.Duration) yaml.MapSlice {
+	cfg = append(cfg,
+		yaml.MapItem{Key: "servers", Value: servers},
+		yaml.MapItem{Key: "paths", Value: paths},
+	)
+
+	if timeout != nil {
+		cfg = append(cfg, yaml.MapItem{Key: "timeout", Value: timeout})
+	}
+
+	return cfg
+}
+
 func (cg *ConfigGenerator) addFiltersToYaml(cfg yaml.MapSlice, filters []monitoringv1alpha1.Filter) yaml.MapSlice {
 	if len(filters) == 0 {
 		return cfg
@@ -18,6 +18,9 @@ import (
 	"fmt"
 	"log/slog"
 	"os"
+	"regexp"
+	"slices"
+	"strconv"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -731,6 +734,220 @@ func TestProbeIngressSDConfigGeneration(t *testing.T) {
 	golden.Assert(t, string(cfg), "ProbeIngressSDConfigGeneration.golden")
 }
 
+func TestProbeServiceSDConfigGeneration(t *testing.T) {
+	p := defaultPrometheus()
+
+	cg := mustNewConfigGenerator(t, p)
+	cfg, err := cg.GenerateServerConfiguration(
+		p,
+		nil,
+		nil,
+		map[string]*monitoringv1.Probe{
+			"probe1": {
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "testprobe1",
+					Namespace: "default",
+				},
+				Spec: monitoringv1.ProbeSpec{
+					ProberSpec: monitoringv1.ProberSpec{
+						Scheme: "http",
+						URL:    "blackbox.exporter.io",
+						Path:   "/probe",
+					},
+					Module: "tcp_connect",
+					Targets: monitoringv1.ProbeTargets{
+						Service: &monitoringv1.ProbeTargetService{
+							Selector: metav1.LabelSelector{
+								MatchLabels: map[string]string{
+									"prometheus.io/probe": "true",
+								},
+							},
+							Port: ptr.To("web"),
+							RelabelConfigs: []monitoringv1.RelabelConfig{
+								{
+									TargetLabel: "foo",
+									Replacement: ptr.To("bar"),
+									Action:      "replace",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		nil,
+		&assets.StoreBuilder{},
+		nil,
+		nil,
+		nil,
+		nil,
+	)
+	require.NoError(t, err)
+
+	golden.Assert(t, string(cfg), "ProbeServiceSDConfigGeneration.golden")
+}
+
+func TestProbeServicePortIsEscaped(t *testing.T) {
+	p := defaultPrometheus()
+
+	cg := mustNewConfigGenerator(t, p)
+	cfg, err := cg.GenerateServerConfiguration(
+		p,
+		nil,
+		nil,
+		map[string]*monitoringv1.Probe{
+			"probe1": {
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "testprobe1",
+					Namespace: "default",
+				},
+				Spec: monitoringv1.ProbeSpec{
+					ProberSpec: monitoringv1.ProberSpec{
+						URL: "blackbox.exporter.io",
+					},
+					Targets: monitoringv1.ProbeTargets{
+						Service: &monitoringv1.ProbeTargetService{
+							Port: ptr.To("web.*"),
+						},
+					},
+				},
+			},
+		},
+		nil,
+		&assets.StoreBuilder{},
+		nil,
+		nil,
+		nil,
+		nil,
+	)
+	require.NoError(t, err)
+
+	require.Contains(t, string(cfg), `regex: web\.\*`)
+}
+
+func TestProbeHTTPRouteConfigGeneration(t *testing.T) {
+	p := defaultPrometheus()
+
+	cg := mustNewConfigGenerator(t, p).WithProbeHTTPRoutes(map[string][]HTTPRouteTargets{
+		"default/testprobe1": {
+			{
+				Namespace: "default",
+				Name:      "route1",
+				URLs:      []string{"https://example.com/", "https://example.com/api"},
+			},
+			{
+				Namespace: "web",
+				Name:      "route2",
+				URLs:      []string{"https://web.example.com/"},
+			},
+		},
+	})
+	cfg, err := cg.GenerateServerConfiguration(
+		p,
+		nil,
+		nil,
+		map[string]*monitoringv1.Probe{
+			"probe1": {
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "testprobe1",
+					Namespace: "default",
+				},
+				Spec: monitoringv1.ProbeSpec{
+					ProberSpec: monitoringv1.ProberSpec{
+						Scheme: "http",
+						URL:    "blackbox.exporter.io",
+						Path:   "/probe",
+					},
+					Module: "http_2xx",
+					Targets: monitoringv1.ProbeTargets{
+						HTTPRoute: &monitoringv1.ProbeTargetHTTPRoute{
+							Selector: metav1.LabelSelector{
+								MatchLabels: map[string]string{
+									"prometheus.io/probe": "true",
+								},
+							},
+							NamespaceSelector: monitoringv1.NamespaceSelector{
+								Any: true,
+							},
+							Scheme: ptr.To("https"),
+						},
+					},
+				},
+			},
+		},
+		nil,
+		&assets.StoreBuilder{},
+		nil,
+		nil,
+		nil,
+		nil,
+	)
+	require.NoError(t, err)
+
+	golden.Assert(t, string(cfg), "ProbeHTTPRouteConfigGeneration.golden")
+}
+
+func TestProbeManagedProberConfigGeneration(t *testing.T) {
+	p := defaultPrometheus()
+	p.Spec.ManagedProber = &monitoringv1.ManagedProberSpec{}
+
+	cg := mustNewConfigGenerator(t, p).WithManagedProber(BlackboxExporterAddress(p))
+	cfg, err := cg.GenerateServerConfiguration(
+		p,
+		nil,
+		nil,
+		map[string]*monitoringv1.Probe{
+			"probe1": {
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "testprobe1",
+					Namespace: "default",
+				},
+				Spec: monitoringv1.ProbeSpec{
+					Module: "http_2xx",
+					Modules: []monitoringv1.ProbeModule{
+						{
+							Name:   "http_2xx",
+							Prober: "http",
+						},
+					},
+					Targets: monitoringv1.ProbeTargets{
+						StaticConfig: &monitoringv1.ProbeTargetStaticConfig{
+							Targets: []string{"prometheus.io"},
+						},
+					},
+				},
+			},
+			"probe2": {
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "testprobe2",
+					Namespace: "default",
+				},
+				Spec: monitoringv1.ProbeSpec{
+					ProberSpec: monitoringv1.ProberSpec{
+						URL:  "blackbox.exporter.io",
+						Path: "/probe",
+					},
+					Module: "http_2xx",
+					Targets: monitoringv1.ProbeTargets{
+						StaticConfig: &monitoringv1.ProbeTargetStaticConfig{
+							Targets: []string{"prometheus.io"},
+						},
+					},
+				},
+			},
+		},
+		nil,
+		&assets.StoreBuilder{},
+		nil,
+		nil,
+		nil,
+		nil,
+	)
+	require.NoError(t, err)
+
+	golden.Assert(t, string(cfg), "ProbeManagedProberConfigGeneration.golden")
+}
+
 func TestProbeIngressSDConfigGenerationWithShards(t *testing.T) {
 	p := defaultPrometheus()
 	p.Spec.Shards = ptr.To(int32(2))
@@ -1550,6 +1767,90 @@ func TestAdditionalScrapeConfigs(t *testing.T) {
 	}
 }
 
+func TestConsistentShardingConfigGeneration(t *testing.T) {
+	p := defaultPrometheus()
+	p.Spec.Shards = ptr.To(int32(3))
+	p.Spec.ShardingStrategy = ptr.To(monitoringv1.ConsistentShardingStrategy)
+
+	cg := mustNewConfigGenerator(t, p)
+	cfg, err := cg.GenerateServerConfiguration(
+		p,
+		map[string]*monitoringv1.ServiceMonitor{"monitor": defaultServiceMonitor()},
+		nil,
+		nil,
+		nil,
+		&assets.StoreBuilder{},
+		nil,
+		nil,
+		nil,
+		nil,
+	)
+	require.NoError(t, err)
+	golden.Assert(t, string(cfg), "ConsistentShardingConfigGeneration.golden")
+}
+
+func TestConsistentShardingAssignment(t *testing.T) {
+	var previous [][]int
+	for shards := int32(1); shards <= 20; shards++ {
+		assignment := consistentShardingAssignment(shards)
+		require.Len(t, assignment, int(shards))
+
+		// All buckets are assigned exactly once and evenly.
+		owner := map[int]int{}
+		for shard, buckets := range assignment {
+			require.True(t, slices.IsSorted(buckets))
+			require.InDelta(t, float64(consistentShardingBuckets)/float64(shards), len(buckets), 1)
+
+			for _, b := range buckets {
+				_, found := owner[b]
+				require.False(t, found, "bucket %d assigned twice", b)
+				owner[b] = shard
+			}
+		}
+		require.Len(t, owner, consistentShardingBuckets)
+
+		// Only the buckets of the new shard have moved.
+		for shard, buckets := range previous {
+			for _, b := range buckets {
+				if owner[b] != shard {
+					require.Equal(t, int(shards-1), owner[b], "bucket %d moved from shard %d to shard %d", b, shard, owner[b])
+				}
+			}
+		}
+
+		previous = assignment
+	}
+}
+
+func TestBucketsRegex(t *testing.T) {
+	for shards := int32(1); shards <= 32; shards++ {
+		for shard, buckets := range consistentShardingAssignment(shards) {
+			re := regexp.MustCompile("^(?:" + bucketsRegex(buckets) + ")$")
+			for b := range consistentShardingBuckets {
+				require.Equal(t, slices.Contains(buckets, b), re.MatchString(strconv.Itoa(b)), "shards=%d shard=%d bucket=%d", shards, shard, b)
+			}
+			require.False(t, re.MatchString("01"))
+		}
+	}
+
+	all := make([]int, consistentShardingBuckets)
+	for i := range all {
+		all[i] = i
+	}
+
+	for _, tc := range []struct {
+		buckets  []int
+		expected string
+	}{
+		{buckets: all, expected: "[0-9]|[1-9][0-9]|[1-9][0-9][0-9]|10[0-1][0-9]|102[0-3]"},
+		{buckets: []int{3, 4, 5, 7, 12, 13, 14, 15, 16, 17, 18, 19, 20}, expected: "[3-5]|7|1[2-9]|20"},
+		{buckets: []int{998, 999, 1000}, expected: "99[8-9]|1000"},
+		{buckets: []int{150, 151, 152, 153, 154, 155, 156, 157, 158, 159, 160, 161}, expected: "15[0-9]|16[0-1]"},
+	} {
+		require.Equal(t, tc.expected, bucketsRegex(tc.buckets))
+	}
+}
+
 func TestAdditionalAlertRelabelConfigs(t *testing.T) {
 	p := defaultPrometheus()
 	p.Spec.Alerting = &monitoringv1.AlertingSpec{
@@ -9489,6 +9790,7 @@ func TestAppendConvertClassicHistogramsToNHCB(t *testing.T) {
 func TestOTLPConfig(t *testing.T) {
 	testCases := []struct {
 		otlpConfig    *monitoringv1.OTLPConfig
+		otlpTenants   map[string]*monitoringv1alpha1.OTLPTenant
 		nameValScheme *monitoringv1.NameValidationSchemeOptions
 		name          string
 		version       string
@@ -9609,6 +9911,40 @@ func TestOTLPConfig(t *testing.T) {
 			},
 			golden: "OTLPConfig_Config_convert_histograms_to_nhcb_with_old_version.golden",
 		},
+		{
+			name:    "Config OTLP tenants without OTLP config",
+			version: "v3.0.0",
+			otlpTenants: map[string]*monitoringv1alpha1.OTLPTenant{
+				"team-b/tenant": {
+					Spec: monitoringv1alpha1.OTLPTenantSpec{
+						PromoteResourceAttributes: []string{"k8s.pod.name", "service.version"},
+					},
+				},
+				"team-a/tenant": {
+					Spec: monitoringv1alpha1.OTLPTenantSpec{
+						PromoteResourceAttributes: []string{"k8s.namespace.name", "k8s.pod.name"},
+						TranslationStrategy:       ptr.To(monitoringv1.NoUTF8EscapingWithSuffixes),
+					},
+				},
+			},
+			golden: "OTLPConfig_Config_tenants.golden",
+		},
+		{
+			name:    "Config OTLP tenants merged with OTLP config",
+			version: "v3.0.0",
+			otlpConfig: &monitoringv1.OTLPConfig{
+				PromoteResourceAttributes: []string{"service.instance.id", "k8s.pod.name"},
+				TranslationStrategy:       ptr.To(monitoringv1.UnderscoreEscapingWithSuffixes),
+			},
+			otlpTenants: map[string]*monitoringv1alpha1.OTLPTenant{
+				"team-a/tenant": {
+					Spec: monitoringv1alpha1.OTLPTenantSpec{
+						PromoteResourceAttributes: []string{"k8s.pod.name", "k8s.namespace.name"},
+					},
+				},
+			},
+			golden: "OTLPConfig_Config_tenants_merged.golden",
+		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -9623,7 +9959,7 @@ func TestOTLPConfig(t *testing.T) {
 
 			p.Spec.CommonPrometheusFields.NameValidationScheme = tc.nameValScheme
 
-			cg := mustNewConfigGenerator(t, p)
+			cg := mustNewConfigGenerator(t, p).WithOTLPTenants(tc.otlpTenants)
 
 			cfg, err := cg.GenerateServerConfiguration(
 				p,
@@ -9647,6 +9983,105 @@ func TestOTLPConfig(t *testing.T) {
 	}
 }
 
+func TestRemoteWriteResources(t *testing.T) {
+	remoteWrites := map[string]*monitoringv1alpha1.RemoteWrite{
+		"team-b/backend": {
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "backend",
+				Namespace: "team-b",
+			},
+			Spec: monitoringv1.RemoteWriteSpec{
+				URL: "http://team-b.example.com/api/v1/write",
+			},
+		},
+		"team-a/backend": {
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "backend",
+				Namespace: "team-a",
+			},
+			Spec: monitoringv1.RemoteWriteSpec{
+				URL:  "https://team-a.example.com/api/v1/write",
+				Name: ptr.To("team-a"),
+				BasicAuth: &monitoringv1.BasicAuth{
+					Username: v1.SecretKeySelector{
+						LocalObjectReference: v1.LocalObjectReference{Name: "remote-write"},
+						Key:                  "username",
+					},
+					Password: v1.SecretKeySelector{
+						LocalObjectReference: v1.LocalObjectReference{Name: "remote-write"},
+						Key:                  "password",
+					},
+				},
+				WriteRelabelConfigs: []monitoringv1.RelabelConfig{
+					{
+						SourceLabels: []monitoringv1.LabelName{"__name__"},
+						Regex:        "up",
+						Action:       "keep",
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range []struct {
+		name                   string
+		remoteWrite            []monitoringv1.RemoteWriteSpec
+		enforcedNamespaceLabel string
+		golden                 string
+	}{
+		{
+			name:   "remote write resources only",
+			golden: "RemoteWriteResources.golden",
+		},
+		{
+			name: "remote write resources with enforced namespace label",
+			remoteWrite: []monitoringv1.RemoteWriteSpec{
+				{
+					URL: "http://central.example.com/api/v1/write",
+				},
+			},
+			enforcedNamespaceLabel: "tenant",
+			golden:                 "RemoteWriteResources_EnforcedNamespaceLabel.golden",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			store := assets.NewTestStoreBuilder(
+				&v1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "remote-write",
+						Namespace: "team-a",
+					},
+					Data: map[string][]byte{
+						"username": []byte("team-a"),
+						"password": []byte("secret"),
+					},
+				},
+			)
+
+			p := defaultPrometheus()
+			p.Spec.CommonPrometheusFields.RemoteWrite = tc.remoteWrite
+			p.Spec.CommonPrometheusFields.EnforcedNamespaceLabel = tc.enforcedNamespaceLabel
+
+			cg := mustNewConfigGenerator(t, p).WithRemoteWrites(remoteWrites)
+
+			cfg, err := cg.GenerateServerConfiguration(
+				p,
+				nil,
+				nil,
+				nil,
+				nil,
+				store,
+				nil,
+				nil,
+				nil,
+				nil,
+			)
+			require.NoError(t, err)
+			golden.Assert(t, string(cfg), tc.golden)
+		})
+	}
+}
+
 func TestTracingConfig(t *testing.T) {
 	samplingTwo := resource.MustParse("0.5")
 	testCases := []struct {
@@ -12001,6 +12436,184 @@ func TestScrapeConfigSpecConfigWithIonosSD(t *testing.T) {
 	}
 }
 
+func TestScrapeConfigSpecConfigWithVultrMarathonUyuniSD(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		scSpec monitoringv1alpha1.ScrapeConfigSpec
+		golden string
+	}{
+		{
+			name: "vultr_sd_config",
+			scSpec: monitoringv1alpha1.ScrapeConfigSpec{
+				VultrSDConfigs: []monitoringv1alpha1.VultrSDConfig{
+					{
+						Authorization: &monitoringv1.SafeAuthorization{
+							Credentials: &v1.SecretKeySelector{
+								LocalObjectReference: v1.LocalObjectReference{
+									Name: "secret",
+								},
+								Key: "credential",
+							},
+						},
+						FollowRedirects: ptr.To(true),
+						EnableHTTP2:     ptr.To(true),
+						Port:            ptr.To(int32(9100)),
+						RefreshInterval: (*monitoringv1.Duration)(ptr.To("30s")),
+					},
+				},
+			},
+			golden: "ScrapeConfigSpecConfig_VultrSD.golden",
+		},
+		{
+			name: "marathon_sd_config",
+			scSpec: monitoringv1alpha1.ScrapeConfigSpec{
+				MarathonSDConfigs: []monitoringv1alpha1.MarathonSDConfig{
+					{
+						Servers: []string{
+							"https://marathon-1.example.com:8080",
+							"https://marathon-2.example.com:8080",
+						},
+						AuthToken: &v1.SecretKeySelector{
+							LocalObjectReference: v1.LocalObjectReference{
+								Name: "secret",
+							},
+							Key: "token",
+						},
+						RefreshInterval: (*monitoringv1.Duration)(ptr.To("30s")),
+					},
+				},
+			},
+			golden: "ScrapeConfigSpecConfig_MarathonSD.golden",
+		},
+		{
+			name: "uyuni_sd_config",
+			scSpec: monitoringv1alpha1.ScrapeConfigSpec{
+				UyuniSDConfigs: []monitoringv1alpha1.UyuniSDConfig{
+					{
+						Server:   "https://uyuni.example.com",
+						Username: "admin",
+						Password: v1.SecretKeySelector{
+							LocalObjectReference: v1.LocalObjectReference{
+								Name: "secret",
+							},
+							Key: "credential",
+						},
+						Entitlement:     ptr.To("monitoring_entitled"),
+						Separator:       ptr.To(","),
+						RefreshInterval: (*monitoringv1.Duration)(ptr.To("1m")),
+					},
+				},
+			},
+			golden: "ScrapeConfigSpecConfig_UyuniSD.golden",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			store := assets.NewTestStoreBuilder(
+				&v1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "secret",
+						Namespace: "default",
+					},
+					Data: map[string][]byte{
+						"token":      []byte("value"),
+						"credential": []byte("value"),
+					},
+				},
+			)
+
+			scs := map[string]*monitoringv1alpha1.ScrapeConfig{
+				"sc": {
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "testscrapeconfig1",
+						Namespace: "default",
+					},
+					Spec: tc.scSpec,
+				},
+			}
+
+			p := defaultPrometheus()
+			cg := mustNewConfigGenerator(t, p)
+			cfg, err := cg.GenerateServerConfiguration(
+				p,
+				nil,
+				nil,
+				nil,
+				scs,
+				store,
+				nil,
+				nil,
+				nil,
+				nil,
+			)
+			require.NoError(t, err)
+			golden.Assert(t, string(cfg), tc.golden)
+		})
+	}
+}
+
+func TestScrapeConfigSpecConfigWithZookeeperSD(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		scSpec monitoringv1alpha1.ScrapeConfigSpec
+		golden string
+	}{
+		{
+			name: "serverset_sd_config",
+			scSpec: monitoringv1alpha1.ScrapeConfigSpec{
+				ServersetSDConfigs: []monitoringv1alpha1.ServersetSDConfig{
+					{
+						Servers: []string{"zk-1:2181", "zk-2:2181"},
+						Paths:   []string{"/aurora/jobs"},
+						Timeout: (*monitoringv1.Duration)(ptr.To("15s")),
+					},
+				},
+			},
+			golden: "ScrapeConfigSpecConfig_ServersetSD.golden",
+		},
+		{
+			name: "nerve_sd_config",
+			scSpec: monitoringv1alpha1.ScrapeConfigSpec{
+				NerveSDConfigs: []monitoringv1alpha1.NerveSDConfig{
+					{
+						Servers: []string{"zk-1:2181"},
+						Paths:   []string{"/nerve/services/api", "/nerve/services/web"},
+					},
+				},
+			},
+			golden: "ScrapeConfigSpecConfig_NerveSD.golden",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			scs := map[string]*monitoringv1alpha1.ScrapeConfig{
+				"sc": {
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "testscrapeconfig1",
+						Namespace: "default",
+					},
+					Spec: tc.scSpec,
+				},
+			}
+
+			p := defaultPrometheus()
+			cg := mustNewConfigGenerator(t, p)
+			cfg, err := cg.GenerateServerConfiguration(
+				p,
+				nil,
+				nil,
+				nil,
+				scs,
+				assets.NewTestStoreBuilder(),
+				nil,
+				nil,
+				nil,
+				nil,
+			)
+			require.NoError(t, err)
+			golden.Assert(t, string(cfg), tc.golden)
+		})
+	}
+}
+
 func TestServiceMonitorWithDefaultScrapeClassRelabelings(t *testing.T) {
 	p := defaultPrometheus()
 	serviceMonitor := defaultServiceMonitor()
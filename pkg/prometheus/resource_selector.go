@@ -21,6 +21,7 @@ import (
 	"log/slog"
 	"net/url"
 	"regexp"
+	"slices"
 	"strings"
 
 	"github.com/asaskevich/govalidator"
@@ -56,6 +57,71 @@ type ResourceSelector struct {
 
 type ListAllByNamespaceFn func(namespace string, selector labels.Selector, appendFn cache.AppendFunc) error
 
+// ConfigurationResource is the set of configuration resources which can be
+// selected by Prometheus and PrometheusAgent.
+type ConfigurationResource interface {
+	*monitoringv1.ServiceMonitor | *monitoringv1.PodMonitor | *monitoringv1.Probe | *monitoringv1alpha1.ScrapeConfig | *monitoringv1alpha1.OTLPTenant | *monitoringv1alpha1.RemoteWrite
+	metav1.Object
+}
+
+// TypedConfigurationResource holds a selected configuration resource and the
+// result of its validation.
+type TypedConfigurationResource[T ConfigurationResource] struct {
+	resource T
+	err      error
+	reason   string
+}
+
+// Resource returns the configuration resource.
+func (r TypedConfigurationResource[T]) Resource() T {
+	return r.resource
+}
+
+// Err returns the reason why the resource has been rejected or nil if the
+// resource is valid.
+func (r TypedConfigurationResource[T]) Err() error {
+	return r.err
+}
+
+// Conditions returns the status conditions of the resource with regard to
+// the workload which selected it.
+func (r TypedConfigurationResource[T]) Conditions() []monitoringv1.ConfigResourceCondition {
+	return []monitoringv1.ConfigResourceCondition{
+		operator.NewConfigResourceCondition(r.resource, r.reason, r.err),
+	}
+}
+
+// TypedResourcesSelection holds the configuration resources (valid or not)
+// selected by a workload, indexed by their "<namespace>/<name>" key.
+type TypedResourcesSelection[T ConfigurationResource] map[string]TypedConfigurationResource[T]
+
+// ValidResources returns only the resources which passed the validation.
+func (s TypedResourcesSelection[T]) ValidResources() map[string]T {
+	res := make(map[string]T, len(s))
+	for k, r := range s {
+		if r.err != nil {
+			continue
+		}
+		res[k] = r.resource
+	}
+
+	return res
+}
+
+// keys returns the sorted keys of the valid resources.
+func (s TypedResourcesSelection[T]) keys() []string {
+	keys := make([]string, 0, len(s))
+	for k, r := range s {
+		if r.err != nil {
+			continue
+		}
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+
+	return keys
+}
+
 func NewResourceSelector(l *slog.Logger, p monitoringv1.PrometheusInterface, store *assets.StoreBuilder, namespaceInformers cache.SharedIndexInformer, metrics *operator.Metrics, eventRecorder record.EventRecorder) (*ResourceSelector, error) {
 	promVersion := operator.StringValOrDefault(p.GetCommonPrometheusFields().Version, operator.DefaultPrometheusVersion)
 	version, err := semver.ParseTolerant(promVersion)
@@ -78,7 +144,7 @@ func NewResourceSelector(l *slog.Logger, p monitoringv1.PrometheusInterface, sto
 // SelectServiceMonitors selects ServiceMonitors based on the selectors in the Prometheus CR and filters them
 // returning only those with a valid configuration. This function also populates authentication stores and performs validations against
 // scrape intervals and relabel configs.
-func (rs *ResourceSelector) SelectServiceMonitors(ctx context.Context, listFn ListAllByNamespaceFn) (map[string]*monitoringv1.ServiceMonitor, error) {
+func (rs *ResourceSelector) SelectServiceMonitors(ctx context.Context, listFn ListAllByNamespaceFn) (TypedResourcesSelection[*monitoringv1.ServiceMonitor], error) {
 	cpf := rs.p.GetCommonPrometheusFields()
 	objMeta := rs.p.GetObjectMeta()
 	namespaces := []string{}
@@ -125,11 +191,16 @@ func (rs *ResourceSelector) SelectServiceMonitors(ctx context.Context, listFn Li
 	}
 
 	var rejected int
-	res := make(map[string]*monitoringv1.ServiceMonitor, len(serviceMonitors))
+	res := make(TypedResourcesSelection[*monitoringv1.ServiceMonitor], len(serviceMonitors))
 	for namespaceAndName, sm := range serviceMonitors {
 		var err error
 		rejectFn := func(sm *monitoringv1.ServiceMonitor, err error) {
 			rejected++
+			res[namespaceAndName] = TypedConfigurationResource[*monitoringv1.ServiceMonitor]{
+				resource: sm,
+				err:      err,
+				reason:   operator.InvalidConfigurationEvent,
+			}
 			rs.l.Warn("skipping servicemonitor",
 				"error", err.Error(),
 				"servicemonitor", namespaceAndName,
@@ -219,17 +290,15 @@ func (rs *ResourceSelector) SelectServiceMonitors(ctx context.Context, listFn Li
 			continue
 		}
 
-		res[namespaceAndName] = sm
+		res[namespaceAndName] = TypedConfigurationResource[*monitoringv1.ServiceMonitor]{
+			resource: sm,
+		}
 	}
 
-	smKeys := []string{}
-	for k := range res {
-		smKeys = append(smKeys, k)
-	}
-	rs.l.Debug("selected ServiceMonitors", "servicemonitors", strings.Join(smKeys, ","), "namespace", objMeta.GetNamespace(), "prometheus", objMeta.GetName())
+	rs.l.Debug("selected ServiceMonitors", "servicemonitors", strings.Join(res.keys(), ","), "namespace", objMeta.GetNamespace(), "prometheus", objMeta.GetName())
 
 	if pKey, ok := rs.accessor.MetaNamespaceKey(rs.p); ok {
-		rs.metrics.SetSelectedResources(pKey, monitoringv1.ServiceMonitorsKind, len(res))
+		rs.metrics.SetSelectedResources(pKey, monitoringv1.ServiceMonitorsKind, len(res)-rejected)
 		rs.metrics.SetRejectedResources(pKey, monitoringv1.ServiceMonitorsKind, rejected)
 	}
 
@@ -394,7 +463,7 @@ func validateMonitorSelectorMechanism(selectorMechanism *monitoringv1.SelectorMe
 // SelectPodMonitors selects PodMonitors based on the selectors in the Prometheus CR and filters them
 // returning only those with a valid configuration. This function also populates authentication stores and performs validations against
 // scrape intervals and relabel configs.
-func (rs *ResourceSelector) SelectPodMonitors(ctx context.Context, listFn ListAllByNamespaceFn) (map[string]*monitoringv1.PodMonitor, error) {
+func (rs *ResourceSelector) SelectPodMonitors(ctx context.Context, listFn ListAllByNamespaceFn) (TypedResourcesSelection[*monitoringv1.PodMonitor], error) {
 	cpf := rs.p.GetCommonPrometheusFields()
 	objMeta := rs.p.GetObjectMeta()
 	namespaces := []string{}
@@ -441,11 +510,16 @@ func (rs *ResourceSelector) SelectPodMonitors(ctx context.Context, listFn ListAl
 	}
 
 	var rejected int
-	res := make(map[string]*monitoringv1.PodMonitor, len(podMonitors))
+	res := make(TypedResourcesSelection[*monitoringv1.PodMonitor], len(podMonitors))
 	for namespaceAndName, pm := range podMonitors {
 		var err error
 		rejectFn := func(pm *monitoringv1.PodMonitor, err error) {
 			rejected++
+			res[namespaceAndName] = TypedConfigurationResource[*monitoringv1.PodMonitor]{
+				resource: pm,
+				err:      err,
+				reason:   operator.InvalidConfigurationEvent,
+			}
 			rs.l.Warn("skipping podmonitor",
 				"error", err.Error(),
 				"podmonitor", namespaceAndName,
@@ -528,17 +602,15 @@ func (rs *ResourceSelector) SelectPodMonitors(ctx context.Context, listFn ListAl
 			continue
 		}
 
-		res[namespaceAndName] = pm
+		res[namespaceAndName] = TypedConfigurationResource[*monitoringv1.PodMonitor]{
+			resource: pm,
+		}
 	}
 
-	pmKeys := []string{}
-	for k := range res {
-		pmKeys = append(pmKeys, k)
-	}
-	rs.l.Debug("selected PodMonitors", "podmonitors", strings.Join(pmKeys, ","), "namespace", objMeta.GetNamespace(), "prometheus", objMeta.GetName())
+	rs.l.Debug("selected PodMonitors", "podmonitors", strings.Join(res.keys(), ","), "namespace", objMeta.GetNamespace(), "prometheus", objMeta.GetName())
 
 	if pKey, ok := rs.accessor.MetaNamespaceKey(rs.p); ok {
-		rs.metrics.SetSelectedResources(pKey, monitoringv1.PodMonitorsKind, len(res))
+		rs.metrics.SetSelectedResources(pKey, monitoringv1.PodMonitorsKind, len(res)-rejected)
 		rs.metrics.SetRejectedResources(pKey, monitoringv1.PodMonitorsKind, rejected)
 	}
 
@@ -548,7 +620,7 @@ func (rs *ResourceSelector) SelectPodMonitors(ctx context.Context, listFn ListAl
 // SelectProbes selects Probes based on the selectors in the Prometheus CR and filters them
 // returning only those with a valid configuration. This function also populates authentication stores and performs validations against
 // scrape intervals, relabel configs and Probe URLs.
-func (rs *ResourceSelector) SelectProbes(ctx context.Context, listFn ListAllByNamespaceFn) (map[string]*monitoringv1.Probe, error) {
+func (rs *ResourceSelector) SelectProbes(ctx context.Context, listFn ListAllByNamespaceFn) (TypedResourcesSelection[*monitoringv1.Probe], error) {
 	cpf := rs.p.GetCommonPrometheusFields()
 	objMeta := rs.p.GetObjectMeta()
 	namespaces := []string{}
@@ -594,11 +666,16 @@ func (rs *ResourceSelector) SelectProbes(ctx context.Context, listFn ListAllByNa
 	}
 
 	var rejected int
-	res := make(map[string]*monitoringv1.Probe, len(probes))
+	res := make(TypedResourcesSelection[*monitoringv1.Probe], len(probes))
 
 	for probeName, probe := range probes {
 		rejectFn := func(probe *monitoringv1.Probe, err error) {
 			rejected++
+			res[probeName] = TypedConfigurationResource[*monitoringv1.Probe]{
+				resource: probe,
+				err:      err,
+				reason:   operator.InvalidConfigurationEvent,
+			}
 			rs.l.Warn("skipping probe",
 				"error", err.Error(),
 				"probe", probeName,
@@ -674,34 +751,60 @@ func (rs *ResourceSelector) SelectProbes(ctx context.Context, listFn ListAllByNa
 			}
 		}
 
+		if probe.Spec.Targets.Service != nil {
+			if err = rs.ValidateRelabelConfigs(probe.Spec.Targets.Service.RelabelConfigs); err != nil {
+				err = fmt.Errorf("targets.service.relabelConfigs: %w", err)
+				rejectFn(probe, err)
+				continue
+			}
+		}
+
+		if probe.Spec.Targets.HTTPRoute != nil {
+			if err = rs.ValidateRelabelConfigs(probe.Spec.Targets.HTTPRoute.RelabelConfigs); err != nil {
+				err = fmt.Errorf("targets.httpRoute.relabelConfigs: %w", err)
+				rejectFn(probe, err)
+				continue
+			}
+		}
+
 		if err = validateProxyURL(&probe.Spec.ProberSpec.ProxyURL); err != nil {
 			rejectFn(probe, fmt.Errorf("proxyURL: %w", err))
 			continue
 		}
 
-		if err = validateProberURL(probe.Spec.ProberSpec.URL); err != nil {
+		if usesManagedProber(probe) && rs.hasManagedProber() {
+			if err = validateProbeModules(probe); err != nil {
+				rejectFn(probe, fmt.Errorf("modules: %w", err))
+				continue
+			}
+		} else if err = validateProberURL(probe.Spec.ProberSpec.URL); err != nil {
 			err := fmt.Errorf("%s url specified in proberSpec is invalid, it should be of the format `hostname` or `hostname:port`: %w", probe.Spec.ProberSpec.URL, err)
 			rejectFn(probe, err)
 			continue
 		}
 
-		res[probeName] = probe
+		res[probeName] = TypedConfigurationResource[*monitoringv1.Probe]{
+			resource: probe,
+		}
 	}
 
-	probeKeys := make([]string, 0)
-	for k := range res {
-		probeKeys = append(probeKeys, k)
-	}
-	rs.l.Debug("selected Probes", "probes", strings.Join(probeKeys, ","), "namespace", objMeta.GetNamespace(), "prometheus", objMeta.GetName())
+	rs.l.Debug("selected Probes", "probes", strings.Join(res.keys(), ","), "namespace", objMeta.GetNamespace(), "prometheus", objMeta.GetName())
 
 	if pKey, ok := rs.accessor.MetaNamespaceKey(rs.p); ok {
-		rs.metrics.SetSelectedResources(pKey, monitoringv1.ProbesKind, len(res))
+		rs.metrics.SetSelectedResources(pKey, monitoringv1.ProbesKind, len(res)-rejected)
 		rs.metrics.SetRejectedResources(pKey, monitoringv1.ProbesKind, rejected)
 	}
 
 	return res, nil
 }
 
+// hasManagedProber returns true if the operator manages a blackbox exporter
+// for the Prometheus object.
+func (rs *ResourceSelector) hasManagedProber() bool {
+	p, ok := rs.p.(*monitoringv1.Prometheus)
+	return ok && p.Spec.ManagedProber != nil
+}
+
 func validateProxyURL(proxyurl *string) error {
 	if proxyurl == nil {
 		return nil
@@ -743,7 +846,7 @@ func validateServer(server string) error {
 
 // SelectScrapeConfigs selects ScrapeConfigs based on the selectors in the Prometheus CR and filters them
 // returning only those with a valid configuration.
-func (rs *ResourceSelector) SelectScrapeConfigs(ctx context.Context, listFn ListAllByNamespaceFn) (map[string]*monitoringv1alpha1.ScrapeConfig, error) {
+func (rs *ResourceSelector) SelectScrapeConfigs(ctx context.Context, listFn ListAllByNamespaceFn) (TypedResourcesSelection[*monitoringv1alpha1.ScrapeConfig], error) {
 	cpf := rs.p.GetCommonPrometheusFields()
 	objMeta := rs.p.GetObjectMeta()
 	namespaces := []string{}
@@ -790,11 +893,16 @@ func (rs *ResourceSelector) SelectScrapeConfigs(ctx context.Context, listFn List
 	}
 
 	var rejected int
-	res := make(map[string]*monitoringv1alpha1.ScrapeConfig, len(scrapeConfigs))
+	res := make(TypedResourcesSelection[*monitoringv1alpha1.ScrapeConfig], len(scrapeConfigs))
 
 	for scName, sc := range scrapeConfigs {
 		rejectFn := func(sc *monitoringv1alpha1.ScrapeConfig, err error) {
 			rejected++
+			res[scName] = TypedConfigurationResource[*monitoringv1alpha1.ScrapeConfig]{
+				resource: sc,
+				err:      err,
+				reason:   operator.InvalidConfigurationEvent,
+			}
 			rs.l.Warn("skipping scrapeconfig",
 				"error", err.Error(),
 				"scrapeconfig", scName,
@@ -965,23 +1073,344 @@ func (rs *ResourceSelector) SelectScrapeConfigs(ctx context.Context, listFn List
 			continue
 		}
 
-		res[scName] = sc
-	}
+		if err = rs.validateVultrSDConfigs(ctx, sc); err != nil {
+			rejectFn(sc, fmt.Errorf("VultrSDConfigs: %w", err))
+			continue
+		}
+
+		if err = rs.validateMarathonSDConfigs(ctx, sc); err != nil {
+			rejectFn(sc, fmt.Errorf("MarathonSDConfigs: %w", err))
+			continue
+		}
+
+		if err = rs.validateUyuniSDConfigs(ctx, sc); err != nil {
+			rejectFn(sc, fmt.Errorf("UyuniSDConfigs: %w", err))
+			continue
+		}
+
+		if err = rs.validateServersetSDConfigs(sc); err != nil {
+			rejectFn(sc, fmt.Errorf("ServersetSDConfigs: %w", err))
+			continue
+		}
 
-	scrapeConfigKeys := make([]string, 0)
-	for k := range res {
-		scrapeConfigKeys = append(scrapeConfigKeys, k)
+		if err = rs.validateNerveSDConfigs(sc); err != nil {
+			rejectFn(sc, fmt.Errorf("NerveSDConfigs: %w", err))
+			continue
+		}
+
+		res[scName] = TypedConfigurationResource[*monitoringv1alpha1.ScrapeConfig]{
+			resource: sc,
+		}
 	}
-	rs.l.Debug("selected ScrapeConfigs", "scrapeConfig", strings.Join(scrapeConfigKeys, ","), "namespace", objMeta.GetNamespace(), "prometheus", objMeta.GetName())
+
+	rs.l.Debug("selected ScrapeConfigs", "scrapeConfig", strings.Join(res.keys(), ","), "namespace", objMeta.GetNamespace(), "prometheus", objMeta.GetName())
 
 	if sKey, ok := rs.accessor.MetaNamespaceKey(rs.p); ok {
-		rs.metrics.SetSelectedResources(sKey, monitoringv1alpha1.ScrapeConfigsKind, len(res))
+		rs.metrics.SetSelectedResources(sKey, monitoringv1alpha1.ScrapeConfigsKind, len(res)-rejected)
 		rs.metrics.SetRejectedResources(sKey, monitoringv1alpha1.ScrapeConfigsKind, rejected)
 	}
 
 	return res, nil
 }
 
+// SelectOTLPTenants selects OTLPTenants based on the selectors in the
+// Prometheus CR and filters them returning only those which are compatible
+// with the OTLP configuration of the Prometheus CR.
+//
+// Because the translation strategy is global to Prometheus, a tenant is
+// rejected when its strategy differs from `spec.otlp.translationStrategy` or,
+// if the latter isn't defined, from the strategy of the first tenant (in
+// lexicographical order of the "<namespace>/<name>" keys) which defines one.
+func (rs *ResourceSelector) SelectOTLPTenants(listFn ListAllByNamespaceFn) (TypedResourcesSelection[*monitoringv1alpha1.OTLPTenant], error) {
+	p, ok := rs.p.(*monitoringv1.Prometheus)
+	if !ok {
+		return nil, fmt.Errorf("OTLPTenant selection isn't supported for %T", rs.p)
+	}
+
+	objMeta := p.GetObjectMeta()
+	namespaces := []string{}
+
+	// Selectors might overlap. Deduplicate them along the keyFunc.
+	tenants := make(map[string]*monitoringv1alpha1.OTLPTenant)
+
+	tenantSelector, err := metav1.LabelSelectorAsSelector(p.Spec.OTLPTenantSelector)
+	if err != nil {
+		return nil, err
+	}
+
+	// If 'OTLPTenantNamespaceSelector' is nil only check own namespace.
+	if p.Spec.OTLPTenantNamespaceSelector == nil {
+		namespaces = append(namespaces, objMeta.GetNamespace())
+	} else {
+		tenantNSSelector, err := metav1.LabelSelectorAsSelector(p.Spec.OTLPTenantNamespaceSelector)
+		if err != nil {
+			return nil, err
+		}
+
+		namespaces, err = operator.ListMatchingNamespaces(tenantNSSelector, rs.namespaceInformers)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	rs.l.Debug("filtering namespaces to select OTLPTenants from", "namespaces", strings.Join(namespaces, ","), "namespace", objMeta.GetNamespace(), "prometheus", objMeta.GetName())
+
+	for _, ns := range namespaces {
+		err := listFn(ns, tenantSelector, func(obj interface{}) {
+			if k, ok := rs.accessor.MetaNamespaceKey(obj); ok {
+				tenant := obj.(*monitoringv1alpha1.OTLPTenant).DeepCopy()
+				if err := k8sutil.AddTypeInformationToObject(tenant); err != nil {
+					rs.l.Error("failed to set OTLPTenant type information", "namespace", ns, "err", err)
+					return
+				}
+				tenants[k] = tenant
+			}
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list OTLPTenants in namespace %s: %w", ns, err)
+		}
+	}
+
+	var (
+		rejected            int
+		res                 = make(TypedResourcesSelection[*monitoringv1alpha1.OTLPTenant], len(tenants))
+		translationStrategy *monitoringv1.TranslationStrategyOption
+		strategyOwner       = "spec.otlp.translationStrategy"
+	)
+
+	if p.Spec.OTLP != nil {
+		translationStrategy = p.Spec.OTLP.TranslationStrategy
+	}
+
+	keys := make([]string, 0, len(tenants))
+	for k := range tenants {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+
+	for _, tenantName := range keys {
+		tenant := tenants[tenantName]
+		rejectFn := func(tenant *monitoringv1alpha1.OTLPTenant, err error) {
+			rejected++
+			res[tenantName] = TypedConfigurationResource[*monitoringv1alpha1.OTLPTenant]{
+				resource: tenant,
+				err:      err,
+				reason:   operator.InvalidConfigurationEvent,
+			}
+			rs.l.Warn("skipping otlptenant",
+				"error", err.Error(),
+				"otlptenant", tenantName,
+				"namespace", objMeta.GetNamespace(),
+				"prometheus", objMeta.GetName(),
+			)
+			rs.eventRecorder.Eventf(tenant, v1.EventTypeWarning, operator.InvalidConfigurationEvent, "OTLPTenant %s was rejected due to invalid configuration: %v", tenant.GetName(), err)
+		}
+
+		if rs.version.LT(semver.MustParse("2.55.0")) {
+			rejectFn(tenant, fmt.Errorf("OTLP configuration is only supported from Prometheus version 2.55.0"))
+			continue
+		}
+
+		if ts := tenant.Spec.TranslationStrategy; ts != nil {
+			if *ts == monitoringv1.NoUTF8EscapingWithSuffixes && ptr.Deref(p.Spec.NameValidationScheme, "") == monitoringv1.LegacyNameValidationScheme {
+				rejectFn(tenant, fmt.Errorf("translationStrategy %q is not compatible with nameValidationScheme %q", *ts, monitoringv1.LegacyNameValidationScheme))
+				continue
+			}
+
+			if *ts == monitoringv1.NoTranslation && rs.version.LT(semver.MustParse("3.4.0")) {
+				rejectFn(tenant, fmt.Errorf("translationStrategy %q is only supported from Prometheus version 3.4.0", *ts))
+				continue
+			}
+
+			if translationStrategy != nil && *translationStrategy != *ts {
+				rejectFn(tenant, fmt.Errorf("translationStrategy %q conflicts with %q defined by %s", *ts, *translationStrategy, strategyOwner))
+				continue
+			}
+
+			if translationStrategy == nil {
+				translationStrategy = ts
+				strategyOwner = fmt.Sprintf("OTLPTenant %s", tenantName)
+			}
+		}
+
+		res[tenantName] = TypedConfigurationResource[*monitoringv1alpha1.OTLPTenant]{
+			resource: tenant,
+		}
+	}
+
+	rs.l.Debug("selected OTLPTenants", "otlptenants", strings.Join(res.keys(), ","), "namespace", objMeta.GetNamespace(), "prometheus", objMeta.GetName())
+
+	if sKey, ok := rs.accessor.MetaNamespaceKey(p); ok {
+		rs.metrics.SetSelectedResources(sKey, monitoringv1alpha1.OTLPTenantsKind, len(res)-rejected)
+		rs.metrics.SetRejectedResources(sKey, monitoringv1alpha1.OTLPTenantsKind, rejected)
+	}
+
+	return res, nil
+}
+
+// SelectRemoteWrites selects RemoteWrites based on the selectors in the
+// Prometheus or PrometheusAgent CR and filters them returning only those with
+// a valid configuration. The secrets and configmaps referenced by the valid
+// resources are loaded into the store.
+//
+// Because RemoteWrite resources are managed by tenants, the fields giving
+// access to the filesystem or to the ambient credentials of the Prometheus
+// pods are rejected.
+func (rs *ResourceSelector) SelectRemoteWrites(ctx context.Context, listFn ListAllByNamespaceFn) (TypedResourcesSelection[*monitoringv1alpha1.RemoteWrite], error) {
+	cpf := rs.p.GetCommonPrometheusFields()
+	objMeta := rs.p.GetObjectMeta()
+	namespaces := []string{}
+
+	// Selectors might overlap. Deduplicate them along the keyFunc.
+	remoteWrites := make(map[string]*monitoringv1alpha1.RemoteWrite)
+
+	rwSelector, err := metav1.LabelSelectorAsSelector(cpf.RemoteWriteSelector)
+	if err != nil {
+		return nil, err
+	}
+
+	// If 'RemoteWriteNamespaceSelector' is nil only check own namespace.
+	if cpf.RemoteWriteNamespaceSelector == nil {
+		namespaces = append(namespaces, objMeta.GetNamespace())
+	} else {
+		rwNSSelector, err := metav1.LabelSelectorAsSelector(cpf.RemoteWriteNamespaceSelector)
+		if err != nil {
+			return nil, err
+		}
+
+		namespaces, err = operator.ListMatchingNamespaces(rwNSSelector, rs.namespaceInformers)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	rs.l.Debug("filtering namespaces to select RemoteWrites from", "namespaces", strings.Join(namespaces, ","), "namespace", objMeta.GetNamespace(), "prometheus", objMeta.GetName())
+
+	for _, ns := range namespaces {
+		err := listFn(ns, rwSelector, func(obj interface{}) {
+			if k, ok := rs.accessor.MetaNamespaceKey(obj); ok {
+				rw := obj.(*monitoringv1alpha1.RemoteWrite).DeepCopy()
+				if err := k8sutil.AddTypeInformationToObject(rw); err != nil {
+					rs.l.Error("failed to set RemoteWrite type information", "namespace", ns, "err", err)
+					return
+				}
+				remoteWrites[k] = rw
+			}
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list RemoteWrites in namespace %s: %w", ns, err)
+		}
+	}
+
+	var (
+		rejected int
+		res      = make(TypedResourcesSelection[*monitoringv1alpha1.RemoteWrite], len(remoteWrites))
+		names    = map[string]string{}
+	)
+
+	for i, rw := range cpf.RemoteWrite {
+		if ptr.Deref(rw.Name, "") != "" {
+			names[*rw.Name] = fmt.Sprintf("spec.remoteWrite[%d]", i)
+		}
+	}
+
+	keys := make([]string, 0, len(remoteWrites))
+	for k := range remoteWrites {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+
+	for _, rwName := range keys {
+		rw := remoteWrites[rwName]
+		rejectFn := func(rw *monitoringv1alpha1.RemoteWrite, err error) {
+			rejected++
+			res[rwName] = TypedConfigurationResource[*monitoringv1alpha1.RemoteWrite]{
+				resource: rw,
+				err:      err,
+				reason:   operator.InvalidConfigurationEvent,
+			}
+			rs.l.Warn("skipping remotewrite",
+				"error", err.Error(),
+				"remotewrite", rwName,
+				"namespace", objMeta.GetNamespace(),
+				"prometheus", objMeta.GetName(),
+			)
+			rs.eventRecorder.Eventf(rw, v1.EventTypeWarning, operator.InvalidConfigurationEvent, "RemoteWrite %s was rejected due to invalid configuration: %v", rw.GetName(), err)
+		}
+
+		if err := validateTenantRemoteWrite(rw.Spec); err != nil {
+			rejectFn(rw, err)
+			continue
+		}
+
+		if err := rs.ValidateRelabelConfigs(rw.Spec.WriteRelabelConfigs); err != nil {
+			rejectFn(rw, fmt.Errorf("writeRelabelConfigs: %w", err))
+			continue
+		}
+
+		if err := addRemoteWriteToStore(ctx, rs.store, rw.GetNamespace(), rw.Spec); err != nil {
+			rejectFn(rw, err)
+			continue
+		}
+
+		if name := ptr.Deref(rw.Spec.Name, ""); name != "" {
+			if owner, found := names[name]; found {
+				rejectFn(rw, fmt.Errorf("name %q is already used by %s", name, owner))
+				continue
+			}
+			names[name] = fmt.Sprintf("RemoteWrite %s", rwName)
+		}
+
+		res[rwName] = TypedConfigurationResource[*monitoringv1alpha1.RemoteWrite]{
+			resource: rw,
+		}
+	}
+
+	rs.l.Debug("selected RemoteWrites", "remotewrites", strings.Join(res.keys(), ","), "namespace", objMeta.GetNamespace(), "prometheus", objMeta.GetName())
+
+	if pKey, ok := rs.accessor.MetaNamespaceKey(rs.p); ok {
+		rs.metrics.SetSelectedResources(pKey, monitoringv1alpha1.RemoteWritesKind, len(res)-rejected)
+		rs.metrics.SetRejectedResources(pKey, monitoringv1alpha1.RemoteWritesKind, rejected)
+	}
+
+	return res, nil
+}
+
+// validateTenantRemoteWrite rejects the remote write fields which would let
+// a tenant read files from the Prometheus container or reuse the ambient
+// cloud credentials of the Prometheus pods.
+func validateTenantRemoteWrite(spec monitoringv1.RemoteWriteSpec) error {
+	//nolint:staticcheck // Ignore SA1019 this field is marked as deprecated.
+	if spec.BearerToken != "" {
+		return fmt.Errorf("bearerToken is not allowed, use authorization instead")
+	}
+
+	//nolint:staticcheck // Ignore SA1019 this field is marked as deprecated.
+	if spec.BearerTokenFile != "" {
+		return fmt.Errorf("bearerTokenFile is not allowed, use authorization instead")
+	}
+
+	if spec.Authorization != nil && spec.Authorization.CredentialsFile != "" {
+		return fmt.Errorf("authorization: credentialsFile is not allowed")
+	}
+
+	if tls := spec.TLSConfig; tls != nil {
+		if tls.CAFile != "" || tls.CertFile != "" || tls.KeyFile != "" {
+			return fmt.Errorf("tlsConfig: caFile, certFile and keyFile are not allowed")
+		}
+	}
+
+	if spec.Sigv4 != nil && (spec.Sigv4.AccessKey == nil || spec.Sigv4.SecretKey == nil) {
+		return fmt.Errorf("sigv4: accessKey and secretKey are required")
+	}
+
+	if spec.AzureAD != nil && (spec.AzureAD.ManagedIdentity != nil || spec.AzureAD.SDK != nil) {
+		return fmt.Errorf("azureAd: only oauth is allowed")
+	}
+
+	return nil
+}
+
 func (rs *ResourceSelector) validateKubernetesSDConfigs(ctx context.Context, sc *monitoringv1alpha1.ScrapeConfig) error {
 	for i, config := range sc.Spec.KubernetesSDConfigs {
 		if err := rs.store.AddBasicAuth(ctx, sc.GetNamespace(), config.BasicAuth); err != nil {
@@ -1625,3 +2054,173 @@ func (rs *ResourceSelector) validateIonosSDConfigs(ctx context.Context, sc *moni
 	}
 	return nil
 }
+
+func (rs *ResourceSelector) validateVultrSDConfigs(ctx context.Context, sc *monitoringv1alpha1.ScrapeConfig) error {
+	if len(sc.Spec.VultrSDConfigs) == 0 {
+		return nil
+	}
+
+	if rs.version.LT(semver.MustParse("2.40.0")) {
+		return fmt.Errorf("vultr SD configuration is only supported for Prometheus version >= 2.40.0")
+	}
+
+	for i, config := range sc.Spec.VultrSDConfigs {
+		if config.Authorization != nil && config.OAuth2 != nil {
+			return fmt.Errorf("[%d]: authorization and oauth2 cannot be set at the same time", i)
+		}
+
+		if err := rs.store.AddSafeAuthorizationCredentials(ctx, sc.GetNamespace(), config.Authorization); err != nil {
+			return fmt.Errorf("[%d]: %w", i, err)
+		}
+
+		if err := rs.store.AddOAuth2(ctx, sc.GetNamespace(), config.OAuth2); err != nil {
+			return fmt.Errorf("[%d]: %w", i, err)
+		}
+
+		if err := rs.store.AddSafeTLSConfig(ctx, sc.GetNamespace(), config.TLSConfig); err != nil {
+			return fmt.Errorf("[%d]: %w", i, err)
+		}
+
+		if err := addProxyConfigToStore(ctx, config.ProxyConfig, rs.store, sc.GetNamespace()); err != nil {
+			return fmt.Errorf("[%d]: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+func (rs *ResourceSelector) validateMarathonSDConfigs(ctx context.Context, sc *monitoringv1alpha1.ScrapeConfig) error {
+	for i, config := range sc.Spec.MarathonSDConfigs {
+		for _, server := range config.Servers {
+			if err := validateServer(server); err != nil {
+				return fmt.Errorf("[%d]: %w", i, err)
+			}
+		}
+
+		var authMethods int
+		for _, set := range []bool{
+			config.AuthToken != nil,
+			config.BasicAuth != nil,
+			config.Authorization != nil,
+			config.OAuth2 != nil,
+		} {
+			if set {
+				authMethods++
+			}
+		}
+
+		if authMethods > 1 {
+			return fmt.Errorf("[%d]: at most one of authToken, basicAuth, authorization and oauth2 can be set", i)
+		}
+
+		if config.AuthToken != nil {
+			if _, err := rs.store.GetSecretKey(ctx, sc.GetNamespace(), *config.AuthToken); err != nil {
+				return fmt.Errorf("[%d]: %w", i, err)
+			}
+		}
+
+		if err := rs.store.AddBasicAuth(ctx, sc.GetNamespace(), config.BasicAuth); err != nil {
+			return fmt.Errorf("[%d]: %w", i, err)
+		}
+
+		if err := rs.store.AddSafeAuthorizationCredentials(ctx, sc.GetNamespace(), config.Authorization); err != nil {
+			return fmt.Errorf("[%d]: %w", i, err)
+		}
+
+		if err := rs.store.AddOAuth2(ctx, sc.GetNamespace(), config.OAuth2); err != nil {
+			return fmt.Errorf("[%d]: %w", i, err)
+		}
+
+		if err := rs.store.AddSafeTLSConfig(ctx, sc.GetNamespace(), config.TLSConfig); err != nil {
+			return fmt.Errorf("[%d]: %w", i, err)
+		}
+
+		if err := addProxyConfigToStore(ctx, config.ProxyConfig, rs.store, sc.GetNamespace()); err != nil {
+			return fmt.Errorf("[%d]: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+func (rs *ResourceSelector) validateUyuniSDConfigs(ctx context.Context, sc *monitoringv1alpha1.ScrapeConfig) error {
+	if len(sc.Spec.UyuniSDConfigs) == 0 {
+		return nil
+	}
+
+	if rs.version.LT(semver.MustParse("2.26.0")) {
+		return fmt.Errorf("uyuni SD configuration is only supported for Prometheus version >= 2.26.0")
+	}
+
+	for i, config := range sc.Spec.UyuniSDConfigs {
+		if err := validateServer(config.Server); err != nil {
+			return fmt.Errorf("[%d]: %w", i, err)
+		}
+
+		if config.Authorization != nil && config.OAuth2 != nil {
+			return fmt.Errorf("[%d]: authorization and oauth2 cannot be set at the same time", i)
+		}
+
+		if _, err := rs.store.GetSecretKey(ctx, sc.GetNamespace(), config.Password); err != nil {
+			return fmt.Errorf("[%d]: %w", i, err)
+		}
+
+		if err := rs.store.AddSafeAuthorizationCredentials(ctx, sc.GetNamespace(), config.Authorization); err != nil {
+			return fmt.Errorf("[%d]: %w", i, err)
+		}
+
+		if err := rs.store.AddOAuth2(ctx, sc.GetNamespace(), config.OAuth2); err != nil {
+			return fmt.Errorf("[%d]: %w", i, err)
+		}
+
+		if err := rs.store.AddSafeTLSConfig(ctx, sc.GetNamespace(), config.TLSConfig); err != nil {
+			return fmt.Errorf("[%d]: %w", i, err)
+		}
+
+		if err := addProxyConfigToStore(ctx, config.ProxyConfig, rs.store, sc.GetNamespace()); err != nil {
+			return fmt.Errorf("[%d]: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+func (rs *ResourceSelector) validateServersetSDConfigs(sc *monitoringv1alpha1.ScrapeConfig) error {
+	for i, config := range sc.Spec.ServersetSDConfigs {
+		if err := validateZookeeperSDConfig(config.Servers, config.Paths); err != nil {
+			return fmt.Errorf("[%d]: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+func (rs *ResourceSelector) validateNerveSDConfigs(sc *monitoringv1alpha1.ScrapeConfig) error {
+	for i, config := range sc.Spec.NerveSDConfigs {
+		if err := validateZookeeperSDConfig(config.Servers, config.Paths); err != nil {
+			return fmt.Errorf("[%d]: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+// validateZookeeperSDConfig mirrors the checks done by Prometheus for the
+// Zookeeper-based service discovery mechanisms (Serverset and Nerve).
+func validateZookeeperSDConfig(servers, paths []string) error {
+	if len(servers) == 0 {
+		return fmt.Errorf("servers must not be empty")
+	}
+
+	if len(paths) == 0 {
+		return fmt.Errorf("paths must not be empty")
+	}
+
+	for _, path := range paths {
+		if !strings.HasPrefix(path, "/") {
+			return fmt.Errorf("path %q must start with '/'", path)
+		}
+	}
+
+	return nil
+}
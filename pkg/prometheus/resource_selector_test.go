@@ -17,13 +17,16 @@ package prometheus
 import (
 	"context"
 	"log/slog"
+	"maps"
 	"os"
+	"slices"
 	"testing"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/prometheus/model/relabel"
 	"github.com/stretchr/testify/require"
 	v1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/client-go/kubernetes/fake"
@@ -437,10 +440,11 @@ func TestValidateRelabelConfig(t *testing.T) {
 
 func TestSelectProbes(t *testing.T) {
 	for _, tc := range []struct {
-		scenario    string
-		updateSpec  func(*monitoringv1.ProbeSpec)
-		selected    bool
-		scrapeClass *string
+		scenario      string
+		updateSpec    func(*monitoringv1.ProbeSpec)
+		selected      bool
+		scrapeClass   *string
+		managedProber bool
 	}{
 		{
 			scenario: "url starting with http",
@@ -635,10 +639,90 @@ func TestSelectProbes(t *testing.T) {
 			},
 			selected: true,
 		},
+		{
+			scenario: "empty url without managed prober",
+			updateSpec: func(ps *monitoringv1.ProbeSpec) {
+				ps.ProberSpec.URL = ""
+				ps.Module = "http_2xx"
+				ps.Modules = []monitoringv1.ProbeModule{{Name: "http_2xx", Prober: "http"}}
+			},
+			selected: false,
+		},
+		{
+			scenario:      "empty url with managed prober",
+			managedProber: true,
+			updateSpec: func(ps *monitoringv1.ProbeSpec) {
+				ps.ProberSpec.URL = ""
+				ps.Module = "http_2xx"
+				ps.Modules = []monitoringv1.ProbeModule{
+					{
+						Name:   "http_2xx",
+						Prober: "http",
+						Config: &apiextensionsv1.JSON{Raw: []byte(`{"valid_status_codes":[200]}`)},
+					},
+				}
+			},
+			selected: true,
+		},
+		{
+			scenario:      "managed prober with undefined module",
+			managedProber: true,
+			updateSpec: func(ps *monitoringv1.ProbeSpec) {
+				ps.ProberSpec.URL = ""
+				ps.Module = "tcp_connect"
+				ps.Modules = []monitoringv1.ProbeModule{{Name: "http_2xx", Prober: "http"}}
+			},
+			selected: false,
+		},
+		{
+			scenario:      "managed prober with invalid module config",
+			managedProber: true,
+			updateSpec: func(ps *monitoringv1.ProbeSpec) {
+				ps.ProberSpec.URL = ""
+				ps.Module = "http_2xx"
+				ps.Modules = []monitoringv1.ProbeModule{
+					{
+						Name:   "http_2xx",
+						Prober: "http",
+						Config: &apiextensionsv1.JSON{Raw: []byte(`["foo"]`)},
+					},
+				}
+			},
+			selected: false,
+		},
+		{
+			scenario:      "managed prober with unknown module setting",
+			managedProber: true,
+			updateSpec: func(ps *monitoringv1.ProbeSpec) {
+				ps.ProberSpec.URL = ""
+				ps.Module = "http_2xx"
+				ps.Modules = []monitoringv1.ProbeModule{
+					{
+						Name:   "http_2xx",
+						Prober: "http",
+						Config: &apiextensionsv1.JSON{Raw: []byte(`{"valid_status_code":[200]}`)},
+					},
+				}
+			},
+			selected: false,
+		},
+		{
+			scenario:      "prober url with managed prober",
+			managedProber: true,
+			updateSpec: func(ps *monitoringv1.ProbeSpec) {
+				ps.ProberSpec.URL = "blackbox-exporter:9115"
+			},
+			selected: true,
+		},
 	} {
 		t.Run(tc.scenario, func(t *testing.T) {
 			cs := fake.NewSimpleClientset()
 
+			var managedProber *monitoringv1.ManagedProberSpec
+			if tc.managedProber {
+				managedProber = &monitoringv1.ManagedProberSpec{}
+			}
+
 			rs, err := NewResourceSelector(
 				newLogger(),
 				&monitoringv1.Prometheus{
@@ -650,6 +734,7 @@ func TestSelectProbes(t *testing.T) {
 								},
 							},
 						},
+						ManagedProber: managedProber,
 					},
 				},
 				assets.NewStoreBuilder(cs.CoreV1(), cs.CoreV1()),
@@ -687,9 +772,13 @@ func TestSelectProbes(t *testing.T) {
 
 			require.NoError(t, err)
 			if tc.selected {
-				require.Len(t, probes, 1)
+				require.Len(t, probes.ValidResources(), 1)
 			} else {
-				require.Empty(t, probes)
+				require.Empty(t, probes.ValidResources())
+				require.Len(t, probes, 1)
+				for _, r := range probes {
+					require.Error(t, r.Err())
+				}
 			}
 		})
 	}
@@ -1146,9 +1235,13 @@ func TestSelectServiceMonitors(t *testing.T) {
 
 			require.NoError(t, err)
 			if tc.selected {
-				require.Len(t, sms, 1)
+				require.Len(t, sms.ValidResources(), 1)
 			} else {
-				require.Empty(t, sms)
+				require.Empty(t, sms.ValidResources())
+				require.Len(t, sms, 1)
+				for _, r := range sms {
+					require.Error(t, r.Err())
+				}
 			}
 		})
 	}
@@ -1321,11 +1414,13 @@ func TestSelectPodMonitors(t *testing.T) {
 			require.NoError(t, err)
 
 			if tc.selected {
-				require.Len(t, sms, 1)
+				require.Len(t, sms.ValidResources(), 1)
 				return
 			}
 
-			require.Empty(t, sms)
+			require.Empty(t, sms.ValidResources())
+			require.Len(t, sms, 1)
+			require.Error(t, sms["test/test"].Err())
 		})
 	}
 }
@@ -4196,6 +4291,201 @@ func TestSelectScrapeConfigs(t *testing.T) {
 			},
 			selected: false,
 		},
+		{
+			scenario: "Vultr SD config with valid authorization",
+			updateSpec: func(sc *monitoringv1alpha1.ScrapeConfigSpec) {
+				sc.VultrSDConfigs = []monitoringv1alpha1.VultrSDConfig{
+					{
+						Authorization: &monitoringv1.SafeAuthorization{
+							Credentials: &v1.SecretKeySelector{
+								LocalObjectReference: v1.LocalObjectReference{
+									Name: "secret",
+								},
+								Key: "key1",
+							},
+						},
+					},
+				}
+			},
+			selected: true,
+		},
+		{
+			scenario: "Vultr SD config with invalid secret ref",
+			updateSpec: func(sc *monitoringv1alpha1.ScrapeConfigSpec) {
+				sc.VultrSDConfigs = []monitoringv1alpha1.VultrSDConfig{
+					{
+						Authorization: &monitoringv1.SafeAuthorization{
+							Credentials: &v1.SecretKeySelector{
+								LocalObjectReference: v1.LocalObjectReference{
+									Name: "wrong",
+								},
+								Key: "key1",
+							},
+						},
+					},
+				}
+			},
+			selected: false,
+		},
+		{
+			scenario: "Marathon SD config with valid auth token",
+			updateSpec: func(sc *monitoringv1alpha1.ScrapeConfigSpec) {
+				sc.MarathonSDConfigs = []monitoringv1alpha1.MarathonSDConfig{
+					{
+						Servers: []string{"https://marathon.example.com:8080"},
+						AuthToken: &v1.SecretKeySelector{
+							LocalObjectReference: v1.LocalObjectReference{
+								Name: "secret",
+							},
+							Key: "key1",
+						},
+					},
+				}
+			},
+			selected: true,
+		},
+		{
+			scenario: "Marathon SD config with invalid auth token secret ref",
+			updateSpec: func(sc *monitoringv1alpha1.ScrapeConfigSpec) {
+				sc.MarathonSDConfigs = []monitoringv1alpha1.MarathonSDConfig{
+					{
+						Servers: []string{"https://marathon.example.com:8080"},
+						AuthToken: &v1.SecretKeySelector{
+							LocalObjectReference: v1.LocalObjectReference{
+								Name: "wrong",
+							},
+							Key: "key1",
+						},
+					},
+				}
+			},
+			selected: false,
+		},
+		{
+			scenario: "Marathon SD config with both auth token and basic auth",
+			updateSpec: func(sc *monitoringv1alpha1.ScrapeConfigSpec) {
+				sc.MarathonSDConfigs = []monitoringv1alpha1.MarathonSDConfig{
+					{
+						Servers: []string{"https://marathon.example.com:8080"},
+						AuthToken: &v1.SecretKeySelector{
+							LocalObjectReference: v1.LocalObjectReference{
+								Name: "secret",
+							},
+							Key: "key1",
+						},
+						BasicAuth: &monitoringv1.BasicAuth{
+							Username: v1.SecretKeySelector{
+								LocalObjectReference: v1.LocalObjectReference{
+									Name: "secret",
+								},
+								Key: "key1",
+							},
+							Password: v1.SecretKeySelector{
+								LocalObjectReference: v1.LocalObjectReference{
+									Name: "secret",
+								},
+								Key: "key2",
+							},
+						},
+					},
+				}
+			},
+			selected: false,
+		},
+		{
+			scenario: "Marathon SD config with invalid server",
+			updateSpec: func(sc *monitoringv1alpha1.ScrapeConfigSpec) {
+				sc.MarathonSDConfigs = []monitoringv1alpha1.MarathonSDConfig{
+					{
+						Servers: []string{"marathon.example.com"},
+					},
+				}
+			},
+			selected: false,
+		},
+		{
+			scenario: "Uyuni SD config with valid password",
+			updateSpec: func(sc *monitoringv1alpha1.ScrapeConfigSpec) {
+				sc.UyuniSDConfigs = []monitoringv1alpha1.UyuniSDConfig{
+					{
+						Server:   "https://uyuni.example.com",
+						Username: "admin",
+						Password: v1.SecretKeySelector{
+							LocalObjectReference: v1.LocalObjectReference{
+								Name: "secret",
+							},
+							Key: "key1",
+						},
+					},
+				}
+			},
+			selected: true,
+		},
+		{
+			scenario: "Uyuni SD config with invalid password secret ref",
+			updateSpec: func(sc *monitoringv1alpha1.ScrapeConfigSpec) {
+				sc.UyuniSDConfigs = []monitoringv1alpha1.UyuniSDConfig{
+					{
+						Server:   "https://uyuni.example.com",
+						Username: "admin",
+						Password: v1.SecretKeySelector{
+							LocalObjectReference: v1.LocalObjectReference{
+								Name: "wrong",
+							},
+							Key: "key1",
+						},
+					},
+				}
+			},
+			selected: false,
+		},
+		{
+			scenario: "Serverset SD config with valid paths",
+			updateSpec: func(sc *monitoringv1alpha1.ScrapeConfigSpec) {
+				sc.ServersetSDConfigs = []monitoringv1alpha1.ServersetSDConfig{
+					{
+						Servers: []string{"zk-1:2181", "zk-2:2181"},
+						Paths:   []string{"/aurora/jobs"},
+					},
+				}
+			},
+			selected: true,
+		},
+		{
+			scenario: "Serverset SD config with relative path",
+			updateSpec: func(sc *monitoringv1alpha1.ScrapeConfigSpec) {
+				sc.ServersetSDConfigs = []monitoringv1alpha1.ServersetSDConfig{
+					{
+						Servers: []string{"zk-1:2181"},
+						Paths:   []string{"aurora/jobs"},
+					},
+				}
+			},
+			selected: false,
+		},
+		{
+			scenario: "Nerve SD config with valid paths",
+			updateSpec: func(sc *monitoringv1alpha1.ScrapeConfigSpec) {
+				sc.NerveSDConfigs = []monitoringv1alpha1.NerveSDConfig{
+					{
+						Servers: []string{"zk-1:2181"},
+						Paths:   []string{"/nerve/services"},
+					},
+				}
+			},
+			selected: true,
+		},
+		{
+			scenario: "Nerve SD config without servers",
+			updateSpec: func(sc *monitoringv1alpha1.ScrapeConfigSpec) {
+				sc.NerveSDConfigs = []monitoringv1alpha1.NerveSDConfig{
+					{
+						Paths: []string{"/nerve/services"},
+					},
+				}
+			},
+			selected: false,
+		},
 		{
 			scenario: "Inexistent Scrape Class",
 			updateSpec: func(sc *monitoringv1alpha1.ScrapeConfigSpec) {
@@ -4295,10 +4585,296 @@ func TestSelectScrapeConfigs(t *testing.T) {
 
 			require.NoError(t, err)
 			if tc.selected {
-				require.Len(t, sms, 1)
+				require.Len(t, sms.ValidResources(), 1)
 			} else {
-				require.Empty(t, sms)
+				require.Empty(t, sms.ValidResources())
+				require.Len(t, sms, 1)
+				for _, r := range sms {
+					require.Error(t, r.Err())
+				}
+			}
+		})
+	}
+}
+
+func TestSelectOTLPTenants(t *testing.T) {
+	newTenant := func(ns string, ts *monitoringv1.TranslationStrategyOption) *monitoringv1alpha1.OTLPTenant {
+		return &monitoringv1alpha1.OTLPTenant{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "tenant",
+				Namespace: ns,
+			},
+			Spec: monitoringv1alpha1.OTLPTenantSpec{
+				PromoteResourceAttributes: []string{"k8s.pod.name"},
+				TranslationStrategy:       ts,
+			},
+		}
+	}
+
+	for _, tc := range []struct {
+		scenario    string
+		promVersion string
+		otlp        *monitoringv1.OTLPConfig
+		tenants     []*monitoringv1alpha1.OTLPTenant
+		selected    []string
+	}{
+		{
+			scenario: "tenants without translation strategy",
+			tenants: []*monitoringv1alpha1.OTLPTenant{
+				newTenant("team-a", nil),
+				newTenant("team-b", nil),
+			},
+			selected: []string{"team-a/tenant", "team-b/tenant"},
+		},
+		{
+			scenario: "tenants with the same translation strategy",
+			tenants: []*monitoringv1alpha1.OTLPTenant{
+				newTenant("team-a", ptr.To(monitoringv1.NoUTF8EscapingWithSuffixes)),
+				newTenant("team-b", ptr.To(monitoringv1.NoUTF8EscapingWithSuffixes)),
+			},
+			selected: []string{"team-a/tenant", "team-b/tenant"},
+		},
+		{
+			scenario: "tenants with conflicting translation strategies",
+			tenants: []*monitoringv1alpha1.OTLPTenant{
+				newTenant("team-b", ptr.To(monitoringv1.UnderscoreEscapingWithSuffixes)),
+				newTenant("team-a", ptr.To(monitoringv1.NoUTF8EscapingWithSuffixes)),
+				newTenant("team-c", nil),
+			},
+			selected: []string{"team-a/tenant", "team-c/tenant"},
+		},
+		{
+			scenario: "tenant conflicting with the Prometheus translation strategy",
+			otlp: &monitoringv1.OTLPConfig{
+				TranslationStrategy: ptr.To(monitoringv1.UnderscoreEscapingWithSuffixes),
+			},
+			tenants: []*monitoringv1alpha1.OTLPTenant{
+				newTenant("team-a", ptr.To(monitoringv1.NoUTF8EscapingWithSuffixes)),
+				newTenant("team-b", ptr.To(monitoringv1.UnderscoreEscapingWithSuffixes)),
+			},
+			selected: []string{"team-b/tenant"},
+		},
+		{
+			scenario:    "NoTranslation strategy with unsupported version",
+			promVersion: "v3.0.0",
+			tenants: []*monitoringv1alpha1.OTLPTenant{
+				newTenant("team-a", ptr.To(monitoringv1.NoTranslation)),
+			},
+		},
+		{
+			scenario:    "unsupported Prometheus version",
+			promVersion: "v2.54.0",
+			tenants: []*monitoringv1alpha1.OTLPTenant{
+				newTenant("team-a", nil),
+			},
+		},
+	} {
+		t.Run(tc.scenario, func(t *testing.T) {
+			rs, err := NewResourceSelector(
+				newLogger(),
+				&monitoringv1.Prometheus{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "test",
+						Namespace: "test",
+					},
+					Spec: monitoringv1.PrometheusSpec{
+						CommonPrometheusFields: monitoringv1.CommonPrometheusFields{
+							Version: tc.promVersion,
+							OTLP:    tc.otlp,
+						},
+					},
+				},
+				assets.NewTestStoreBuilder(),
+				nil,
+				operator.NewMetrics(prometheus.NewPedanticRegistry()),
+				record.NewFakeRecorder(len(tc.tenants)),
+			)
+			require.NoError(t, err)
+
+			tenants, err := rs.SelectOTLPTenants(func(_ string, _ labels.Selector, appendFn cache.AppendFunc) error {
+				for _, tenant := range tc.tenants {
+					appendFn(tenant)
+				}
+				return nil
+			})
+			require.NoError(t, err)
+			require.Len(t, tenants, len(tc.tenants))
+
+			selected := slices.Sorted(maps.Keys(tenants.ValidResources()))
+			if len(tc.selected) == 0 {
+				require.Empty(t, selected)
+				return
+			}
+			require.Equal(t, tc.selected, selected)
+		})
+	}
+}
+
+func TestSelectRemoteWrites(t *testing.T) {
+	newRemoteWrite := func(ns string, f func(*monitoringv1.RemoteWriteSpec)) *monitoringv1alpha1.RemoteWrite {
+		rw := &monitoringv1alpha1.RemoteWrite{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "backend",
+				Namespace: ns,
+			},
+			Spec: monitoringv1.RemoteWriteSpec{
+				URL: "http://example.com/api/v1/write",
+			},
+		}
+		if f != nil {
+			f(&rw.Spec)
+		}
+		return rw
+	}
+
+	for _, tc := range []struct {
+		scenario     string
+		remoteWrite  []monitoringv1.RemoteWriteSpec
+		remoteWrites []*monitoringv1alpha1.RemoteWrite
+		selected     []string
+	}{
+		{
+			scenario: "valid remote writes",
+			remoteWrites: []*monitoringv1alpha1.RemoteWrite{
+				newRemoteWrite("team-a", nil),
+				newRemoteWrite("team-b", func(spec *monitoringv1.RemoteWriteSpec) {
+					spec.Authorization = &monitoringv1.Authorization{
+						SafeAuthorization: monitoringv1.SafeAuthorization{
+							Credentials: &v1.SecretKeySelector{
+								LocalObjectReference: v1.LocalObjectReference{Name: "remote-write"},
+								Key:                  "token",
+							},
+						},
+					}
+				}),
+			},
+			selected: []string{"team-a/backend", "team-b/backend"},
+		},
+		{
+			scenario: "missing secret",
+			remoteWrites: []*monitoringv1alpha1.RemoteWrite{
+				newRemoteWrite("team-a", func(spec *monitoringv1.RemoteWriteSpec) {
+					spec.Authorization = &monitoringv1.Authorization{
+						SafeAuthorization: monitoringv1.SafeAuthorization{
+							Credentials: &v1.SecretKeySelector{
+								LocalObjectReference: v1.LocalObjectReference{Name: "remote-write"},
+								Key:                  "token",
+							},
+						},
+					}
+				}),
+			},
+		},
+		{
+			scenario: "file-based credentials",
+			remoteWrites: []*monitoringv1alpha1.RemoteWrite{
+				newRemoteWrite("team-a", func(spec *monitoringv1.RemoteWriteSpec) {
+					spec.BearerTokenFile = "/var/run/secrets/kubernetes.io/serviceaccount/token"
+				}),
+				newRemoteWrite("team-b", func(spec *monitoringv1.RemoteWriteSpec) {
+					spec.TLSConfig = &monitoringv1.TLSConfig{KeyFile: "/etc/prometheus/secrets/tls.key"}
+				}),
+				newRemoteWrite("team-c", func(spec *monitoringv1.RemoteWriteSpec) {
+					spec.Authorization = &monitoringv1.Authorization{CredentialsFile: "/etc/token"}
+				}),
+			},
+		},
+		{
+			scenario: "ambient cloud credentials",
+			remoteWrites: []*monitoringv1alpha1.RemoteWrite{
+				newRemoteWrite("team-a", func(spec *monitoringv1.RemoteWriteSpec) {
+					spec.Sigv4 = &monitoringv1.Sigv4{Region: "us-east-1"}
+				}),
+				newRemoteWrite("team-b", func(spec *monitoringv1.RemoteWriteSpec) {
+					spec.AzureAD = &monitoringv1.AzureAD{
+						ManagedIdentity: &monitoringv1.ManagedIdentity{ClientID: "00000000-a12b-3cd4-e56f-000000000000"},
+					}
+				}),
+			},
+		},
+		{
+			scenario: "invalid write relabel config",
+			remoteWrites: []*monitoringv1alpha1.RemoteWrite{
+				newRemoteWrite("team-a", func(spec *monitoringv1.RemoteWriteSpec) {
+					spec.WriteRelabelConfigs = []monitoringv1.RelabelConfig{
+						{
+							Action: "replace",
+							Regex:  "(",
+						},
+					}
+				}),
+			},
+		},
+		{
+			scenario: "duplicate names",
+			remoteWrite: []monitoringv1.RemoteWriteSpec{
+				{
+					URL:  "http://central.example.com/api/v1/write",
+					Name: ptr.To("central"),
+				},
+			},
+			remoteWrites: []*monitoringv1alpha1.RemoteWrite{
+				newRemoteWrite("team-a", func(spec *monitoringv1.RemoteWriteSpec) {
+					spec.Name = ptr.To("central")
+				}),
+				newRemoteWrite("team-b", func(spec *monitoringv1.RemoteWriteSpec) {
+					spec.Name = ptr.To("backend")
+				}),
+				newRemoteWrite("team-c", func(spec *monitoringv1.RemoteWriteSpec) {
+					spec.Name = ptr.To("backend")
+				}),
+			},
+			selected: []string{"team-b/backend"},
+		},
+	} {
+		t.Run(tc.scenario, func(t *testing.T) {
+			cs := fake.NewSimpleClientset(
+				&v1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "remote-write",
+						Namespace: "team-b",
+					},
+					Data: map[string][]byte{
+						"token": []byte("secret"),
+					},
+				},
+			)
+
+			rs, err := NewResourceSelector(
+				newLogger(),
+				&monitoringv1alpha1.PrometheusAgent{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "test",
+						Namespace: "test",
+					},
+					Spec: monitoringv1alpha1.PrometheusAgentSpec{
+						CommonPrometheusFields: monitoringv1.CommonPrometheusFields{
+							RemoteWrite: tc.remoteWrite,
+						},
+					},
+				},
+				assets.NewStoreBuilder(cs.CoreV1(), cs.CoreV1()),
+				nil,
+				operator.NewMetrics(prometheus.NewPedanticRegistry()),
+				record.NewFakeRecorder(len(tc.remoteWrites)),
+			)
+			require.NoError(t, err)
+
+			remoteWrites, err := rs.SelectRemoteWrites(context.Background(), func(_ string, _ labels.Selector, appendFn cache.AppendFunc) error {
+				for _, rw := range tc.remoteWrites {
+					appendFn(rw)
+				}
+				return nil
+			})
+			require.NoError(t, err)
+			require.Len(t, remoteWrites, len(tc.remoteWrites))
+
+			selected := slices.Sorted(maps.Keys(remoteWrites.ValidResources()))
+			if len(tc.selected) == 0 {
+				require.Empty(t, selected)
+				return
 			}
+			require.Equal(t, tc.selected, selected)
 		})
 	}
 }
@@ -0,0 +1,255 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"path"
+
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/k8sutil"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+	prompkg "github.com/prometheus-operator/prometheus-operator/pkg/prometheus"
+)
+
+const (
+	blackboxExporterContainerName = "blackbox-exporter"
+	blackboxExporterPortName      = "http"
+	blackboxExporterConfigDir     = "/etc/blackbox-exporter"
+	blackboxExporterConfigVolume  = "config"
+)
+
+// reconcileManagedProber creates or updates the blackbox exporter managed for
+// the Prometheus object and configures it with the modules of the given
+// probes. If the Prometheus object doesn't define a managed prober, the
+// objects of the blackbox exporter are deleted.
+func (c *Operator) reconcileManagedProber(ctx context.Context, p *monitoringv1.Prometheus, probes map[string]*monitoringv1.Probe) error {
+	if p.Spec.ManagedProber == nil {
+		return c.deleteManagedProber(ctx, p)
+	}
+
+	conf, err := prompkg.GenerateBlackboxExporterConfig(probes)
+	if err != nil {
+		return fmt.Errorf("failed to generate the blackbox exporter configuration: %w", err)
+	}
+
+	s := makeBlackboxExporterSecret(p, c.config, conf)
+	if err := k8sutil.CreateOrUpdateSecret(ctx, c.kclient.CoreV1().Secrets(p.Namespace), s); err != nil {
+		return fmt.Errorf("failed to reconcile the blackbox exporter configuration secret: %w", err)
+	}
+
+	d := makeBlackboxExporterDeployment(p, c.config, fmt.Sprintf("%x", sha256.Sum256(conf)))
+	if err := k8sutil.CreateOrUpdateDeployment(ctx, c.kclient.AppsV1().Deployments(p.Namespace), d); err != nil {
+		return fmt.Errorf("failed to reconcile the blackbox exporter deployment: %w", err)
+	}
+
+	svc := makeBlackboxExporterService(p, c.config)
+	if _, err := k8sutil.CreateOrUpdateService(ctx, c.kclient.CoreV1().Services(p.Namespace), svc); err != nil {
+		return fmt.Errorf("failed to reconcile the blackbox exporter service: %w", err)
+	}
+
+	return nil
+}
+
+// deleteManagedProber deletes the objects of the managed blackbox exporter.
+// The configuration secret is deleted last because its presence indicates
+// that the other objects may exist. The secret is looked up in the informer
+// cache to avoid API requests for the Prometheus objects which have never
+// defined a managed prober.
+func (c *Operator) deleteManagedProber(ctx context.Context, p *monitoringv1.Prometheus) error {
+	name := prompkg.BlackboxExporterName(p)
+
+	if _, err := c.secrInfs.Get(p.Namespace + "/" + name); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to get the blackbox exporter configuration secret: %w", err)
+	}
+
+	if err := c.kclient.AppsV1().Deployments(p.Namespace).Delete(ctx, name, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete the blackbox exporter deployment: %w", err)
+	}
+
+	if err := c.kclient.CoreV1().Services(p.Namespace).Delete(ctx, name, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete the blackbox exporter service: %w", err)
+	}
+
+	if err := c.kclient.CoreV1().Secrets(p.Namespace).Delete(ctx, name, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete the blackbox exporter configuration secret: %w", err)
+	}
+
+	return nil
+}
+
+func makeBlackboxExporterSelectorLabels(name string) map[string]string {
+	return map[string]string{
+		"app.kubernetes.io/managed-by":  "prometheus-operator",
+		"app.kubernetes.io/name":        "blackbox-exporter",
+		"app.kubernetes.io/instance":    name,
+		prompkg.PrometheusNameLabelName: name,
+	}
+}
+
+func makeBlackboxExporterSecret(p *monitoringv1.Prometheus, config prompkg.Config, conf []byte) *v1.Secret {
+	s := &v1.Secret{
+		Data: map[string][]byte{
+			prompkg.BlackboxExporterConfigFile: conf,
+		},
+	}
+
+	operator.UpdateObject(
+		s,
+		operator.WithName(prompkg.BlackboxExporterName(p)),
+		operator.WithLabels(config.Labels),
+		operator.WithAnnotations(config.Annotations),
+		operator.WithManagingOwner(p),
+	)
+
+	return s
+}
+
+func makeBlackboxExporterDeployment(p *monitoringv1.Prometheus, config prompkg.Config, configHash string) *appsv1.Deployment {
+	spec := p.Spec.ManagedProber
+	selectorLabels := makeBlackboxExporterSelectorLabels(p.Name)
+
+	d := &appsv1.Deployment{
+		Spec: appsv1.DeploymentSpec{
+			Replicas: ptr.To(ptr.Deref(spec.Replicas, 1)),
+			Selector: &metav1.LabelSelector{
+				MatchLabels: selectorLabels,
+			},
+			Template: v1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: selectorLabels,
+					Annotations: map[string]string{
+						operator.InputHashAnnotationName: configHash,
+					},
+				},
+				Spec: v1.PodSpec{
+					// The modules can't read the service account token
+					// since it isn't mounted.
+					AutomountServiceAccountToken: ptr.To(false),
+					ImagePullSecrets:             p.Spec.ImagePullSecrets,
+					NodeSelector:                 spec.NodeSelector,
+					Tolerations:                  spec.Tolerations,
+					SecurityContext: &v1.PodSecurityContext{
+						RunAsNonRoot: ptr.To(true),
+						RunAsUser:    ptr.To(int64(65534)),
+						SeccompProfile: &v1.SeccompProfile{
+							Type: v1.SeccompProfileTypeRuntimeDefault,
+						},
+					},
+					Containers: []v1.Container{
+						{
+							Name:  blackboxExporterContainerName,
+							Image: ptr.Deref(spec.Image, operator.DefaultBlackboxExporterImage),
+							Args: []string{
+								fmt.Sprintf("--config.file=%s", path.Join(blackboxExporterConfigDir, prompkg.BlackboxExporterConfigFile)),
+								fmt.Sprintf("--web.listen-address=:%d", prompkg.BlackboxExporterPort),
+							},
+							Ports: []v1.ContainerPort{
+								{
+									Name:          blackboxExporterPortName,
+									ContainerPort: prompkg.BlackboxExporterPort,
+									Protocol:      v1.ProtocolTCP,
+								},
+							},
+							ReadinessProbe: &v1.Probe{
+								ProbeHandler: v1.ProbeHandler{
+									HTTPGet: &v1.HTTPGetAction{
+										Path: "/-/healthy",
+										Port: intstr.FromString(blackboxExporterPortName),
+									},
+								},
+							},
+							Resources: spec.Resources,
+							VolumeMounts: []v1.VolumeMount{
+								{
+									Name:      blackboxExporterConfigVolume,
+									MountPath: blackboxExporterConfigDir,
+									ReadOnly:  true,
+								},
+							},
+							TerminationMessagePolicy: v1.TerminationMessageFallbackToLogsOnError,
+							SecurityContext: &v1.SecurityContext{
+								AllowPrivilegeEscalation: ptr.To(false),
+								ReadOnlyRootFilesystem:   ptr.To(true),
+								Capabilities: &v1.Capabilities{
+									Drop: []v1.Capability{"ALL"},
+								},
+							},
+						},
+					},
+					Volumes: []v1.Volume{
+						{
+							Name: blackboxExporterConfigVolume,
+							VolumeSource: v1.VolumeSource{
+								Secret: &v1.SecretVolumeSource{
+									SecretName: prompkg.BlackboxExporterName(p),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	operator.UpdateObject(
+		d,
+		operator.WithName(prompkg.BlackboxExporterName(p)),
+		operator.WithLabels(selectorLabels),
+		operator.WithLabels(config.Labels),
+		operator.WithAnnotations(config.Annotations),
+		operator.WithManagingOwner(p),
+	)
+
+	return d
+}
+
+func makeBlackboxExporterService(p *monitoringv1.Prometheus, config prompkg.Config) *v1.Service {
+	svc := &v1.Service{
+		Spec: v1.ServiceSpec{
+			Ports: []v1.ServicePort{
+				{
+					Name:       blackboxExporterPortName,
+					Port:       prompkg.BlackboxExporterPort,
+					TargetPort: intstr.FromString(blackboxExporterPortName),
+				},
+			},
+			Selector: makeBlackboxExporterSelectorLabels(p.Name),
+		},
+	}
+
+	operator.UpdateObject(
+		svc,
+		operator.WithName(prompkg.BlackboxExporterName(p)),
+		operator.WithLabels(makeBlackboxExporterSelectorLabels(p.Name)),
+		operator.WithLabels(config.Labels),
+		operator.WithAnnotations(config.Annotations),
+		operator.WithManagingOwner(p),
+	)
+
+	return svc
+}
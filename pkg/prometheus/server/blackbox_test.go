@@ -0,0 +1,149 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	metadatafake "k8s.io/client-go/metadata/fake"
+	"k8s.io/utils/ptr"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/informers"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+	prompkg "github.com/prometheus-operator/prometheus-operator/pkg/prometheus"
+)
+
+func TestReconcileManagedProber(t *testing.T) {
+	ctx := context.Background()
+
+	p := &monitoringv1.Prometheus{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test",
+			Namespace: "default",
+		},
+		Spec: monitoringv1.PrometheusSpec{
+			ManagedProber: &monitoringv1.ManagedProberSpec{
+				Replicas: ptr.To(int32(2)),
+			},
+		},
+	}
+	probes := map[string]*monitoringv1.Probe{
+		"default/probe": {
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "probe",
+				Namespace: "default",
+			},
+			Spec: monitoringv1.ProbeSpec{
+				Module: "http_2xx",
+				Modules: []monitoringv1.ProbeModule{
+					{
+						Name:   "http_2xx",
+						Prober: "http",
+					},
+				},
+			},
+		},
+	}
+
+	o := Operator{kclient: fake.NewClientset()}
+	name := prompkg.BlackboxExporterName(p)
+
+	require.NoError(t, o.reconcileManagedProber(ctx, p, probes))
+
+	s, err := o.kclient.CoreV1().Secrets("default").Get(ctx, name, metav1.GetOptions{})
+	require.NoError(t, err)
+	require.Equal(t, "modules:\n  default/probe/http_2xx:\n    prober: http\n", string(s.Data[prompkg.BlackboxExporterConfigFile]))
+
+	d, err := o.kclient.AppsV1().Deployments("default").Get(ctx, name, metav1.GetOptions{})
+	require.NoError(t, err)
+	require.Equal(t, int32(2), *d.Spec.Replicas)
+	require.Equal(t, operator.DefaultBlackboxExporterImage, d.Spec.Template.Spec.Containers[0].Image)
+	hash := d.Spec.Template.Annotations[operator.InputHashAnnotationName]
+	require.NotEmpty(t, hash)
+
+	svc, err := o.kclient.CoreV1().Services("default").Get(ctx, name, metav1.GetOptions{})
+	require.NoError(t, err)
+	require.Equal(t, d.Spec.Template.Labels, svc.Spec.Selector)
+
+	// Updating the modules rolls out the blackbox exporter.
+	probes["default/probe"].Spec.Modules[0].Timeout = ptr.To(monitoringv1.Duration("5s"))
+	require.NoError(t, o.reconcileManagedProber(ctx, p, probes))
+
+	d, err = o.kclient.AppsV1().Deployments("default").Get(ctx, name, metav1.GetOptions{})
+	require.NoError(t, err)
+	require.NotEqual(t, hash, d.Spec.Template.Annotations[operator.InputHashAnnotationName])
+
+	// Without managed prober, the API isn't queried if the configuration
+	// secret isn't in the cache.
+	p.Spec.ManagedProber = nil
+	o.secrInfs = newSecretInformers(ctx, t)
+	o.kclient.(*fake.Clientset).ClearActions()
+	require.NoError(t, o.reconcileManagedProber(ctx, p, probes))
+	require.Empty(t, o.kclient.(*fake.Clientset).Actions())
+
+	// Otherwise the objects are deleted.
+	o.secrInfs = newSecretInformers(ctx, t, &metav1.PartialObjectMetadata{
+		TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "Secret"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "default",
+		},
+	})
+	require.NoError(t, o.reconcileManagedProber(ctx, p, probes))
+
+	_, err = o.kclient.AppsV1().Deployments("default").Get(ctx, name, metav1.GetOptions{})
+	require.True(t, apierrors.IsNotFound(err))
+	_, err = o.kclient.CoreV1().Services("default").Get(ctx, name, metav1.GetOptions{})
+	require.True(t, apierrors.IsNotFound(err))
+	_, err = o.kclient.CoreV1().Secrets("default").Get(ctx, name, metav1.GetOptions{})
+	require.True(t, apierrors.IsNotFound(err))
+}
+
+func newSecretInformers(ctx context.Context, t *testing.T, objs ...runtime.Object) *informers.ForResource {
+	t.Helper()
+
+	scheme := metadatafake.NewTestScheme()
+	require.NoError(t, metav1.AddMetaToScheme(scheme))
+
+	infs, err := informers.NewInformersForResourceWithTransform(
+		informers.NewMetadataInformerFactory(
+			map[string]struct{}{v1.NamespaceAll: {}},
+			map[string]struct{}{},
+			metadatafake.NewSimpleMetadataClient(scheme, objs...),
+			resyncPeriod,
+			nil,
+		),
+		v1.SchemeGroupVersion.WithResource(string(v1.ResourceSecrets)),
+		informers.PartialObjectMetadataStrip,
+	)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(ctx)
+	t.Cleanup(cancel)
+
+	go infs.Start(ctx.Done())
+	require.Eventually(t, infs.HasSynced, 5*time.Second, 10*time.Millisecond)
+
+	return infs
+}
@@ -31,6 +31,7 @@ import (
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/metadata"
 	"k8s.io/client-go/rest"
@@ -66,7 +67,8 @@ type Operator struct {
 	accessor *operator.Accessor
 	config   prompkg.Config
 
-	controllerID string
+	controllerID  string
+	clusterDomain string
 
 	nsPromInf cache.SharedIndexInformer
 	nsMonInf  cache.SharedIndexInformer
@@ -76,6 +78,9 @@ type Operator struct {
 	pmonInfs  *informers.ForResource
 	probeInfs *informers.ForResource
 	sconInfs  *informers.ForResource
+	otlpInfs  *informers.ForResource
+	rwInfs    *informers.ForResource
+	routeInfs *informers.ForResource
 	ruleInfs  *informers.ForResource
 	cmapInfs  *informers.ForResource
 	secrInfs  *informers.ForResource
@@ -89,11 +94,16 @@ type Operator struct {
 
 	endpointSliceSupported        bool
 	scrapeConfigSupported         bool
+	otlpTenantSupported           bool
+	remoteWriteSupported          bool
+	httpRouteSupported            bool
 	canReadStorageClass           bool
 	disableUnmanagedConfiguration bool
 	retentionPoliciesEnabled      bool
 	configResourcesStatusEnabled  bool
 
+	shardAutoscaler *shardAutoscaler
+
 	eventRecorder record.EventRecorder
 }
 
@@ -113,6 +123,28 @@ func WithScrapeConfig() ControllerOption {
 	}
 }
 
+// WithOTLPTenant tells that the controller manages OTLPTenant objects.
+func WithOTLPTenant() ControllerOption {
+	return func(o *Operator) {
+		o.otlpTenantSupported = true
+	}
+}
+
+// WithRemoteWrite tells that the controller manages RemoteWrite objects.
+func WithRemoteWrite() ControllerOption {
+	return func(o *Operator) {
+		o.remoteWriteSupported = true
+	}
+}
+
+// WithHTTPRoute tells that the controller can watch Gateway API HTTPRoute
+// objects for the Probe HTTPRoute targets.
+func WithHTTPRoute() ControllerOption {
+	return func(o *Operator) {
+		o.httpRouteSupported = true
+	}
+}
+
 // WithStorageClassValidation tells that the controller should verify that the
 // Prometheus spec references a valid StorageClass name.
 func WithStorageClassValidation() ControllerOption {
@@ -170,10 +202,14 @@ func New(ctx context.Context, restConfig *rest.Config, c operator.Config, logger
 		reconciliations: &operator.ReconciliationTracker{},
 
 		controllerID:                 c.ControllerID,
+		clusterDomain:                c.ClusterDomain,
 		eventRecorder:                c.EventRecorderFactory(client, controllerName),
 		retentionPoliciesEnabled:     c.Gates.Enabled(operator.PrometheusShardRetentionPolicyFeature),
 		configResourcesStatusEnabled: c.Gates.Enabled(operator.StatusForConfigurationResourcesFeature),
 	}
+	if c.Gates.Enabled(operator.PrometheusShardAutoscalingFeature) {
+		o.shardAutoscaler = newShardAutoscaler()
+	}
 	for _, opt := range opts {
 		opt(o)
 	}
@@ -269,6 +305,59 @@ func New(ctx context.Context, restConfig *rest.Config, c operator.Config, logger
 			return nil, fmt.Errorf("error creating scrapeconfigs informers: %w", err)
 		}
 	}
+
+	if o.otlpTenantSupported {
+		o.otlpInfs, err = informers.NewInformersForResource(
+			informers.NewMonitoringInformerFactories(
+				c.Namespaces.AllowList,
+				c.Namespaces.DenyList,
+				mclient,
+				resyncPeriod,
+				nil,
+			),
+			monitoringv1alpha1.SchemeGroupVersion.WithResource(monitoringv1alpha1.OTLPTenantName),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("error creating otlptenants informers: %w", err)
+		}
+	}
+
+	if o.remoteWriteSupported {
+		o.rwInfs, err = informers.NewInformersForResource(
+			informers.NewMonitoringInformerFactories(
+				c.Namespaces.AllowList,
+				c.Namespaces.DenyList,
+				mclient,
+				resyncPeriod,
+				nil,
+			),
+			monitoringv1alpha1.SchemeGroupVersion.WithResource(monitoringv1alpha1.RemoteWriteName),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("error creating remotewrites informers: %w", err)
+		}
+	}
+
+	if o.httpRouteSupported {
+		dynClient, err := dynamic.NewForConfig(restConfig)
+		if err != nil {
+			return nil, fmt.Errorf("instantiating dynamic client failed: %w", err)
+		}
+
+		o.routeInfs, err = informers.NewInformersForResource(
+			informers.NewDynamicInformerFactories(
+				c.Namespaces.AllowList,
+				c.Namespaces.DenyList,
+				dynClient,
+				resyncPeriod,
+				nil,
+			),
+			prompkg.HTTPRouteGroupVersionResource,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("error creating httproutes informers: %w", err)
+		}
+	}
 	o.ruleInfs, err = informers.NewInformersForResource(
 		informers.NewMonitoringInformerFactories(
 			c.Namespaces.AllowList,
@@ -389,6 +478,9 @@ func (c *Operator) waitForCacheSync(ctx context.Context) error {
 		{"PrometheusRule", c.ruleInfs},
 		{"Probe", c.probeInfs},
 		{"ScrapeConfig", c.sconInfs},
+		{"OTLPTenant", c.otlpInfs},
+		{"RemoteWrite", c.rwInfs},
+		{"HTTPRoute", c.routeInfs},
 		{"ConfigMap", c.cmapInfs},
 		{"Secret", c.secrInfs},
 		{"StatefulSet", c.ssetInfs},
@@ -434,6 +526,7 @@ func (c *Operator) addHandlers() {
 		c.metrics,
 		monitoringv1.ServiceMonitorsKind,
 		c.enqueueForMonitorNamespace,
+		operator.WithoutStatusUpdates(),
 	))
 
 	c.pmonInfs.AddEventHandler(operator.NewEventHandler(
@@ -442,6 +535,7 @@ func (c *Operator) addHandlers() {
 		c.metrics,
 		monitoringv1.PodMonitorsKind,
 		c.enqueueForMonitorNamespace,
+		operator.WithoutStatusUpdates(),
 	))
 
 	c.probeInfs.AddEventHandler(operator.NewEventHandler(
@@ -450,6 +544,7 @@ func (c *Operator) addHandlers() {
 		c.metrics,
 		monitoringv1.ProbesKind,
 		c.enqueueForMonitorNamespace,
+		operator.WithoutStatusUpdates(),
 	))
 
 	if c.sconInfs != nil {
@@ -459,6 +554,40 @@ func (c *Operator) addHandlers() {
 			c.metrics,
 			monitoringv1alpha1.ScrapeConfigsKind,
 			c.enqueueForMonitorNamespace,
+			operator.WithoutStatusUpdates(),
+		))
+	}
+
+	if c.otlpInfs != nil {
+		c.otlpInfs.AddEventHandler(operator.NewEventHandler(
+			c.logger,
+			c.accessor,
+			c.metrics,
+			monitoringv1alpha1.OTLPTenantsKind,
+			c.enqueueForMonitorNamespace,
+			operator.WithoutStatusUpdates(),
+		))
+	}
+
+	if c.rwInfs != nil {
+		c.rwInfs.AddEventHandler(operator.NewEventHandler(
+			c.logger,
+			c.accessor,
+			c.metrics,
+			monitoringv1alpha1.RemoteWritesKind,
+			c.enqueueForMonitorNamespace,
+			operator.WithoutStatusUpdates(),
+		))
+	}
+
+	if c.routeInfs != nil {
+		c.routeInfs.AddEventHandler(operator.NewEventHandler(
+			c.logger,
+			c.accessor,
+			c.metrics,
+			"HTTPRoute",
+			c.enqueueForHTTPRouteNamespace,
+			operator.WithoutStatusUpdates(),
 		))
 	}
 
@@ -468,6 +597,7 @@ func (c *Operator) addHandlers() {
 		c.metrics,
 		monitoringv1.PrometheusRuleKind,
 		c.enqueueForMonitorNamespace,
+		operator.WithoutStatusUpdates(),
 	))
 
 	c.cmapInfs.AddEventHandler(operator.NewEventHandler(
@@ -508,6 +638,15 @@ func (c *Operator) Run(ctx context.Context) error {
 	if c.scrapeConfigSupported {
 		go c.sconInfs.Start(ctx.Done())
 	}
+	if c.otlpTenantSupported {
+		go c.otlpInfs.Start(ctx.Done())
+	}
+	if c.remoteWriteSupported {
+		go c.rwInfs.Start(ctx.Done())
+	}
+	if c.httpRouteSupported {
+		go c.routeInfs.Start(ctx.Done())
+	}
 	go c.ruleInfs.Start(ctx.Done())
 	go c.cmapInfs.Start(ctx.Done())
 	go c.secrInfs.Start(ctx.Done())
@@ -530,6 +669,10 @@ func (c *Operator) Run(ctx context.Context) error {
 	// TODO(simonpasquier): watch for Prometheus pods instead of polling.
 	go operator.StatusPoller(ctx, c)
 
+	if c.shardAutoscaler != nil {
+		go c.runShardAutoscaler(ctx)
+	}
+
 	c.metrics.Ready().Set(1)
 	<-ctx.Done()
 	return nil
@@ -558,6 +701,21 @@ func (c *Operator) enqueueForMonitorNamespace(nsName string) {
 	c.enqueueForNamespace(c.nsMonInf.GetStore(), nsName)
 }
 
+// enqueueForHTTPRouteNamespace enqueues all the Prometheus objects. Because
+// Probe objects can select HTTPRoute objects from other namespaces, the
+// namespace of the HTTPRoute object isn't used for filtering.
+func (c *Operator) enqueueForHTTPRouteNamespace(_ string) {
+	err := c.promInfs.ListAll(labels.Everything(), func(obj interface{}) {
+		c.rr.EnqueueForReconciliation(obj.(*monitoringv1.Prometheus))
+	})
+	if err != nil {
+		c.logger.Error(
+			"listing all Prometheus instances from cache failed",
+			"err", err,
+		)
+	}
+}
+
 // enqueueForNamespace enqueues all Prometheus object keys that belong to the
 // given namespace or select objects in the given namespace.
 func (c *Operator) enqueueForNamespace(store cache.Store, nsName string) {
@@ -661,6 +819,36 @@ func (c *Operator) enqueueForNamespace(store cache.Store, nsName string) {
 			c.rr.EnqueueForReconciliation(p)
 			return
 		}
+
+		// Check for Prometheus instances selecting OTLPTenants in the NS.
+		otlpTenantNSSelector, err := metav1.LabelSelectorAsSelector(p.Spec.OTLPTenantNamespaceSelector)
+		if err != nil {
+			c.logger.Error(
+				fmt.Sprintf("failed to convert OTLPTenantNamespaceSelector of %q to selector", p.Name),
+				"err", err,
+			)
+			return
+		}
+
+		if otlpTenantNSSelector.Matches(labels.Set(ns.Labels)) {
+			c.rr.EnqueueForReconciliation(p)
+			return
+		}
+
+		// Check for Prometheus instances selecting RemoteWrites in the NS.
+		remoteWriteNSSelector, err := metav1.LabelSelectorAsSelector(p.Spec.RemoteWriteNamespaceSelector)
+		if err != nil {
+			c.logger.Error(
+				fmt.Sprintf("failed to convert RemoteWriteNamespaceSelector of %q to selector", p.Name),
+				"err", err,
+			)
+			return
+		}
+
+		if remoteWriteNSSelector.Matches(labels.Set(ns.Labels)) {
+			c.rr.EnqueueForReconciliation(p)
+			return
+		}
 	})
 	if err != nil {
 		c.logger.Error(
@@ -692,9 +880,11 @@ func (c *Operator) handleMonitorNamespaceUpdate(oldo, curo interface{}) {
 		p := obj.(*monitoringv1.Prometheus)
 
 		for name, selector := range map[string]*metav1.LabelSelector{
+			"OTLPTenants":     p.Spec.OTLPTenantNamespaceSelector,
 			"PodMonitors":     p.Spec.PodMonitorNamespaceSelector,
 			"Probes":          p.Spec.ProbeNamespaceSelector,
 			"PrometheusRules": p.Spec.RuleNamespaceSelector,
+			"RemoteWrites":    p.Spec.RemoteWriteNamespaceSelector,
 			"ServiceMonitors": p.Spec.ServiceMonitorNamespaceSelector,
 		} {
 
@@ -737,7 +927,11 @@ func (c *Operator) sync(ctx context.Context, key string) error {
 
 	if apierrors.IsNotFound(err) {
 		c.reconciliations.ForgetObject(key)
+		if c.shardAutoscaler != nil {
+			c.shardAutoscaler.forget(key)
+		}
 		// Dependent resources are cleaned up by K8s via OwnerReferences
+		c.removeConfigResourcesBindings(ctx, key)
 		return nil
 	}
 	if err != nil {
@@ -768,7 +962,7 @@ func (c *Operator) sync(ctx context.Context, key string) error {
 	}
 
 	logger.Info("sync prometheus")
-	ruleConfigMapNames, err := c.createOrUpdateRuleConfigMaps(ctx, p)
+	ruleConfigMapNames, ruleSelection, err := c.createOrUpdateRuleConfigMaps(ctx, p)
 	if err != nil {
 		return err
 	}
@@ -784,10 +978,17 @@ func (c *Operator) sync(ctx context.Context, key string) error {
 		return err
 	}
 
-	if err := c.createOrUpdateConfigurationSecret(ctx, p, cg, ruleConfigMapNames, assetStore); err != nil {
+	resources, err := c.createOrUpdateConfigurationSecret(ctx, p, cg, ruleConfigMapNames, assetStore)
+	if err != nil {
 		return fmt.Errorf("creating config failed: %w", err)
 	}
 
+	c.updateConfigResourcesStatus(ctx, p, resources, ruleSelection)
+
+	if err := c.reconcileManagedProber(ctx, p, resources.Probes.ValidResources()); err != nil {
+		return fmt.Errorf("failed to reconcile the managed prober: %w", err)
+	}
+
 	tlsAssets, err := operator.ReconcileShardedSecret(ctx, assetStore.TLSAssets(), c.kclient, prompkg.NewTLSAssetSecret(p, c.config))
 	if err != nil {
 		return fmt.Errorf("failed to reconcile the TLS secrets: %w", err)
@@ -979,6 +1180,61 @@ func (c *Operator) shouldRetain(p *monitoringv1.Prometheus) (bool, error) {
 	return false, nil
 }
 
+// updateConfigResourcesStatus updates the status of the configuration
+// resources (ServiceMonitor, PodMonitor, Probe, ScrapeConfig and
+// PrometheusRule) selected by the Prometheus object.
+func (c *Operator) updateConfigResourcesStatus(ctx context.Context, p *monitoringv1.Prometheus, resources prompkg.ResourcesSelection, rules operator.PrometheusRuleSelection) {
+	if !c.configResourcesStatusEnabled {
+		return
+	}
+
+	syncer := operator.NewConfigResourceSyncer(c.mclient, monitoringv1.PrometheusName, p)
+	if err := prompkg.UpdateConfigResourcesStatus(ctx, syncer, resources, c.configResourceListers()); err != nil {
+		c.logger.Warn("failed to update the status of configuration resources", "err", err, "name", p.Name, "namespace", p.Namespace)
+	}
+
+	if err := operator.UpdatePrometheusRulesStatus(ctx, syncer, rules, c.ruleInfs); err != nil {
+		c.logger.Warn("failed to update the status of PrometheusRule resources", "err", err, "name", p.Name, "namespace", p.Namespace)
+	}
+}
+
+// removeConfigResourcesBindings removes the bindings of a deleted Prometheus
+// object from the status of the configuration resources.
+func (c *Operator) removeConfigResourcesBindings(ctx context.Context, key string) {
+	if !c.configResourcesStatusEnabled {
+		return
+	}
+
+	ns, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		c.logger.Error("failed to split key", "err", err, "key", key)
+		return
+	}
+
+	syncer := operator.NewConfigResourceSyncer(c.mclient, monitoringv1.PrometheusName, &metav1.ObjectMeta{Namespace: ns, Name: name})
+	if err := prompkg.UpdateConfigResourcesStatus(ctx, syncer, prompkg.ResourcesSelection{}, c.configResourceListers()); err != nil {
+		c.logger.Warn("failed to remove bindings from configuration resources", "err", err, "key", key)
+	}
+
+	if err := operator.UpdatePrometheusRulesStatus(ctx, syncer, operator.PrometheusRuleSelection{}, c.ruleInfs); err != nil {
+		c.logger.Warn("failed to remove bindings from PrometheusRule resources", "err", err, "key", key)
+	}
+}
+
+func (c *Operator) configResourceListers() prompkg.ConfigResourceListers {
+	listers := prompkg.ConfigResourceListers{
+		ServiceMonitors: c.smonInfs.ListAll,
+		PodMonitors:     c.pmonInfs.ListAll,
+		Probes:          c.probeInfs.ListAll,
+	}
+
+	if c.sconInfs != nil {
+		listers.ScrapeConfigs = c.sconInfs.ListAll
+	}
+
+	return listers
+}
+
 // UpdateStatus updates the status subresource of the object identified by the given
 // key.
 // UpdateStatus implements the operator.Syncer interface.
@@ -1008,6 +1264,9 @@ func (c *Operator) UpdateStatus(ctx context.Context, key string) error {
 	}
 	p.Status.Selector = selector.String()
 	p.Status.Shards = ptr.Deref(p.Spec.Shards, 1)
+	if c.shardAutoscaler != nil && p.Spec.ShardAutoscaling != nil {
+		p.Status.RecommendedShards = c.shardAutoscaler.recommendation(key)
+	}
 
 	if _, err = c.mclient.MonitoringV1().Prometheuses(p.Namespace).ApplyStatus(ctx, prompkg.ApplyConfigurationFromPrometheus(p, true), metav1.ApplyOptions{FieldManager: operator.PrometheusOperatorFieldManager, Force: true}); err != nil {
 		c.logger.Info("failed to apply prometheus status subresource, trying again without scale fields", "err", err)
@@ -1109,7 +1368,9 @@ func ListOptions(name string) metav1.ListOptions {
 	}
 }
 
-func (c *Operator) createOrUpdateConfigurationSecret(ctx context.Context, p *monitoringv1.Prometheus, cg *prompkg.ConfigGenerator, ruleConfigMapNames []string, store *assets.StoreBuilder) error {
+func (c *Operator) createOrUpdateConfigurationSecret(ctx context.Context, p *monitoringv1.Prometheus, cg *prompkg.ConfigGenerator, ruleConfigMapNames []string, store *assets.StoreBuilder) (prompkg.ResourcesSelection, error) {
+	var resources prompkg.ResourcesSelection
+
 	// If no service or pod monitor selectors are configured, the user wants to
 	// manage configuration themselves. Do create an empty Secret if it doesn't
 	// exist.
@@ -1120,60 +1381,92 @@ func (c *Operator) createOrUpdateConfigurationSecret(ctx context.Context, p *mon
 		// make an empty secret
 		s, err := prompkg.MakeConfigurationSecret(p, c.config, nil)
 		if err != nil {
-			return fmt.Errorf("generating empty config secret failed: %w", err)
+			return resources, fmt.Errorf("generating empty config secret failed: %w", err)
 		}
 		sClient := c.kclient.CoreV1().Secrets(p.Namespace)
 		_, err = sClient.Get(ctx, s.Name, metav1.GetOptions{})
 		if apierrors.IsNotFound(err) {
 			if _, err := c.kclient.CoreV1().Secrets(p.Namespace).Create(ctx, s, metav1.CreateOptions{}); err != nil && !apierrors.IsAlreadyExists(err) {
-				return fmt.Errorf("creating empty config file failed: %w", err)
+				return resources, fmt.Errorf("creating empty config file failed: %w", err)
 			}
 		}
 		if !apierrors.IsNotFound(err) && err != nil {
-			return err
+			return resources, err
 		}
 
-		return nil
+		return resources, nil
 	}
 
 	resourceSelector, err := prompkg.NewResourceSelector(c.logger, p, store, c.nsMonInf, c.metrics, c.eventRecorder)
 	if err != nil {
-		return err
+		return resources, err
 	}
 
-	smons, err := resourceSelector.SelectServiceMonitors(ctx, c.smonInfs.ListAllByNamespace)
+	resources.ServiceMonitors, err = resourceSelector.SelectServiceMonitors(ctx, c.smonInfs.ListAllByNamespace)
 	if err != nil {
-		return fmt.Errorf("selecting ServiceMonitors failed: %w", err)
+		return resources, fmt.Errorf("selecting ServiceMonitors failed: %w", err)
 	}
 
-	pmons, err := resourceSelector.SelectPodMonitors(ctx, c.pmonInfs.ListAllByNamespace)
+	resources.PodMonitors, err = resourceSelector.SelectPodMonitors(ctx, c.pmonInfs.ListAllByNamespace)
 	if err != nil {
-		return fmt.Errorf("selecting PodMonitors failed: %w", err)
+		return resources, fmt.Errorf("selecting PodMonitors failed: %w", err)
 	}
 
-	bmons, err := resourceSelector.SelectProbes(ctx, c.probeInfs.ListAllByNamespace)
+	resources.Probes, err = resourceSelector.SelectProbes(ctx, c.probeInfs.ListAllByNamespace)
 	if err != nil {
-		return fmt.Errorf("selecting Probes failed: %w", err)
+		return resources, fmt.Errorf("selecting Probes failed: %w", err)
+	}
+
+	var listHTTPRoutes prompkg.ListAllByNamespaceFn
+	if c.routeInfs != nil {
+		listHTTPRoutes = c.routeInfs.ListAllByNamespace
+	}
+
+	probeHTTPRoutes, err := resourceSelector.SelectProbeHTTPRoutes(resources.Probes, listHTTPRoutes)
+	if err != nil {
+		return resources, fmt.Errorf("selecting HTTPRoutes failed: %w", err)
+	}
+	cg = cg.WithProbeHTTPRoutes(probeHTTPRoutes)
+
+	if p.Spec.ManagedProber != nil {
+		cg = cg.WithManagedProber(prompkg.BlackboxExporterAddress(p))
 	}
 
-	var scrapeConfigs map[string]*monitoringv1alpha1.ScrapeConfig
 	if c.sconInfs != nil {
-		scrapeConfigs, err = resourceSelector.SelectScrapeConfigs(ctx, c.sconInfs.ListAllByNamespace)
+		resources.ScrapeConfigs, err = resourceSelector.SelectScrapeConfigs(ctx, c.sconInfs.ListAllByNamespace)
+		if err != nil {
+			return resources, fmt.Errorf("selecting ScrapeConfigs failed: %w", err)
+		}
+	}
+
+	if c.otlpInfs != nil {
+		otlpTenants, err := resourceSelector.SelectOTLPTenants(c.otlpInfs.ListAllByNamespace)
 		if err != nil {
-			return fmt.Errorf("selecting ScrapeConfigs failed: %w", err)
+			return resources, fmt.Errorf("selecting OTLPTenants failed: %w", err)
 		}
+
+		cg = cg.WithOTLPTenants(otlpTenants.ValidResources())
+	}
+
+	if c.rwInfs != nil {
+		remoteWrites, err := resourceSelector.SelectRemoteWrites(ctx, c.rwInfs.ListAllByNamespace)
+		if err != nil {
+			return resources, fmt.Errorf("selecting RemoteWrites failed: %w", err)
+		}
+
+		cg = cg.WithRemoteWrites(remoteWrites.ValidResources())
 	}
 
 	if err := prompkg.AddRemoteReadsToStore(ctx, store, p.GetNamespace(), p.Spec.RemoteRead); err != nil {
-		return err
+		return resources, err
 	}
 
 	if err := prompkg.AddRemoteWritesToStore(ctx, store, p.GetNamespace(), p.Spec.RemoteWrite); err != nil {
-		return err
+		return resources, err
 	}
 
 	if err := prompkg.AddAPIServerConfigToStore(ctx, store, p.GetNamespace(), p.Spec.APIServerConfig); err != nil {
-		return err
+		return resources, err
 	}
 
 	if p.Spec.Alerting != nil {
@@ -1181,40 +1474,40 @@ func (c *Operator) createOrUpdateConfigurationSecret(ctx context.Context, p *mon
 
 		for i, am := range ams {
 			if err := validateAlertmanagerEndpoints(p, am); err != nil {
-				return fmt.Errorf("alertmanager %d: %w", i, err)
+				return resources, fmt.Errorf("alertmanager %d: %w", i, err)
 			}
 		}
 
 		if err := addAlertmanagerEndpointsToStore(ctx, store, p.GetNamespace(), ams); err != nil {
-			return err
+			return resources, err
 		}
 	}
 
 	if err := prompkg.AddScrapeClassesToStore(ctx, store, p.GetNamespace(), p.Spec.ScrapeClasses); err != nil {
-		return fmt.Errorf("failed to process scrape classes: %w", err)
+		return resources, fmt.Errorf("failed to process scrape classes: %w", err)
 	}
 
 	sClient := c.kclient.CoreV1().Secrets(p.Namespace)
 	additionalScrapeConfigs, err := k8sutil.LoadSecretRef(ctx, c.logger, sClient, p.Spec.AdditionalScrapeConfigs)
 	if err != nil {
-		return fmt.Errorf("loading additional scrape configs from Secret failed: %w", err)
+		return resources, fmt.Errorf("loading additional scrape configs from Secret failed: %w", err)
 	}
 	additionalAlertRelabelConfigs, err := k8sutil.LoadSecretRef(ctx, c.logger, sClient, p.Spec.AdditionalAlertRelabelConfigs)
 	if err != nil {
-		return fmt.Errorf("loading additional alert relabel configs from Secret failed: %w", err)
+		return resources, fmt.Errorf("loading additional alert relabel configs from Secret failed: %w", err)
 	}
 	additionalAlertManagerConfigs, err := k8sutil.LoadSecretRef(ctx, c.logger, sClient, p.Spec.AdditionalAlertManagerConfigs)
 	if err != nil {
-		return fmt.Errorf("loading additional alert manager configs from Secret failed: %w", err)
+		return resources, fmt.Errorf("loading additional alert manager configs from Secret failed: %w", err)
 	}
 
 	// Update secret based on the most recent configuration.
 	conf, err := cg.GenerateServerConfiguration(
 		p,
-		smons,
-		pmons,
-		bmons,
-		scrapeConfigs,
+		resources.ServiceMonitors.ValidResources(),
+		resources.PodMonitors.ValidResources(),
+		resources.Probes.ValidResources(),
+		resources.ScrapeConfigs.ValidResources(),
 		store,
 		additionalScrapeConfigs,
 		additionalAlertRelabelConfigs,
@@ -1222,17 +1515,17 @@ func (c *Operator) createOrUpdateConfigurationSecret(ctx context.Context, p *mon
 		ruleConfigMapNames,
 	)
 	if err != nil {
-		return fmt.Errorf("generating config failed: %w", err)
+		return resources, fmt.Errorf("generating config failed: %w", err)
 	}
 
 	// Compress config to avoid 1mb secret limit for a while
 	s, err := prompkg.MakeConfigurationSecret(p, c.config, conf)
 	if err != nil {
-		return fmt.Errorf("creating compressed secret failed: %w", err)
+		return resources, fmt.Errorf("creating compressed secret failed: %w", err)
 	}
 
 	c.logger.Debug("updating Prometheus configuration secret")
-	return k8sutil.CreateOrUpdateSecret(ctx, sClient, s)
+	return resources, k8sutil.CreateOrUpdateSecret(ctx, sClient, s)
 }
 
 func (c *Operator) createOrUpdateWebConfigSecret(ctx context.Context, p *monitoringv1.Prometheus) error {
@@ -0,0 +1,169 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"path"
+
+	"github.com/prometheus/client_golang/prometheus"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	kubeinformers "k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/assets"
+	monitoringclient "github.com/prometheus-operator/prometheus-operator/pkg/client/versioned"
+	"github.com/prometheus-operator/prometheus-operator/pkg/informers"
+	"github.com/prometheus-operator/prometheus-operator/pkg/k8sutil"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+	prompkg "github.com/prometheus-operator/prometheus-operator/pkg/prometheus"
+)
+
+const (
+	// RenderedConfigFilename is the name of the Prometheus configuration
+	// file returned by RenderConfiguration.
+	RenderedConfigFilename = "prometheus.yaml"
+	// RenderedRulesDir is the directory of the rule files returned by
+	// RenderConfiguration.
+	RenderedRulesDir = "rules"
+)
+
+// RenderConfiguration returns the configuration files that the controller
+// would generate for the given Prometheus object, keyed by file name.
+//
+// The Prometheus configuration is returned as "prometheus.yaml" and the rule
+// files are returned under the "rules/" directory.
+//
+// The function doesn't require a Kubernetes cluster: the clients are expected
+// to be fake clients populated with the namespaces, the configuration
+// resources, the secrets and the configmaps. The generated objects are
+// written back to the clients.
+func RenderConfiguration(ctx context.Context, logger *slog.Logger, kclient kubernetes.Interface, mclient monitoringclient.Interface, p *monitoringv1.Prometheus) (map[string][]byte, error) {
+	if logger == nil {
+		logger = slog.New(slog.DiscardHandler)
+	}
+
+	p = p.DeepCopy()
+	if err := k8sutil.AddTypeInformationToObject(p); err != nil {
+		return nil, fmt.Errorf("failed to set Prometheus type information: %w", err)
+	}
+
+	c := &Operator{
+		kclient:               kclient,
+		mclient:               mclient,
+		logger:                logger,
+		accessor:              operator.NewAccessor(logger),
+		metrics:               operator.NewMetrics(prometheus.NewRegistry()),
+		eventRecorder:         &record.FakeRecorder{},
+		scrapeConfigSupported: true,
+		otlpTenantSupported:   true,
+		remoteWriteSupported:  true,
+	}
+
+	var err error
+	for _, inf := range []struct {
+		infs     **informers.ForResource
+		resource schema.GroupVersionResource
+	}{
+		{&c.smonInfs, monitoringv1.SchemeGroupVersion.WithResource(monitoringv1.ServiceMonitorName)},
+		{&c.pmonInfs, monitoringv1.SchemeGroupVersion.WithResource(monitoringv1.PodMonitorName)},
+		{&c.probeInfs, monitoringv1.SchemeGroupVersion.WithResource(monitoringv1.ProbeName)},
+		{&c.ruleInfs, monitoringv1.SchemeGroupVersion.WithResource(monitoringv1.PrometheusRuleName)},
+		{&c.sconInfs, monitoringv1alpha1.SchemeGroupVersion.WithResource(monitoringv1alpha1.ScrapeConfigName)},
+		{&c.otlpInfs, monitoringv1alpha1.SchemeGroupVersion.WithResource(monitoringv1alpha1.OTLPTenantName)},
+		{&c.rwInfs, monitoringv1alpha1.SchemeGroupVersion.WithResource(monitoringv1alpha1.RemoteWriteName)},
+	} {
+		*inf.infs, err = informers.NewInformersForResource(
+			informers.NewMonitoringInformerFactories(
+				map[string]struct{}{v1.NamespaceAll: {}},
+				nil,
+				mclient,
+				0,
+				nil,
+			),
+			inf.resource,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("error creating %s informers: %w", inf.resource.Resource, err)
+		}
+
+		(*inf.infs).Start(ctx.Done())
+		if !cache.WaitForCacheSync(ctx.Done(), (*inf.infs).HasSynced) {
+			return nil, fmt.Errorf("failed to sync cache for %s informers", inf.resource.Resource)
+		}
+	}
+
+	kinfs := kubeinformers.NewSharedInformerFactory(kclient, 0)
+	c.nsMonInf = kinfs.Core().V1().Namespaces().Informer()
+	c.nsPromInf = c.nsMonInf
+	kinfs.Start(ctx.Done())
+	for inf, synced := range kinfs.WaitForCacheSync(ctx.Done()) {
+		if !synced {
+			return nil, fmt.Errorf("failed to sync cache for %s informer", inf)
+		}
+	}
+
+	ruleConfigMapNames, _, err := c.createOrUpdateRuleConfigMaps(ctx, p)
+	if err != nil {
+		return nil, err
+	}
+
+	cg, err := prompkg.NewConfigGenerator(logger, p, prompkg.WithEndpointSliceSupport())
+	if err != nil {
+		return nil, err
+	}
+
+	store := assets.NewStoreBuilder(kclient.CoreV1(), kclient.CoreV1())
+	if _, err := c.createOrUpdateConfigurationSecret(ctx, p, cg, ruleConfigMapNames, store); err != nil {
+		return nil, fmt.Errorf("creating config failed: %w", err)
+	}
+
+	files := map[string][]byte{}
+
+	s, err := kclient.CoreV1().Secrets(p.Namespace).Get(ctx, prompkg.ConfigSecretName(p), metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the configuration secret: %w", err)
+	}
+
+	if b := s.Data[prompkg.ConfigFilename]; len(b) > 0 {
+		conf, err := operator.GunzipConfig(b)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decompress the configuration: %w", err)
+		}
+
+		files[RenderedConfigFilename] = []byte(conf)
+	}
+
+	for _, name := range ruleConfigMapNames {
+		cm, err := kclient.CoreV1().ConfigMaps(p.Namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the rules configmap %q: %w", name, err)
+		}
+
+		for f, content := range cm.Data {
+			files[path.Join(RenderedRulesDir, f)] = []byte(content)
+		}
+	}
+
+	return files, nil
+}
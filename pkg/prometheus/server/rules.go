@@ -31,12 +31,12 @@ import (
 	prompkg "github.com/prometheus-operator/prometheus-operator/pkg/prometheus"
 )
 
-func (c *Operator) createOrUpdateRuleConfigMaps(ctx context.Context, p *monitoringv1.Prometheus) ([]string, error) {
+func (c *Operator) createOrUpdateRuleConfigMaps(ctx context.Context, p *monitoringv1.Prometheus) ([]string, operator.PrometheusRuleSelection, error) {
 	cClient := c.kclient.CoreV1().ConfigMaps(p.Namespace)
 
 	namespaces, err := c.selectRuleNamespaces(p)
 	if err != nil {
-		return nil, err
+		return nil, operator.PrometheusRuleSelection{}, err
 	}
 
 	excludedFromEnforcement := p.Spec.ExcludedFromEnforcement
@@ -61,22 +61,22 @@ func (c *Operator) createOrUpdateRuleConfigMaps(ctx context.Context, p *monitori
 
 	promRuleSelector, err := operator.NewPrometheusRuleSelector(operator.PrometheusFormat, promVersion, p.Spec.RuleSelector, nsLabeler, c.ruleInfs, c.eventRecorder, logger)
 	if err != nil {
-		return nil, fmt.Errorf("initializing PrometheusRules failed: %w", err)
+		return nil, operator.PrometheusRuleSelection{}, fmt.Errorf("initializing PrometheusRules failed: %w", err)
 	}
 
-	newRules, rejected, err := promRuleSelector.Select(namespaces)
+	selection, err := promRuleSelector.Select(namespaces)
 	if err != nil {
-		return nil, fmt.Errorf("selecting PrometheusRules failed: %w", err)
+		return nil, operator.PrometheusRuleSelection{}, fmt.Errorf("selecting PrometheusRules failed: %w", err)
 	}
 
 	if pKey, ok := c.accessor.MetaNamespaceKey(p); ok {
-		c.metrics.SetSelectedResources(pKey, monitoringv1.PrometheusRuleKind, len(newRules))
-		c.metrics.SetRejectedResources(pKey, monitoringv1.PrometheusRuleKind, rejected)
+		c.metrics.SetSelectedResources(pKey, monitoringv1.PrometheusRuleKind, selection.SelectedLen())
+		c.metrics.SetRejectedResources(pKey, monitoringv1.PrometheusRuleKind, selection.RejectedLen())
 	}
 
 	currentConfigMapList, err := cClient.List(ctx, prometheusRulesConfigMapSelector(p.Name))
 	if err != nil {
-		return nil, err
+		return nil, operator.PrometheusRuleSelection{}, err
 	}
 	currentConfigMaps := currentConfigMapList.Items
 
@@ -87,7 +87,7 @@ func (c *Operator) createOrUpdateRuleConfigMaps(ctx context.Context, p *monitori
 		}
 	}
 
-	equal := reflect.DeepEqual(newRules, currentRules)
+	equal := reflect.DeepEqual(selection.RuleFiles(), currentRules)
 	if equal && len(currentConfigMaps) != 0 {
 		c.logger.Debug("no PrometheusRule changes",
 			"namespace", p.Namespace,
@@ -97,17 +97,17 @@ func (c *Operator) createOrUpdateRuleConfigMaps(ctx context.Context, p *monitori
 		for _, cm := range currentConfigMaps {
 			currentConfigMapNames = append(currentConfigMapNames, cm.Name)
 		}
-		return currentConfigMapNames, nil
+		return currentConfigMapNames, selection, nil
 	}
 
 	newConfigMaps, err := makeRulesConfigMaps(
 		p,
-		newRules,
+		selection.RuleFiles(),
 		operator.WithAnnotations(c.config.Annotations),
 		operator.WithLabels(c.config.Labels),
 	)
 	if err != nil {
-		return nil, fmt.Errorf("failed to make rules ConfigMaps: %w", err)
+		return nil, operator.PrometheusRuleSelection{}, fmt.Errorf("failed to make rules ConfigMaps: %w", err)
 	}
 
 	newConfigMapNames := make([]string, 0, len(newConfigMaps))
@@ -123,10 +123,10 @@ func (c *Operator) createOrUpdateRuleConfigMaps(ctx context.Context, p *monitori
 		for _, cm := range newConfigMaps {
 			_, err = cClient.Create(ctx, &cm, metav1.CreateOptions{})
 			if err != nil {
-				return nil, fmt.Errorf("failed to create ConfigMap '%v': %w", cm.Name, err)
+				return nil, operator.PrometheusRuleSelection{}, fmt.Errorf("failed to create ConfigMap '%v': %w", cm.Name, err)
 			}
 		}
-		return newConfigMapNames, nil
+		return newConfigMapNames, selection, nil
 	}
 
 	// Simply deleting old ConfigMaps and creating new ones for now. Could be
@@ -134,7 +134,7 @@ func (c *Operator) createOrUpdateRuleConfigMaps(ctx context.Context, p *monitori
 	for _, cm := range currentConfigMaps {
 		err := cClient.Delete(ctx, cm.Name, metav1.DeleteOptions{})
 		if err != nil {
-			return nil, fmt.Errorf("failed to delete current ConfigMap '%v': %w", cm.Name, err)
+			return nil, operator.PrometheusRuleSelection{}, fmt.Errorf("failed to delete current ConfigMap '%v': %w", cm.Name, err)
 		}
 	}
 
@@ -145,11 +145,11 @@ func (c *Operator) createOrUpdateRuleConfigMaps(ctx context.Context, p *monitori
 	for _, cm := range newConfigMaps {
 		_, err = cClient.Create(ctx, &cm, metav1.CreateOptions{})
 		if err != nil {
-			return nil, fmt.Errorf("failed to create new ConfigMap '%v': %w", cm.Name, err)
+			return nil, operator.PrometheusRuleSelection{}, fmt.Errorf("failed to create new ConfigMap '%v': %w", cm.Name, err)
 		}
 	}
 
-	return newConfigMapNames, nil
+	return newConfigMapNames, selection, nil
 }
 
 func prometheusRulesConfigMapSelector(prometheusName string) metav1.ListOptions {
@@ -0,0 +1,391 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"net"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/k8sutil"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+	prompkg "github.com/prometheus-operator/prometheus-operator/pkg/prometheus"
+)
+
+const (
+	shardAutoscalingInterval = time.Minute
+
+	headSeriesMetricName = "prometheus_tsdb_head_series"
+
+	defaultShardAutoscalingTolerancePercent = 10
+	defaultShardAutoscalingScaleDownDelay   = time.Hour
+)
+
+// shardAutoscaler computes the number of shards recommended for the
+// Prometheus objects defining `spec.shardAutoscaling`.
+type shardAutoscaler struct {
+	client *http.Client
+	now    func() time.Time
+
+	mtx sync.Mutex
+	// Time since which a lower number of shards has been computed, indexed
+	// by Prometheus key.
+	scaleDownSince map[string]time.Time
+	// Last recommendation, indexed by Prometheus key.
+	recommendations map[string]int32
+}
+
+func newShardAutoscaler() *shardAutoscaler {
+	return &shardAutoscaler{
+		client:          &http.Client{Timeout: 10 * time.Second},
+		now:             time.Now,
+		scaleDownSince:  map[string]time.Time{},
+		recommendations: map[string]int32{},
+	}
+}
+
+// desiredShards returns the number of shards required to handle the given
+// number of active series.
+// The current number of shards is returned as long as the average number of
+// series per shard is within the tolerance of the target.
+func desiredShards(spec *monitoringv1.ShardAutoscalingSpec, current int32, series int64) int32 {
+	desired := current
+
+	tolerance := float64(ptr.Deref(spec.TolerancePercent, defaultShardAutoscalingTolerancePercent)) / 100
+	ratio := float64(series) / (float64(current) * float64(spec.TargetSeriesPerShard))
+	if math.Abs(ratio-1) > tolerance {
+		desired = int32(math.Ceil(float64(series) / float64(spec.TargetSeriesPerShard)))
+	}
+
+	if minShards := ptr.Deref(spec.MinShards, 1); desired < minShards {
+		desired = minShards
+	}
+
+	if spec.MaxShards != nil && desired > *spec.MaxShards {
+		desired = *spec.MaxShards
+	}
+
+	return desired
+}
+
+// recommend returns the recommended number of shards.
+// A lower number of shards is only recommended once it has been consistently
+// computed for the duration of the scale-down delay. It is then recommended
+// for as long as it is computed.
+func (sa *shardAutoscaler) recommend(key string, spec *monitoringv1.ShardAutoscalingSpec, current int32, series int64) (int32, error) {
+	delay := defaultShardAutoscalingScaleDownDelay
+	if spec.ScaleDownDelay != nil {
+		d, err := time.ParseDuration(string(*spec.ScaleDownDelay))
+		if err != nil {
+			return 0, fmt.Errorf("invalid scaleDownDelay: %w", err)
+		}
+		delay = d
+	}
+
+	desired := desiredShards(spec, current, series)
+
+	sa.mtx.Lock()
+	defer sa.mtx.Unlock()
+
+	if desired >= current {
+		delete(sa.scaleDownSince, key)
+		sa.recommendations[key] = desired
+		return desired, nil
+	}
+
+	since, found := sa.scaleDownSince[key]
+	if !found {
+		since = sa.now()
+		sa.scaleDownSince[key] = since
+	}
+
+	if sa.now().Sub(since) < delay {
+		desired = current
+	}
+
+	sa.recommendations[key] = desired
+	return desired, nil
+}
+
+// recommendation returns the last recommendation for the Prometheus key.
+func (sa *shardAutoscaler) recommendation(key string) *int32 {
+	sa.mtx.Lock()
+	defer sa.mtx.Unlock()
+
+	r, found := sa.recommendations[key]
+	if !found {
+		return nil
+	}
+
+	return ptr.To(r)
+}
+
+// forget removes the state of the Prometheus key.
+func (sa *shardAutoscaler) forget(key string) {
+	sa.mtx.Lock()
+	defer sa.mtx.Unlock()
+
+	delete(sa.scaleDownSince, key)
+	delete(sa.recommendations, key)
+}
+
+// runShardAutoscaler periodically computes the number of shards of the
+// Prometheus objects defining `spec.shardAutoscaling`.
+func (c *Operator) runShardAutoscaler(ctx context.Context) {
+	ticker := time.NewTicker(shardAutoscalingInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		err := c.promInfs.ListAll(labels.Everything(), func(obj interface{}) {
+			p := obj.(*monitoringv1.Prometheus)
+			if p.Spec.ShardAutoscaling == nil || p.Spec.Paused || c.rr.DeletionInProgress(p) || !c.rr.IsManagedByController(p) {
+				return
+			}
+
+			if err := c.autoscaleShards(ctx, p); err != nil {
+				c.logger.Warn("failed to compute the number of shards", "err", err, "name", p.Name, "namespace", p.Namespace)
+			}
+		})
+		if err != nil {
+			c.logger.Error("failed to list Prometheus objects", "err", err)
+		}
+	}
+}
+
+// autoscaleShards computes the recommended number of shards for the
+// Prometheus object. In the `Scale` mode, it also updates the number of
+// shards of the object.
+func (c *Operator) autoscaleShards(ctx context.Context, p *monitoringv1.Prometheus) error {
+	key, ok := c.accessor.MetaNamespaceKey(p)
+	if !ok {
+		return nil
+	}
+
+	current := ptr.Deref(p.Spec.Shards, 1)
+
+	series, err := c.activeSeries(ctx, p, current)
+	if err != nil {
+		return err
+	}
+
+	previous := c.shardAutoscaler.recommendation(key)
+	recommended, err := c.shardAutoscaler.recommend(key, p.Spec.ShardAutoscaling, current, series)
+	if err != nil {
+		return err
+	}
+
+	if previous == nil || *previous != recommended {
+		c.logger.Info("recommended number of shards changed", "name", p.Name, "namespace", p.Namespace, "series", series, "shards", current, "recommended", recommended)
+		c.RefreshStatusFor(p)
+	}
+
+	if ptr.Deref(p.Spec.ShardAutoscaling.Mode, monitoringv1.RecommendShardAutoscalingMode) != monitoringv1.ScaleShardAutoscalingMode || recommended == current {
+		return nil
+	}
+
+	if recommended < current {
+		retain, err := c.shouldRetain(p)
+		if err != nil {
+			return err
+		}
+
+		if !retain {
+			c.logger.Info("not scaling down because the shard retention policy isn't 'Retain'", "name", p.Name, "namespace", p.Namespace, "recommended", recommended)
+			return nil
+		}
+	}
+
+	patch := fmt.Sprintf(`{"spec":{"shards":%d}}`, recommended)
+	if _, err := c.mclient.MonitoringV1().Prometheuses(p.Namespace).Patch(
+		ctx,
+		p.Name,
+		types.MergePatchType,
+		[]byte(patch),
+		metav1.PatchOptions{FieldManager: operator.PrometheusOperatorFieldManager},
+	); err != nil {
+		return fmt.Errorf("failed to update the number of shards: %w", err)
+	}
+
+	return nil
+}
+
+// activeSeries returns the number of active series handled by the Prometheus
+// object. Because the replicas of a shard scrape the same targets, the
+// number of series of a shard is the maximum value reported by its pods.
+func (c *Operator) activeSeries(ctx context.Context, p *monitoringv1.Prometheus, shards int32) (int64, error) {
+	if p.Spec.ListenLocal {
+		return 0, fmt.Errorf("not supported when listenLocal is true")
+	}
+
+	if p.Spec.PrometheusURIScheme() != "http" {
+		return 0, fmt.Errorf("not supported when the web server uses TLS")
+	}
+
+	pods, err := c.kclient.CoreV1().Pods(p.Namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(makeSelectorLabels(p.Name)).String(),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to list pods: %w", err)
+	}
+
+	perShard := make(map[string]int64, shards)
+	for _, pod := range pods.Items {
+		if ready, _ := k8sutil.PodRunningAndReady(pod); !ready {
+			continue
+		}
+
+		u, err := headSeriesURL(p, &pod, c.clusterDomain)
+		if err != nil {
+			return 0, err
+		}
+
+		series, err := c.shardAutoscaler.headSeries(ctx, u)
+		if err != nil {
+			c.logger.Debug("failed to get the number of head series", "err", err, "pod", pod.Name, "namespace", pod.Namespace)
+			continue
+		}
+
+		shard := pod.Labels[prompkg.ShardLabelName]
+		perShard[shard] = max(perShard[shard], series)
+	}
+
+	var total int64
+	for shard := range shards {
+		series, found := perShard[strconv.Itoa(int(shard))]
+		if !found {
+			return 0, fmt.Errorf("no ready pod reporting series for shard %d", shard)
+		}
+
+		total += series
+	}
+
+	return total, nil
+}
+
+// headSeriesURL returns the URL of the metrics endpoint of the pod, resolved
+// through the governing service.
+func headSeriesURL(p *monitoringv1.Prometheus, pod *v1.Pod, clusterDomain string) (string, error) {
+	portName := prompkg.DefaultPortName
+	if p.Spec.PortName != "" {
+		portName = p.Spec.PortName
+	}
+
+	var port int32
+	for _, c := range pod.Spec.Containers {
+		if c.Name != "prometheus" {
+			continue
+		}
+
+		for _, cp := range c.Ports {
+			if cp.Name == portName {
+				port = cp.ContainerPort
+			}
+		}
+	}
+
+	if port == 0 {
+		return "", fmt.Errorf("pod %s/%s: port %q not found", pod.Namespace, pod.Name, portName)
+	}
+
+	svc := governingServiceName
+	if p.Spec.ServiceName != nil {
+		svc = *p.Spec.ServiceName
+	}
+
+	host := fmt.Sprintf("%s.%s.%s.svc", pod.Name, svc, pod.Namespace)
+	if clusterDomain != "" {
+		host = fmt.Sprintf("%s.%s", host, clusterDomain)
+	}
+
+	return fmt.Sprintf(
+		"http://%s%s",
+		net.JoinHostPort(host, strconv.Itoa(int(port))),
+		path.Join(p.Spec.WebRoutePrefix(), "metrics"),
+	), nil
+}
+
+// headSeries returns the value of the prometheus_tsdb_head_series metric
+// exposed at the given URL.
+func (sa *shardAutoscaler) headSeries(ctx context.Context, u string) (int64, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Accept", "text/plain")
+
+	resp, err := sa.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	return parseHeadSeries(resp.Body)
+}
+
+// parseHeadSeries extracts the value of the prometheus_tsdb_head_series
+// metric from the text exposition format.
+func parseHeadSeries(r io.Reader) (int64, error) {
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if !strings.HasPrefix(line, headSeriesMetricName+" ") {
+			continue
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			break
+		}
+
+		v, err := strconv.ParseFloat(fields[1], 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid value for %s: %w", headSeriesMetricName, err)
+		}
+
+		return int64(v), nil
+	}
+
+	if err := scanner.Err(); err != nil {
+		return 0, err
+	}
+
+	return 0, fmt.Errorf("metric %s not found", headSeriesMetricName)
+}
@@ -0,0 +1,286 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+)
+
+func TestDesiredShards(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		spec     monitoringv1.ShardAutoscalingSpec
+		current  int32
+		series   int64
+		expected int32
+	}{
+		{
+			name:     "within tolerance",
+			spec:     monitoringv1.ShardAutoscalingSpec{TargetSeriesPerShard: 1000},
+			current:  2,
+			series:   2150,
+			expected: 2,
+		},
+		{
+			name:     "scale up",
+			spec:     monitoringv1.ShardAutoscalingSpec{TargetSeriesPerShard: 1000},
+			current:  2,
+			series:   3500,
+			expected: 4,
+		},
+		{
+			name:     "scale down",
+			spec:     monitoringv1.ShardAutoscalingSpec{TargetSeriesPerShard: 1000},
+			current:  4,
+			series:   1500,
+			expected: 2,
+		},
+		{
+			name: "zero tolerance",
+			spec: monitoringv1.ShardAutoscalingSpec{
+				TargetSeriesPerShard: 1000,
+				TolerancePercent:     ptr.To(int32(0)),
+			},
+			current:  2,
+			series:   2001,
+			expected: 3,
+		},
+		{
+			name: "minimum shards",
+			spec: monitoringv1.ShardAutoscalingSpec{
+				TargetSeriesPerShard: 1000,
+				MinShards:            ptr.To(int32(3)),
+			},
+			current:  4,
+			series:   100,
+			expected: 3,
+		},
+		{
+			name: "maximum shards",
+			spec: monitoringv1.ShardAutoscalingSpec{
+				TargetSeriesPerShard: 1000,
+				MaxShards:            ptr.To(int32(5)),
+			},
+			current:  2,
+			series:   10000,
+			expected: 5,
+		},
+		{
+			name:     "no series",
+			spec:     monitoringv1.ShardAutoscalingSpec{TargetSeriesPerShard: 1000},
+			current:  3,
+			series:   0,
+			expected: 1,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, desiredShards(&tc.spec, tc.current, tc.series))
+		})
+	}
+}
+
+func TestShardAutoscalerRecommend(t *testing.T) {
+	now := time.Now()
+	sa := newShardAutoscaler()
+	sa.now = func() time.Time { return now }
+
+	spec := &monitoringv1.ShardAutoscalingSpec{
+		TargetSeriesPerShard: 1000,
+		ScaleDownDelay:       ptr.To(monitoringv1.Duration("10m")),
+	}
+
+	require.Nil(t, sa.recommendation("ns/p"))
+
+	// Scaling up is recommended immediately.
+	r, err := sa.recommend("ns/p", spec, 2, 4000)
+	require.NoError(t, err)
+	require.Equal(t, int32(4), r)
+	require.Equal(t, ptr.To(int32(4)), sa.recommendation("ns/p"))
+
+	// Scaling down is recommended after the delay.
+	r, err = sa.recommend("ns/p", spec, 4, 1000)
+	require.NoError(t, err)
+	require.Equal(t, int32(4), r)
+
+	now = now.Add(5 * time.Minute)
+	r, err = sa.recommend("ns/p", spec, 4, 1000)
+	require.NoError(t, err)
+	require.Equal(t, int32(4), r)
+
+	now = now.Add(5 * time.Minute)
+	r, err = sa.recommend("ns/p", spec, 4, 1000)
+	require.NoError(t, err)
+	require.Equal(t, int32(1), r)
+
+	// Scaling down is still recommended as long as it is computed.
+	now = now.Add(time.Minute)
+	r, err = sa.recommend("ns/p", spec, 4, 1000)
+	require.NoError(t, err)
+	require.Equal(t, int32(1), r)
+
+	// Until the number of series increases.
+	r, err = sa.recommend("ns/p", spec, 4, 4000)
+	require.NoError(t, err)
+	require.Equal(t, int32(4), r)
+
+	// Then the delay starts again.
+	r, err = sa.recommend("ns/p", spec, 4, 1000)
+	require.NoError(t, err)
+	require.Equal(t, int32(4), r)
+
+	now = now.Add(5 * time.Minute)
+	r, err = sa.recommend("ns/p", spec, 4, 1000)
+	require.NoError(t, err)
+	require.Equal(t, int32(4), r)
+
+	now = now.Add(5 * time.Minute)
+	r, err = sa.recommend("ns/p", spec, 4, 1000)
+	require.NoError(t, err)
+	require.Equal(t, int32(1), r)
+
+	// Once scaled down, the delay is reset.
+	r, err = sa.recommend("ns/p", spec, 1, 1000)
+	require.NoError(t, err)
+	require.Equal(t, int32(1), r)
+
+	r, err = sa.recommend("ns/p", spec, 2, 1000)
+	require.NoError(t, err)
+	require.Equal(t, int32(2), r)
+
+	sa.forget("ns/p")
+	require.Nil(t, sa.recommendation("ns/p"))
+}
+
+func TestParseHeadSeries(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		body     string
+		expected int64
+		err      bool
+	}{
+		{
+			name: "valid",
+			body: `# HELP prometheus_tsdb_head_series Total number of series in the head block.
+# TYPE prometheus_tsdb_head_series gauge
+prometheus_tsdb_head_series 12345
+prometheus_tsdb_head_series_created_total 20000
+`,
+			expected: 12345,
+		},
+		{
+			name:     "exponent notation",
+			body:     "prometheus_tsdb_head_series 1.2345e+06\n",
+			expected: 1234500,
+		},
+		{
+			name: "missing metric",
+			body: "prometheus_tsdb_head_series_created_total 20000\n",
+			err:  true,
+		},
+		{
+			name: "invalid value",
+			body: "prometheus_tsdb_head_series foo\n",
+			err:  true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			v, err := parseHeadSeries(strings.NewReader(tc.body))
+			if tc.err {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, v)
+		})
+	}
+}
+
+func TestHeadSeries(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/metrics" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		fmt.Fprintln(w, "prometheus_tsdb_head_series 42")
+	}))
+	defer srv.Close()
+
+	sa := newShardAutoscaler()
+
+	v, err := sa.headSeries(context.Background(), srv.URL+"/metrics")
+	require.NoError(t, err)
+	require.Equal(t, int64(42), v)
+
+	_, err = sa.headSeries(context.Background(), srv.URL+"/foo")
+	require.Error(t, err)
+}
+
+func TestHeadSeriesURL(t *testing.T) {
+	pod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "prometheus-test-shard-1-0",
+			Namespace: "default",
+		},
+		Spec: v1.PodSpec{
+			Containers: []v1.Container{
+				{
+					Name:  "prometheus",
+					Ports: []v1.ContainerPort{{Name: "web", ContainerPort: 9090}},
+				},
+				{
+					Name:  "thanos-sidecar",
+					Ports: []v1.ContainerPort{{Name: "http", ContainerPort: 10902}},
+				},
+			},
+		},
+	}
+
+	p := &monitoringv1.Prometheus{}
+	u, err := headSeriesURL(p, pod, "")
+	require.NoError(t, err)
+	require.Equal(t, "http://prometheus-test-shard-1-0.prometheus-operated.default.svc:9090/metrics", u)
+
+	p.Spec.ServiceName = ptr.To("custom")
+	p.Spec.RoutePrefix = "/prometheus"
+	u, err = headSeriesURL(p, pod, "cluster.local")
+	require.NoError(t, err)
+	require.Equal(t, "http://prometheus-test-shard-1-0.custom.default.svc.cluster.local:9090/prometheus/metrics", u)
+
+	// The port follows the name of the web port.
+	pod.Spec.Containers[0].Ports = []v1.ContainerPort{{Name: "http", ContainerPort: 8080}}
+	p.Spec.PortName = "http"
+	u, err = headSeriesURL(p, pod, "")
+	require.NoError(t, err)
+	require.Equal(t, "http://prometheus-test-shard-1-0.custom.default.svc:8080/prometheus/metrics", u)
+
+	p.Spec.PortName = "web"
+	_, err = headSeriesURL(p, pod, "")
+	require.Error(t, err)
+}
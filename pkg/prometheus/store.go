@@ -25,40 +25,44 @@ import (
 // AddRemoteWritesToStore loads all secret/configmap references from remote-write configs into the store.
 func AddRemoteWritesToStore(ctx context.Context, store *assets.StoreBuilder, namespace string, rws []monitoringv1.RemoteWriteSpec) error {
 	for i, remote := range rws {
-		if err := validateRemoteWriteSpec(remote); err != nil {
+		if err := addRemoteWriteToStore(ctx, store, namespace, remote); err != nil {
 			return fmt.Errorf("remote write %d: %w", i, err)
 		}
+	}
 
-		if err := store.AddBasicAuth(ctx, namespace, remote.BasicAuth); err != nil {
-			return fmt.Errorf("remote write %d: %w", i, err)
-		}
+	return nil
+}
 
-		if err := store.AddOAuth2(ctx, namespace, remote.OAuth2); err != nil {
-			return fmt.Errorf("remote write %d: %w", i, err)
-		}
+func addRemoteWriteToStore(ctx context.Context, store *assets.StoreBuilder, namespace string, remote monitoringv1.RemoteWriteSpec) error {
+	if err := validateRemoteWriteSpec(remote); err != nil {
+		return err
+	}
 
-		if err := store.AddTLSConfig(ctx, namespace, remote.TLSConfig); err != nil {
-			return fmt.Errorf("remote write %d: %w", i, err)
-		}
+	if err := store.AddBasicAuth(ctx, namespace, remote.BasicAuth); err != nil {
+		return err
+	}
 
-		if err := store.AddAuthorizationCredentials(ctx, namespace, remote.Authorization); err != nil {
-			return fmt.Errorf("remote write %d: %w", i, err)
-		}
+	if err := store.AddOAuth2(ctx, namespace, remote.OAuth2); err != nil {
+		return err
+	}
 
-		if err := store.AddSigV4(ctx, namespace, remote.Sigv4); err != nil {
-			return fmt.Errorf("remote write %d: %w", i, err)
-		}
+	if err := store.AddTLSConfig(ctx, namespace, remote.TLSConfig); err != nil {
+		return err
+	}
 
-		if err := store.AddAzureOAuth(ctx, namespace, remote.AzureAD); err != nil {
-			return fmt.Errorf("remote write %d: %w", i, err)
-		}
+	if err := store.AddAuthorizationCredentials(ctx, namespace, remote.Authorization); err != nil {
+		return err
+	}
 
-		if err := store.AddProxyConfig(ctx, namespace, remote.ProxyConfig); err != nil {
-			return fmt.Errorf("remote write %d: %w", i, err)
-		}
+	if err := store.AddSigV4(ctx, namespace, remote.Sigv4); err != nil {
+		return err
 	}
 
-	return nil
+	if err := store.AddAzureOAuth(ctx, namespace, remote.AzureAD); err != nil {
+		return err
+	}
+
+	return store.AddProxyConfig(ctx, namespace, remote.ProxyConfig)
 }
 
 // AddRemoteReadsToStore loads all secret/configmap references from remote-read configs into the store.
@@ -0,0 +1,535 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compactor
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/mitchellh/hashstructure"
+	"github.com/prometheus/client_golang/prometheus"
+	appsv1 "k8s.io/api/apps/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	monitoringv1ac "github.com/prometheus-operator/prometheus-operator/pkg/client/applyconfiguration/monitoring/v1"
+	monitoringv1alpha1ac "github.com/prometheus-operator/prometheus-operator/pkg/client/applyconfiguration/monitoring/v1alpha1"
+	monitoringclient "github.com/prometheus-operator/prometheus-operator/pkg/client/versioned"
+	"github.com/prometheus-operator/prometheus-operator/pkg/informers"
+	"github.com/prometheus-operator/prometheus-operator/pkg/k8sutil"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+)
+
+const (
+	resyncPeriod         = 5 * time.Minute
+	thanosCompactorLabel = "thanos-compactor"
+	controllerName       = "thanos-compactor-controller"
+)
+
+// Operator manages the life cycle of the Thanos Compactor deployments.
+type Operator struct {
+	kclient kubernetes.Interface
+	mclient monitoringclient.Interface
+
+	logger *slog.Logger
+
+	controllerID string
+
+	compactorInfs *informers.ForResource
+	promInfs      *informers.ForResource
+	ssetInfs      *informers.ForResource
+
+	accessor *operator.Accessor
+
+	rr *operator.ResourceReconciler
+
+	metrics             *operator.Metrics
+	reconciliations     *operator.ReconciliationTracker
+	canReadStorageClass bool
+
+	config Config
+}
+
+// Config defines the operator's parameters for the Thanos Compactor controller.
+// Whenever the value of one of these parameters is changed, it triggers an
+// update of the managed statefulsets.
+type Config struct {
+	ThanosDefaultBaseImage string
+	Annotations            operator.Map
+	Labels                 operator.Map
+}
+
+type ControllerOption func(*Operator)
+
+// WithStorageClassValidation tells that the controller should verify that the
+// ThanosCompactor spec references a valid StorageClass name.
+func WithStorageClassValidation() ControllerOption {
+	return func(o *Operator) {
+		o.canReadStorageClass = true
+	}
+}
+
+// New creates a new controller.
+func New(ctx context.Context, restConfig *rest.Config, c operator.Config, logger *slog.Logger, r prometheus.Registerer, options ...ControllerOption) (*Operator, error) {
+	logger = logger.With("component", controllerName)
+
+	client, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating kubernetes client failed: %w", err)
+	}
+
+	mclient, err := monitoringclient.NewForConfig(restConfig)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating monitoring client failed: %w", err)
+	}
+
+	// All the metrics exposed by the controller get the controller="thanos-compactor" label.
+	r = prometheus.WrapRegistererWith(prometheus.Labels{"controller": "thanos-compactor"}, r)
+
+	o := &Operator{
+		kclient:         client,
+		mclient:         mclient,
+		logger:          logger,
+		metrics:         operator.NewMetrics(r),
+		reconciliations: &operator.ReconciliationTracker{},
+		accessor:        operator.NewAccessor(logger),
+		controllerID:    c.ControllerID,
+		config: Config{
+			ThanosDefaultBaseImage: c.ThanosDefaultBaseImage,
+			Annotations:            c.Annotations,
+			Labels:                 c.Labels,
+		},
+	}
+	for _, opt := range options {
+		opt(o)
+	}
+
+	o.compactorInfs, err = informers.NewInformersForResource(
+		informers.NewMonitoringInformerFactories(
+			c.Namespaces.ThanosRulerAllowList,
+			c.Namespaces.DenyList,
+			mclient,
+			resyncPeriod,
+			nil,
+		),
+		monitoringv1alpha1.SchemeGroupVersion.WithResource(monitoringv1alpha1.ThanosCompactorName),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error creating thanoscompactor informers: %w", err)
+	}
+
+	o.rr = operator.NewResourceReconciler(
+		o.logger,
+		o,
+		o.compactorInfs,
+		o.metrics,
+		monitoringv1alpha1.ThanosCompactorKind,
+		r,
+		o.controllerID,
+	)
+
+	o.promInfs, err = informers.NewInformersForResource(
+		informers.NewMonitoringInformerFactories(
+			c.Namespaces.PrometheusAllowList,
+			c.Namespaces.DenyList,
+			mclient,
+			resyncPeriod,
+			func(options *metav1.ListOptions) {
+				options.LabelSelector = c.PromSelector.String()
+			},
+		),
+		monitoringv1.SchemeGroupVersion.WithResource(monitoringv1.PrometheusName),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error creating prometheus informers: %w", err)
+	}
+
+	o.ssetInfs, err = informers.NewInformersForResource(
+		informers.NewKubeInformerFactories(
+			c.Namespaces.ThanosRulerAllowList,
+			c.Namespaces.DenyList,
+			o.kclient,
+			resyncPeriod,
+			nil,
+		),
+		appsv1.SchemeGroupVersion.WithResource("statefulsets"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error creating statefulset informers: %w", err)
+	}
+
+	return o, nil
+}
+
+// waitForCacheSync waits for the informers' caches to be synced.
+func (o *Operator) waitForCacheSync(ctx context.Context) error {
+	for _, infs := range []struct {
+		name                 string
+		informersForResource *informers.ForResource
+	}{
+		{"ThanosCompactor", o.compactorInfs},
+		{"Prometheus", o.promInfs},
+		{"StatefulSet", o.ssetInfs},
+	} {
+		for _, inf := range infs.informersForResource.GetInformers() {
+			if !operator.WaitForNamedCacheSync(ctx, "thanos-compactor", o.logger.With("informer", infs.name), inf.Informer()) {
+				return fmt.Errorf("failed to sync cache for %s informer", infs.name)
+			}
+		}
+	}
+
+	o.logger.Info("successfully synced all caches")
+	return nil
+}
+
+// addHandlers adds the eventhandlers to the informers.
+func (o *Operator) addHandlers() {
+	o.compactorInfs.AddEventHandler(o.rr)
+	o.ssetInfs.AddEventHandler(o.rr)
+
+	// The default replica labels depend on the Prometheus resources which
+	// upload blocks to the bucket.
+	o.promInfs.AddEventHandler(operator.NewEventHandler(
+		o.logger,
+		o.accessor,
+		o.metrics,
+		monitoringv1.PrometheusesKind,
+		o.enqueueForNamespace,
+		operator.WithoutStatusUpdates(),
+	))
+}
+
+// Run the controller.
+func (o *Operator) Run(ctx context.Context) error {
+	go o.rr.Run(ctx)
+	defer o.rr.Stop()
+
+	go o.compactorInfs.Start(ctx.Done())
+	go o.promInfs.Start(ctx.Done())
+	go o.ssetInfs.Start(ctx.Done())
+	if err := o.waitForCacheSync(ctx); err != nil {
+		return err
+	}
+
+	// Refresh the status of the existing ThanosCompactor objects.
+	_ = o.compactorInfs.ListAll(labels.Everything(), func(obj interface{}) {
+		o.rr.EnqueueForStatus(obj.(*monitoringv1alpha1.ThanosCompactor))
+	})
+
+	o.addHandlers()
+
+	go operator.StatusPoller(ctx, o)
+
+	o.metrics.Ready().Set(1)
+	<-ctx.Done()
+	return nil
+}
+
+// Iterate implements the operator.StatusReconciler interface.
+func (o *Operator) Iterate(processFn func(metav1.Object, []monitoringv1.Condition)) {
+	if err := o.compactorInfs.ListAll(labels.Everything(), func(o interface{}) {
+		tc := o.(*monitoringv1alpha1.ThanosCompactor)
+		processFn(tc, tc.Status.Conditions)
+	}); err != nil {
+		o.logger.Error("failed to list ThanosCompactor objects", "err", err)
+	}
+}
+
+// RefreshStatusFor implements the operator.StatusReconciler interface.
+func (o *Operator) RefreshStatusFor(obj metav1.Object) {
+	o.rr.EnqueueForStatus(obj)
+}
+
+// enqueueForNamespace enqueues all ThanosCompactor object keys that belong to
+// the given namespace.
+func (o *Operator) enqueueForNamespace(nsName string) {
+	err := o.compactorInfs.ListAllByNamespace(nsName, labels.Everything(), func(obj interface{}) {
+		o.rr.EnqueueForReconciliation(obj.(*monitoringv1alpha1.ThanosCompactor))
+	})
+	if err != nil {
+		o.logger.Error("listing all ThanosCompactor instances from cache failed",
+			"err", err,
+		)
+	}
+}
+
+// Sync implements the operator.Syncer interface.
+func (o *Operator) Sync(ctx context.Context, key string) error {
+	err := o.sync(ctx, key)
+	o.reconciliations.SetStatus(key, err)
+
+	return err
+}
+
+func (o *Operator) sync(ctx context.Context, key string) error {
+	tc, err := o.getThanosCompactorFromKey(key)
+	if err != nil {
+		return err
+	}
+
+	if tc == nil {
+		o.reconciliations.ForgetObject(key)
+		// Dependent resources are cleaned up by K8s via OwnerReferences
+		return nil
+	}
+
+	if err := k8sutil.AddTypeInformationToObject(tc); err != nil {
+		return fmt.Errorf("failed to set ThanosCompactor type information: %w", err)
+	}
+
+	// Check if the ThanosCompactor instance is marked for deletion.
+	if o.rr.DeletionInProgress(tc) {
+		return nil
+	}
+
+	if tc.Spec.Paused {
+		return nil
+	}
+
+	logger := o.logger.With("key", key)
+	logger.Info("sync thanos-compactor")
+
+	if err := operator.CheckStorageClass(ctx, o.canReadStorageClass, o.kclient, tc.Spec.Storage); err != nil {
+		return err
+	}
+
+	svcClient := o.kclient.CoreV1().Services(tc.Namespace)
+	if _, err = k8sutil.CreateOrUpdateService(ctx, svcClient, makeStatefulSetService(tc, o.config)); err != nil {
+		return fmt.Errorf("synchronizing governing service failed: %w", err)
+	}
+
+	replicaLabels, err := o.replicaLabels(tc)
+	if err != nil {
+		return err
+	}
+
+	existingStatefulSet, err := o.getStatefulSetFromThanosCompactorKey(key)
+	if err != nil {
+		return err
+	}
+
+	ssetClient := o.kclient.AppsV1().StatefulSets(tc.Namespace)
+	if existingStatefulSet == nil {
+		sset, err := makeStatefulSet(tc, o.config, replicaLabels, "")
+		if err != nil {
+			return fmt.Errorf("making thanos compactor statefulset config failed: %w", err)
+		}
+
+		operator.SanitizeSTS(sset)
+		if _, err := ssetClient.Create(ctx, sset, metav1.CreateOptions{}); err != nil {
+			return fmt.Errorf("creating thanos compactor statefulset failed: %w", err)
+		}
+
+		return nil
+	}
+
+	if o.rr.DeletionInProgress(existingStatefulSet) {
+		return nil
+	}
+
+	newSSetInputHash, err := createSSetInputHash(*tc, o.config, replicaLabels, existingStatefulSet.Spec)
+	if err != nil {
+		return err
+	}
+
+	if newSSetInputHash == existingStatefulSet.Annotations[operator.InputHashAnnotationName] {
+		logger.Debug("new statefulset generation inputs match current, skipping any actions", "hash", newSSetInputHash)
+		return nil
+	}
+
+	sset, err := makeStatefulSet(tc, o.config, replicaLabels, newSSetInputHash)
+	if err != nil {
+		return fmt.Errorf("failed to generate statefulset: %w", err)
+	}
+
+	operator.SanitizeSTS(sset)
+
+	logger.Debug("new hash differs from the existing value", "new", newSSetInputHash, "existing", existingStatefulSet.Annotations[operator.InputHashAnnotationName])
+	err = k8sutil.UpdateStatefulSet(ctx, ssetClient, sset)
+	sErr, ok := err.(*apierrors.StatusError)
+
+	if ok && sErr.ErrStatus.Code == 422 && sErr.ErrStatus.Reason == metav1.StatusReasonInvalid {
+		o.metrics.StsDeleteCreateCounter().Inc()
+
+		// Gather only reason for failed update
+		failMsg := make([]string, len(sErr.ErrStatus.Details.Causes))
+		for i, cause := range sErr.ErrStatus.Details.Causes {
+			failMsg[i] = cause.Message
+		}
+
+		logger.Info("recreating ThanosCompactor StatefulSet because the update operation wasn't possible", "reason", strings.Join(failMsg, ", "))
+		propagationPolicy := metav1.DeletePropagationForeground
+		if err := ssetClient.Delete(ctx, sset.GetName(), metav1.DeleteOptions{PropagationPolicy: &propagationPolicy}); err != nil {
+			return fmt.Errorf("failed to delete StatefulSet to avoid forbidden action: %w", err)
+		}
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("updating StatefulSet failed: %w", err)
+	}
+
+	return nil
+}
+
+// replicaLabels returns the deduplication replica labels of the given
+// ThanosCompactor object.
+func (o *Operator) replicaLabels(tc *monitoringv1alpha1.ThanosCompactor) ([]string, error) {
+	if len(tc.Spec.ReplicaLabels) > 0 {
+		return tc.Spec.ReplicaLabels, nil
+	}
+
+	var prometheuses []*monitoringv1.Prometheus
+	err := o.promInfs.ListAllByNamespace(tc.Namespace, labels.Everything(), func(obj interface{}) {
+		prometheuses = append(prometheuses, obj.(*monitoringv1.Prometheus))
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list Prometheus objects: %w", err)
+	}
+
+	return defaultReplicaLabels(tc, prometheuses), nil
+}
+
+// getThanosCompactorFromKey returns a copy of the ThanosCompactor object identified by key.
+// If the object is not found, it returns a nil pointer.
+func (o *Operator) getThanosCompactorFromKey(key string) (*monitoringv1alpha1.ThanosCompactor, error) {
+	obj, err := o.compactorInfs.Get(key)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			o.logger.Info("ThanosCompactor not found", "key", key)
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to retrieve ThanosCompactor from informer: %w", err)
+	}
+
+	return obj.(*monitoringv1alpha1.ThanosCompactor).DeepCopy(), nil
+}
+
+// getStatefulSetFromThanosCompactorKey returns a copy of the StatefulSet object
+// corresponding to the ThanosCompactor object identified by key.
+// If the object is not found, it returns a nil pointer without error.
+func (o *Operator) getStatefulSetFromThanosCompactorKey(key string) (*appsv1.StatefulSet, error) {
+	keyParts := strings.Split(key, "/")
+	ssetName := keyParts[0] + "/" + prefixedName(keyParts[1])
+
+	obj, err := o.ssetInfs.Get(ssetName)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			o.logger.Info("StatefulSet not found", "key", ssetName)
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to retrieve StatefulSet from informer: %w", err)
+	}
+
+	return obj.(*appsv1.StatefulSet).DeepCopy(), nil
+}
+
+// UpdateStatus implements the operator.Syncer interface.
+func (o *Operator) UpdateStatus(ctx context.Context, key string) error {
+	tc, err := o.getThanosCompactorFromKey(key)
+	if err != nil {
+		return err
+	}
+
+	if tc == nil || o.rr.DeletionInProgress(tc) {
+		return nil
+	}
+
+	sset, err := o.getStatefulSetFromThanosCompactorKey(key)
+	if err != nil {
+		return fmt.Errorf("failed to get StatefulSet: %w", err)
+	}
+
+	if sset != nil && o.rr.DeletionInProgress(sset) {
+		return nil
+	}
+
+	stsReporter, err := operator.NewStatefulSetReporter(ctx, o.kclient, sset)
+	if err != nil {
+		return fmt.Errorf("failed to retrieve statefulset state: %w", err)
+	}
+
+	availableCondition := stsReporter.Update(tc)
+	reconciledCondition := o.reconciliations.GetCondition(key, tc.Generation)
+	tc.Status.Conditions = operator.UpdateConditions(tc.Status.Conditions, availableCondition, reconciledCondition)
+	tc.Status.Paused = tc.Spec.Paused
+
+	if _, err = o.mclient.MonitoringV1alpha1().ThanosCompactors(tc.Namespace).ApplyStatus(ctx, applyConfigurationFromThanosCompactor(tc), metav1.ApplyOptions{FieldManager: operator.PrometheusOperatorFieldManager, Force: true}); err != nil {
+		return fmt.Errorf("failed to apply status subresource: %w", err)
+	}
+
+	return nil
+}
+
+func createSSetInputHash(tc monitoringv1alpha1.ThanosCompactor, c Config, replicaLabels []string, ss appsv1.StatefulSetSpec) (string, error) {
+	// The controller should ignore any changes to RevisionHistoryLimit field because
+	// it may be modified by external actors.
+	// See https://github.com/prometheus-operator/prometheus-operator/issues/5712
+	ss.RevisionHistoryLimit = nil
+
+	hash, err := hashstructure.Hash(struct {
+		ThanosCompactorLabels      map[string]string
+		ThanosCompactorAnnotations map[string]string
+		ThanosCompactorGeneration  int64
+		Config                     Config
+		ReplicaLabels              []string
+		StatefulSetSpec            appsv1.StatefulSetSpec
+	}{
+		ThanosCompactorLabels:      tc.Labels,
+		ThanosCompactorAnnotations: tc.Annotations,
+		ThanosCompactorGeneration:  tc.Generation,
+		Config:                     c,
+		ReplicaLabels:              replicaLabels,
+		StatefulSetSpec:            ss,
+	},
+		nil,
+	)
+	if err != nil {
+		return "", fmt.Errorf("failed to calculate combined hash: %w", err)
+	}
+
+	return fmt.Sprintf("%d", hash), nil
+}
+
+func applyConfigurationFromThanosCompactor(tc *monitoringv1alpha1.ThanosCompactor) *monitoringv1alpha1ac.ThanosCompactorApplyConfiguration {
+	tcac := monitoringv1alpha1ac.ThanosComponentStatus().
+		WithPaused(tc.Status.Paused).
+		WithReplicas(tc.Status.Replicas).
+		WithAvailableReplicas(tc.Status.AvailableReplicas).
+		WithUpdatedReplicas(tc.Status.UpdatedReplicas).
+		WithUnavailableReplicas(tc.Status.UnavailableReplicas)
+
+	for _, condition := range tc.Status.Conditions {
+		tcac.WithConditions(
+			monitoringv1ac.Condition().
+				WithType(condition.Type).
+				WithStatus(condition.Status).
+				WithLastTransitionTime(condition.LastTransitionTime).
+				WithReason(condition.Reason).
+				WithMessage(condition.Message).
+				WithObservedGeneration(condition.ObservedGeneration),
+		)
+	}
+
+	return monitoringv1alpha1ac.ThanosCompactor(tc.Name, tc.Namespace).WithStatus(tcac)
+}
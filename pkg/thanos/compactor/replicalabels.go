@@ -0,0 +1,56 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compactor
+
+import (
+	"slices"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	prompkg "github.com/prometheus-operator/prometheus-operator/pkg/prometheus"
+)
+
+// defaultReplicaLabels returns the replica label names of the Prometheus
+// resources which upload blocks to the same bucket as the one compacted by
+// the ThanosCompactor resource.
+//
+// The label names are derived with the same logic as the one used to
+// generate the Prometheus external labels so that both stay in sync.
+func defaultReplicaLabels(tc *monitoringv1alpha1.ThanosCompactor, prometheuses []*monitoringv1.Prometheus) []string {
+	var replicaLabels []string
+	for _, p := range prometheuses {
+		if p.Namespace != tc.Namespace {
+			continue
+		}
+
+		if p.Spec.Thanos == nil || p.Spec.Thanos.ObjectStorageConfig == nil {
+			continue
+		}
+
+		osc := p.Spec.Thanos.ObjectStorageConfig
+		if osc.Name != tc.Spec.ObjectStorageConfig.Name || osc.Key != tc.Spec.ObjectStorageConfig.Key {
+			continue
+		}
+
+		// An empty label name means that the replica external label is
+		// disabled.
+		if l := prompkg.ReplicaExternalLabelName(p); l != "" {
+			replicaLabels = append(replicaLabels, l)
+		}
+	}
+
+	slices.Sort(replicaLabels)
+	return slices.Compact(replicaLabels)
+}
@@ -0,0 +1,340 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compactor
+
+import (
+	"fmt"
+	"maps"
+	"path/filepath"
+
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/k8sutil"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+)
+
+const (
+	configDir     = "/etc/thanos/config"
+	storageDir    = "/thanos/data"
+	containerName = "thanos-compact"
+
+	defaultTerminationGracePeriodSeconds = int64(120)
+)
+
+func makeStatefulSet(tc *monitoringv1alpha1.ThanosCompactor, config Config, replicaLabels []string, inputHash string) (*appsv1.StatefulSet, error) {
+	spec, err := makeStatefulSetSpec(tc, config, replicaLabels)
+	if err != nil {
+		return nil, err
+	}
+
+	statefulset := &appsv1.StatefulSet{Spec: *spec}
+	operator.UpdateObject(
+		statefulset,
+		operator.WithName(prefixedName(tc.Name)),
+		operator.WithInputHashAnnotation(inputHash),
+		operator.WithAnnotations(tc.GetAnnotations()),
+		operator.WithAnnotations(config.Annotations),
+		operator.WithLabels(tc.GetLabels()),
+		operator.WithLabels(config.Labels),
+		operator.WithManagingOwner(tc),
+		operator.WithoutKubectlAnnotations(),
+	)
+
+	if len(tc.Spec.ImagePullSecrets) > 0 {
+		statefulset.Spec.Template.Spec.ImagePullSecrets = tc.Spec.ImagePullSecrets
+	}
+
+	storageSpec := tc.Spec.Storage
+	switch {
+	case storageSpec == nil:
+		statefulset.Spec.Template.Spec.Volumes = append(statefulset.Spec.Template.Spec.Volumes, v1.Volume{
+			Name: volumeName(tc.Name),
+			VolumeSource: v1.VolumeSource{
+				EmptyDir: &v1.EmptyDirVolumeSource{},
+			},
+		})
+
+	case storageSpec.EmptyDir != nil:
+		statefulset.Spec.Template.Spec.Volumes = append(statefulset.Spec.Template.Spec.Volumes, v1.Volume{
+			Name: volumeName(tc.Name),
+			VolumeSource: v1.VolumeSource{
+				EmptyDir: storageSpec.EmptyDir,
+			},
+		})
+
+	case storageSpec.Ephemeral != nil:
+		statefulset.Spec.Template.Spec.Volumes = append(statefulset.Spec.Template.Spec.Volumes, v1.Volume{
+			Name: volumeName(tc.Name),
+			VolumeSource: v1.VolumeSource{
+				Ephemeral: storageSpec.Ephemeral,
+			},
+		})
+
+	default: // storageSpec.VolumeClaimTemplate
+		pvcTemplate := operator.MakeVolumeClaimTemplate(storageSpec.VolumeClaimTemplate)
+		if pvcTemplate.Name == "" {
+			pvcTemplate.Name = volumeName(tc.Name)
+		}
+		if storageSpec.VolumeClaimTemplate.Spec.AccessModes == nil {
+			pvcTemplate.Spec.AccessModes = []v1.PersistentVolumeAccessMode{v1.ReadWriteOnce}
+		} else {
+			pvcTemplate.Spec.AccessModes = storageSpec.VolumeClaimTemplate.Spec.AccessModes
+		}
+		pvcTemplate.Spec.Resources = storageSpec.VolumeClaimTemplate.Spec.Resources
+		pvcTemplate.Spec.Selector = storageSpec.VolumeClaimTemplate.Spec.Selector
+		statefulset.Spec.VolumeClaimTemplates = append(statefulset.Spec.VolumeClaimTemplates, *pvcTemplate)
+	}
+
+	statefulset.Spec.Template.Spec.Volumes = append(statefulset.Spec.Template.Spec.Volumes, tc.Spec.Volumes...)
+
+	return statefulset, nil
+}
+
+func makeStatefulSetSpec(tc *monitoringv1alpha1.ThanosCompactor, config Config, replicaLabels []string) (*appsv1.StatefulSetSpec, error) {
+	thanosVersion := operator.StringValOrDefault(ptr.Deref(tc.Spec.Version, ""), operator.DefaultThanosVersion)
+
+	imagePath, err := operator.BuildImagePath(
+		ptr.Deref(tc.Spec.Image, ""),
+		operator.StringValOrDefault(config.ThanosDefaultBaseImage, operator.DefaultThanosBaseImage),
+		thanosVersion,
+		"",
+		"",
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build image path: %w", err)
+	}
+
+	objstoreMountPath := filepath.Join(configDir, "objstore-config")
+	volumes := []v1.Volume{
+		{
+			Name: "objstore-config",
+			VolumeSource: v1.VolumeSource{
+				Secret: &v1.SecretVolumeSource{
+					SecretName: tc.Spec.ObjectStorageConfig.Name,
+					Items: []v1.KeyToPath{
+						{
+							Key:  tc.Spec.ObjectStorageConfig.Key,
+							Path: tc.Spec.ObjectStorageConfig.Key,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	storageVolName := volumeName(tc.Name)
+	if tc.Spec.Storage != nil && tc.Spec.Storage.VolumeClaimTemplate.Name != "" {
+		storageVolName = tc.Spec.Storage.VolumeClaimTemplate.Name
+	}
+
+	volumeMounts := []v1.VolumeMount{
+		{
+			Name:      "objstore-config",
+			MountPath: objstoreMountPath,
+			ReadOnly:  true,
+		},
+		{
+			Name:      storageVolName,
+			MountPath: storageDir,
+		},
+	}
+	volumeMounts = append(volumeMounts, tc.Spec.VolumeMounts...)
+
+	args := []monitoringv1.Argument{
+		// Run the compactor continuously instead of exiting after one
+		// iteration.
+		{Name: "wait"},
+		{Name: "data-dir", Value: storageDir},
+		{Name: "objstore.config-file", Value: filepath.Join(objstoreMountPath, tc.Spec.ObjectStorageConfig.Key)},
+	}
+
+	if r := tc.Spec.Retention; r != nil {
+		if r.Raw != nil {
+			args = append(args, monitoringv1.Argument{Name: "retention.resolution-raw", Value: string(*r.Raw)})
+		}
+
+		if r.FiveMinutes != nil {
+			args = append(args, monitoringv1.Argument{Name: "retention.resolution-5m", Value: string(*r.FiveMinutes)})
+		}
+
+		if r.OneHour != nil {
+			args = append(args, monitoringv1.Argument{Name: "retention.resolution-1h", Value: string(*r.OneHour)})
+		}
+	}
+
+	if ptr.Deref(tc.Spec.DisableDownsampling, false) {
+		args = append(args, monitoringv1.Argument{Name: "downsampling.disable"})
+	}
+
+	if len(replicaLabels) > 0 {
+		// Offline deduplication relies on the vertical compaction to merge the
+		// overlapping blocks.
+		args = append(args, monitoringv1.Argument{Name: "compact.enable-vertical-compaction"})
+		for _, l := range replicaLabels {
+			args = append(args, monitoringv1.Argument{Name: "deduplication.replica-label", Value: l})
+		}
+	}
+
+	if tc.Spec.LogLevel != "" && tc.Spec.LogLevel != "info" {
+		args = append(args, monitoringv1.Argument{Name: "log.level", Value: tc.Spec.LogLevel})
+	}
+
+	if tc.Spec.LogFormat != "" {
+		args = append(args, monitoringv1.Argument{Name: "log.format", Value: tc.Spec.LogFormat})
+	}
+
+	containerArgs, err := operator.BuildArgs(args, tc.Spec.AdditionalArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	// The first argument to thanos must be "compact" to start the compactor, e.g. "thanos compact --wait..."
+	containerArgs = append([]string{"compact"}, containerArgs...)
+
+	podAnnotations := map[string]string{}
+	podLabels := map[string]string{}
+	if tc.Spec.PodMetadata != nil {
+		maps.Copy(podLabels, tc.Spec.PodMetadata.Labels)
+		maps.Copy(podAnnotations, tc.Spec.PodMetadata.Annotations)
+	}
+
+	// In cases where an existing selector label is modified, or a new one is added, new sts cannot match existing pods.
+	// We should try to avoid removing such immutable fields whenever possible since doing
+	// so forces us to enter the 'recreate cycle' and can potentially lead to downtime.
+	// The requirement to make a change here should be carefully evaluated.
+	selectorLabels := makeSelectorLabels(tc.Name)
+
+	finalLabels := config.Labels.Merge(podLabels)
+	maps.Copy(finalLabels, selectorLabels)
+
+	podAnnotations["kubectl.kubernetes.io/default-container"] = containerName
+
+	operatorContainers := []v1.Container{
+		{
+			Name:            containerName,
+			Image:           imagePath,
+			ImagePullPolicy: tc.Spec.ImagePullPolicy,
+			Args:            containerArgs,
+			VolumeMounts:    volumeMounts,
+			Resources:       tc.Spec.Resources,
+			Ports: []v1.ContainerPort{
+				{
+					Name:          "http",
+					ContainerPort: 10902,
+					Protocol:      v1.ProtocolTCP,
+				},
+			},
+			TerminationMessagePolicy: v1.TerminationMessageFallbackToLogsOnError,
+			SecurityContext: &v1.SecurityContext{
+				AllowPrivilegeEscalation: ptr.To(false),
+				ReadOnlyRootFilesystem:   ptr.To(true),
+				Capabilities: &v1.Capabilities{
+					Drop: []v1.Capability{"ALL"},
+				},
+			},
+		},
+	}
+
+	containers, err := k8sutil.MergePatchContainers(operatorContainers, tc.Spec.Containers)
+	if err != nil {
+		return nil, fmt.Errorf("failed to merge containers spec: %w", err)
+	}
+
+	return &appsv1.StatefulSetSpec{
+		ServiceName: prefixedName(tc.Name),
+		// The compactor must not run concurrently against the same bucket.
+		Replicas:            ptr.To(int32(1)),
+		PodManagementPolicy: appsv1.OrderedReadyPodManagement,
+		UpdateStrategy: appsv1.StatefulSetUpdateStrategy{
+			Type: appsv1.RollingUpdateStatefulSetStrategyType,
+		},
+		Selector: &metav1.LabelSelector{
+			MatchLabels: finalLabels,
+		},
+		Template: v1.PodTemplateSpec{
+			ObjectMeta: metav1.ObjectMeta{
+				Labels:      finalLabels,
+				Annotations: podAnnotations,
+			},
+			Spec: v1.PodSpec{
+				NodeSelector:                  tc.Spec.NodeSelector,
+				PriorityClassName:             tc.Spec.PriorityClassName,
+				ServiceAccountName:            tc.Spec.ServiceAccountName,
+				TerminationGracePeriodSeconds: ptr.To(defaultTerminationGracePeriodSeconds),
+				Containers:                    containers,
+				Volumes:                       volumes,
+				SecurityContext:               tc.Spec.SecurityContext,
+				Tolerations:                   tc.Spec.Tolerations,
+				Affinity:                      tc.Spec.Affinity,
+				TopologySpreadConstraints:     tc.Spec.TopologySpreadConstraints,
+			},
+		},
+	}, nil
+}
+
+func makeStatefulSetService(tc *monitoringv1alpha1.ThanosCompactor, config Config) *v1.Service {
+	svc := &v1.Service{
+		Spec: v1.ServiceSpec{
+			ClusterIP: "None",
+			Ports: []v1.ServicePort{
+				{
+					Name:       "http",
+					Port:       10902,
+					TargetPort: intstr.FromString("http"),
+					Protocol:   v1.ProtocolTCP,
+				},
+			},
+			Selector: makeSelectorLabels(tc.Name),
+		},
+	}
+
+	operator.UpdateObject(
+		svc,
+		operator.WithName(prefixedName(tc.Name)),
+		operator.WithAnnotations(config.Annotations),
+		operator.WithLabels(map[string]string{"operated-thanos-compactor": "true"}),
+		operator.WithLabels(config.Labels),
+		operator.WithOwner(tc),
+	)
+
+	return svc
+}
+
+// In cases where an existing selector label is modified, or a new one is added, new sts cannot match existing pods.
+// We should try to avoid removing such immutable fields whenever possible since doing
+// so forces us to enter the 'recreate cycle' and can potentially lead to downtime.
+// The requirement to make a change here should be carefully evaluated.
+func makeSelectorLabels(name string) map[string]string {
+	return map[string]string{
+		"app.kubernetes.io/name":       thanosCompactorLabel,
+		"app.kubernetes.io/managed-by": "prometheus-operator",
+		"app.kubernetes.io/instance":   name,
+		thanosCompactorLabel:           name,
+	}
+}
+
+func prefixedName(name string) string {
+	return fmt.Sprintf("thanos-compactor-%s", name)
+}
+
+func volumeName(name string) string {
+	return fmt.Sprintf("%s-data", prefixedName(name))
+}
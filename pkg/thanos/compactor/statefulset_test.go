@@ -0,0 +1,172 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compactor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+)
+
+var defaultTestConfig = Config{
+	ThanosDefaultBaseImage: operator.DefaultThanosBaseImage,
+}
+
+func makeThanosCompactor() *monitoringv1alpha1.ThanosCompactor {
+	return &monitoringv1alpha1.ThanosCompactor{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test",
+			Namespace: "ns",
+		},
+		Spec: monitoringv1alpha1.ThanosCompactorSpec{
+			ObjectStorageConfig: v1.SecretKeySelector{
+				LocalObjectReference: v1.LocalObjectReference{Name: "objstore"},
+				Key:                  "config.yaml",
+			},
+		},
+	}
+}
+
+func TestStatefulSetArgs(t *testing.T) {
+	for _, tc := range []struct {
+		name          string
+		spec          func(*monitoringv1alpha1.ThanosCompactorSpec)
+		replicaLabels []string
+		expected      []string
+	}{
+		{
+			name: "default",
+			spec: func(*monitoringv1alpha1.ThanosCompactorSpec) {},
+			expected: []string{
+				"compact",
+				"--wait",
+				"--data-dir=/thanos/data",
+				"--objstore.config-file=/etc/thanos/config/objstore-config/config.yaml",
+			},
+		},
+		{
+			name: "retention and downsampling",
+			spec: func(s *monitoringv1alpha1.ThanosCompactorSpec) {
+				s.Retention = &monitoringv1alpha1.ThanosCompactorRetention{
+					Raw:         ptr.To(monitoringv1.Duration("30d")),
+					FiveMinutes: ptr.To(monitoringv1.Duration("90d")),
+					OneHour:     ptr.To(monitoringv1.Duration("0d")),
+				}
+				s.DisableDownsampling = ptr.To(true)
+			},
+			expected: []string{
+				"compact",
+				"--wait",
+				"--data-dir=/thanos/data",
+				"--objstore.config-file=/etc/thanos/config/objstore-config/config.yaml",
+				"--retention.resolution-raw=30d",
+				"--retention.resolution-5m=90d",
+				"--retention.resolution-1h=0d",
+				"--downsampling.disable",
+			},
+		},
+		{
+			name:          "replica labels and logging",
+			spec:          func(s *monitoringv1alpha1.ThanosCompactorSpec) { s.LogLevel = "debug" },
+			replicaLabels: []string{"prometheus_replica", "replica"},
+			expected: []string{
+				"compact",
+				"--wait",
+				"--data-dir=/thanos/data",
+				"--objstore.config-file=/etc/thanos/config/objstore-config/config.yaml",
+				"--compact.enable-vertical-compaction",
+				"--deduplication.replica-label=prometheus_replica",
+				"--deduplication.replica-label=replica",
+				"--log.level=debug",
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			thc := makeThanosCompactor()
+			tc.spec(&thc.Spec)
+
+			sset, err := makeStatefulSet(thc, defaultTestConfig, tc.replicaLabels, "")
+			require.NoError(t, err)
+
+			require.Equal(t, "thanos-compactor-test", sset.Name)
+			require.Equal(t, ptr.To(int32(1)), sset.Spec.Replicas)
+			require.Len(t, sset.Spec.Template.Spec.Containers, 1)
+			require.Equal(t, tc.expected, sset.Spec.Template.Spec.Containers[0].Args)
+		})
+	}
+}
+
+func TestStatefulSetStorage(t *testing.T) {
+	thc := makeThanosCompactor()
+	thc.Spec.Storage = &monitoringv1.StorageSpec{
+		VolumeClaimTemplate: monitoringv1.EmbeddedPersistentVolumeClaim{
+			Spec: v1.PersistentVolumeClaimSpec{
+				Resources: v1.VolumeResourceRequirements{
+					Requests: v1.ResourceList{
+						v1.ResourceStorage: resource.MustParse("100Gi"),
+					},
+				},
+			},
+		},
+	}
+
+	sset, err := makeStatefulSet(thc, defaultTestConfig, nil, "")
+	require.NoError(t, err)
+	require.Len(t, sset.Spec.VolumeClaimTemplates, 1)
+	require.Equal(t, "thanos-compactor-test-data", sset.Spec.VolumeClaimTemplates[0].Name)
+	require.Contains(t, sset.Spec.Template.Spec.Containers[0].VolumeMounts, v1.VolumeMount{
+		Name:      "thanos-compactor-test-data",
+		MountPath: "/thanos/data",
+	})
+}
+
+func TestDefaultReplicaLabels(t *testing.T) {
+	makePrometheus := func(name, ns, secret string, replicaLabel *string) *monitoringv1.Prometheus {
+		p := &monitoringv1.Prometheus{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: ns},
+		}
+		p.Spec.ReplicaExternalLabelName = replicaLabel
+		if secret != "" {
+			p.Spec.Thanos = &monitoringv1.ThanosSpec{
+				ObjectStorageConfig: &v1.SecretKeySelector{
+					LocalObjectReference: v1.LocalObjectReference{Name: secret},
+					Key:                  "config.yaml",
+				},
+			}
+		}
+
+		return p
+	}
+
+	labels := defaultReplicaLabels(makeThanosCompactor(), []*monitoringv1.Prometheus{
+		makePrometheus("default", "ns", "objstore", nil),
+		makePrometheus("custom", "ns", "objstore", ptr.To("replica")),
+		makePrometheus("duplicate", "ns", "objstore", nil),
+		makePrometheus("disabled", "ns", "objstore", ptr.To("")),
+		makePrometheus("other-bucket", "ns", "other", ptr.To("other_bucket")),
+		makePrometheus("other-namespace", "other", "objstore", ptr.To("other_namespace")),
+		makePrometheus("no-sidecar", "ns", "", ptr.To("no_sidecar")),
+	})
+
+	require.Equal(t, []string{"prometheus_replica", "replica"}, labels)
+}
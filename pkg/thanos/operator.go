@@ -86,6 +86,8 @@ type Operator struct {
 	reconciliations     *operator.ReconciliationTracker
 	canReadStorageClass bool
 
+	configResourcesStatusEnabled bool
+
 	eventRecorder record.EventRecorder
 
 	config Config
@@ -144,6 +146,8 @@ func New(ctx context.Context, restConfig *rest.Config, c operator.Config, logger
 		eventRecorder:   c.EventRecorderFactory(client, controllerName),
 		reconciliations: &operator.ReconciliationTracker{},
 		controllerID:    c.ControllerID,
+
+		configResourcesStatusEnabled: c.Gates.Enabled(operator.StatusForConfigurationResourcesFeature),
 		config: Config{
 			ReloaderConfig:         c.ReloaderConfig,
 			ThanosDefaultBaseImage: c.ThanosDefaultBaseImage,
@@ -324,6 +328,7 @@ func (o *Operator) addHandlers() {
 		o.metrics,
 		monitoringv1.PrometheusRuleKind,
 		o.enqueueForRulesNamespace,
+		operator.WithoutStatusUpdates(),
 	))
 
 	// The controller needs to watch the namespaces in which the rules live
@@ -441,6 +446,7 @@ func (o *Operator) sync(ctx context.Context, key string) error {
 	if apierrors.IsNotFound(err) {
 		o.reconciliations.ForgetObject(key)
 		// Dependent resources are cleaned up by K8s via OwnerReferences
+		o.removeRulesBindings(ctx, key)
 		return nil
 	}
 	if err != nil {
@@ -469,11 +475,13 @@ func (o *Operator) sync(ctx context.Context, key string) error {
 		return err
 	}
 
-	ruleConfigMapNames, err := o.createOrUpdateRuleConfigMaps(ctx, tr)
+	ruleConfigMapNames, ruleSelection, err := o.createOrUpdateRuleConfigMaps(ctx, tr)
 	if err != nil {
 		return err
 	}
 
+	o.updateRulesStatus(ctx, tr, ruleSelection)
+
 	assetStore := assets.NewStoreBuilder(o.kclient.CoreV1(), o.kclient.CoreV1())
 
 	if err := o.createOrUpdateRulerConfigSecret(ctx, assetStore, tr); err != nil {
@@ -606,6 +614,38 @@ func (o *Operator) getStatefulSetFromThanosRulerKey(key string) (*appsv1.Statefu
 	return obj.(*appsv1.StatefulSet).DeepCopy(), nil
 }
 
+// updateRulesStatus updates the status of the PrometheusRule resources
+// selected by the ThanosRuler object.
+func (o *Operator) updateRulesStatus(ctx context.Context, tr *monitoringv1.ThanosRuler, rules operator.PrometheusRuleSelection) {
+	if !o.configResourcesStatusEnabled {
+		return
+	}
+
+	syncer := operator.NewConfigResourceSyncer(o.mclient, monitoringv1.ThanosRulerName, tr)
+	if err := operator.UpdatePrometheusRulesStatus(ctx, syncer, rules, o.ruleInfs); err != nil {
+		o.logger.Warn("failed to update the status of PrometheusRule resources", "err", err, "name", tr.Name, "namespace", tr.Namespace)
+	}
+}
+
+// removeRulesBindings removes the bindings of a deleted ThanosRuler object
+// from the status of the PrometheusRule resources.
+func (o *Operator) removeRulesBindings(ctx context.Context, key string) {
+	if !o.configResourcesStatusEnabled {
+		return
+	}
+
+	ns, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		o.logger.Error("failed to split key", "err", err, "key", key)
+		return
+	}
+
+	syncer := operator.NewConfigResourceSyncer(o.mclient, monitoringv1.ThanosRulerName, &metav1.ObjectMeta{Namespace: ns, Name: name})
+	if err := operator.UpdatePrometheusRulesStatus(ctx, syncer, operator.PrometheusRuleSelection{}, o.ruleInfs); err != nil {
+		o.logger.Warn("failed to remove bindings from PrometheusRule resources", "err", err, "key", key)
+	}
+}
+
 // UpdateStatus implements the operator.Syncer interface.
 func (o *Operator) UpdateStatus(ctx context.Context, key string) error {
 	tr, err := o.getThanosRulerFromKey(key)
@@ -941,7 +981,7 @@ func (o *Operator) createOrUpdateRulerConfigSecret(ctx context.Context, store *a
 
 	rwConfig, err := yaml.Marshal(
 		yaml.MapSlice{
-			cg.GenerateRemoteWriteConfig(tr.Spec.RemoteWrite, store.ForNamespace(tr.Namespace)),
+			cg.GenerateRemoteWriteConfig(tr.Spec.RemoteWrite, store, tr.Namespace),
 		},
 	)
 	if err != nil {
@@ -0,0 +1,90 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querier
+
+import (
+	"fmt"
+
+	"k8s.io/utils/ptr"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	prompkg "github.com/prometheus-operator/prometheus-operator/pkg/prometheus"
+	"github.com/prometheus-operator/prometheus-operator/pkg/thanos/store"
+)
+
+const (
+	grpcPort = 10901
+
+	prometheusGoverningServiceName  = "prometheus-operated"
+	thanosRulerGoverningServiceName = "thanos-ruler-operated"
+)
+
+// serviceDomain returns the DNS domain of the service in the given namespace.
+// When the cluster domain is empty, the name relies on the DNS search domains
+// of the pod.
+func serviceDomain(service, namespace, clusterDomain string) string {
+	if clusterDomain == "" {
+		return fmt.Sprintf("%s.%s.svc", service, namespace)
+	}
+
+	return fmt.Sprintf("%s.%s.svc.%s", service, namespace, clusterDomain)
+}
+
+// prometheusEndpoints returns the store API endpoints of the Thanos sidecars
+// running in the pods of the given Prometheus resource.
+//
+// It returns nil if the Prometheus resource has no Thanos sidecar or if the
+// sidecar's gRPC server listens on the loopback interface only.
+func prometheusEndpoints(p *monitoringv1.Prometheus, clusterDomain string) []string {
+	if p.Spec.Thanos == nil || p.Spec.Thanos.GRPCListenLocal || p.Spec.Thanos.ListenLocal {
+		return nil
+	}
+
+	var (
+		endpoints []string
+		replicas  = ptr.Deref(prompkg.ReplicasNumberPtr(p), 1)
+		svc       = serviceDomain(ptr.Deref(p.Spec.ServiceName, prometheusGoverningServiceName), p.Namespace, clusterDomain)
+	)
+	for _, ssetName := range prompkg.ExpectedStatefulSetShardNames(p) {
+		for i := range replicas {
+			endpoints = append(endpoints, fmt.Sprintf("%s-%d.%s:%d", ssetName, i, svc, grpcPort))
+		}
+	}
+
+	return endpoints
+}
+
+// thanosRulerEndpoints returns the store API endpoints of the pods of the
+// given ThanosRuler resource.
+func thanosRulerEndpoints(tr *monitoringv1.ThanosRuler, clusterDomain string) []string {
+	var (
+		endpoints []string
+		replicas  = ptr.Deref(tr.Spec.Replicas, 1)
+		svc       = serviceDomain(ptr.Deref(tr.Spec.ServiceName, thanosRulerGoverningServiceName), tr.Namespace, clusterDomain)
+	)
+	for i := range replicas {
+		endpoints = append(endpoints, fmt.Sprintf("thanos-ruler-%s-%d.%s:%d", tr.Name, i, svc, grpcPort))
+	}
+
+	return endpoints
+}
+
+// thanosStoreEndpoint returns the store API endpoint of the given ThanosStore
+// resource. It relies on the DNS SRV records of the headless service to
+// resolve all the pods.
+func thanosStoreEndpoint(ts *monitoringv1alpha1.ThanosStore, clusterDomain string) string {
+	return fmt.Sprintf("dnssrv+_grpc._tcp.%s", serviceDomain(store.ServiceName(ts.Name), ts.Namespace, clusterDomain))
+}
@@ -0,0 +1,127 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querier
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+)
+
+func TestPrometheusEndpoints(t *testing.T) {
+	for _, tc := range []struct {
+		name          string
+		spec          monitoringv1.PrometheusSpec
+		clusterDomain string
+		expected      []string
+	}{
+		{
+			name: "no sidecar",
+			spec: monitoringv1.PrometheusSpec{},
+		},
+		{
+			name: "sidecar listening on localhost",
+			spec: monitoringv1.PrometheusSpec{
+				Thanos: &monitoringv1.ThanosSpec{GRPCListenLocal: true},
+			},
+		},
+		{
+			name: "single replica",
+			spec: monitoringv1.PrometheusSpec{
+				Thanos: &monitoringv1.ThanosSpec{},
+			},
+			expected: []string{
+				"prometheus-k8s-0.prometheus-operated.monitoring.svc:10901",
+			},
+		},
+		{
+			name: "replicas and shards with cluster domain",
+			spec: monitoringv1.PrometheusSpec{
+				CommonPrometheusFields: monitoringv1.CommonPrometheusFields{
+					Replicas: ptr.To(int32(2)),
+					Shards:   ptr.To(int32(2)),
+				},
+				Thanos: &monitoringv1.ThanosSpec{},
+			},
+			clusterDomain: "cluster.local",
+			expected: []string{
+				"prometheus-k8s-0.prometheus-operated.monitoring.svc.cluster.local:10901",
+				"prometheus-k8s-1.prometheus-operated.monitoring.svc.cluster.local:10901",
+				"prometheus-k8s-shard-1-0.prometheus-operated.monitoring.svc.cluster.local:10901",
+				"prometheus-k8s-shard-1-1.prometheus-operated.monitoring.svc.cluster.local:10901",
+			},
+		},
+		{
+			name: "custom governing service",
+			spec: monitoringv1.PrometheusSpec{
+				CommonPrometheusFields: monitoringv1.CommonPrometheusFields{
+					ServiceName: ptr.To("custom"),
+				},
+				Thanos: &monitoringv1.ThanosSpec{},
+			},
+			expected: []string{
+				"prometheus-k8s-0.custom.monitoring.svc:10901",
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			p := &monitoringv1.Prometheus{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "k8s",
+					Namespace: "monitoring",
+				},
+				Spec: tc.spec,
+			}
+
+			require.Equal(t, tc.expected, prometheusEndpoints(p, tc.clusterDomain))
+		})
+	}
+}
+
+func TestThanosRulerEndpoints(t *testing.T) {
+	tr := &monitoringv1.ThanosRuler{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "main",
+			Namespace: "monitoring",
+		},
+	}
+	require.Equal(t, []string{"thanos-ruler-main-0.thanos-ruler-operated.monitoring.svc:10901"}, thanosRulerEndpoints(tr, ""))
+
+	tr.Spec.Replicas = ptr.To(int32(2))
+	tr.Spec.ServiceName = ptr.To("ruler")
+	require.Equal(t,
+		[]string{
+			"thanos-ruler-main-0.ruler.monitoring.svc.example.com:10901",
+			"thanos-ruler-main-1.ruler.monitoring.svc.example.com:10901",
+		},
+		thanosRulerEndpoints(tr, "example.com"),
+	)
+}
+
+func TestThanosStoreEndpoint(t *testing.T) {
+	ts := &monitoringv1alpha1.ThanosStore{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "main",
+			Namespace: "monitoring",
+		},
+	}
+	require.Equal(t, "dnssrv+_grpc._tcp.thanos-store-main.monitoring.svc", thanosStoreEndpoint(ts, ""))
+	require.Equal(t, "dnssrv+_grpc._tcp.thanos-store-main.monitoring.svc.cluster.local", thanosStoreEndpoint(ts, "cluster.local"))
+}
@@ -0,0 +1,823 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querier
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/mitchellh/hashstructure"
+	"github.com/prometheus/client_golang/prometheus"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/cache"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	monitoringv1ac "github.com/prometheus-operator/prometheus-operator/pkg/client/applyconfiguration/monitoring/v1"
+	monitoringv1alpha1ac "github.com/prometheus-operator/prometheus-operator/pkg/client/applyconfiguration/monitoring/v1alpha1"
+	monitoringclient "github.com/prometheus-operator/prometheus-operator/pkg/client/versioned"
+	"github.com/prometheus-operator/prometheus-operator/pkg/informers"
+	"github.com/prometheus-operator/prometheus-operator/pkg/k8sutil"
+	"github.com/prometheus-operator/prometheus-operator/pkg/listwatch"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+)
+
+const (
+	resyncPeriod       = 5 * time.Minute
+	thanosQuerierLabel = "thanos-querier"
+	controllerName     = "thanos-querier-controller"
+)
+
+// Operator manages the life cycle of the Thanos Querier deployments.
+type Operator struct {
+	kclient kubernetes.Interface
+	mclient monitoringclient.Interface
+
+	logger   *slog.Logger
+	accessor *operator.Accessor
+
+	controllerID string
+
+	querierInfs *informers.ForResource
+	promInfs    *informers.ForResource
+	rulerInfs   *informers.ForResource
+	storeInfs   *informers.ForResource
+	ssetInfs    *informers.ForResource
+
+	rr *operator.ResourceReconciler
+
+	nsPromInf   cache.SharedIndexInformer
+	nsThanosInf cache.SharedIndexInformer
+
+	metrics         *operator.Metrics
+	reconciliations *operator.ReconciliationTracker
+
+	thanosStoreSupported bool
+
+	config Config
+}
+
+// Config defines the operator's parameters for the Thanos Querier controller.
+// Whenever the value of one of these parameters is changed, it triggers an
+// update of the managed statefulsets.
+type Config struct {
+	ThanosDefaultBaseImage string
+	ClusterDomain          string
+	Annotations            operator.Map
+	Labels                 operator.Map
+}
+
+type ControllerOption func(*Operator)
+
+// WithThanosStore tells that the controller should discover the ThanosStore
+// resources.
+func WithThanosStore() ControllerOption {
+	return func(o *Operator) {
+		o.thanosStoreSupported = true
+	}
+}
+
+// New creates a new controller.
+func New(ctx context.Context, restConfig *rest.Config, c operator.Config, logger *slog.Logger, r prometheus.Registerer, options ...ControllerOption) (*Operator, error) {
+	logger = logger.With("component", controllerName)
+
+	client, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating kubernetes client failed: %w", err)
+	}
+
+	mclient, err := monitoringclient.NewForConfig(restConfig)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating monitoring client failed: %w", err)
+	}
+
+	// All the metrics exposed by the controller get the controller="thanos-querier" label.
+	r = prometheus.WrapRegistererWith(prometheus.Labels{"controller": "thanos-querier"}, r)
+
+	o := &Operator{
+		kclient:         client,
+		mclient:         mclient,
+		logger:          logger,
+		accessor:        operator.NewAccessor(logger),
+		metrics:         operator.NewMetrics(r),
+		reconciliations: &operator.ReconciliationTracker{},
+		controllerID:    c.ControllerID,
+		config: Config{
+			ThanosDefaultBaseImage: c.ThanosDefaultBaseImage,
+			ClusterDomain:          c.ClusterDomain,
+			Annotations:            c.Annotations,
+			Labels:                 c.Labels,
+		},
+	}
+	for _, opt := range options {
+		opt(o)
+	}
+
+	o.querierInfs, err = informers.NewInformersForResource(
+		informers.NewMonitoringInformerFactories(
+			c.Namespaces.ThanosRulerAllowList,
+			c.Namespaces.DenyList,
+			mclient,
+			resyncPeriod,
+			nil,
+		),
+		monitoringv1alpha1.SchemeGroupVersion.WithResource(monitoringv1alpha1.ThanosQuerierName),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error creating thanosquerier informers: %w", err)
+	}
+
+	o.rr = operator.NewResourceReconciler(
+		o.logger,
+		o,
+		o.querierInfs,
+		o.metrics,
+		monitoringv1alpha1.ThanosQuerierKind,
+		r,
+		o.controllerID,
+	)
+
+	o.promInfs, err = informers.NewInformersForResource(
+		informers.NewMonitoringInformerFactories(
+			c.Namespaces.PrometheusAllowList,
+			c.Namespaces.DenyList,
+			mclient,
+			resyncPeriod,
+			func(options *metav1.ListOptions) {
+				options.LabelSelector = c.PromSelector.String()
+			},
+		),
+		monitoringv1.SchemeGroupVersion.WithResource(monitoringv1.PrometheusName),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error creating prometheus informers: %w", err)
+	}
+
+	o.rulerInfs, err = informers.NewInformersForResource(
+		informers.NewMonitoringInformerFactories(
+			c.Namespaces.ThanosRulerAllowList,
+			c.Namespaces.DenyList,
+			mclient,
+			resyncPeriod,
+			func(options *metav1.ListOptions) {
+				options.LabelSelector = c.ThanosRulerSelector.String()
+			},
+		),
+		monitoringv1.SchemeGroupVersion.WithResource(monitoringv1.ThanosRulerName),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error creating thanosruler informers: %w", err)
+	}
+
+	if o.thanosStoreSupported {
+		o.storeInfs, err = informers.NewInformersForResource(
+			informers.NewMonitoringInformerFactories(
+				c.Namespaces.ThanosRulerAllowList,
+				c.Namespaces.DenyList,
+				mclient,
+				resyncPeriod,
+				nil,
+			),
+			monitoringv1alpha1.SchemeGroupVersion.WithResource(monitoringv1alpha1.ThanosStoreName),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("error creating thanosstore informers: %w", err)
+		}
+	}
+
+	o.ssetInfs, err = informers.NewInformersForResource(
+		informers.NewKubeInformerFactories(
+			c.Namespaces.ThanosRulerAllowList,
+			c.Namespaces.DenyList,
+			o.kclient,
+			resyncPeriod,
+			nil,
+		),
+		appsv1.SchemeGroupVersion.WithResource("statefulsets"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error creating statefulset informers: %w", err)
+	}
+
+	newNamespaceInformer := func(o *Operator, allowList map[string]struct{}) (cache.SharedIndexInformer, error) {
+		lw, privileged, err := listwatch.NewNamespaceListWatchFromClient(
+			ctx,
+			o.logger,
+			c.KubernetesVersion,
+			o.kclient.CoreV1(),
+			o.kclient.AuthorizationV1().SelfSubjectAccessReviews(),
+			allowList,
+			c.Namespaces.DenyList)
+		if err != nil {
+			return nil, err
+		}
+
+		o.logger.Debug("creating namespace informer", "privileged", privileged)
+		return cache.NewSharedIndexInformer(
+			o.metrics.NewInstrumentedListerWatcher(lw),
+			&v1.Namespace{},
+			resyncPeriod,
+			cache.Indexers{},
+		), nil
+	}
+
+	o.nsThanosInf, err = newNamespaceInformer(o, c.Namespaces.ThanosRulerAllowList)
+	if err != nil {
+		return nil, err
+	}
+
+	if listwatch.IdenticalNamespaces(c.Namespaces.ThanosRulerAllowList, c.Namespaces.PrometheusAllowList) {
+		o.nsPromInf = o.nsThanosInf
+	} else {
+		o.nsPromInf, err = newNamespaceInformer(o, c.Namespaces.PrometheusAllowList)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return o, nil
+}
+
+// waitForCacheSync waits for the informers' caches to be synced.
+func (o *Operator) waitForCacheSync(ctx context.Context) error {
+	for _, infs := range []struct {
+		name                 string
+		informersForResource *informers.ForResource
+	}{
+		{"ThanosQuerier", o.querierInfs},
+		{"Prometheus", o.promInfs},
+		{"ThanosRuler", o.rulerInfs},
+		{"ThanosStore", o.storeInfs},
+		{"StatefulSet", o.ssetInfs},
+	} {
+		// Skipping informers that were not started.
+		if infs.informersForResource == nil {
+			continue
+		}
+
+		for _, inf := range infs.informersForResource.GetInformers() {
+			if !operator.WaitForNamedCacheSync(ctx, "thanos-querier", o.logger.With("informer", infs.name), inf.Informer()) {
+				return fmt.Errorf("failed to sync cache for %s informer", infs.name)
+			}
+		}
+	}
+
+	for _, inf := range []struct {
+		name     string
+		informer cache.SharedIndexInformer
+	}{
+		{"ThanosNamespace", o.nsThanosInf},
+		{"PrometheusNamespace", o.nsPromInf},
+	} {
+		if !operator.WaitForNamedCacheSync(ctx, "thanos-querier", o.logger.With("informer", inf.name), inf.informer) {
+			return fmt.Errorf("failed to sync cache for %s informer", inf.name)
+		}
+	}
+
+	o.logger.Info("successfully synced all caches")
+	return nil
+}
+
+// addHandlers adds the eventhandlers to the informers.
+func (o *Operator) addHandlers() {
+	o.querierInfs.AddEventHandler(o.rr)
+	o.ssetInfs.AddEventHandler(o.rr)
+
+	o.promInfs.AddEventHandler(operator.NewEventHandler(
+		o.logger,
+		o.accessor,
+		o.metrics,
+		monitoringv1.PrometheusesKind,
+		o.enqueueForPrometheusNamespace,
+		operator.WithoutStatusUpdates(),
+	))
+
+	o.rulerInfs.AddEventHandler(operator.NewEventHandler(
+		o.logger,
+		o.accessor,
+		o.metrics,
+		monitoringv1.ThanosRulerKind,
+		o.enqueueForThanosRulerNamespace,
+		operator.WithoutStatusUpdates(),
+	))
+
+	if o.storeInfs != nil {
+		o.storeInfs.AddEventHandler(operator.NewEventHandler(
+			o.logger,
+			o.accessor,
+			o.metrics,
+			monitoringv1alpha1.ThanosStoreKind,
+			o.enqueueForThanosStoreNamespace,
+			operator.WithoutStatusUpdates(),
+		))
+	}
+
+	// The controller needs to watch the namespaces because a label change on
+	// a namespace may change the list of store API endpoints.
+	_, _ = o.nsThanosInf.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		UpdateFunc: o.handleNamespaceUpdate,
+	})
+	if o.nsPromInf != o.nsThanosInf {
+		_, _ = o.nsPromInf.AddEventHandler(cache.ResourceEventHandlerFuncs{
+			UpdateFunc: o.handleNamespaceUpdate,
+		})
+	}
+}
+
+// Run the controller.
+func (o *Operator) Run(ctx context.Context) error {
+	go o.rr.Run(ctx)
+	defer o.rr.Stop()
+
+	go o.querierInfs.Start(ctx.Done())
+	go o.promInfs.Start(ctx.Done())
+	go o.rulerInfs.Start(ctx.Done())
+	if o.storeInfs != nil {
+		go o.storeInfs.Start(ctx.Done())
+	}
+	go o.ssetInfs.Start(ctx.Done())
+	go o.nsThanosInf.Run(ctx.Done())
+	if o.nsPromInf != o.nsThanosInf {
+		go o.nsPromInf.Run(ctx.Done())
+	}
+	if err := o.waitForCacheSync(ctx); err != nil {
+		return err
+	}
+
+	// Refresh the status of the existing ThanosQuerier objects.
+	_ = o.querierInfs.ListAll(labels.Everything(), func(obj interface{}) {
+		o.rr.EnqueueForStatus(obj.(*monitoringv1alpha1.ThanosQuerier))
+	})
+
+	o.addHandlers()
+
+	go operator.StatusPoller(ctx, o)
+
+	o.metrics.Ready().Set(1)
+	<-ctx.Done()
+	return nil
+}
+
+// Iterate implements the operator.StatusReconciler interface.
+func (o *Operator) Iterate(processFn func(metav1.Object, []monitoringv1.Condition)) {
+	if err := o.querierInfs.ListAll(labels.Everything(), func(o interface{}) {
+		tq := o.(*monitoringv1alpha1.ThanosQuerier)
+		processFn(tq, tq.Status.Conditions)
+	}); err != nil {
+		o.logger.Error("failed to list ThanosQuerier objects", "err", err)
+	}
+}
+
+// RefreshStatusFor implements the operator.StatusReconciler interface.
+func (o *Operator) RefreshStatusFor(obj metav1.Object) {
+	o.rr.EnqueueForStatus(obj)
+}
+
+func (o *Operator) handleNamespaceUpdate(oldo, curo interface{}) {
+	old := oldo.(*v1.Namespace)
+	cur := curo.(*v1.Namespace)
+
+	// Periodic resync may resend the Namespace without changes in-between.
+	if old.ResourceVersion == cur.ResourceVersion {
+		return
+	}
+
+	o.logger.Debug("Namespace updated", "namespace", cur.GetName())
+	o.metrics.TriggerByCounter("Namespace", operator.UpdateEvent).Inc()
+
+	// Check for ThanosQuerier instances selecting store API endpoints in the
+	// namespace.
+	err := o.querierInfs.ListAll(labels.Everything(), func(obj interface{}) {
+		tq := obj.(*monitoringv1alpha1.ThanosQuerier)
+
+		for name, selector := range map[string]*metav1.LabelSelector{
+			"PrometheusNamespaceSelector":  tq.Spec.PrometheusNamespaceSelector,
+			"ThanosRulerNamespaceSelector": tq.Spec.ThanosRulerNamespaceSelector,
+			"ThanosStoreNamespaceSelector": tq.Spec.ThanosStoreNamespaceSelector,
+		} {
+			sync, err := k8sutil.LabelSelectionHasChanged(old.Labels, cur.Labels, selector)
+			if err != nil {
+				o.logger.Error(
+					"failed to detect label selection change",
+					"err", err,
+					"name", tq.Name,
+					"namespace", tq.Namespace,
+					"subresource", name,
+				)
+				return
+			}
+
+			if sync {
+				o.rr.EnqueueForReconciliation(tq)
+				return
+			}
+		}
+	})
+	if err != nil {
+		o.logger.Error("listing all ThanosQuerier instances from cache failed",
+			"err", err,
+		)
+	}
+}
+
+func (o *Operator) enqueueForPrometheusNamespace(nsName string) {
+	o.enqueueForNamespace(o.nsPromInf.GetStore(), nsName, func(tq *monitoringv1alpha1.ThanosQuerier) *metav1.LabelSelector {
+		return tq.Spec.PrometheusNamespaceSelector
+	})
+}
+
+func (o *Operator) enqueueForThanosRulerNamespace(nsName string) {
+	o.enqueueForNamespace(o.nsThanosInf.GetStore(), nsName, func(tq *monitoringv1alpha1.ThanosQuerier) *metav1.LabelSelector {
+		return tq.Spec.ThanosRulerNamespaceSelector
+	})
+}
+
+func (o *Operator) enqueueForThanosStoreNamespace(nsName string) {
+	o.enqueueForNamespace(o.nsThanosInf.GetStore(), nsName, func(tq *monitoringv1alpha1.ThanosQuerier) *metav1.LabelSelector {
+		return tq.Spec.ThanosStoreNamespaceSelector
+	})
+}
+
+// enqueueForNamespace enqueues all ThanosQuerier object keys that belong to
+// the given namespace or select objects in the given namespace.
+func (o *Operator) enqueueForNamespace(store cache.Store, nsName string, nsSelectorFn func(*monitoringv1alpha1.ThanosQuerier) *metav1.LabelSelector) {
+	nsObject, exists, err := store.GetByKey(nsName)
+	if err != nil {
+		o.logger.Error("get namespace to enqueue ThanosQuerier instances failed",
+			"err", err,
+		)
+		return
+	}
+	if !exists {
+		o.logger.Error("get namespace to enqueue ThanosQuerier instances failed: namespace does not exist",
+			"namespace", nsName,
+		)
+		return
+	}
+	ns := nsObject.(*v1.Namespace)
+
+	err = o.querierInfs.ListAll(labels.Everything(), func(obj interface{}) {
+		tq := obj.(*monitoringv1alpha1.ThanosQuerier)
+		if tq.Namespace == nsName {
+			o.rr.EnqueueForReconciliation(tq)
+			return
+		}
+
+		nsSelector, err := metav1.LabelSelectorAsSelector(nsSelectorFn(tq))
+		if err != nil {
+			o.logger.Error("failed to convert namespace selector",
+				"err", err,
+				"name", tq.Name,
+				"namespace", tq.Namespace,
+			)
+			return
+		}
+
+		if nsSelector.Matches(labels.Set(ns.Labels)) {
+			o.rr.EnqueueForReconciliation(tq)
+			return
+		}
+	})
+	if err != nil {
+		o.logger.Error("listing all ThanosQuerier instances from cache failed",
+			"err", err,
+		)
+	}
+}
+
+// Sync implements the operator.Syncer interface.
+func (o *Operator) Sync(ctx context.Context, key string) error {
+	err := o.sync(ctx, key)
+	o.reconciliations.SetStatus(key, err)
+
+	return err
+}
+
+func (o *Operator) sync(ctx context.Context, key string) error {
+	tq, err := o.getThanosQuerierFromKey(key)
+	if err != nil {
+		return err
+	}
+
+	if tq == nil {
+		o.reconciliations.ForgetObject(key)
+		// Dependent resources are cleaned up by K8s via OwnerReferences
+		return nil
+	}
+
+	if err := k8sutil.AddTypeInformationToObject(tq); err != nil {
+		return fmt.Errorf("failed to set ThanosQuerier type information: %w", err)
+	}
+
+	// Check if the ThanosQuerier instance is marked for deletion.
+	if o.rr.DeletionInProgress(tq) {
+		return nil
+	}
+
+	if tq.Spec.Paused {
+		return nil
+	}
+
+	logger := o.logger.With("key", key)
+	logger.Info("sync thanos-querier")
+
+	endpoints, err := o.selectEndpoints(tq)
+	if err != nil {
+		return fmt.Errorf("failed to discover store API endpoints: %w", err)
+	}
+
+	svcClient := o.kclient.CoreV1().Services(tq.Namespace)
+	if _, err = k8sutil.CreateOrUpdateService(ctx, svcClient, makeStatefulSetService(tq, o.config)); err != nil {
+		return fmt.Errorf("synchronizing governing service failed: %w", err)
+	}
+
+	existingStatefulSet, err := o.getStatefulSetFromThanosQuerierKey(key)
+	if err != nil {
+		return err
+	}
+
+	ssetClient := o.kclient.AppsV1().StatefulSets(tq.Namespace)
+	if existingStatefulSet == nil {
+		sset, err := makeStatefulSet(tq, o.config, endpoints, "")
+		if err != nil {
+			return fmt.Errorf("making thanos querier statefulset config failed: %w", err)
+		}
+
+		operator.SanitizeSTS(sset)
+		if _, err := ssetClient.Create(ctx, sset, metav1.CreateOptions{}); err != nil {
+			return fmt.Errorf("creating thanos querier statefulset failed: %w", err)
+		}
+
+		return nil
+	}
+
+	if o.rr.DeletionInProgress(existingStatefulSet) {
+		return nil
+	}
+
+	newSSetInputHash, err := createSSetInputHash(*tq, o.config, endpoints, existingStatefulSet.Spec)
+	if err != nil {
+		return err
+	}
+
+	if newSSetInputHash == existingStatefulSet.Annotations[operator.InputHashAnnotationName] {
+		logger.Debug("new statefulset generation inputs match current, skipping any actions", "hash", newSSetInputHash)
+		return nil
+	}
+
+	sset, err := makeStatefulSet(tq, o.config, endpoints, newSSetInputHash)
+	if err != nil {
+		return fmt.Errorf("failed to generate statefulset: %w", err)
+	}
+
+	operator.SanitizeSTS(sset)
+
+	logger.Debug("new hash differs from the existing value", "new", newSSetInputHash, "existing", existingStatefulSet.Annotations[operator.InputHashAnnotationName])
+	err = k8sutil.UpdateStatefulSet(ctx, ssetClient, sset)
+	sErr, ok := err.(*apierrors.StatusError)
+
+	if ok && sErr.ErrStatus.Code == 422 && sErr.ErrStatus.Reason == metav1.StatusReasonInvalid {
+		o.metrics.StsDeleteCreateCounter().Inc()
+
+		// Gather only reason for failed update
+		failMsg := make([]string, len(sErr.ErrStatus.Details.Causes))
+		for i, cause := range sErr.ErrStatus.Details.Causes {
+			failMsg[i] = cause.Message
+		}
+
+		logger.Info("recreating ThanosQuerier StatefulSet because the update operation wasn't possible", "reason", strings.Join(failMsg, ", "))
+		propagationPolicy := metav1.DeletePropagationForeground
+		if err := ssetClient.Delete(ctx, sset.GetName(), metav1.DeleteOptions{PropagationPolicy: &propagationPolicy}); err != nil {
+			return fmt.Errorf("failed to delete StatefulSet to avoid forbidden action: %w", err)
+		}
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("updating StatefulSet failed: %w", err)
+	}
+
+	return nil
+}
+
+// selectEndpoints returns the store API endpoints of the Prometheus,
+// ThanosRuler and ThanosStore resources selected by the ThanosQuerier object
+// followed by the static endpoints.
+func (o *Operator) selectEndpoints(tq *monitoringv1alpha1.ThanosQuerier) ([]string, error) {
+	var endpoints []string
+
+	err := o.listSelected(o.promInfs, o.nsPromInf, tq.Namespace, tq.Spec.PrometheusSelector, tq.Spec.PrometheusNamespaceSelector, func(obj interface{}) {
+		endpoints = append(endpoints, prometheusEndpoints(obj.(*monitoringv1.Prometheus), o.config.ClusterDomain)...)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("prometheus: %w", err)
+	}
+
+	err = o.listSelected(o.rulerInfs, o.nsThanosInf, tq.Namespace, tq.Spec.ThanosRulerSelector, tq.Spec.ThanosRulerNamespaceSelector, func(obj interface{}) {
+		endpoints = append(endpoints, thanosRulerEndpoints(obj.(*monitoringv1.ThanosRuler), o.config.ClusterDomain)...)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("thanosruler: %w", err)
+	}
+
+	if o.storeInfs != nil {
+		err = o.listSelected(o.storeInfs, o.nsThanosInf, tq.Namespace, tq.Spec.ThanosStoreSelector, tq.Spec.ThanosStoreNamespaceSelector, func(obj interface{}) {
+			endpoints = append(endpoints, thanosStoreEndpoint(obj.(*monitoringv1alpha1.ThanosStore), o.config.ClusterDomain))
+		})
+		if err != nil {
+			return nil, fmt.Errorf("thanosstore: %w", err)
+		}
+	}
+
+	// Sort the discovered endpoints to ensure a stable ordering of the
+	// arguments.
+	slices.Sort(endpoints)
+
+	for _, e := range tq.Spec.Endpoints {
+		if !slices.Contains(endpoints, e) {
+			endpoints = append(endpoints, e)
+		}
+	}
+
+	return endpoints, nil
+}
+
+// listSelected calls appendFn for each object of infs matching the label
+// and namespace selectors. It does nothing if the label selector is nil.
+func (o *Operator) listSelected(infs *informers.ForResource, nsInf cache.SharedIndexInformer, namespace string, selector, nsSelector *metav1.LabelSelector, appendFn cache.AppendFunc) error {
+	if selector == nil {
+		return nil
+	}
+
+	objSelector, err := metav1.LabelSelectorAsSelector(selector)
+	if err != nil {
+		return fmt.Errorf("invalid label selector: %w", err)
+	}
+
+	namespaces := []string{namespace}
+	if nsSelector != nil {
+		s, err := metav1.LabelSelectorAsSelector(nsSelector)
+		if err != nil {
+			return fmt.Errorf("invalid namespace selector: %w", err)
+		}
+
+		namespaces, err = operator.ListMatchingNamespaces(s, nsInf)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, ns := range namespaces {
+		if err := infs.ListAllByNamespace(ns, objSelector, appendFn); err != nil {
+			return fmt.Errorf("failed to list objects in namespace %s: %w", ns, err)
+		}
+	}
+
+	return nil
+}
+
+// getThanosQuerierFromKey returns a copy of the ThanosQuerier object identified by key.
+// If the object is not found, it returns a nil pointer.
+func (o *Operator) getThanosQuerierFromKey(key string) (*monitoringv1alpha1.ThanosQuerier, error) {
+	obj, err := o.querierInfs.Get(key)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			o.logger.Info("ThanosQuerier not found", "key", key)
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to retrieve ThanosQuerier from informer: %w", err)
+	}
+
+	return obj.(*monitoringv1alpha1.ThanosQuerier).DeepCopy(), nil
+}
+
+// getStatefulSetFromThanosQuerierKey returns a copy of the StatefulSet object
+// corresponding to the ThanosQuerier object identified by key.
+// If the object is not found, it returns a nil pointer without error.
+func (o *Operator) getStatefulSetFromThanosQuerierKey(key string) (*appsv1.StatefulSet, error) {
+	keyParts := strings.Split(key, "/")
+	ssetName := keyParts[0] + "/" + prefixedName(keyParts[1])
+
+	obj, err := o.ssetInfs.Get(ssetName)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			o.logger.Info("StatefulSet not found", "key", ssetName)
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to retrieve StatefulSet from informer: %w", err)
+	}
+
+	return obj.(*appsv1.StatefulSet).DeepCopy(), nil
+}
+
+// UpdateStatus implements the operator.Syncer interface.
+func (o *Operator) UpdateStatus(ctx context.Context, key string) error {
+	tq, err := o.getThanosQuerierFromKey(key)
+	if err != nil {
+		return err
+	}
+
+	if tq == nil || o.rr.DeletionInProgress(tq) {
+		return nil
+	}
+
+	sset, err := o.getStatefulSetFromThanosQuerierKey(key)
+	if err != nil {
+		return fmt.Errorf("failed to get StatefulSet: %w", err)
+	}
+
+	if sset != nil && o.rr.DeletionInProgress(sset) {
+		return nil
+	}
+
+	stsReporter, err := operator.NewStatefulSetReporter(ctx, o.kclient, sset)
+	if err != nil {
+		return fmt.Errorf("failed to retrieve statefulset state: %w", err)
+	}
+
+	availableCondition := stsReporter.Update(tq)
+	reconciledCondition := o.reconciliations.GetCondition(key, tq.Generation)
+	tq.Status.Conditions = operator.UpdateConditions(tq.Status.Conditions, availableCondition, reconciledCondition)
+	tq.Status.Paused = tq.Spec.Paused
+
+	if _, err = o.mclient.MonitoringV1alpha1().ThanosQueriers(tq.Namespace).ApplyStatus(ctx, applyConfigurationFromThanosQuerier(tq), metav1.ApplyOptions{FieldManager: operator.PrometheusOperatorFieldManager, Force: true}); err != nil {
+		return fmt.Errorf("failed to apply status subresource: %w", err)
+	}
+
+	return nil
+}
+
+func createSSetInputHash(tq monitoringv1alpha1.ThanosQuerier, c Config, endpoints []string, ss appsv1.StatefulSetSpec) (string, error) {
+	// The controller should ignore any changes to RevisionHistoryLimit field because
+	// it may be modified by external actors.
+	// See https://github.com/prometheus-operator/prometheus-operator/issues/5712
+	ss.RevisionHistoryLimit = nil
+
+	hash, err := hashstructure.Hash(struct {
+		ThanosQuerierLabels      map[string]string
+		ThanosQuerierAnnotations map[string]string
+		ThanosQuerierGeneration  int64
+		Config                   Config
+		Endpoints                []string
+		StatefulSetSpec          appsv1.StatefulSetSpec
+	}{
+		ThanosQuerierLabels:      tq.Labels,
+		ThanosQuerierAnnotations: tq.Annotations,
+		ThanosQuerierGeneration:  tq.Generation,
+		Config:                   c,
+		Endpoints:                endpoints,
+		StatefulSetSpec:          ss,
+	},
+		nil,
+	)
+	if err != nil {
+		return "", fmt.Errorf("failed to calculate combined hash: %w", err)
+	}
+
+	return fmt.Sprintf("%d", hash), nil
+}
+
+func applyConfigurationFromThanosQuerier(tq *monitoringv1alpha1.ThanosQuerier) *monitoringv1alpha1ac.ThanosQuerierApplyConfiguration {
+	tqac := monitoringv1alpha1ac.ThanosComponentStatus().
+		WithPaused(tq.Status.Paused).
+		WithReplicas(tq.Status.Replicas).
+		WithAvailableReplicas(tq.Status.AvailableReplicas).
+		WithUpdatedReplicas(tq.Status.UpdatedReplicas).
+		WithUnavailableReplicas(tq.Status.UnavailableReplicas)
+
+	for _, condition := range tq.Status.Conditions {
+		tqac.WithConditions(
+			monitoringv1ac.Condition().
+				WithType(condition.Type).
+				WithStatus(condition.Status).
+				WithLastTransitionTime(condition.LastTransitionTime).
+				WithReason(condition.Reason).
+				WithMessage(condition.Message).
+				WithObservedGeneration(condition.ObservedGeneration),
+		)
+	}
+
+	return monitoringv1alpha1ac.ThanosQuerier(tq.Name, tq.Namespace).WithStatus(tqac)
+}
@@ -0,0 +1,247 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querier
+
+import (
+	"fmt"
+	"maps"
+
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/k8sutil"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+)
+
+const (
+	containerName = "thanos-query"
+
+	defaultTerminationGracePeriodSeconds = int64(120)
+)
+
+var defaultReplicaLabels = []string{"prometheus_replica", "thanos_ruler_replica"}
+
+func makeStatefulSet(tq *monitoringv1alpha1.ThanosQuerier, config Config, endpoints []string, inputHash string) (*appsv1.StatefulSet, error) {
+	spec, err := makeStatefulSetSpec(tq, config, endpoints)
+	if err != nil {
+		return nil, err
+	}
+
+	statefulset := &appsv1.StatefulSet{Spec: *spec}
+	operator.UpdateObject(
+		statefulset,
+		operator.WithName(prefixedName(tq.Name)),
+		operator.WithInputHashAnnotation(inputHash),
+		operator.WithAnnotations(tq.GetAnnotations()),
+		operator.WithAnnotations(config.Annotations),
+		operator.WithLabels(tq.GetLabels()),
+		operator.WithLabels(config.Labels),
+		operator.WithManagingOwner(tq),
+		operator.WithoutKubectlAnnotations(),
+	)
+
+	if len(tq.Spec.ImagePullSecrets) > 0 {
+		statefulset.Spec.Template.Spec.ImagePullSecrets = tq.Spec.ImagePullSecrets
+	}
+
+	return statefulset, nil
+}
+
+func makeStatefulSetSpec(tq *monitoringv1alpha1.ThanosQuerier, config Config, endpoints []string) (*appsv1.StatefulSetSpec, error) {
+	thanosVersion := operator.StringValOrDefault(ptr.Deref(tq.Spec.Version, ""), operator.DefaultThanosVersion)
+
+	imagePath, err := operator.BuildImagePath(
+		ptr.Deref(tq.Spec.Image, ""),
+		operator.StringValOrDefault(config.ThanosDefaultBaseImage, operator.DefaultThanosBaseImage),
+		thanosVersion,
+		"",
+		"",
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build image path: %w", err)
+	}
+
+	var args []monitoringv1.Argument
+
+	replicaLabels := tq.Spec.ReplicaLabels
+	if len(replicaLabels) == 0 {
+		replicaLabels = defaultReplicaLabels
+	}
+	for _, l := range replicaLabels {
+		args = append(args, monitoringv1.Argument{Name: "query.replica-label", Value: l})
+	}
+
+	for _, e := range endpoints {
+		args = append(args, monitoringv1.Argument{Name: "endpoint", Value: e})
+	}
+
+	if tq.Spec.LogLevel != "" && tq.Spec.LogLevel != "info" {
+		args = append(args, monitoringv1.Argument{Name: "log.level", Value: tq.Spec.LogLevel})
+	}
+
+	if tq.Spec.LogFormat != "" {
+		args = append(args, monitoringv1.Argument{Name: "log.format", Value: tq.Spec.LogFormat})
+	}
+
+	containerArgs, err := operator.BuildArgs(args, tq.Spec.AdditionalArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	// The first argument to thanos must be "query" to start the querier, e.g. "thanos query --endpoint..."
+	containerArgs = append([]string{"query"}, containerArgs...)
+
+	podAnnotations := map[string]string{}
+	podLabels := map[string]string{}
+	if tq.Spec.PodMetadata != nil {
+		maps.Copy(podLabels, tq.Spec.PodMetadata.Labels)
+		maps.Copy(podAnnotations, tq.Spec.PodMetadata.Annotations)
+	}
+
+	// In cases where an existing selector label is modified, or a new one is added, new sts cannot match existing pods.
+	// We should try to avoid removing such immutable fields whenever possible since doing
+	// so forces us to enter the 'recreate cycle' and can potentially lead to downtime.
+	// The requirement to make a change here should be carefully evaluated.
+	selectorLabels := makeSelectorLabels(tq.Name)
+
+	finalLabels := config.Labels.Merge(podLabels)
+	maps.Copy(finalLabels, selectorLabels)
+
+	podAnnotations["kubectl.kubernetes.io/default-container"] = containerName
+
+	operatorContainers := []v1.Container{
+		{
+			Name:            containerName,
+			Image:           imagePath,
+			ImagePullPolicy: tq.Spec.ImagePullPolicy,
+			Args:            containerArgs,
+			VolumeMounts:    tq.Spec.VolumeMounts,
+			Resources:       tq.Spec.Resources,
+			Ports: []v1.ContainerPort{
+				{
+					Name:          "grpc",
+					ContainerPort: grpcPort,
+					Protocol:      v1.ProtocolTCP,
+				},
+				{
+					Name:          "http",
+					ContainerPort: 10902,
+					Protocol:      v1.ProtocolTCP,
+				},
+			},
+			TerminationMessagePolicy: v1.TerminationMessageFallbackToLogsOnError,
+			SecurityContext: &v1.SecurityContext{
+				AllowPrivilegeEscalation: ptr.To(false),
+				ReadOnlyRootFilesystem:   ptr.To(true),
+				Capabilities: &v1.Capabilities{
+					Drop: []v1.Capability{"ALL"},
+				},
+			},
+		},
+	}
+
+	containers, err := k8sutil.MergePatchContainers(operatorContainers, tq.Spec.Containers)
+	if err != nil {
+		return nil, fmt.Errorf("failed to merge containers spec: %w", err)
+	}
+
+	return &appsv1.StatefulSetSpec{
+		ServiceName: prefixedName(tq.Name),
+		Replicas:    tq.Spec.Replicas,
+		// PodManagementPolicy is set to Parallel to mitigate issues in kubernetes: https://github.com/kubernetes/kubernetes/issues/60164
+		// This is also mentioned as one of limitations of StatefulSets: https://kubernetes.io/docs/concepts/workloads/controllers/statefulset/#limitations
+		PodManagementPolicy: appsv1.ParallelPodManagement,
+		UpdateStrategy: appsv1.StatefulSetUpdateStrategy{
+			Type: appsv1.RollingUpdateStatefulSetStrategyType,
+		},
+		Selector: &metav1.LabelSelector{
+			MatchLabels: finalLabels,
+		},
+		Template: v1.PodTemplateSpec{
+			ObjectMeta: metav1.ObjectMeta{
+				Labels:      finalLabels,
+				Annotations: podAnnotations,
+			},
+			Spec: v1.PodSpec{
+				NodeSelector:                  tq.Spec.NodeSelector,
+				PriorityClassName:             tq.Spec.PriorityClassName,
+				ServiceAccountName:            tq.Spec.ServiceAccountName,
+				TerminationGracePeriodSeconds: ptr.To(defaultTerminationGracePeriodSeconds),
+				Containers:                    containers,
+				Volumes:                       tq.Spec.Volumes,
+				SecurityContext:               tq.Spec.SecurityContext,
+				Tolerations:                   tq.Spec.Tolerations,
+				Affinity:                      tq.Spec.Affinity,
+				TopologySpreadConstraints:     tq.Spec.TopologySpreadConstraints,
+			},
+		},
+	}, nil
+}
+
+func makeStatefulSetService(tq *monitoringv1alpha1.ThanosQuerier, config Config) *v1.Service {
+	svc := &v1.Service{
+		Spec: v1.ServiceSpec{
+			ClusterIP: "None",
+			Ports: []v1.ServicePort{
+				{
+					Name:       "grpc",
+					Port:       grpcPort,
+					TargetPort: intstr.FromString("grpc"),
+					Protocol:   v1.ProtocolTCP,
+				},
+				{
+					Name:       "http",
+					Port:       10902,
+					TargetPort: intstr.FromString("http"),
+					Protocol:   v1.ProtocolTCP,
+				},
+			},
+			Selector: makeSelectorLabels(tq.Name),
+		},
+	}
+
+	operator.UpdateObject(
+		svc,
+		operator.WithName(prefixedName(tq.Name)),
+		operator.WithAnnotations(config.Annotations),
+		operator.WithLabels(map[string]string{"operated-thanos-querier": "true"}),
+		operator.WithLabels(config.Labels),
+		operator.WithOwner(tq),
+	)
+
+	return svc
+}
+
+// In cases where an existing selector label is modified, or a new one is added, new sts cannot match existing pods.
+// We should try to avoid removing such immutable fields whenever possible since doing
+// so forces us to enter the 'recreate cycle' and can potentially lead to downtime.
+// The requirement to make a change here should be carefully evaluated.
+func makeSelectorLabels(name string) map[string]string {
+	return map[string]string{
+		"app.kubernetes.io/name":       thanosQuerierLabel,
+		"app.kubernetes.io/managed-by": "prometheus-operator",
+		"app.kubernetes.io/instance":   name,
+		thanosQuerierLabel:             name,
+	}
+}
+
+func prefixedName(name string) string {
+	return fmt.Sprintf("thanos-querier-%s", name)
+}
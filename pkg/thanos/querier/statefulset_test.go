@@ -0,0 +1,135 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querier
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+)
+
+var defaultTestConfig = Config{
+	ThanosDefaultBaseImage: operator.DefaultThanosBaseImage,
+}
+
+func TestStatefulSetArgs(t *testing.T) {
+	for _, tc := range []struct {
+		name      string
+		spec      monitoringv1alpha1.ThanosQuerierSpec
+		endpoints []string
+		expected  []string
+	}{
+		{
+			name: "default replica labels",
+			endpoints: []string{
+				"prometheus-k8s-0.prometheus-operated.monitoring.svc:10901",
+				"static:10901",
+			},
+			expected: []string{
+				"query",
+				"--query.replica-label=prometheus_replica",
+				"--query.replica-label=thanos_ruler_replica",
+				"--endpoint=prometheus-k8s-0.prometheus-operated.monitoring.svc:10901",
+				"--endpoint=static:10901",
+			},
+		},
+		{
+			name: "custom replica labels and logging",
+			spec: monitoringv1alpha1.ThanosQuerierSpec{
+				ThanosComponentSpec: monitoringv1alpha1.ThanosComponentSpec{
+					LogLevel:  "warn",
+					LogFormat: "logfmt",
+				},
+				ReplicaLabels: []string{"replica"},
+			},
+			expected: []string{
+				"query",
+				"--query.replica-label=replica",
+				"--log.level=warn",
+				"--log.format=logfmt",
+			},
+		},
+		{
+			name: "additional args",
+			spec: monitoringv1alpha1.ThanosQuerierSpec{
+				ThanosComponentSpec: monitoringv1alpha1.ThanosComponentSpec{
+					AdditionalArgs: []monitoringv1.Argument{{Name: "query.auto-downsampling"}},
+				},
+			},
+			expected: []string{
+				"query",
+				"--query.replica-label=prometheus_replica",
+				"--query.replica-label=thanos_ruler_replica",
+				"--query.auto-downsampling",
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			tq := &monitoringv1alpha1.ThanosQuerier{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test",
+					Namespace: "ns",
+				},
+				Spec: tc.spec,
+			}
+
+			sset, err := makeStatefulSet(tq, defaultTestConfig, tc.endpoints, "")
+			require.NoError(t, err)
+
+			require.Equal(t, "thanos-querier-test", sset.Name)
+			require.Equal(t, "thanos-querier-test", sset.Spec.ServiceName)
+			require.Len(t, sset.Spec.Template.Spec.Containers, 1)
+			require.Equal(t, tc.expected, sset.Spec.Template.Spec.Containers[0].Args)
+		})
+	}
+}
+
+func TestStatefulSetImage(t *testing.T) {
+	tq := &monitoringv1alpha1.ThanosQuerier{
+		Spec: monitoringv1alpha1.ThanosQuerierSpec{
+			ThanosComponentSpec: monitoringv1alpha1.ThanosComponentSpec{
+				Version: ptr.To("v0.37.0"),
+			},
+		},
+	}
+
+	sset, err := makeStatefulSet(tq, defaultTestConfig, nil, "")
+	require.NoError(t, err)
+	require.Equal(t, operator.DefaultThanosBaseImage+":v0.37.0", sset.Spec.Template.Spec.Containers[0].Image)
+
+	tq.Spec.Image = ptr.To("quay.io/thanos/thanos:custom")
+	sset, err = makeStatefulSet(tq, defaultTestConfig, nil, "")
+	require.NoError(t, err)
+	require.Equal(t, "quay.io/thanos/thanos:custom", sset.Spec.Template.Spec.Containers[0].Image)
+}
+
+func TestStatefulSetInputHash(t *testing.T) {
+	tq := monitoringv1alpha1.ThanosQuerier{}
+
+	h1, err := createSSetInputHash(tq, defaultTestConfig, []string{"a:10901"}, appsv1.StatefulSetSpec{})
+	require.NoError(t, err)
+
+	h2, err := createSSetInputHash(tq, defaultTestConfig, []string{"a:10901", "b:10901"}, appsv1.StatefulSetSpec{})
+	require.NoError(t, err)
+
+	require.NotEqual(t, h1, h2)
+}
@@ -33,12 +33,12 @@ import (
 
 const labelThanosRulerName = "thanos-ruler-name"
 
-func (o *Operator) createOrUpdateRuleConfigMaps(ctx context.Context, t *monitoringv1.ThanosRuler) ([]string, error) {
+func (o *Operator) createOrUpdateRuleConfigMaps(ctx context.Context, t *monitoringv1.ThanosRuler) ([]string, operator.PrometheusRuleSelection, error) {
 	cClient := o.kclient.CoreV1().ConfigMaps(t.Namespace)
 
 	namespaces, err := o.selectRuleNamespaces(t)
 	if err != nil {
-		return nil, err
+		return nil, operator.PrometheusRuleSelection{}, err
 	}
 
 	excludedFromEnforcement := t.Spec.ExcludedFromEnforcement
@@ -63,22 +63,22 @@ func (o *Operator) createOrUpdateRuleConfigMaps(ctx context.Context, t *monitori
 
 	promRuleSelector, err := operator.NewPrometheusRuleSelector(operator.ThanosFormat, thanosVersion, t.Spec.RuleSelector, nsLabeler, o.ruleInfs, o.eventRecorder, logger)
 	if err != nil {
-		return nil, fmt.Errorf("initializing PrometheusRules failed: %w", err)
+		return nil, operator.PrometheusRuleSelection{}, fmt.Errorf("initializing PrometheusRules failed: %w", err)
 	}
 
-	newRules, rejected, err := promRuleSelector.Select(namespaces)
+	selection, err := promRuleSelector.Select(namespaces)
 	if err != nil {
-		return nil, fmt.Errorf("selecting PrometheusRules failed: %w", err)
+		return nil, operator.PrometheusRuleSelection{}, fmt.Errorf("selecting PrometheusRules failed: %w", err)
 	}
 
 	if tKey, ok := o.accessor.MetaNamespaceKey(t); ok {
-		o.metrics.SetSelectedResources(tKey, monitoringv1.PrometheusRuleKind, len(newRules))
-		o.metrics.SetRejectedResources(tKey, monitoringv1.PrometheusRuleKind, rejected)
+		o.metrics.SetSelectedResources(tKey, monitoringv1.PrometheusRuleKind, selection.SelectedLen())
+		o.metrics.SetRejectedResources(tKey, monitoringv1.PrometheusRuleKind, selection.RejectedLen())
 	}
 
 	currentConfigMapList, err := cClient.List(ctx, prometheusRulesConfigMapSelector(t.Name))
 	if err != nil {
-		return nil, err
+		return nil, operator.PrometheusRuleSelection{}, err
 	}
 	currentConfigMaps := currentConfigMapList.Items
 
@@ -89,7 +89,7 @@ func (o *Operator) createOrUpdateRuleConfigMaps(ctx context.Context, t *monitori
 		}
 	}
 
-	equal := reflect.DeepEqual(newRules, currentRules)
+	equal := reflect.DeepEqual(selection.RuleFiles(), currentRules)
 	if equal && len(currentConfigMaps) != 0 {
 		o.logger.Debug("no PrometheusRule changes",
 			"namespace", t.Namespace,
@@ -99,17 +99,17 @@ func (o *Operator) createOrUpdateRuleConfigMaps(ctx context.Context, t *monitori
 		for _, cm := range currentConfigMaps {
 			currentConfigMapNames = append(currentConfigMapNames, cm.Name)
 		}
-		return currentConfigMapNames, nil
+		return currentConfigMapNames, selection, nil
 	}
 
 	newConfigMaps, err := makeRulesConfigMaps(
 		t,
-		newRules,
+		selection.RuleFiles(),
 		operator.WithAnnotations(o.config.Annotations),
 		operator.WithLabels(o.config.Labels),
 	)
 	if err != nil {
-		return nil, fmt.Errorf("failed to make rules ConfigMaps: %w", err)
+		return nil, operator.PrometheusRuleSelection{}, fmt.Errorf("failed to make rules ConfigMaps: %w", err)
 	}
 
 	newConfigMapNames := make([]string, 0, len(newConfigMaps))
@@ -125,10 +125,10 @@ func (o *Operator) createOrUpdateRuleConfigMaps(ctx context.Context, t *monitori
 		for _, cm := range newConfigMaps {
 			_, err = cClient.Create(ctx, &cm, metav1.CreateOptions{})
 			if err != nil {
-				return nil, fmt.Errorf("failed to create ConfigMap '%v': %w", cm.Name, err)
+				return nil, operator.PrometheusRuleSelection{}, fmt.Errorf("failed to create ConfigMap '%v': %w", cm.Name, err)
 			}
 		}
-		return newConfigMapNames, nil
+		return newConfigMapNames, selection, nil
 	}
 
 	// Simply deleting old ConfigMaps and creating new ones for now. Could be
@@ -136,7 +136,7 @@ func (o *Operator) createOrUpdateRuleConfigMaps(ctx context.Context, t *monitori
 	for _, cm := range currentConfigMaps {
 		err := cClient.Delete(ctx, cm.Name, metav1.DeleteOptions{})
 		if err != nil {
-			return nil, fmt.Errorf("failed to delete current ConfigMap '%v': %w", cm.Name, err)
+			return nil, operator.PrometheusRuleSelection{}, fmt.Errorf("failed to delete current ConfigMap '%v': %w", cm.Name, err)
 		}
 	}
 
@@ -147,11 +147,11 @@ func (o *Operator) createOrUpdateRuleConfigMaps(ctx context.Context, t *monitori
 	for _, cm := range newConfigMaps {
 		_, err = cClient.Create(ctx, &cm, metav1.CreateOptions{})
 		if err != nil {
-			return nil, fmt.Errorf("failed to create new ConfigMap '%v': %w", cm.Name, err)
+			return nil, operator.PrometheusRuleSelection{}, fmt.Errorf("failed to create new ConfigMap '%v': %w", cm.Name, err)
 		}
 	}
 
-	return newConfigMapNames, nil
+	return newConfigMapNames, selection, nil
 }
 
 func prometheusRulesConfigMapSelector(thanosRulerName string) metav1.ListOptions {
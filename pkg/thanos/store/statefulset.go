@@ -0,0 +1,336 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"fmt"
+	"maps"
+	"path/filepath"
+
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/k8sutil"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+)
+
+const (
+	configDir     = "/etc/thanos/config"
+	storageDir    = "/thanos/data"
+	containerName = "thanos-store"
+
+	defaultTerminationGracePeriodSeconds = int64(120)
+)
+
+func makeStatefulSet(ts *monitoringv1alpha1.ThanosStore, config Config, inputHash string) (*appsv1.StatefulSet, error) {
+	spec, err := makeStatefulSetSpec(ts, config)
+	if err != nil {
+		return nil, err
+	}
+
+	statefulset := &appsv1.StatefulSet{Spec: *spec}
+	operator.UpdateObject(
+		statefulset,
+		operator.WithName(prefixedName(ts.Name)),
+		operator.WithInputHashAnnotation(inputHash),
+		operator.WithAnnotations(ts.GetAnnotations()),
+		operator.WithAnnotations(config.Annotations),
+		operator.WithLabels(ts.GetLabels()),
+		operator.WithLabels(config.Labels),
+		operator.WithManagingOwner(ts),
+		operator.WithoutKubectlAnnotations(),
+	)
+
+	if len(ts.Spec.ImagePullSecrets) > 0 {
+		statefulset.Spec.Template.Spec.ImagePullSecrets = ts.Spec.ImagePullSecrets
+	}
+
+	storageSpec := ts.Spec.Storage
+	switch {
+	case storageSpec == nil:
+		statefulset.Spec.Template.Spec.Volumes = append(statefulset.Spec.Template.Spec.Volumes, v1.Volume{
+			Name: volumeName(ts.Name),
+			VolumeSource: v1.VolumeSource{
+				EmptyDir: &v1.EmptyDirVolumeSource{},
+			},
+		})
+
+	case storageSpec.EmptyDir != nil:
+		statefulset.Spec.Template.Spec.Volumes = append(statefulset.Spec.Template.Spec.Volumes, v1.Volume{
+			Name: volumeName(ts.Name),
+			VolumeSource: v1.VolumeSource{
+				EmptyDir: storageSpec.EmptyDir,
+			},
+		})
+
+	case storageSpec.Ephemeral != nil:
+		statefulset.Spec.Template.Spec.Volumes = append(statefulset.Spec.Template.Spec.Volumes, v1.Volume{
+			Name: volumeName(ts.Name),
+			VolumeSource: v1.VolumeSource{
+				Ephemeral: storageSpec.Ephemeral,
+			},
+		})
+
+	default: // storageSpec.VolumeClaimTemplate
+		pvcTemplate := operator.MakeVolumeClaimTemplate(storageSpec.VolumeClaimTemplate)
+		if pvcTemplate.Name == "" {
+			pvcTemplate.Name = volumeName(ts.Name)
+		}
+		if storageSpec.VolumeClaimTemplate.Spec.AccessModes == nil {
+			pvcTemplate.Spec.AccessModes = []v1.PersistentVolumeAccessMode{v1.ReadWriteOnce}
+		} else {
+			pvcTemplate.Spec.AccessModes = storageSpec.VolumeClaimTemplate.Spec.AccessModes
+		}
+		pvcTemplate.Spec.Resources = storageSpec.VolumeClaimTemplate.Spec.Resources
+		pvcTemplate.Spec.Selector = storageSpec.VolumeClaimTemplate.Spec.Selector
+		statefulset.Spec.VolumeClaimTemplates = append(statefulset.Spec.VolumeClaimTemplates, *pvcTemplate)
+	}
+
+	statefulset.Spec.Template.Spec.Volumes = append(statefulset.Spec.Template.Spec.Volumes, ts.Spec.Volumes...)
+
+	return statefulset, nil
+}
+
+func makeStatefulSetSpec(ts *monitoringv1alpha1.ThanosStore, config Config) (*appsv1.StatefulSetSpec, error) {
+	thanosVersion := operator.StringValOrDefault(ptr.Deref(ts.Spec.Version, ""), operator.DefaultThanosVersion)
+
+	imagePath, err := operator.BuildImagePath(
+		ptr.Deref(ts.Spec.Image, ""),
+		operator.StringValOrDefault(config.ThanosDefaultBaseImage, operator.DefaultThanosBaseImage),
+		thanosVersion,
+		"",
+		"",
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build image path: %w", err)
+	}
+
+	objstoreMountPath := filepath.Join(configDir, "objstore-config")
+	volumes := []v1.Volume{
+		{
+			Name: "objstore-config",
+			VolumeSource: v1.VolumeSource{
+				Secret: &v1.SecretVolumeSource{
+					SecretName: ts.Spec.ObjectStorageConfig.Name,
+					Items: []v1.KeyToPath{
+						{
+							Key:  ts.Spec.ObjectStorageConfig.Key,
+							Path: ts.Spec.ObjectStorageConfig.Key,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	storageVolName := volumeName(ts.Name)
+	if ts.Spec.Storage != nil && ts.Spec.Storage.VolumeClaimTemplate.Name != "" {
+		storageVolName = ts.Spec.Storage.VolumeClaimTemplate.Name
+	}
+
+	volumeMounts := []v1.VolumeMount{
+		{
+			Name:      "objstore-config",
+			MountPath: objstoreMountPath,
+			ReadOnly:  true,
+		},
+		{
+			Name:      storageVolName,
+			MountPath: storageDir,
+		},
+	}
+	volumeMounts = append(volumeMounts, ts.Spec.VolumeMounts...)
+
+	args := []monitoringv1.Argument{
+		{Name: "data-dir", Value: storageDir},
+		{Name: "objstore.config-file", Value: filepath.Join(objstoreMountPath, ts.Spec.ObjectStorageConfig.Key)},
+	}
+
+	if ts.Spec.MinTime != nil {
+		args = append(args, monitoringv1.Argument{Name: "min-time", Value: *ts.Spec.MinTime})
+	}
+
+	if ts.Spec.MaxTime != nil {
+		args = append(args, monitoringv1.Argument{Name: "max-time", Value: *ts.Spec.MaxTime})
+	}
+
+	if ts.Spec.LogLevel != "" && ts.Spec.LogLevel != "info" {
+		args = append(args, monitoringv1.Argument{Name: "log.level", Value: ts.Spec.LogLevel})
+	}
+
+	if ts.Spec.LogFormat != "" {
+		args = append(args, monitoringv1.Argument{Name: "log.format", Value: ts.Spec.LogFormat})
+	}
+
+	containerArgs, err := operator.BuildArgs(args, ts.Spec.AdditionalArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	// The first argument to thanos must be "store" to start the store gateway, e.g. "thanos store --data-dir..."
+	containerArgs = append([]string{"store"}, containerArgs...)
+
+	podAnnotations := map[string]string{}
+	podLabels := map[string]string{}
+	if ts.Spec.PodMetadata != nil {
+		maps.Copy(podLabels, ts.Spec.PodMetadata.Labels)
+		maps.Copy(podAnnotations, ts.Spec.PodMetadata.Annotations)
+	}
+
+	// In cases where an existing selector label is modified, or a new one is added, new sts cannot match existing pods.
+	// We should try to avoid removing such immutable fields whenever possible since doing
+	// so forces us to enter the 'recreate cycle' and can potentially lead to downtime.
+	// The requirement to make a change here should be carefully evaluated.
+	selectorLabels := makeSelectorLabels(ts.Name)
+
+	finalLabels := config.Labels.Merge(podLabels)
+	maps.Copy(finalLabels, selectorLabels)
+
+	podAnnotations["kubectl.kubernetes.io/default-container"] = containerName
+
+	operatorContainers := []v1.Container{
+		{
+			Name:            containerName,
+			Image:           imagePath,
+			ImagePullPolicy: ts.Spec.ImagePullPolicy,
+			Args:            containerArgs,
+			VolumeMounts:    volumeMounts,
+			Resources:       ts.Spec.Resources,
+			Ports: []v1.ContainerPort{
+				{
+					Name:          "grpc",
+					ContainerPort: 10901,
+					Protocol:      v1.ProtocolTCP,
+				},
+				{
+					Name:          "http",
+					ContainerPort: 10902,
+					Protocol:      v1.ProtocolTCP,
+				},
+			},
+			TerminationMessagePolicy: v1.TerminationMessageFallbackToLogsOnError,
+			SecurityContext: &v1.SecurityContext{
+				AllowPrivilegeEscalation: ptr.To(false),
+				ReadOnlyRootFilesystem:   ptr.To(true),
+				Capabilities: &v1.Capabilities{
+					Drop: []v1.Capability{"ALL"},
+				},
+			},
+		},
+	}
+
+	containers, err := k8sutil.MergePatchContainers(operatorContainers, ts.Spec.Containers)
+	if err != nil {
+		return nil, fmt.Errorf("failed to merge containers spec: %w", err)
+	}
+
+	return &appsv1.StatefulSetSpec{
+		ServiceName: prefixedName(ts.Name),
+		Replicas:    ts.Spec.Replicas,
+		// PodManagementPolicy is set to Parallel to mitigate issues in kubernetes: https://github.com/kubernetes/kubernetes/issues/60164
+		// This is also mentioned as one of limitations of StatefulSets: https://kubernetes.io/docs/concepts/workloads/controllers/statefulset/#limitations
+		PodManagementPolicy: appsv1.ParallelPodManagement,
+		UpdateStrategy: appsv1.StatefulSetUpdateStrategy{
+			Type: appsv1.RollingUpdateStatefulSetStrategyType,
+		},
+		Selector: &metav1.LabelSelector{
+			MatchLabels: finalLabels,
+		},
+		Template: v1.PodTemplateSpec{
+			ObjectMeta: metav1.ObjectMeta{
+				Labels:      finalLabels,
+				Annotations: podAnnotations,
+			},
+			Spec: v1.PodSpec{
+				NodeSelector:                  ts.Spec.NodeSelector,
+				PriorityClassName:             ts.Spec.PriorityClassName,
+				ServiceAccountName:            ts.Spec.ServiceAccountName,
+				TerminationGracePeriodSeconds: ptr.To(defaultTerminationGracePeriodSeconds),
+				Containers:                    containers,
+				Volumes:                       volumes,
+				SecurityContext:               ts.Spec.SecurityContext,
+				Tolerations:                   ts.Spec.Tolerations,
+				Affinity:                      ts.Spec.Affinity,
+				TopologySpreadConstraints:     ts.Spec.TopologySpreadConstraints,
+			},
+		},
+	}, nil
+}
+
+func makeStatefulSetService(ts *monitoringv1alpha1.ThanosStore, config Config) *v1.Service {
+	svc := &v1.Service{
+		Spec: v1.ServiceSpec{
+			ClusterIP: "None",
+			Ports: []v1.ServicePort{
+				{
+					Name:       "grpc",
+					Port:       10901,
+					TargetPort: intstr.FromString("grpc"),
+					Protocol:   v1.ProtocolTCP,
+				},
+				{
+					Name:       "http",
+					Port:       10902,
+					TargetPort: intstr.FromString("http"),
+					Protocol:   v1.ProtocolTCP,
+				},
+			},
+			Selector: makeSelectorLabels(ts.Name),
+		},
+	}
+
+	operator.UpdateObject(
+		svc,
+		operator.WithName(prefixedName(ts.Name)),
+		operator.WithAnnotations(config.Annotations),
+		operator.WithLabels(map[string]string{"operated-thanos-store": "true"}),
+		operator.WithLabels(config.Labels),
+		operator.WithOwner(ts),
+	)
+
+	return svc
+}
+
+// In cases where an existing selector label is modified, or a new one is added, new sts cannot match existing pods.
+// We should try to avoid removing such immutable fields whenever possible since doing
+// so forces us to enter the 'recreate cycle' and can potentially lead to downtime.
+// The requirement to make a change here should be carefully evaluated.
+func makeSelectorLabels(name string) map[string]string {
+	return map[string]string{
+		"app.kubernetes.io/name":       thanosStoreLabel,
+		"app.kubernetes.io/managed-by": "prometheus-operator",
+		"app.kubernetes.io/instance":   name,
+		thanosStoreLabel:               name,
+	}
+}
+
+// ServiceName returns the name of the headless service exposing the pods of
+// the given ThanosStore resource.
+func ServiceName(name string) string {
+	return prefixedName(name)
+}
+
+func prefixedName(name string) string {
+	return fmt.Sprintf("thanos-store-%s", name)
+}
+
+func volumeName(name string) string {
+	return fmt.Sprintf("%s-data", prefixedName(name))
+}
@@ -0,0 +1,160 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+)
+
+var defaultTestConfig = Config{
+	ThanosDefaultBaseImage: operator.DefaultThanosBaseImage,
+}
+
+func makeThanosStore() *monitoringv1alpha1.ThanosStore {
+	return &monitoringv1alpha1.ThanosStore{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test",
+			Namespace: "ns",
+		},
+		Spec: monitoringv1alpha1.ThanosStoreSpec{
+			ObjectStorageConfig: v1.SecretKeySelector{
+				LocalObjectReference: v1.LocalObjectReference{Name: "objstore"},
+				Key:                  "config.yaml",
+			},
+		},
+	}
+}
+
+func TestStatefulSetArgs(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		spec     func(*monitoringv1alpha1.ThanosStoreSpec)
+		expected []string
+	}{
+		{
+			name: "default",
+			spec: func(*monitoringv1alpha1.ThanosStoreSpec) {},
+			expected: []string{
+				"store",
+				"--data-dir=/thanos/data",
+				"--objstore.config-file=/etc/thanos/config/objstore-config/config.yaml",
+			},
+		},
+		{
+			name: "time range and logging",
+			spec: func(s *monitoringv1alpha1.ThanosStoreSpec) {
+				s.MinTime = ptr.To("-4w")
+				s.MaxTime = ptr.To("-2h")
+				s.LogLevel = "debug"
+				s.LogFormat = "json"
+			},
+			expected: []string{
+				"store",
+				"--data-dir=/thanos/data",
+				"--objstore.config-file=/etc/thanos/config/objstore-config/config.yaml",
+				"--min-time=-4w",
+				"--max-time=-2h",
+				"--log.level=debug",
+				"--log.format=json",
+			},
+		},
+		{
+			name: "additional args",
+			spec: func(s *monitoringv1alpha1.ThanosStoreSpec) {
+				s.AdditionalArgs = []monitoringv1.Argument{{Name: "store.grpc.series-max-concurrency", Value: "10"}}
+			},
+			expected: []string{
+				"store",
+				"--data-dir=/thanos/data",
+				"--objstore.config-file=/etc/thanos/config/objstore-config/config.yaml",
+				"--store.grpc.series-max-concurrency=10",
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := makeThanosStore()
+			tc.spec(&ts.Spec)
+
+			sset, err := makeStatefulSet(ts, defaultTestConfig, "")
+			require.NoError(t, err)
+
+			require.Equal(t, "thanos-store-test", sset.Name)
+			require.Equal(t, "thanos-store-test", sset.Spec.ServiceName)
+			require.Len(t, sset.Spec.Template.Spec.Containers, 1)
+			require.Equal(t, tc.expected, sset.Spec.Template.Spec.Containers[0].Args)
+		})
+	}
+}
+
+func TestStatefulSetConflictingArgs(t *testing.T) {
+	ts := makeThanosStore()
+	ts.Spec.AdditionalArgs = []monitoringv1.Argument{{Name: "data-dir", Value: "/tmp"}}
+
+	_, err := makeStatefulSet(ts, defaultTestConfig, "")
+	require.Error(t, err)
+}
+
+func TestStatefulSetStorage(t *testing.T) {
+	ts := makeThanosStore()
+	sset, err := makeStatefulSet(ts, defaultTestConfig, "")
+	require.NoError(t, err)
+	require.Empty(t, sset.Spec.VolumeClaimTemplates)
+	require.Contains(t, sset.Spec.Template.Spec.Volumes, v1.Volume{
+		Name: "thanos-store-test-data",
+		VolumeSource: v1.VolumeSource{
+			EmptyDir: &v1.EmptyDirVolumeSource{},
+		},
+	})
+
+	ts.Spec.Storage = &monitoringv1.StorageSpec{
+		VolumeClaimTemplate: monitoringv1.EmbeddedPersistentVolumeClaim{
+			Spec: v1.PersistentVolumeClaimSpec{
+				Resources: v1.VolumeResourceRequirements{
+					Requests: v1.ResourceList{
+						v1.ResourceStorage: resource.MustParse("10Gi"),
+					},
+				},
+			},
+		},
+	}
+	sset, err = makeStatefulSet(ts, defaultTestConfig, "")
+	require.NoError(t, err)
+	require.Len(t, sset.Spec.VolumeClaimTemplates, 1)
+	require.Equal(t, "thanos-store-test-data", sset.Spec.VolumeClaimTemplates[0].Name)
+	require.Equal(t, []v1.PersistentVolumeAccessMode{v1.ReadWriteOnce}, sset.Spec.VolumeClaimTemplates[0].Spec.AccessModes)
+	for _, vol := range sset.Spec.Template.Spec.Volumes {
+		require.NotEqual(t, "thanos-store-test-data", vol.Name)
+	}
+}
+
+func TestStatefulSetService(t *testing.T) {
+	svc := makeStatefulSetService(makeThanosStore(), defaultTestConfig)
+
+	require.Equal(t, "thanos-store-test", svc.Name)
+	require.Equal(t, "None", svc.Spec.ClusterIP)
+	require.Equal(t, makeSelectorLabels("test"), svc.Spec.Selector)
+	require.Equal(t, "grpc", svc.Spec.Ports[0].Name)
+	require.Equal(t, int32(10901), svc.Spec.Ports[0].Port)
+}